@@ -17,6 +17,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/spaghetti-lover/qairlines/config"
 	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api"
 	"github.com/spaghetti-lover/qairlines/internal/infra/mail"
 	"github.com/spaghetti-lover/qairlines/internal/infra/worker"
@@ -75,6 +76,10 @@ func main() {
 		log.Fatal("failed to create Redis client")
 	}
 	store := db.NewStore(connPool)
+	// Cấp số vé điện tử cho các vé cũ theo tiền tố hãng trong cấu hình
+	if _, err := store.AssignTicketNumbersTx(ctx, cfg.AirlineTicketPrefix); err != nil {
+		log.Fatal("cannot assign ticket numbers: ", err)
+	}
 
 	redisOpt := asynq.RedisClientOpt{
 		Addr: cfg.RedisAddress,
@@ -85,7 +90,9 @@ func main() {
 	waitGroup, ctx := errgroup.WithContext(ctx)
 
 	// Start task processor in goroutine
-	runTaskProcessor(ctx, waitGroup, cfg, redisOpt, store)
+	runTaskProcessor(ctx, waitGroup, cfg, redisOpt, store, taskDistributor)
+	// Start periodic task scheduler in goroutine
+	runTaskScheduler(ctx, waitGroup, cfg, redisOpt)
 	// Start server in goroutine
 	runApiServer(ctx, waitGroup, cfg, redis, store, taskDistributor)
 
@@ -127,9 +134,28 @@ func runApiServer(ctx context.Context, waitGroup *errgroup.Group, config config.
 	})
 }
 
-func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config config.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
+func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config config.Config, redisOpt asynq.RedisClientOpt, store db.Store, taskDistributor worker.TaskDistributor) {
 	mailer := mail.NewGmailSender(config.MailSenderName, config.MailSenderAddress, config.MailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
+	loyaltyRules := entities.LoyaltyRules{
+		AmountPerPoint: config.LoyaltyAmountPerPoint,
+		PointValue:     config.LoyaltyPointValue,
+		PointsTTL:      config.LoyaltyPointsTTL,
+	}
+	tierPolicy := entities.TierPolicy{
+		Window:                 config.LoyaltyTierWindow,
+		SilverPoints:           config.LoyaltySilverPoints,
+		GoldPoints:             config.LoyaltyGoldPoints,
+		PlatinumPoints:         config.LoyaltyPlatinumPoints,
+		SilverExtraBaggageKg:   config.LoyaltySilverExtraBaggageKg,
+		GoldExtraBaggageKg:     config.LoyaltyGoldExtraBaggageKg,
+		PlatinumExtraBaggageKg: config.LoyaltyPlatinumExtraBaggageKg,
+		FreeSeatSelectionTier:  entities.LoyaltyTier(config.LoyaltyFreeSeatSelectionTier),
+		WaitlistPriorityTier:   entities.LoyaltyTier(config.LoyaltyWaitlistPriorityTier),
+		PriorityBoardingTier:   entities.LoyaltyTier(config.LoyaltyPriorityBoardingTier),
+		LoungeAccessTier:       entities.LoyaltyTier(config.LoyaltyLoungeAccessTier),
+	}
+	cabinLayout := entities.CabinLayout{FirstClassRows: config.FirstClassRows, BusinessRows: config.BusinessRows}
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer, taskDistributor, config.WaitlistOfferTTL, config.WaitlistClaimURL, loyaltyRules, tierPolicy, cabinLayout)
 	log.Println("Task processor started")
 	if err := taskProcessor.Start(); err != nil {
 		log.Fatalf("Failed to start task processor: %v", err)
@@ -142,3 +168,18 @@ func runTaskProcessor(ctx context.Context, waitGroup *errgroup.Group, config con
 		return nil
 	})
 }
+
+func runTaskScheduler(ctx context.Context, waitGroup *errgroup.Group, config config.Config, redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt, config.LoyaltyTierRecalculationInterval)
+	log.Println("Task scheduler started")
+	if err := taskScheduler.Start(); err != nil {
+		log.Fatalf("Failed to start task scheduler: %v", err)
+	}
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Println("Shutting down task scheduler...")
+		taskScheduler.Shutdown()
+		log.Println("Task scheduler gracefully stopped")
+		return nil
+	})
+}
@@ -22,9 +22,78 @@ type Config struct {
 	RateLimiterRequestSec   int           `mapstructure:"RATE_LIMITER_REQUEST_SEC"`
 	RateLimiterRequestBurst int           `mapstructure:"RATE_LIMITER_REQUEST_BURST"`
 	StripeSecretKey         string        `mapstructure:"STRIPE_SECRET_KEY"`
+	StripeWebhookSecret     string        `mapstructure:"STRIPE_WEBHOOK_SECRET"`
 	RedisDB                 string        `mapstructure:"REDIS_DB"`
 	RedisUsername           string        `mapstructure:"REDIS_USERNAME"`
 	RedisPassword           string        `mapstructure:"REDIS_PASSWORD"`
+	// Manage booking (tra cứu booking bằng PNR + họ hành khách)
+	ManageBookingTokenDuration time.Duration `mapstructure:"MANAGE_BOOKING_TOKEN_DURATION"`
+	ManageBookingLookupPerMin  int           `mapstructure:"MANAGE_BOOKING_LOOKUP_PER_MIN"`
+	// 3 số đầu của số vé điện tử (IATA airline code)
+	AirlineTicketPrefix string `mapstructure:"AIRLINE_TICKET_PREFIX"`
+	// Quy tắc hoàn tiền khi huỷ booking
+	RefundFullBefore               time.Duration `mapstructure:"REFUND_FULL_BEFORE"`
+	RefundPartialBefore            time.Duration `mapstructure:"REFUND_PARTIAL_BEFORE"`
+	RefundPartialPercentEconomy    int           `mapstructure:"REFUND_PARTIAL_PERCENT_ECONOMY"`
+	RefundPartialPercentBusiness   int           `mapstructure:"REFUND_PARTIAL_PERCENT_BUSINESS"`
+	RefundPartialPercentFirstClass int           `mapstructure:"REFUND_PARTIAL_PERCENT_FIRST_CLASS"`
+	// Phí đổi chuyến bay và đơn vị tiền tệ dùng khi thu/hoàn tiền chênh lệch
+	FlightChangeFee int64  `mapstructure:"FLIGHT_CHANGE_FEE"`
+	PaymentCurrency string `mapstructure:"PAYMENT_CURRENCY"`
+	// Thời gian lưu kết quả của request có Idempotency-Key
+	IdempotencyKeyTTL time.Duration `mapstructure:"IDEMPOTENCY_KEY_TTL"`
+	// Thời gian nối chuyến tối thiểu giữa hai chặng liên tiếp của một booking
+	MinConnectionTime time.Duration `mapstructure:"MIN_CONNECTION_TIME"`
+	// Giá từng hạng ghế tính theo % giá cơ bản của chuyến bay
+	EconomyFarePercent    int64 `mapstructure:"ECONOMY_FARE_PERCENT"`
+	BusinessFarePercent   int64 `mapstructure:"BUSINESS_FARE_PERCENT"`
+	FirstClassFarePercent int64 `mapstructure:"FIRST_CLASS_FARE_PERCENT"`
+	// Số hàng ghế đầu máy bay dành cho hạng nhất và hạng thương gia, các hàng còn lại là hạng phổ thông
+	FirstClassRows int32 `mapstructure:"FIRST_CLASS_ROWS"`
+	BusinessRows   int32 `mapstructure:"BUSINESS_ROWS"`
+	// Giá vé trẻ em / em bé tính theo % giá người lớn và số em bé tối đa mỗi người lớn
+	ChildFarePercent   int64 `mapstructure:"CHILD_FARE_PERCENT"`
+	InfantFarePercent  int64 `mapstructure:"INFANT_FARE_PERCENT"`
+	MaxInfantsPerAdult int   `mapstructure:"MAX_INFANTS_PER_ADULT"`
+	// Thuế GTGT (% giá cơ bản) và các loại phí thu trên mỗi vé có ghế
+	FareVATPercent int64 `mapstructure:"FARE_VAT_PERCENT"`
+	AirportFee     int64 `mapstructure:"AIRPORT_FEE"`
+	SecurityFee    int64 `mapstructure:"SECURITY_FEE"`
+	// Thời gian giữ giá vé đã khóa cho khách trước khi đặt chỗ
+	FareQuoteTTL time.Duration `mapstructure:"FARE_QUOTE_TTL"`
+	// Thời gian giữ danh sách gói giá trong cache
+	FareFamilyCacheTTL time.Duration `mapstructure:"FARE_FAMILY_CACHE_TTL"`
+	// Thời gian khách trong danh sách chờ được giữ ghế trống và link nhận ghế gửi qua email
+	WaitlistOfferTTL time.Duration `mapstructure:"WAITLIST_OFFER_TTL"`
+	WaitlistClaimURL string        `mapstructure:"WAITLIST_CLAIM_URL"`
+	// Số khách tối thiểu để gửi yêu cầu đặt chỗ theo đoàn
+	GroupBookingMinPassengers int32 `mapstructure:"GROUP_BOOKING_MIN_PASSENGERS"`
+	// Điểm thưởng: số tiền vé cho 1 điểm, giá trị 1 điểm khi thanh toán và thời hạn dùng điểm
+	LoyaltyAmountPerPoint int64         `mapstructure:"LOYALTY_AMOUNT_PER_POINT"`
+	LoyaltyPointValue     int64         `mapstructure:"LOYALTY_POINT_VALUE"`
+	LoyaltyPointsTTL      time.Duration `mapstructure:"LOYALTY_POINTS_TTL"`
+	// Hạng thành viên: điểm xét hạng tối thiểu tích lũy trong khoảng LoyaltyTierWindow và chu kỳ xét lại hạng
+	LoyaltySilverPoints              int32         `mapstructure:"LOYALTY_SILVER_POINTS"`
+	LoyaltyGoldPoints                int32         `mapstructure:"LOYALTY_GOLD_POINTS"`
+	LoyaltyPlatinumPoints            int32         `mapstructure:"LOYALTY_PLATINUM_POINTS"`
+	LoyaltyTierWindow                time.Duration `mapstructure:"LOYALTY_TIER_WINDOW"`
+	LoyaltyTierRecalculationInterval time.Duration `mapstructure:"LOYALTY_TIER_RECALCULATION_INTERVAL"`
+	// Quyền lợi hạng thành viên: số kg hành lý ký gửi cộng thêm của từng hạng và hạng thấp nhất được hưởng từng quyền lợi
+	LoyaltySilverExtraBaggageKg   int32  `mapstructure:"LOYALTY_SILVER_EXTRA_BAGGAGE_KG"`
+	LoyaltyGoldExtraBaggageKg     int32  `mapstructure:"LOYALTY_GOLD_EXTRA_BAGGAGE_KG"`
+	LoyaltyPlatinumExtraBaggageKg int32  `mapstructure:"LOYALTY_PLATINUM_EXTRA_BAGGAGE_KG"`
+	LoyaltyFreeSeatSelectionTier  string `mapstructure:"LOYALTY_FREE_SEAT_SELECTION_TIER"`
+	LoyaltyWaitlistPriorityTier   string `mapstructure:"LOYALTY_WAITLIST_PRIORITY_TIER"`
+	LoyaltyPriorityBoardingTier   string `mapstructure:"LOYALTY_PRIORITY_BOARDING_TIER"`
+	LoyaltyLoungeAccessTier       string `mapstructure:"LOYALTY_LOUNGE_ACCESS_TIER"`
+	// Ví tín dụng: thời hạn sử dụng của tiền hoàn vào ví và tín dụng thiện chí
+	WalletCreditTTL time.Duration `mapstructure:"WALLET_CREDIT_TTL"`
+	// Giấy tờ hành khách: mã quốc gia của mạng bay nội địa và số tháng hộ chiếu phải còn hạn sau ngày về của hành trình quốc tế
+	HomeCountry            string `mapstructure:"HOME_COUNTRY"`
+	PassportValidityMonths int    `mapstructure:"PASSPORT_VALIDITY_MONTHS"`
+	// Làm thủ tục trực tuyến: mở trước giờ khởi hành CheckInOpensBefore và đóng trước CheckInClosesBefore
+	CheckInOpensBefore  time.Duration `mapstructure:"CHECK_IN_OPENS_BEFORE"`
+	CheckInClosesBefore time.Duration `mapstructure:"CHECK_IN_CLOSES_BEFORE"`
 }
 
 // LoadConfig reads configuration from file or environment variables.
@@ -33,6 +102,54 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.SetConfigName(".env")
 	viper.SetConfigType("env")
 	viper.AutomaticEnv()
+	viper.SetDefault("MANAGE_BOOKING_TOKEN_DURATION", 30*time.Minute)
+	viper.SetDefault("MANAGE_BOOKING_LOOKUP_PER_MIN", 5)
+	viper.SetDefault("AIRLINE_TICKET_PREFIX", "888")
+	viper.SetDefault("REFUND_FULL_BEFORE", 7*24*time.Hour)
+	viper.SetDefault("REFUND_PARTIAL_BEFORE", 24*time.Hour)
+	viper.SetDefault("REFUND_PARTIAL_PERCENT_ECONOMY", 50)
+	viper.SetDefault("REFUND_PARTIAL_PERCENT_BUSINESS", 75)
+	viper.SetDefault("REFUND_PARTIAL_PERCENT_FIRST_CLASS", 90)
+	viper.SetDefault("FLIGHT_CHANGE_FEE", 300000)
+	viper.SetDefault("PAYMENT_CURRENCY", "vnd")
+	viper.SetDefault("IDEMPOTENCY_KEY_TTL", 24*time.Hour)
+	viper.SetDefault("MIN_CONNECTION_TIME", 45*time.Minute)
+	viper.SetDefault("ECONOMY_FARE_PERCENT", 100)
+	viper.SetDefault("BUSINESS_FARE_PERCENT", 150)
+	viper.SetDefault("FIRST_CLASS_FARE_PERCENT", 200)
+	viper.SetDefault("FIRST_CLASS_ROWS", 2)
+	viper.SetDefault("BUSINESS_ROWS", 6)
+	viper.SetDefault("CHILD_FARE_PERCENT", 75)
+	viper.SetDefault("INFANT_FARE_PERCENT", 10)
+	viper.SetDefault("MAX_INFANTS_PER_ADULT", 1)
+	viper.SetDefault("FARE_VAT_PERCENT", 10)
+	viper.SetDefault("AIRPORT_FEE", 100000)
+	viper.SetDefault("SECURITY_FEE", 20000)
+	viper.SetDefault("FARE_QUOTE_TTL", 15*time.Minute)
+	viper.SetDefault("FARE_FAMILY_CACHE_TTL", 10*time.Minute)
+	viper.SetDefault("WAITLIST_OFFER_TTL", 2*time.Hour)
+	viper.SetDefault("WAITLIST_CLAIM_URL", "http://localhost:3000/waitlist/claim")
+	viper.SetDefault("GROUP_BOOKING_MIN_PASSENGERS", 10)
+	viper.SetDefault("LOYALTY_AMOUNT_PER_POINT", 10000)
+	viper.SetDefault("LOYALTY_POINT_VALUE", 100)
+	viper.SetDefault("LOYALTY_POINTS_TTL", 2*365*24*time.Hour)
+	viper.SetDefault("LOYALTY_SILVER_POINTS", 2000)
+	viper.SetDefault("LOYALTY_GOLD_POINTS", 5000)
+	viper.SetDefault("LOYALTY_PLATINUM_POINTS", 10000)
+	viper.SetDefault("LOYALTY_TIER_WINDOW", 365*24*time.Hour)
+	viper.SetDefault("LOYALTY_TIER_RECALCULATION_INTERVAL", 24*time.Hour)
+	viper.SetDefault("LOYALTY_SILVER_EXTRA_BAGGAGE_KG", 10)
+	viper.SetDefault("LOYALTY_GOLD_EXTRA_BAGGAGE_KG", 15)
+	viper.SetDefault("LOYALTY_PLATINUM_EXTRA_BAGGAGE_KG", 20)
+	viper.SetDefault("LOYALTY_FREE_SEAT_SELECTION_TIER", "gold")
+	viper.SetDefault("LOYALTY_WAITLIST_PRIORITY_TIER", "silver")
+	viper.SetDefault("LOYALTY_PRIORITY_BOARDING_TIER", "gold")
+	viper.SetDefault("LOYALTY_LOUNGE_ACCESS_TIER", "gold")
+	viper.SetDefault("WALLET_CREDIT_TTL", 365*24*time.Hour)
+	viper.SetDefault("HOME_COUNTRY", "VN")
+	viper.SetDefault("PASSPORT_VALIDITY_MONTHS", 6)
+	viper.SetDefault("CHECK_IN_OPENS_BEFORE", 24*time.Hour)
+	viper.SetDefault("CHECK_IN_CLOSES_BEFORE", time.Hour)
 	err = viper.ReadInConfig()
 	if err != nil {
 		return
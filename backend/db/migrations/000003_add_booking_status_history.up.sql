@@ -0,0 +1,16 @@
+CREATE TABLE booking_status_history (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  from_status booking_status,
+  to_status booking_status NOT NULL,
+  actor VARCHAR(255) NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  created_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_booking_status_history_booking_id ON booking_status_history (booking_id);
+
+-- Ghi lại trạng thái hiện tại của các booking đã có làm mốc lịch sử đầu tiên
+INSERT INTO booking_status_history (booking_id, from_status, to_status, actor, reason, created_at)
+SELECT booking_id, NULL, status, 'system', 'imported existing booking', created_at
+FROM Bookings;
@@ -0,0 +1,2 @@
+ALTER TABLE Bookings DROP CONSTRAINT IF EXISTS bookings_pnr_key;
+ALTER TABLE Bookings DROP COLUMN IF EXISTS pnr;
@@ -0,0 +1,24 @@
+ALTER TABLE Bookings ADD COLUMN pnr VARCHAR(6);
+
+-- Sinh mã PNR cho các booking đã có. Bảng chữ cái giống utils.GeneratePNR
+-- (bỏ các ký tự dễ nhầm 0, O, 1, I, L).
+DO $$
+DECLARE
+  alphabet CONSTANT TEXT := 'ABCDEFGHJKMNPQRSTUVWXYZ23456789';
+  rec RECORD;
+  candidate TEXT;
+BEGIN
+  FOR rec IN SELECT booking_id FROM Bookings WHERE pnr IS NULL LOOP
+    LOOP
+      candidate := '';
+      FOR i IN 1..6 LOOP
+        candidate := candidate || substr(alphabet, 1 + floor(random() * length(alphabet))::INT, 1);
+      END LOOP;
+      EXIT WHEN NOT EXISTS (SELECT 1 FROM Bookings WHERE pnr = candidate);
+    END LOOP;
+    UPDATE Bookings SET pnr = candidate WHERE booking_id = rec.booking_id;
+  END LOOP;
+END $$;
+
+ALTER TABLE Bookings ALTER COLUMN pnr SET NOT NULL;
+ALTER TABLE Bookings ADD CONSTRAINT bookings_pnr_key UNIQUE (pnr);
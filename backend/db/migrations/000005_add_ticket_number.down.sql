@@ -0,0 +1,3 @@
+ALTER TABLE Tickets DROP CONSTRAINT IF EXISTS tickets_ticket_number_key;
+ALTER TABLE Tickets DROP COLUMN IF EXISTS ticket_number;
+DROP SEQUENCE IF EXISTS ticket_number_seq;
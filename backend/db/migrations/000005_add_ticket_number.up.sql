@@ -0,0 +1,8 @@
+CREATE SEQUENCE IF NOT EXISTS ticket_number_seq START 1;
+
+-- Số vé điện tử 13 chữ số: 3 số đầu hãng (AIRLINE_TICKET_PREFIX) + 9 số serial + 1 số kiểm tra.
+-- Vé đã có trước migration này được cấp số khi server khởi động (AssignTicketNumbersTx) để dùng đúng
+-- tiền tố hãng trong cấu hình thay vì giá trị cố định trong SQL.
+ALTER TABLE Tickets ADD COLUMN ticket_number VARCHAR(13);
+
+ALTER TABLE Tickets ADD CONSTRAINT tickets_ticket_number_key UNIQUE (ticket_number);
@@ -0,0 +1,10 @@
+CREATE TABLE refunds (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  amount BIGINT NOT NULL CHECK (amount >= 0),
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  reason TEXT NOT NULL DEFAULT '',
+  created_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_refunds_booking_id ON refunds (booking_id);
@@ -0,0 +1,5 @@
+-- PostgreSQL không hỗ trợ xoá giá trị khỏi enum; tạo lại kiểu không có 'multiCity'
+ALTER TABLE Bookings ALTER COLUMN trip_type TYPE VARCHAR(20);
+DROP TYPE trip_type;
+CREATE TYPE trip_type AS ENUM ('oneWay', 'roundTrip');
+ALTER TABLE Bookings ALTER COLUMN trip_type TYPE trip_type USING trip_type::trip_type;
@@ -0,0 +1,2 @@
+-- Giá trị enum mới phải được commit trước khi dùng trong ràng buộc ở migration sau
+ALTER TYPE trip_type ADD VALUE IF NOT EXISTS 'multiCity';
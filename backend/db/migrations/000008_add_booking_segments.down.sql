@@ -0,0 +1,9 @@
+DELETE FROM Bookings WHERE trip_type = 'multiCity';
+
+ALTER TABLE Bookings DROP CONSTRAINT IF EXISTS bookings_trip_type_check;
+ALTER TABLE Bookings ADD CONSTRAINT bookings_return_flight_id_check CHECK (
+  trip_type = 'oneWay' AND return_flight_id IS NULL
+  OR trip_type = 'roundTrip' AND return_flight_id IS NOT NULL
+);
+
+DROP TABLE IF EXISTS booking_segments;
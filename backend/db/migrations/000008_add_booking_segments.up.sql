@@ -0,0 +1,30 @@
+CREATE TABLE booking_segments (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  segment_order SMALLINT NOT NULL CHECK (segment_order BETWEEN 1 AND 6),
+  flight_id BIGINT NOT NULL REFERENCES Flights(flight_id) ON DELETE CASCADE,
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT booking_segments_order_key UNIQUE (booking_id, segment_order),
+  CONSTRAINT booking_segments_flight_key UNIQUE (booking_id, flight_id)
+);
+
+CREATE INDEX idx_booking_segments_flight_id ON booking_segments (flight_id);
+
+-- Chuyển các booking hiện có sang mô hình chặng bay
+INSERT INTO booking_segments (booking_id, segment_order, flight_id, created_at)
+SELECT booking_id, 1, departure_flight_id, created_at
+FROM Bookings
+WHERE departure_flight_id IS NOT NULL;
+
+INSERT INTO booking_segments (booking_id, segment_order, flight_id, created_at)
+SELECT booking_id, 2, return_flight_id, created_at
+FROM Bookings
+WHERE return_flight_id IS NOT NULL;
+
+-- Booking multiCity chỉ lưu chặng đầu ở departure_flight_id, các chặng còn lại nằm trong booking_segments
+ALTER TABLE Bookings DROP CONSTRAINT IF EXISTS bookings_return_flight_id_check;
+ALTER TABLE Bookings ADD CONSTRAINT bookings_trip_type_check CHECK (
+  trip_type = 'oneWay' AND return_flight_id IS NULL
+  OR trip_type = 'roundTrip' AND return_flight_id IS NOT NULL
+  OR trip_type = 'multiCity' AND return_flight_id IS NULL
+);
@@ -0,0 +1,10 @@
+DELETE FROM Tickets WHERE passenger_type = 'infant';
+
+DROP INDEX IF EXISTS idx_tickets_accompanying_ticket_id;
+ALTER TABLE Tickets DROP CONSTRAINT IF EXISTS tickets_passenger_seat_check;
+ALTER TABLE Tickets
+  DROP COLUMN IF EXISTS accompanying_ticket_id,
+  DROP COLUMN IF EXISTS passenger_type,
+  ALTER COLUMN seat_id SET NOT NULL;
+
+DROP TYPE IF EXISTS passenger_type;
@@ -0,0 +1,14 @@
+CREATE TYPE passenger_type AS ENUM ('adult', 'child', 'infant');
+
+-- Em bé ngồi cùng người lớn nên không có ghế riêng và luôn gắn với vé của người lớn đi kèm
+ALTER TABLE Tickets
+  ADD COLUMN passenger_type passenger_type NOT NULL DEFAULT 'adult',
+  ADD COLUMN accompanying_ticket_id BIGINT REFERENCES Tickets(ticket_id) ON DELETE CASCADE,
+  ALTER COLUMN seat_id DROP NOT NULL;
+
+ALTER TABLE Tickets ADD CONSTRAINT tickets_passenger_seat_check CHECK (
+  passenger_type = 'infant' AND seat_id IS NULL AND accompanying_ticket_id IS NOT NULL
+  OR passenger_type <> 'infant' AND seat_id IS NOT NULL AND accompanying_ticket_id IS NULL
+);
+
+CREATE INDEX idx_tickets_accompanying_ticket_id ON Tickets (accompanying_ticket_id);
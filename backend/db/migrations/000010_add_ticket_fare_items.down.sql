@@ -0,0 +1 @@
+DROP TABLE IF EXISTS ticket_fare_items;
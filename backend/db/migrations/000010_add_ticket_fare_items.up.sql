@@ -0,0 +1,12 @@
+-- Chi tiết giá của từng vé: giá cơ bản, thuế và phí
+CREATE TABLE ticket_fare_items (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  ticket_id BIGINT NOT NULL REFERENCES Tickets(ticket_id) ON DELETE CASCADE,
+  item_type VARCHAR(20) NOT NULL,
+  code VARCHAR(20) NOT NULL,
+  description VARCHAR(100) NOT NULL DEFAULT '',
+  amount BIGINT NOT NULL CHECK (amount >= 0),
+  created_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_ticket_fare_items_ticket_id ON ticket_fare_items (ticket_id);
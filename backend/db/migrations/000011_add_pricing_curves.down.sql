@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_tickets_flight_id_status;
+DROP TABLE IF EXISTS pricing_curves;
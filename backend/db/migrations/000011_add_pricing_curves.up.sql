@@ -0,0 +1,14 @@
+-- Đường cong giá theo chặng bay và hạng ghế; mỗi bước là {"threshold": ..., "percent": ...}
+CREATE TABLE pricing_curves (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  departure_city VARCHAR(100) NOT NULL,
+  arrival_city VARCHAR(100) NOT NULL,
+  flight_class flight_class NOT NULL,
+  load_factor_steps JSONB NOT NULL DEFAULT '[]',
+  days_to_departure_steps JSONB NOT NULL DEFAULT '[]',
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT pricing_curves_route_class_key UNIQUE (departure_city, arrival_city, flight_class)
+);
+
+CREATE INDEX idx_tickets_flight_id_status ON Tickets (flight_id, status);
@@ -0,0 +1,4 @@
+ALTER TABLE Tickets DROP COLUMN IF EXISTS fare_family;
+
+DROP TABLE IF EXISTS fare_families;
+DROP TYPE IF EXISTS fare_family_type;
@@ -0,0 +1,32 @@
+CREATE TYPE fare_family_type AS ENUM ('lite', 'classic', 'flex');
+
+-- Mỗi hạng ghế bán nhiều gói giá; gói giá quyết định hành lý, phí đổi, hoàn tiền và quyền chọn ghế
+CREATE TABLE fare_families (
+  id BIGSERIAL PRIMARY KEY,
+  flight_class flight_class NOT NULL,
+  family fare_family_type NOT NULL,
+  name VARCHAR NOT NULL,
+  fare_percent INT NOT NULL CHECK (fare_percent > 0),
+  cabin_baggage_kg INT NOT NULL DEFAULT 7 CHECK (cabin_baggage_kg >= 0),
+  checked_baggage_kg INT NOT NULL DEFAULT 0 CHECK (checked_baggage_kg >= 0),
+  changeable BOOLEAN NOT NULL DEFAULT TRUE,
+  change_fee_waived BOOLEAN NOT NULL DEFAULT FALSE,
+  refundable BOOLEAN NOT NULL DEFAULT TRUE,
+  full_refund BOOLEAN NOT NULL DEFAULT FALSE,
+  seat_selection BOOLEAN NOT NULL DEFAULT TRUE,
+  CONSTRAINT fare_families_class_family_key UNIQUE (flight_class, family)
+);
+
+INSERT INTO fare_families (flight_class, family, name, fare_percent, cabin_baggage_kg, checked_baggage_kg, changeable, change_fee_waived, refundable, full_refund, seat_selection) VALUES
+  ('economy', 'lite', 'Economy Lite', 85, 7, 0, FALSE, FALSE, FALSE, FALSE, FALSE),
+  ('economy', 'classic', 'Economy Classic', 100, 7, 23, TRUE, FALSE, TRUE, FALSE, TRUE),
+  ('economy', 'flex', 'Economy Flex', 130, 10, 32, TRUE, TRUE, TRUE, TRUE, TRUE),
+  ('business', 'lite', 'Business Lite', 90, 10, 32, FALSE, FALSE, FALSE, FALSE, TRUE),
+  ('business', 'classic', 'Business Classic', 100, 14, 40, TRUE, FALSE, TRUE, FALSE, TRUE),
+  ('business', 'flex', 'Business Flex', 125, 14, 50, TRUE, TRUE, TRUE, TRUE, TRUE),
+  ('firstClass', 'lite', 'First Lite', 90, 14, 40, FALSE, FALSE, FALSE, FALSE, TRUE),
+  ('firstClass', 'classic', 'First Classic', 100, 18, 50, TRUE, FALSE, TRUE, FALSE, TRUE),
+  ('firstClass', 'flex', 'First Flex', 120, 18, 64, TRUE, TRUE, TRUE, TRUE, TRUE);
+
+-- Vé đã bán trước đây được xem là gói Classic, giữ nguyên quy định cũ
+ALTER TABLE Tickets ADD COLUMN fare_family fare_family_type NOT NULL DEFAULT 'classic';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ticket_ancillaries;
+DROP TABLE IF EXISTS ancillaries;
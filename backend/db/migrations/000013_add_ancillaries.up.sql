@@ -0,0 +1,43 @@
+-- Danh mục dịch vụ bổ trợ (hành lý, suất ăn, ưu tiên lên máy bay); để trống tuyến hoặc hạng ghế nghĩa là áp dụng cho tất cả
+CREATE TABLE ancillaries (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  code VARCHAR(20) NOT NULL,
+  ancillary_type VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  description VARCHAR(255) NOT NULL DEFAULT '',
+  departure_city VARCHAR(100) NOT NULL DEFAULT '',
+  arrival_city VARCHAR(100) NOT NULL DEFAULT '',
+  flight_class VARCHAR(20) NOT NULL DEFAULT '',
+  price BIGINT NOT NULL CHECK (price >= 0),
+  max_quantity INT NOT NULL DEFAULT 1 CHECK (max_quantity > 0),
+  active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT ancillaries_code_scope_key UNIQUE (code, departure_city, arrival_city, flight_class)
+);
+
+INSERT INTO ancillaries (code, ancillary_type, name, description, price, max_quantity) VALUES
+  ('BAG20', 'baggage', 'Extra baggage 20kg', 'One additional checked bag up to 20kg', 250000, 3),
+  ('BAG32', 'baggage', 'Extra baggage 32kg', 'One additional checked bag up to 32kg', 400000, 3),
+  ('MEAL', 'meal', 'Hot meal', 'Hot meal served on board', 120000, 2),
+  ('PRIORITY', 'priority_boarding', 'Priority boarding', 'Board the aircraft ahead of other passengers', 80000, 1);
+
+-- Dịch vụ bổ trợ đã mua cho từng vé, lưu giá tại thời điểm mua
+CREATE TABLE ticket_ancillaries (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  ticket_id BIGINT NOT NULL REFERENCES Tickets(ticket_id) ON DELETE CASCADE,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  ancillary_id BIGINT REFERENCES ancillaries(id) ON DELETE SET NULL,
+  code VARCHAR(20) NOT NULL,
+  ancillary_type VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  quantity INT NOT NULL CHECK (quantity > 0),
+  unit_price BIGINT NOT NULL CHECK (unit_price >= 0),
+  amount BIGINT NOT NULL CHECK (amount >= 0),
+  status VARCHAR(20) NOT NULL DEFAULT 'active',
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  cancelled_at timestamptz
+);
+
+CREATE INDEX idx_ticket_ancillaries_booking_id ON ticket_ancillaries (booking_id);
+CREATE INDEX idx_ticket_ancillaries_ticket_id ON ticket_ancillaries (ticket_id);
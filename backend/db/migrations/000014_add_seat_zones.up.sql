@@ -0,0 +1,18 @@
+-- Vùng ghế thu phí (hàng đầu, hàng thoát hiểm, ghế rộng chân) theo chuyến bay hoặc loại máy bay; ghế ngoài mọi vùng được chọn miễn phí
+CREATE TABLE seat_zones (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  flight_id BIGINT REFERENCES Flights(flight_id) ON DELETE CASCADE,
+  aircraft_type VARCHAR(100) NOT NULL DEFAULT '',
+  zone_type VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  row_from INT NOT NULL CHECK (row_from > 0),
+  row_to INT NOT NULL,
+  price BIGINT NOT NULL CHECK (price >= 0),
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT seat_zones_rows_check CHECK (row_to >= row_from),
+  CONSTRAINT seat_zones_scope_check CHECK (flight_id IS NOT NULL OR aircraft_type <> '')
+);
+
+CREATE INDEX idx_seat_zones_flight_id ON seat_zones (flight_id);
+CREATE INDEX idx_seat_zones_aircraft_type ON seat_zones (aircraft_type);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS waitlist_entries;
+ALTER TABLE Customers DROP COLUMN IF EXISTS loyalty_tier;
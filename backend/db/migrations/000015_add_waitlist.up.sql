@@ -0,0 +1,21 @@
+-- Hạng thành viên của khách hàng, dùng để xếp ưu tiên trong danh sách chờ
+ALTER TABLE Customers ADD COLUMN loyalty_tier VARCHAR(20) NOT NULL DEFAULT 'member';
+
+-- Danh sách chờ theo chuyến bay và hạng ghế khi khoang đã kín chỗ
+CREATE TABLE waitlist_entries (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  flight_id BIGINT NOT NULL REFERENCES Flights(flight_id) ON DELETE CASCADE,
+  flight_class flight_class NOT NULL,
+  user_email VARCHAR(255) NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+  claim_token VARCHAR(64) UNIQUE,
+  offer_expires_at timestamptz,
+  joined_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now())
+);
+
+-- Mỗi khách chỉ có một lượt chờ còn hiệu lực cho mỗi khoang của chuyến bay
+CREATE UNIQUE INDEX waitlist_entries_active_key ON waitlist_entries (flight_id, flight_class, user_email)
+  WHERE status IN ('waiting', 'offered');
+CREATE INDEX idx_waitlist_entries_queue ON waitlist_entries (flight_id, flight_class, status, joined_at);
+CREATE INDEX idx_waitlist_entries_user_email ON waitlist_entries (user_email);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS group_booking_passengers;
+DROP TABLE IF EXISTS group_bookings;
@@ -0,0 +1,41 @@
+-- Yêu cầu đặt chỗ theo đoàn (từ 10 khách): khách gửi hành trình và số khách, admin báo giá kèm tiền cọc và hạn cọc
+CREATE TABLE group_bookings (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_email VARCHAR(255) NOT NULL,
+  departure_city VARCHAR(100) NOT NULL,
+  arrival_city VARCHAR(100) NOT NULL,
+  departure_date timestamptz NOT NULL,
+  return_date timestamptz,
+  flight_class flight_class NOT NULL,
+  headcount INT NOT NULL CHECK (headcount > 0),
+  note TEXT NOT NULL DEFAULT '',
+  status VARCHAR(20) NOT NULL DEFAULT 'requested',
+  outbound_flight_id BIGINT REFERENCES Flights(flight_id) ON DELETE SET NULL,
+  return_flight_id BIGINT REFERENCES Flights(flight_id) ON DELETE SET NULL,
+  fare_per_passenger BIGINT NOT NULL DEFAULT 0 CHECK (fare_per_passenger >= 0),
+  deposit_amount BIGINT NOT NULL DEFAULT 0 CHECK (deposit_amount >= 0),
+  deposit_deadline timestamptz,
+  name_cutoff timestamptz,
+  deposit_paid_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_group_bookings_user_email ON group_bookings (user_email);
+CREATE INDEX idx_group_bookings_outbound_flight_id ON group_bookings (outbound_flight_id);
+CREATE INDEX idx_group_bookings_return_flight_id ON group_bookings (return_flight_id);
+
+-- Danh sách tên khách của đoàn, có thể bổ sung đến hạn chốt tên
+CREATE TABLE group_booking_passengers (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  group_booking_id BIGINT NOT NULL REFERENCES group_bookings(id) ON DELETE CASCADE,
+  first_name VARCHAR(100) NOT NULL,
+  last_name VARCHAR(100) NOT NULL,
+  phone_number VARCHAR(20) NOT NULL DEFAULT '',
+  gender gender_type NOT NULL,
+  date_of_birth DATE NOT NULL,
+  identification_number VARCHAR(50) NOT NULL DEFAULT '',
+  address TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_group_booking_passengers_group_booking_id ON group_booking_passengers (group_booking_id);
@@ -0,0 +1,6 @@
+DELETE FROM ticket_fare_items WHERE item_type = 'discount';
+ALTER TABLE ticket_fare_items DROP CONSTRAINT ticket_fare_items_amount_check;
+ALTER TABLE ticket_fare_items ADD CONSTRAINT ticket_fare_items_amount_check CHECK (amount >= 0);
+
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
@@ -0,0 +1,38 @@
+-- Mã khuyến mãi: giảm theo % hoặc số tiền cố định trên giá cơ bản, có thời hạn, giới hạn tuyến/hạng ghế và số lượt dùng
+CREATE TABLE promo_codes (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  code VARCHAR(32) NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT '',
+  discount_type VARCHAR(10) NOT NULL CHECK (discount_type IN ('percent', 'fixed')),
+  discount_value BIGINT NOT NULL CHECK (discount_value > 0),
+  max_discount BIGINT NOT NULL DEFAULT 0 CHECK (max_discount >= 0),
+  valid_from timestamptz NOT NULL,
+  valid_until timestamptz NOT NULL,
+  departure_city VARCHAR(100) NOT NULL DEFAULT '',
+  arrival_city VARCHAR(100) NOT NULL DEFAULT '',
+  flight_classes VARCHAR(20)[] NOT NULL DEFAULT '{}',
+  max_uses INT NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+  max_uses_per_customer INT NOT NULL DEFAULT 0 CHECK (max_uses_per_customer >= 0),
+  stackable BOOLEAN NOT NULL DEFAULT false,
+  active BOOLEAN NOT NULL DEFAULT true,
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now()),
+  CHECK (valid_until > valid_from)
+);
+
+-- Lượt dùng mã, ghi cùng transaction tạo booking để giới hạn số lượt luôn đúng
+CREATE TABLE promo_redemptions (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  promo_code_id BIGINT NOT NULL REFERENCES promo_codes(id),
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  user_email VARCHAR(255) NOT NULL,
+  amount BIGINT NOT NULL CHECK (amount >= 0),
+  created_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_promo_redemptions_promo_code_id ON promo_redemptions (promo_code_id, user_email);
+CREATE INDEX idx_promo_redemptions_booking_id ON promo_redemptions (booking_id);
+
+-- Dòng giảm giá trong chi tiết giá vé có số tiền âm
+ALTER TABLE ticket_fare_items DROP CONSTRAINT ticket_fare_items_amount_check;
+ALTER TABLE ticket_fare_items ADD CONSTRAINT ticket_fare_items_amount_check CHECK (amount >= 0 OR item_type = 'discount');
@@ -0,0 +1,20 @@
+-- Sổ điểm thưởng của khách hàng: mỗi lần cộng điểm là một lô có hạn dùng, remaining là số điểm còn lại của lô.
+-- Số dư Customers.loyalty_points luôn được cập nhật cùng transaction ghi sổ
+CREATE TABLE loyalty_transactions (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES Customers(user_id) ON DELETE CASCADE,
+  kind VARCHAR(20) NOT NULL CHECK (kind IN ('accrual', 'redemption', 'reversal', 'expiry', 'refund')),
+  points INT NOT NULL,
+  remaining INT NOT NULL DEFAULT 0 CHECK (remaining >= 0),
+  amount BIGINT NOT NULL DEFAULT 0 CHECK (amount >= 0),
+  ticket_id BIGINT REFERENCES Tickets(ticket_id) ON DELETE SET NULL,
+  booking_id BIGINT REFERENCES Bookings(booking_id) ON DELETE SET NULL,
+  description VARCHAR(255) NOT NULL DEFAULT '',
+  expires_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_loyalty_transactions_user_id ON loyalty_transactions (user_id, created_at);
+CREATE INDEX idx_loyalty_transactions_booking_id ON loyalty_transactions (booking_id);
+-- Mỗi vé chỉ được cộng điểm một lần
+CREATE UNIQUE INDEX idx_loyalty_transactions_ticket_accrual ON loyalty_transactions (ticket_id) WHERE kind = 'accrual';
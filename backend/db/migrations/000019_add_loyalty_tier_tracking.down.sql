@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_loyalty_transactions_kind_created_at;
+ALTER TABLE Customers DROP CONSTRAINT IF EXISTS customers_loyalty_tier_check;
+ALTER TABLE Customers DROP COLUMN IF EXISTS loyalty_tier_updated_at;
@@ -0,0 +1,5 @@
+-- Hạng thành viên được tính lại định kỳ theo điểm tích lũy 12 tháng gần nhất
+ALTER TABLE Customers ADD COLUMN loyalty_tier_updated_at timestamptz NOT NULL DEFAULT (now());
+ALTER TABLE Customers ADD CONSTRAINT customers_loyalty_tier_check CHECK (loyalty_tier IN ('member', 'silver', 'gold', 'platinum'));
+
+CREATE INDEX idx_loyalty_transactions_kind_created_at ON loyalty_transactions (kind, created_at);
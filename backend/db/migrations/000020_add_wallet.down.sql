@@ -0,0 +1,3 @@
+ALTER TABLE refunds DROP COLUMN IF EXISTS method;
+DROP TABLE IF EXISTS wallet_transactions;
+ALTER TABLE Customers DROP COLUMN IF EXISTS wallet_balance;
@@ -0,0 +1,23 @@
+-- Ví tín dụng của khách hàng: mỗi khoản ghi có là một lô có hạn dùng, remaining là số tiền còn lại của lô.
+-- Số dư Customers.wallet_balance luôn được cập nhật cùng transaction ghi sổ
+ALTER TABLE Customers ADD COLUMN wallet_balance BIGINT NOT NULL DEFAULT 0 CHECK (wallet_balance >= 0);
+
+CREATE TABLE wallet_transactions (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES Customers(user_id) ON DELETE CASCADE,
+  kind VARCHAR(20) NOT NULL CHECK (kind IN ('refund', 'goodwill', 'payment', 'restore', 'expiry')),
+  amount BIGINT NOT NULL,
+  remaining BIGINT NOT NULL DEFAULT 0 CHECK (remaining >= 0),
+  booking_id BIGINT REFERENCES Bookings(booking_id) ON DELETE SET NULL,
+  refund_id BIGINT REFERENCES refunds(id) ON DELETE SET NULL,
+  issued_by VARCHAR(255) NOT NULL DEFAULT '',
+  description VARCHAR(255) NOT NULL DEFAULT '',
+  expires_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_wallet_transactions_user_id ON wallet_transactions (user_id, created_at);
+CREATE INDEX idx_wallet_transactions_booking_id ON wallet_transactions (booking_id);
+
+-- Khoản hoàn tiền được trả về phương thức thanh toán ban đầu hoặc vào ví
+ALTER TABLE refunds ADD COLUMN method VARCHAR(20) NOT NULL DEFAULT 'original' CHECK (method IN ('original', 'wallet'));
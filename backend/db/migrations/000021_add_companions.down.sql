@@ -0,0 +1,3 @@
+ALTER TABLE TicketOwnerSnapshots DROP COLUMN IF EXISTS document_expiry;
+ALTER TABLE TicketOwnerSnapshots DROP COLUMN IF EXISTS companion_id;
+DROP TABLE IF EXISTS companions;
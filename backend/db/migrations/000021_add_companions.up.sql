@@ -0,0 +1,21 @@
+-- Hồ sơ người đi cùng khách lưu sẵn để đặt vé nhanh; dữ liệu được chép vào TicketOwnerSnapshots khi đặt chỗ
+CREATE TABLE companions (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES Customers(user_id) ON DELETE CASCADE,
+  first_name VARCHAR(100) NOT NULL,
+  last_name VARCHAR(100) NOT NULL,
+  gender gender_type NOT NULL DEFAULT 'Other',
+  date_of_birth DATE NOT NULL,
+  document_type VARCHAR(20) NOT NULL CHECK (document_type IN ('passport', 'id_card')),
+  document_number VARCHAR(50) NOT NULL,
+  document_expiry DATE NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_companions_user_id ON companions (user_id);
+
+-- Vé được đặt từ hồ sơ người đi cùng giữ lại mã hồ sơ và hạn giấy tờ lúc đặt.
+-- Hạn '0001-01-01' nghĩa là không có thông tin, tương ứng time.Time rỗng
+ALTER TABLE TicketOwnerSnapshots ADD COLUMN companion_id BIGINT REFERENCES companions(id) ON DELETE SET NULL;
+ALTER TABLE TicketOwnerSnapshots ADD COLUMN document_expiry DATE NOT NULL DEFAULT '0001-01-01';
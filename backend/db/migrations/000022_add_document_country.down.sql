@@ -0,0 +1,2 @@
+ALTER TABLE TicketOwnerSnapshots DROP COLUMN IF EXISTS document_country;
+ALTER TABLE companions DROP COLUMN IF EXISTS document_country;
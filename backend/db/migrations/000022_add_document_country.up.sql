@@ -0,0 +1,3 @@
+-- Nước cấp giấy tờ (mã ISO 3166-1 alpha-2), dùng để kiểm tra định dạng số hộ chiếu khi đặt chỗ
+ALTER TABLE companions ADD COLUMN document_country VARCHAR(2) NOT NULL DEFAULT '';
+ALTER TABLE TicketOwnerSnapshots ADD COLUMN document_country VARCHAR(2) NOT NULL DEFAULT '';
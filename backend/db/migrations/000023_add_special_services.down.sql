@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ticket_special_services;
+DROP TABLE IF EXISTS special_services;
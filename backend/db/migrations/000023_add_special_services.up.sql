@@ -0,0 +1,41 @@
+-- Danh mục mã dịch vụ đặc biệt (SSR) theo IATA; flight_limit là số yêu cầu tối đa trên một chuyến bay, 0 là không giới hạn
+CREATE TABLE special_services (
+  code VARCHAR(4) PRIMARY KEY,
+  category VARCHAR(30) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  flight_limit INT NOT NULL DEFAULT 0 CHECK (flight_limit >= 0),
+  active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+INSERT INTO special_services (code, category, name, flight_limit) VALUES
+  ('WCHR', 'wheelchair', 'Wheelchair to the aircraft door', 0),
+  ('WCHS', 'wheelchair', 'Wheelchair, cannot use stairs', 0),
+  ('WCHC', 'wheelchair', 'Wheelchair to the seat, immobile passenger', 2),
+  ('UMNR', 'unaccompanied_minor', 'Unaccompanied minor', 4),
+  ('PETC', 'pet', 'Pet in cabin', 2),
+  ('VGML', 'meal', 'Vegetarian meal', 0),
+  ('AVML', 'meal', 'Asian vegetarian meal', 0),
+  ('MOML', 'meal', 'Muslim meal', 0),
+  ('KSML', 'meal', 'Kosher meal', 0),
+  ('HNML', 'meal', 'Hindu meal', 0),
+  ('DBML', 'meal', 'Diabetic meal', 0),
+  ('GFML', 'meal', 'Gluten-free meal', 0),
+  ('CHML', 'meal', 'Child meal', 0),
+  ('BBML', 'meal', 'Baby meal', 0);
+
+-- Dịch vụ đặc biệt đã yêu cầu cho từng vé, lưu tên và nhóm tại thời điểm yêu cầu
+CREATE TABLE ticket_special_services (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  ticket_id BIGINT NOT NULL REFERENCES Tickets(ticket_id) ON DELETE CASCADE,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  flight_id BIGINT NOT NULL REFERENCES Flights(flight_id) ON DELETE CASCADE,
+  code VARCHAR(4) NOT NULL REFERENCES special_services(code),
+  category VARCHAR(30) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  note VARCHAR(255) NOT NULL DEFAULT '',
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT ticket_special_services_ticket_code_key UNIQUE (ticket_id, code)
+);
+
+CREATE INDEX idx_ticket_special_services_booking_id ON ticket_special_services (booking_id);
+CREATE INDEX idx_ticket_special_services_flight_code ON ticket_special_services (flight_id, code);
@@ -0,0 +1,10 @@
+-- Thủ tục trực tuyến của từng vé; xoá dòng khi hành khách huỷ làm thủ tục trước khi cửa sổ đóng
+CREATE TABLE ticket_check_ins (
+  ticket_id BIGINT PRIMARY KEY REFERENCES Tickets(ticket_id) ON DELETE CASCADE,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  flight_id BIGINT NOT NULL REFERENCES Flights(flight_id) ON DELETE CASCADE,
+  checked_in_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_ticket_check_ins_booking_id ON ticket_check_ins (booking_id);
+CREATE INDEX idx_ticket_check_ins_flight_id ON ticket_check_ins (flight_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS flight_changes;
+DROP TABLE IF EXISTS payments;
@@ -0,0 +1,36 @@
+-- Sổ thanh toán: mỗi payment intent tạo ra được ghi lại cùng thứ nó thanh toán (purpose + reference_id)
+-- và chỉ được coi là đã thu khi webhook của cổng thanh toán xác nhận
+CREATE TABLE payments (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  booking_id BIGINT REFERENCES Bookings(booking_id) ON DELETE SET NULL,
+  purpose VARCHAR(30) NOT NULL,
+  reference_id BIGINT NOT NULL DEFAULT 0,
+  intent_id VARCHAR(255) NOT NULL UNIQUE,
+  amount BIGINT NOT NULL CHECK (amount > 0),
+  amount_received BIGINT NOT NULL DEFAULT 0 CHECK (amount_received >= 0),
+  amount_refunded BIGINT NOT NULL DEFAULT 0 CHECK (amount_refunded >= 0 AND amount_refunded <= amount_received),
+  currency VARCHAR(10) NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  updated_at timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX idx_payments_booking_id ON payments (booking_id);
+
+-- Yêu cầu đổi chuyến; khi có tiền chênh lệch phải trả, vé chỉ được chuyển sau khi payment tương ứng thành công.
+-- tickets lưu giá mới và chi tiết giá của từng vé theo báo giá
+CREATE TABLE flight_changes (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  from_flight_id BIGINT NOT NULL REFERENCES Flights(flight_id) ON DELETE CASCADE,
+  to_flight_id BIGINT NOT NULL REFERENCES Flights(flight_id) ON DELETE CASCADE,
+  tickets JSONB NOT NULL,
+  amount_due BIGINT NOT NULL DEFAULT 0,
+  refund_amount BIGINT NOT NULL DEFAULT 0,
+  actor VARCHAR(255) NOT NULL DEFAULT '',
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  completed_at timestamptz
+);
+
+CREATE INDEX idx_flight_changes_booking_id ON flight_changes (booking_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS seat_selections;
+DROP INDEX IF EXISTS idx_seats_flight_seat_code;
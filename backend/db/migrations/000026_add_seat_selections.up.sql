@@ -0,0 +1,26 @@
+-- Ghế có mã được gán cho một vé còn hiệu lực thì is_available = false; huỷ vé hoặc đổi chuyến trả ghế về true.
+-- Mỗi mã ghế của một chuyến chỉ được giữ bởi một vé
+UPDATE Seats SET is_available = true;
+UPDATE Seats s
+SET is_available = false
+FROM Tickets t
+WHERE t.seat_id = s.seat_id
+  AND t.status = 'Active'
+  AND s.seat_code <> '';
+
+CREATE UNIQUE INDEX idx_seats_flight_seat_code ON Seats (flight_id, seat_code)
+WHERE is_available = false AND seat_code <> '';
+
+-- Yêu cầu chọn ghế có phí của booking đã thanh toán; ghế chỉ được đổi sau khi payment tương ứng thành công.
+-- selections lưu mã ghế và phí của từng vé
+CREATE TABLE seat_selections (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  booking_id BIGINT NOT NULL REFERENCES Bookings(booking_id) ON DELETE CASCADE,
+  selections JSONB NOT NULL,
+  amount_due BIGINT NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  created_at timestamptz NOT NULL DEFAULT (now()),
+  completed_at timestamptz
+);
+
+CREATE INDEX idx_seat_selections_booking_id ON seat_selections (booking_id);
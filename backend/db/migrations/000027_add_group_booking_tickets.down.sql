@@ -0,0 +1,4 @@
+ALTER TABLE group_booking_passengers DROP COLUMN IF EXISTS document_country;
+ALTER TABLE group_booking_passengers DROP COLUMN IF EXISTS document_expiry;
+ALTER TABLE group_booking_passengers DROP COLUMN IF EXISTS passport_number;
+ALTER TABLE group_bookings DROP COLUMN IF EXISTS booking_id;
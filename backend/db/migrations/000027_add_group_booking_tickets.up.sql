@@ -0,0 +1,7 @@
+-- Đoàn đã cọc và đủ tên được xuất vé thành một booking; chỗ giữ cho đoàn chuyển thành vé của booking đó
+ALTER TABLE group_bookings ADD COLUMN booking_id BIGINT REFERENCES Bookings(booking_id) ON DELETE SET NULL;
+
+-- Giấy tờ của khách trong đoàn, được kiểm tra như khi đặt chỗ và chép sang vé khi xuất vé
+ALTER TABLE group_booking_passengers ADD COLUMN passport_number VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE group_booking_passengers ADD COLUMN document_expiry DATE;
+ALTER TABLE group_booking_passengers ADD COLUMN document_country VARCHAR(2) NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- Ghế trống được mời cho lượt chờ nào; task gửi lại cùng khoá không mời ghế đó thêm lần nữa
+ALTER TABLE waitlist_entries ADD COLUMN offer_key VARCHAR(64) UNIQUE;
@@ -0,0 +1,2 @@
+ALTER TABLE flights DROP COLUMN IF EXISTS arrival_country;
+ALTER TABLE flights DROP COLUMN IF EXISTS departure_country;
@@ -0,0 +1,3 @@
+-- Quốc gia (ISO 3166-1 alpha-2) của sân bay đi và đến; chuyến có đầu ngoài nước nhà là chuyến quốc tế
+ALTER TABLE flights ADD COLUMN departure_country VARCHAR(2) NOT NULL DEFAULT 'VN';
+ALTER TABLE flights ADD COLUMN arrival_country VARCHAR(2) NOT NULL DEFAULT 'VN';
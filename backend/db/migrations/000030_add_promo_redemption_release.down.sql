@@ -0,0 +1 @@
+ALTER TABLE promo_redemptions DROP COLUMN IF EXISTS released_at;
@@ -0,0 +1,2 @@
+-- Lượt dùng mã của booking đã huỷ được trả lại, không còn tính vào giới hạn số lượt
+ALTER TABLE promo_redemptions ADD COLUMN released_at timestamptz;
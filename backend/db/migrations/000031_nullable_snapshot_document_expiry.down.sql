@@ -0,0 +1,3 @@
+UPDATE TicketOwnerSnapshots SET document_expiry = '0001-01-01' WHERE document_expiry IS NULL;
+ALTER TABLE TicketOwnerSnapshots ALTER COLUMN document_expiry SET DEFAULT '0001-01-01';
+ALTER TABLE TicketOwnerSnapshots ALTER COLUMN document_expiry SET NOT NULL;
@@ -0,0 +1,4 @@
+-- Vé không có thông tin hạn giấy tờ lưu NULL thay cho ngày '0001-01-01'
+ALTER TABLE TicketOwnerSnapshots ALTER COLUMN document_expiry DROP NOT NULL;
+ALTER TABLE TicketOwnerSnapshots ALTER COLUMN document_expiry DROP DEFAULT;
+UPDATE TicketOwnerSnapshots SET document_expiry = NULL WHERE document_expiry = '0001-01-01';
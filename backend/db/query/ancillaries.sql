@@ -0,0 +1,33 @@
+-- name: UpsertAncillary :one
+INSERT INTO ancillaries (
+  code,
+  ancillary_type,
+  name,
+  description,
+  departure_city,
+  arrival_city,
+  flight_class,
+  price,
+  max_quantity,
+  active
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+ON CONFLICT (code, departure_city, arrival_city, flight_class) DO UPDATE
+SET ancillary_type = EXCLUDED.ancillary_type,
+    name = EXCLUDED.name,
+    description = EXCLUDED.description,
+    price = EXCLUDED.price,
+    max_quantity = EXCLUDED.max_quantity,
+    active = EXCLUDED.active,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListAncillaries :many
+SELECT * FROM ancillaries
+ORDER BY code, departure_city, arrival_city, flight_class;
+
+-- name: DeleteAncillary :one
+DELETE FROM ancillaries
+WHERE id = $1
+RETURNING *;
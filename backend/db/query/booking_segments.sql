@@ -0,0 +1,25 @@
+-- name: CreateBookingSegment :one
+INSERT INTO booking_segments (
+  booking_id,
+  segment_order,
+  flight_id
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListBookingSegments :many
+SELECT * FROM booking_segments
+WHERE booking_id = $1
+ORDER BY segment_order;
+
+-- name: ListBookingSegmentsByBookingIDs :many
+SELECT * FROM booking_segments
+WHERE booking_id = ANY(sqlc.arg(booking_ids)::bigint[])
+ORDER BY booking_id, segment_order;
+
+-- name: UpdateBookingSegmentFlight :one
+UPDATE booking_segments
+SET flight_id = sqlc.arg(new_flight_id)
+WHERE booking_id = sqlc.arg(booking_id)
+  AND flight_id = sqlc.arg(old_flight_id)
+RETURNING *;
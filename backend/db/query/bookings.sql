@@ -4,15 +4,22 @@ INSERT INTO bookings (
   trip_type,
   departure_flight_id,
   return_flight_id,
-  status
+  status,
+  pnr
 ) VALUES (
-  $1, $2, $3, $4, $5
-) RETURNING *;
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (pnr) DO NOTHING
+RETURNING *;
 
 -- name: GetBooking :one
 SELECT * FROM bookings
 WHERE booking_id = $1 LIMIT 1;
 
+-- name: GetBookingByPNR :one
+SELECT * FROM bookings
+WHERE pnr = $1 LIMIT 1;
+
 -- name: ListBookings :many
 SELECT * FROM bookings
 ORDER BY booking_id
@@ -26,6 +33,61 @@ JOIN Users u ON Bookings.user_email = u.email
 WHERE u.user_id = $1;
 
 
+-- name: ListCustomerTrips :many
+SELECT b.*,
+    t.first_departure_time::timestamptz AS first_departure_time,
+    t.last_departure_time::timestamptz AS last_departure_time
+FROM bookings b
+    JOIN (
+        SELECT bf.booking_id,
+            MIN(f.departure_time) AS first_departure_time,
+            MAX(f.departure_time) AS last_departure_time
+        FROM (
+                SELECT bs.booking_id, bs.flight_id
+                FROM booking_segments bs
+                UNION
+                SELECT tk.booking_id, tk.flight_id
+                FROM tickets tk
+                WHERE tk.booking_id IS NOT NULL
+            ) bf
+            JOIN flights f ON f.flight_id = bf.flight_id
+        GROUP BY bf.booking_id
+    ) t ON t.booking_id = b.booking_id
+WHERE b.user_email = sqlc.arg(user_email)
+    AND (t.last_departure_time >= sqlc.arg(now)::timestamptz) = sqlc.arg(upcoming)::boolean
+    AND (sqlc.arg(status)::text = '' OR b.status::text = sqlc.arg(status)::text)
+    AND (sqlc.narg(departs_from)::timestamptz IS NULL OR t.first_departure_time >= sqlc.narg(departs_from)::timestamptz)
+    AND (sqlc.narg(departs_to)::timestamptz IS NULL OR t.first_departure_time < sqlc.narg(departs_to)::timestamptz)
+ORDER BY CASE WHEN sqlc.arg(upcoming)::boolean THEN t.first_departure_time END ASC,
+    t.first_departure_time DESC,
+    b.booking_id
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountCustomerTrips :one
+SELECT COUNT(*)
+FROM bookings b
+    JOIN (
+        SELECT bf.booking_id,
+            MIN(f.departure_time) AS first_departure_time,
+            MAX(f.departure_time) AS last_departure_time
+        FROM (
+                SELECT bs.booking_id, bs.flight_id
+                FROM booking_segments bs
+                UNION
+                SELECT tk.booking_id, tk.flight_id
+                FROM tickets tk
+                WHERE tk.booking_id IS NOT NULL
+            ) bf
+            JOIN flights f ON f.flight_id = bf.flight_id
+        GROUP BY bf.booking_id
+    ) t ON t.booking_id = b.booking_id
+WHERE b.user_email = sqlc.arg(user_email)
+    AND (t.last_departure_time >= sqlc.arg(now)::timestamptz) = sqlc.arg(upcoming)::boolean
+    AND (sqlc.arg(status)::text = '' OR b.status::text = sqlc.arg(status)::text)
+    AND (sqlc.narg(departs_from)::timestamptz IS NULL OR t.first_departure_time >= sqlc.narg(departs_from)::timestamptz)
+    AND (sqlc.narg(departs_to)::timestamptz IS NULL OR t.first_departure_time < sqlc.narg(departs_to)::timestamptz);
+
 -- name: DeleteBookings :exec
 DELETE FROM bookings
 WHERE booking_id = $1;
@@ -35,3 +97,40 @@ UPDATE bookings
 SET user_email = NULL,
     updated_at = NOW()
 WHERE user_email = $1;
+
+-- name: GetBookingForUpdate :one
+SELECT * FROM bookings
+WHERE booking_id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: UpdateBookingStatus :one
+UPDATE bookings
+SET status = $2
+WHERE booking_id = $1
+RETURNING *;
+
+-- name: GetBookingByPNRAndLastName :one
+SELECT b.* FROM bookings b
+WHERE b.pnr = sqlc.arg(pnr)
+  AND EXISTS (
+    SELECT 1
+    FROM tickets t
+    JOIN ticketownersnapshots o ON o.ticket_id = t.ticket_id
+    WHERE t.booking_id = b.booking_id
+      AND lower(o.last_name) = lower(sqlc.arg(last_name)::text)
+  )
+LIMIT 1;
+
+-- name: UpdateBookingDepartureFlight :one
+UPDATE bookings
+SET departure_flight_id = $2,
+    updated_at = NOW()
+WHERE booking_id = $1
+RETURNING *;
+
+-- name: UpdateBookingReturnFlight :one
+UPDATE bookings
+SET return_flight_id = $2,
+    updated_at = NOW()
+WHERE booking_id = $1
+RETURNING *;
@@ -0,0 +1,35 @@
+-- name: CreateTicketCheckIn :one
+INSERT INTO ticket_check_ins (
+  ticket_id,
+  booking_id,
+  flight_id,
+  checked_in_at
+) VALUES (
+  $1, $2, $3, $4
+)
+ON CONFLICT (ticket_id) DO NOTHING
+RETURNING *;
+
+-- name: ListTicketCheckInsByBookingID :many
+SELECT * FROM ticket_check_ins
+WHERE booking_id = $1
+ORDER BY ticket_id;
+
+-- name: ListTicketCheckInsByFlightID :many
+SELECT * FROM ticket_check_ins
+WHERE flight_id = $1
+ORDER BY ticket_id;
+
+-- name: DeleteTicketCheckIn :one
+DELETE FROM ticket_check_ins
+WHERE ticket_id = $1
+  AND booking_id = $2
+RETURNING *;
+
+-- name: DeleteTicketCheckInsByTicketID :exec
+DELETE FROM ticket_check_ins
+WHERE ticket_id = $1;
+
+-- name: DeleteTicketCheckInsByBookingID :exec
+DELETE FROM ticket_check_ins
+WHERE booking_id = $1;
@@ -0,0 +1,48 @@
+-- name: CreateCompanion :one
+INSERT INTO companions (
+  user_id,
+  first_name,
+  last_name,
+  gender,
+  date_of_birth,
+  document_type,
+  document_number,
+  document_expiry,
+  document_country
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: ListCompanionsByUser :many
+SELECT * FROM companions
+WHERE user_id = $1
+ORDER BY first_name, last_name, id;
+
+-- name: CountCompanionsByUser :one
+SELECT COUNT(*) FROM companions
+WHERE user_id = $1;
+
+-- name: GetCompanionByEmail :one
+SELECT c.* FROM companions c
+JOIN Users u ON u.user_id = c.user_id
+WHERE c.id = $1 AND u.email = $2
+LIMIT 1;
+
+-- name: UpdateCompanion :one
+UPDATE companions
+SET first_name = $3,
+    last_name = $4,
+    gender = $5,
+    date_of_birth = $6,
+    document_type = $7,
+    document_number = $8,
+    document_expiry = $9,
+    document_country = $10,
+    updated_at = NOW()
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteCompanion :one
+DELETE FROM companions
+WHERE id = $1 AND user_id = $2
+RETURNING *;
@@ -58,4 +58,9 @@ LIMIT $1 OFFSET $2;
 -- name: DeleteCustomerByID :one
 DELETE FROM Customers
 WHERE user_id = $1
-RETURNING user_id;
\ No newline at end of file
+RETURNING user_id;
+
+-- name: LockCustomer :exec
+SELECT user_id FROM Customers
+WHERE user_id = $1
+FOR NO KEY UPDATE;
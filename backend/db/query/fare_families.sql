@@ -0,0 +1,8 @@
+-- name: ListFareFamilies :many
+SELECT * FROM fare_families
+ORDER BY flight_class, fare_percent;
+
+-- name: GetFareFamily :one
+SELECT * FROM fare_families
+WHERE flight_class = $1
+  AND family = $2;
@@ -0,0 +1,26 @@
+-- name: CreateFlightChange :one
+INSERT INTO flight_changes (
+  booking_id,
+  from_flight_id,
+  to_flight_id,
+  tickets,
+  amount_due,
+  refund_amount,
+  actor,
+  status,
+  completed_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetFlightChangeForUpdate :one
+SELECT * FROM flight_changes
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateFlightChangeStatus :one
+UPDATE flight_changes
+SET status = $2,
+    completed_at = $3
+WHERE id = $1
+RETURNING *;
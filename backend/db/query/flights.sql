@@ -9,9 +9,11 @@ INSERT INTO flights (
     departure_time,
     arrival_time,
     base_price,
-    status
+    status,
+    departure_country,
+    arrival_country
   )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 -- name: GetFlight :one
 SELECT *
@@ -29,6 +31,10 @@ SELECT flight_id,
   base_price,
   status
 FROM Flights;
+-- name: ListFlightsByIDs :many
+SELECT *
+FROM flights
+WHERE flight_id = ANY(sqlc.arg(flight_ids)::bigint[]);
 -- name: ListFlights :many
 SELECT flight_id,
   flight_number,
@@ -76,4 +82,48 @@ SELECT flight_id,
 FROM Flights
 WHERE departure_city = $1
   AND arrival_city = $2
-  AND DATE(departure_time) = $3;
\ No newline at end of file
+  AND DATE(departure_time) = $3;
+-- name: ListAlternativeFlights :many
+SELECT *
+FROM flights
+WHERE departure_city = $1
+  AND arrival_city = $2
+  AND departure_time > $3
+  AND flight_id <> $4
+  AND status <> 'Cancelled'
+ORDER BY departure_time
+LIMIT 20;
+-- name: CountSoldSeats :one
+SELECT COUNT(*) FROM tickets
+WHERE flight_id = $1
+  AND status = 'Active'
+  AND seat_id IS NOT NULL;
+-- name: CountSoldSeatsByClass :many
+SELECT seats.flight_id, seats.flight_class, SUM(seats.sold)::bigint AS sold
+FROM (
+    SELECT t.flight_id, t.flight_class, COUNT(*) AS sold
+    FROM tickets t
+    WHERE t.flight_id = ANY(sqlc.arg(flight_ids)::bigint[])
+      AND t.status = 'Active'
+      AND t.seat_id IS NOT NULL
+    GROUP BY t.flight_id, t.flight_class
+    UNION ALL
+    SELECT f.flight_id, g.flight_class, SUM(g.headcount) AS sold
+    FROM group_bookings g
+        JOIN unnest(sqlc.arg(flight_ids)::bigint[]) AS f(flight_id)
+            ON f.flight_id = g.outbound_flight_id OR f.flight_id = g.return_flight_id
+    WHERE g.status = 'deposit_paid' OR (g.status = 'quoted' AND g.deposit_deadline > NOW())
+    GROUP BY f.flight_id, g.flight_class
+    UNION ALL
+    SELECT w.flight_id, w.flight_class, COUNT(*) AS sold
+    FROM waitlist_entries w
+    WHERE w.flight_id = ANY(sqlc.arg(flight_ids)::bigint[])
+      AND w.status IN ('offered', 'claimed')
+      AND w.offer_expires_at > NOW()
+    GROUP BY w.flight_id, w.flight_class
+) seats
+GROUP BY seats.flight_id, seats.flight_class;
+-- name: LockFlight :exec
+SELECT flight_id FROM flights
+WHERE flight_id = $1
+FOR UPDATE;
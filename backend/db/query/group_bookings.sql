@@ -0,0 +1,100 @@
+-- name: CreateGroupBooking :one
+INSERT INTO group_bookings (
+  user_email,
+  departure_city,
+  arrival_city,
+  departure_date,
+  return_date,
+  flight_class,
+  headcount,
+  note
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetGroupBooking :one
+SELECT * FROM group_bookings
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetGroupBookingForUpdate :one
+SELECT * FROM group_bookings
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListGroupBookings :many
+SELECT * FROM group_bookings
+ORDER BY created_at DESC;
+
+-- name: ListGroupBookingsByEmail :many
+SELECT * FROM group_bookings
+WHERE user_email = $1
+ORDER BY created_at DESC;
+
+-- name: QuoteGroupBooking :one
+UPDATE group_bookings
+SET status = 'quoted',
+    outbound_flight_id = $2,
+    return_flight_id = $3,
+    fare_per_passenger = $4,
+    deposit_amount = $5,
+    deposit_deadline = $6,
+    name_cutoff = $7,
+    updated_at = NOW()
+WHERE id = $1 AND status IN ('requested', 'quoted')
+RETURNING *;
+
+-- name: PayGroupDeposit :one
+UPDATE group_bookings
+SET status = 'deposit_paid',
+    deposit_paid_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1 AND status = 'quoted'
+RETURNING *;
+
+-- name: TicketGroupBooking :one
+UPDATE group_bookings
+SET status = 'ticketed',
+    booking_id = $2,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'deposit_paid'
+RETURNING *;
+
+-- name: ReleaseGroupBooking :one
+UPDATE group_bookings
+SET status = 'released',
+    updated_at = NOW()
+WHERE id = $1 AND status = 'quoted' AND deposit_deadline <= NOW()
+RETURNING *;
+
+-- name: CountGroupBlockedSeats :one
+SELECT COALESCE(SUM(headcount), 0)::bigint FROM group_bookings
+WHERE (outbound_flight_id = $1 OR return_flight_id = $1)
+  AND (status = 'deposit_paid' OR (status = 'quoted' AND deposit_deadline > NOW()));
+
+-- name: CreateGroupBookingPassenger :one
+INSERT INTO group_booking_passengers (
+  group_booking_id,
+  first_name,
+  last_name,
+  phone_number,
+  gender,
+  date_of_birth,
+  identification_number,
+  address,
+  passport_number,
+  document_expiry,
+  document_country
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING *;
+
+-- name: ListGroupBookingPassengers :many
+SELECT * FROM group_booking_passengers
+WHERE group_booking_id = $1
+ORDER BY id;
+
+-- name: DeleteGroupBookingPassengers :exec
+DELETE FROM group_booking_passengers
+WHERE group_booking_id = $1;
@@ -0,0 +1,42 @@
+-- name: ListLoyaltyTierCandidates :many
+SELECT c.user_id, u.email, u.first_name, c.loyalty_tier,
+  COALESCE(SUM(lt.points), 0)::int AS qualifying_points
+FROM customers c
+  JOIN users u ON u.user_id = c.user_id
+  LEFT JOIN loyalty_transactions lt ON lt.user_id = c.user_id
+    AND lt.kind = 'accrual'
+    AND lt.created_at >= $1
+    AND NOT EXISTS (
+      SELECT 1 FROM loyalty_transactions r
+      WHERE r.ticket_id = lt.ticket_id AND r.kind = 'reversal'
+    )
+WHERE c.user_id > $2
+GROUP BY c.user_id, u.email, u.first_name, c.loyalty_tier
+ORDER BY c.user_id
+LIMIT $3;
+
+-- name: GetCustomerQualifyingPoints :one
+SELECT COALESCE(SUM(lt.points), 0)::int FROM loyalty_transactions lt
+WHERE lt.user_id = $1
+  AND lt.kind = 'accrual'
+  AND lt.created_at >= $2
+  AND NOT EXISTS (
+    SELECT 1 FROM loyalty_transactions r
+    WHERE r.ticket_id = lt.ticket_id AND r.kind = 'reversal'
+  );
+
+-- name: GetCustomerLoyaltyTier :one
+SELECT loyalty_tier FROM customers
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: GetCustomerLoyaltyTierByEmail :one
+SELECT c.loyalty_tier FROM customers c
+  JOIN users u ON u.user_id = c.user_id
+WHERE u.email = $1
+LIMIT 1;
+
+-- name: UpdateCustomerLoyaltyTier :exec
+UPDATE customers
+SET loyalty_tier = $2, loyalty_tier_updated_at = now()
+WHERE user_id = $1;
@@ -0,0 +1,81 @@
+-- name: CreateLoyaltyTransaction :one
+INSERT INTO loyalty_transactions (
+  user_id,
+  kind,
+  points,
+  remaining,
+  amount,
+  ticket_id,
+  booking_id,
+  description,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: ListLoyaltyTransactions :many
+SELECT * FROM loyalty_transactions
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountLoyaltyTransactions :one
+SELECT COUNT(*) FROM loyalty_transactions
+WHERE user_id = $1;
+
+-- name: ListOpenLoyaltyLots :many
+SELECT * FROM loyalty_transactions
+WHERE user_id = $1 AND remaining > 0
+ORDER BY expires_at, id
+FOR UPDATE;
+
+-- name: ListExpiredLoyaltyLots :many
+SELECT * FROM loyalty_transactions
+WHERE user_id = $1 AND remaining > 0 AND expires_at <= $2
+ORDER BY expires_at, id
+FOR UPDATE;
+
+-- name: UpdateLoyaltyLotRemaining :exec
+UPDATE loyalty_transactions
+SET remaining = $2
+WHERE id = $1;
+
+-- name: GetLoyaltyAccrualByTicket :one
+SELECT * FROM loyalty_transactions
+WHERE ticket_id = $1 AND kind = 'accrual'
+LIMIT 1;
+
+-- name: ListLoyaltyRedemptionsByBooking :many
+SELECT * FROM loyalty_transactions
+WHERE booking_id = $1 AND kind IN ('redemption', 'refund')
+ORDER BY id;
+
+-- name: SumLoyaltyRedeemedByBooking :one
+SELECT COALESCE(SUM(CASE WHEN kind = 'redemption' THEN amount ELSE -amount END), 0)::bigint FROM loyalty_transactions
+WHERE booking_id = $1 AND kind IN ('redemption', 'refund');
+
+-- name: ListLoyaltyAccrualCandidates :many
+SELECT t.ticket_id, t.booking_id, t.price, c.user_id
+FROM tickets t
+  JOIN bookings b ON b.booking_id = t.booking_id
+  JOIN users u ON u.email = b.user_email
+  JOIN customers c ON c.user_id = u.user_id
+WHERE t.flight_id = $1
+  AND t.status = 'Active'
+  AND b.status = 'confirmed'
+  AND NOT EXISTS (
+    SELECT 1 FROM loyalty_transactions lt
+    WHERE lt.ticket_id = t.ticket_id AND lt.kind = 'accrual'
+  )
+ORDER BY t.ticket_id;
+
+-- name: GetCustomerLoyaltyPointsForUpdate :one
+SELECT COALESCE(loyalty_points, 0)::int FROM customers
+WHERE user_id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: AddCustomerLoyaltyPoints :exec
+UPDATE customers
+SET loyalty_points = COALESCE(loyalty_points, 0) + sqlc.arg(points)::int
+WHERE user_id = sqlc.arg(user_id);
@@ -0,0 +1,51 @@
+-- name: AddPaymentRefund :one
+UPDATE payments
+SET amount_refunded = amount_refunded + sqlc.arg(amount),
+    updated_at = now()
+WHERE id = sqlc.arg(id)
+  AND amount_refunded + sqlc.arg(amount) <= amount_received
+RETURNING *;
+
+-- name: AttachGroupDepositPayments :exec
+UPDATE payments
+SET booking_id = sqlc.arg(booking_id),
+    updated_at = now()
+WHERE purpose = 'group_deposit'
+  AND reference_id = sqlc.arg(group_booking_id)
+  AND booking_id IS NULL;
+
+-- name: CreatePayment :one
+INSERT INTO payments (
+  booking_id,
+  purpose,
+  reference_id,
+  intent_id,
+  amount,
+  currency
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetPaymentByIntentID :one
+SELECT * FROM payments
+WHERE intent_id = $1;
+
+-- name: GetPaymentByIntentIDForUpdate :one
+SELECT * FROM payments
+WHERE intent_id = $1
+FOR UPDATE;
+
+-- name: ListCapturedPaymentsByBookingID :many
+SELECT * FROM payments
+WHERE booking_id = $1
+  AND status = 'succeeded'
+  AND amount_received > amount_refunded
+ORDER BY created_at, id;
+
+-- name: UpdatePaymentStatus :one
+UPDATE payments
+SET status = $2,
+    amount_received = $3,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
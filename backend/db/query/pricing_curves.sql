@@ -0,0 +1,30 @@
+-- name: UpsertPricingCurve :one
+INSERT INTO pricing_curves (
+  departure_city,
+  arrival_city,
+  flight_class,
+  load_factor_steps,
+  days_to_departure_steps
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (departure_city, arrival_city, flight_class) DO UPDATE
+SET load_factor_steps = EXCLUDED.load_factor_steps,
+    days_to_departure_steps = EXCLUDED.days_to_departure_steps,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListPricingCurves :many
+SELECT * FROM pricing_curves
+ORDER BY departure_city, arrival_city, flight_class;
+
+-- name: ListPricingCurvesByRoute :many
+SELECT * FROM pricing_curves
+WHERE departure_city = $1
+  AND arrival_city = $2
+ORDER BY flight_class;
+
+-- name: DeletePricingCurve :one
+DELETE FROM pricing_curves
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,66 @@
+-- name: CreatePromoCode :one
+INSERT INTO promo_codes (
+  code,
+  description,
+  discount_type,
+  discount_value,
+  max_discount,
+  valid_from,
+  valid_until,
+  departure_city,
+  arrival_city,
+  flight_classes,
+  max_uses,
+  max_uses_per_customer,
+  stackable
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+) RETURNING *;
+
+-- name: GetPromoCodeByCode :one
+SELECT * FROM promo_codes
+WHERE code = $1
+LIMIT 1;
+
+-- name: GetPromoCodeForUpdate :one
+SELECT * FROM promo_codes
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListPromoCodes :many
+SELECT * FROM promo_codes
+ORDER BY created_at DESC;
+
+-- name: DeactivatePromoCode :one
+UPDATE promo_codes
+SET active = false,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CountPromoRedemptions :one
+SELECT COUNT(*) FROM promo_redemptions
+WHERE promo_code_id = $1
+  AND released_at IS NULL;
+
+-- name: CountPromoRedemptionsByEmail :one
+SELECT COUNT(*) FROM promo_redemptions
+WHERE promo_code_id = $1 AND user_email = $2
+  AND released_at IS NULL;
+
+-- name: CreatePromoRedemption :one
+INSERT INTO promo_redemptions (
+  promo_code_id,
+  booking_id,
+  user_email,
+  amount
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ReleasePromoRedemptions :exec
+UPDATE promo_redemptions
+SET released_at = NOW()
+WHERE booking_id = $1
+  AND released_at IS NULL;
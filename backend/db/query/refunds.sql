@@ -0,0 +1,21 @@
+-- name: CreateRefund :one
+INSERT INTO refunds (
+  booking_id,
+  amount,
+  status,
+  reason,
+  method
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListRefundsByBookingID :many
+SELECT * FROM refunds
+WHERE booking_id = $1
+ORDER BY created_at, id;
+
+-- name: UpdateRefundStatus :one
+UPDATE refunds
+SET status = $2
+WHERE id = $1
+RETURNING *;
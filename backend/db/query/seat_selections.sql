@@ -0,0 +1,20 @@
+-- name: CreateSeatSelection :one
+INSERT INTO seat_selections (
+  booking_id,
+  selections,
+  amount_due
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetSeatSelectionForUpdate :one
+SELECT * FROM seat_selections
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateSeatSelectionStatus :one
+UPDATE seat_selections
+SET status = $2,
+    completed_at = NOW()
+WHERE id = $1
+RETURNING *;
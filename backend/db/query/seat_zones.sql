@@ -0,0 +1,27 @@
+-- name: CreateSeatZone :one
+INSERT INTO seat_zones (
+  flight_id,
+  aircraft_type,
+  zone_type,
+  name,
+  row_from,
+  row_to,
+  price
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: ListSeatZones :many
+SELECT * FROM seat_zones
+ORDER BY flight_id NULLS FIRST, aircraft_type, row_from;
+
+-- name: ListSeatZonesForFlight :many
+SELECT * FROM seat_zones
+WHERE flight_id = $1
+   OR (flight_id IS NULL AND aircraft_type = $2)
+ORDER BY row_from;
+
+-- name: DeleteSeatZone :one
+DELETE FROM seat_zones
+WHERE id = $1
+RETURNING *;
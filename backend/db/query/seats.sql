@@ -42,4 +42,12 @@ WHERE t.ticket_id = $1;
 -- name: UpdateSeatAvailability :exec
 UPDATE Seats
 SET is_available = $2
-WHERE seat_id = (SELECT seat_id FROM Tickets WHERE ticket_id = $1);
\ No newline at end of file
+WHERE seat_id = (SELECT seat_id FROM Tickets WHERE ticket_id = $1);
+
+-- name: IsSeatCodeTaken :one
+SELECT EXISTS (
+  SELECT 1 FROM "seats" s
+  JOIN Tickets t ON t.seat_id = s.seat_id
+  WHERE s.flight_id = $1 AND s.seat_code = $2
+    AND t.status = 'Active'
+) AS taken;
@@ -0,0 +1,44 @@
+-- name: ListSpecialServices :many
+SELECT * FROM special_services
+ORDER BY category, code;
+
+-- name: CreateTicketSpecialService :one
+INSERT INTO ticket_special_services (
+  ticket_id,
+  booking_id,
+  flight_id,
+  code,
+  category,
+  name,
+  note
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: ListTicketSpecialServicesByBookingID :many
+SELECT * FROM ticket_special_services
+WHERE booking_id = $1
+ORDER BY ticket_id, id;
+
+-- name: ListTicketSpecialServicesByFlightID :many
+SELECT * FROM ticket_special_services
+WHERE flight_id = $1
+ORDER BY ticket_id, id;
+
+-- name: CountFlightSpecialServices :one
+SELECT COUNT(*) FROM ticket_special_services ts
+JOIN Tickets t ON t.ticket_id = ts.ticket_id
+WHERE ts.flight_id = $1
+  AND ts.code = $2
+  AND t.status = 'Active';
+
+-- name: DeleteTicketSpecialService :one
+DELETE FROM ticket_special_services
+WHERE id = $1
+  AND booking_id = $2
+RETURNING *;
+
+-- name: UpdateTicketSpecialServicesFlight :exec
+UPDATE ticket_special_services
+SET flight_id = $2
+WHERE ticket_id = $1;
@@ -0,0 +1,41 @@
+-- name: CreateTicketAncillary :one
+INSERT INTO ticket_ancillaries (
+  ticket_id,
+  booking_id,
+  ancillary_id,
+  code,
+  ancillary_type,
+  name,
+  quantity,
+  unit_price,
+  amount,
+  status
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: ListTicketAncillariesByBookingID :many
+SELECT * FROM ticket_ancillaries
+WHERE booking_id = $1
+ORDER BY ticket_id, id;
+
+-- name: GetTicketAncillaryForUpdate :one
+SELECT * FROM ticket_ancillaries
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ActivateTicketAncillary :one
+UPDATE ticket_ancillaries
+SET status = 'active'
+WHERE id = $1
+  AND status = 'pending_payment'
+RETURNING *;
+
+-- name: CancelTicketAncillary :one
+UPDATE ticket_ancillaries
+SET status = 'cancelled',
+    cancelled_at = NOW()
+WHERE id = $1
+  AND booking_id = $2
+  AND status IN ('active', 'pending_payment')
+RETURNING *;
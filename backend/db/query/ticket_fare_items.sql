@@ -0,0 +1,20 @@
+-- name: CreateTicketFareItem :one
+INSERT INTO ticket_fare_items (
+  ticket_id,
+  item_type,
+  code,
+  description,
+  amount
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: DeleteTicketFareItems :exec
+DELETE FROM ticket_fare_items
+WHERE ticket_id = $1;
+
+-- name: ListTicketFareItemsByBookingID :many
+SELECT f.* FROM ticket_fare_items f
+JOIN tickets t ON t.ticket_id = f.ticket_id
+WHERE t.booking_id = $1
+ORDER BY f.ticket_id, f.id;
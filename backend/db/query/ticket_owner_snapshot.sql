@@ -1,8 +1,9 @@
 -- name: CreateTicketOwnerSnapshot :one
 INSERT INTO TicketOwnerSnapshots (
   ticket_id, first_name, last_name, phone_number, gender, date_of_birth,
-  passport_number, identification_number, address
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+  passport_number, identification_number, address, companion_id, document_expiry,
+  document_country
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 
 -- name: GetTicketOwnerSnapshot :one
@@ -5,9 +5,13 @@ INSERT INTO tickets (
         price,
         status,
         booking_id,
-        flight_id
+        flight_id,
+        ticket_number,
+        passenger_type,
+        accompanying_ticket_id,
+        fare_family
     )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING *;
 -- name: GetTicketByID :one
 SELECT t.ticket_id,
@@ -18,6 +22,8 @@ SELECT t.ticket_id,
     t.flight_id,
     t.created_at,
     t.updated_at,
+    t.ticket_number,
+    t.fare_family,
     s.seat_code,
     s.seat_id,
     s.is_available,
@@ -30,10 +36,12 @@ SELECT t.ticket_id,
     o.date_of_birth AS owner_date_of_birth,
     o.passport_number AS owner_passport_number,
     o.identification_number AS owner_identification_number,
-    o.address AS owner_address
+    o.address AS owner_address,
+    b.pnr AS booking_pnr
 FROM Tickets t
     LEFT JOIN Seats s ON t.seat_id = s.seat_id
     LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Bookings b ON t.booking_id = b.booking_id
 WHERE t.ticket_id = $1;
 -- name: ListTickets :many
 SELECT *
@@ -67,6 +75,8 @@ SELECT t.ticket_id,
     t.flight_id,
     t.created_at,
     t.updated_at,
+    t.ticket_number,
+    t.passenger_type,
     s.seat_code,
     s.is_available,
     s.class AS seat_class,
@@ -77,10 +87,12 @@ SELECT t.ticket_id,
     o.date_of_birth AS owner_date_of_birth,
     o.passport_number AS owner_passport_number,
     o.identification_number AS owner_identification_number,
-    o.address AS owner_address
+    o.address AS owner_address,
+    b.pnr AS booking_pnr
 FROM Tickets t
     LEFT JOIN Seats s ON t.seat_id = s.seat_id
     LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Bookings b ON t.booking_id = b.booking_id
 WHERE t.flight_id = $1;
 -- name: UpdateTicketStatus :one
 UPDATE Tickets
@@ -101,11 +113,14 @@ RETURNING ticket_id,
     booking_id,
     flight_id,
     updated_at,
-    (
-        SELECT seat_code
-        FROM Seats
-        WHERE seat_id = Tickets.seat_id
-    ) AS seat_code,
+    COALESCE(
+        (
+            SELECT seat_code
+            FROM Seats
+            WHERE seat_id = Tickets.seat_id
+        ),
+        ''
+    )::VARCHAR AS seat_code,
     (
         SELECT first_name
         FROM TicketOwnerSnapshots
@@ -123,7 +138,8 @@ RETURNING ticket_id,
     ) AS owner_phone_number;
 -- name: UpdateSeat :one
 UPDATE Seats
-SET seat_code = $2
+SET seat_code = $2,
+    is_available = false
 WHERE seat_id = (
         SELECT seat_id
         FROM Tickets
@@ -140,7 +156,11 @@ SELECT ticket_id,
     booking_id,
     flight_id,
     created_at,
-    updated_at
+    updated_at,
+    ticket_number,
+    passenger_type,
+    accompanying_ticket_id,
+    fare_family
 FROM Tickets
 WHERE Tickets.booking_id = $1
     AND (
@@ -160,4 +180,99 @@ WHERE Tickets.booking_id = $1
                 WHERE Bookings.booking_id = $1
             )
         )
-    );
\ No newline at end of file
+    );
+-- name: ListTicketsByBookingID :many
+SELECT *
+FROM tickets
+WHERE booking_id = $1
+ORDER BY ticket_id;
+-- name: ListTripTicketsByBookingIDs :many
+SELECT sqlc.embed(t),
+    s.seat_code,
+    o.first_name,
+    o.last_name
+FROM tickets t
+    LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Seats s ON t.seat_id = s.seat_id
+WHERE t.booking_id = ANY(sqlc.arg(booking_ids)::bigint[])
+ORDER BY t.ticket_id;
+-- name: GetTicketByNumber :one
+SELECT t.ticket_id,
+    t.status,
+    t.flight_class,
+    t.price,
+    t.booking_id,
+    t.flight_id,
+    t.created_at,
+    t.updated_at,
+    t.ticket_number,
+    t.fare_family,
+    s.seat_code,
+    s.seat_id,
+    s.is_available,
+    s.flight_id,
+    s.class AS seat_class,
+    o.first_name AS owner_first_name,
+    o.last_name AS owner_last_name,
+    o.gender AS owner_gender,
+    o.phone_number AS owner_phone_number,
+    o.date_of_birth AS owner_date_of_birth,
+    o.passport_number AS owner_passport_number,
+    o.identification_number AS owner_identification_number,
+    o.address AS owner_address,
+    b.pnr AS booking_pnr
+FROM Tickets t
+    LEFT JOIN Seats s ON t.seat_id = s.seat_id
+    LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Bookings b ON t.booking_id = b.booking_id
+WHERE t.ticket_number = $1;
+-- name: NextTicketSerial :one
+SELECT nextval('ticket_number_seq')::BIGINT AS serial;
+
+-- name: UpdateTicketFlight :one
+UPDATE Tickets
+SET flight_id = $2,
+    seat_id = $3,
+    price = $4,
+    updated_at = NOW()
+WHERE ticket_id = $1
+RETURNING *;
+
+-- name: ListTicketOwnersByBookingID :many
+SELECT t.ticket_id,
+    s.seat_code,
+    o.first_name,
+    o.last_name,
+    o.phone_number,
+    o.gender,
+    o.date_of_birth,
+    o.passport_number,
+    o.identification_number,
+    o.address,
+    o.companion_id,
+    o.document_expiry,
+    o.document_country
+FROM Tickets t
+    JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Seats s ON t.seat_id = s.seat_id
+WHERE t.booking_id = $1
+ORDER BY t.ticket_id;
+
+-- name: ListUnnumberedTicketIDs :many
+SELECT ticket_id FROM Tickets
+WHERE ticket_number IS NULL
+ORDER BY ticket_id
+FOR UPDATE;
+
+-- name: SetTicketNumber :exec
+UPDATE Tickets
+SET ticket_number = $2
+WHERE ticket_id = $1
+  AND ticket_number IS NULL;
+
+-- name: ListInfantTicketsByAccompanyingTicketID :many
+SELECT ticket_id, status FROM Tickets
+WHERE accompanying_ticket_id = $1
+  AND status <> 'Cancelled'
+ORDER BY ticket_id
+FOR UPDATE;
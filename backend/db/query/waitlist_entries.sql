@@ -0,0 +1,76 @@
+-- name: CreateWaitlistEntry :one
+INSERT INTO waitlist_entries (
+  flight_id,
+  flight_class,
+  user_email
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListWaitlistEntriesByEmail :many
+SELECT * FROM waitlist_entries
+WHERE user_email = $1
+ORDER BY joined_at DESC;
+
+-- name: GetWaitlistEntryByClaimToken :one
+SELECT * FROM waitlist_entries
+WHERE claim_token = $1
+LIMIT 1;
+
+-- name: GetWaitlistEntryByOfferKey :one
+SELECT * FROM waitlist_entries
+WHERE offer_key = $1
+LIMIT 1;
+
+-- name: GetNextWaitlistEntry :one
+SELECT w.* FROM waitlist_entries w
+LEFT JOIN Users u ON u.email = w.user_email
+LEFT JOIN Customers c ON c.user_id = u.user_id
+WHERE w.flight_id = $1
+  AND w.flight_class = $2
+  AND w.status = 'waiting'
+ORDER BY COALESCE(c.loyalty_tier = ANY($3::text[]), false) DESC,
+  w.joined_at,
+  w.id
+LIMIT 1
+FOR UPDATE OF w SKIP LOCKED;
+
+-- name: OfferWaitlistEntry :one
+UPDATE waitlist_entries
+SET status = 'offered',
+    claim_token = $2,
+    offer_expires_at = $3,
+    offer_key = $4,
+    updated_at = NOW()
+WHERE id = $1 AND status = 'waiting'
+RETURNING *;
+
+-- name: ExpireWaitlistOffer :one
+UPDATE waitlist_entries
+SET status = 'expired',
+    updated_at = NOW()
+WHERE id = $1 AND status IN ('offered', 'claimed')
+RETURNING *;
+
+-- name: ClaimWaitlistOffer :one
+UPDATE waitlist_entries
+SET status = 'claimed',
+    updated_at = NOW()
+WHERE id = $1 AND status = 'offered' AND offer_expires_at > NOW()
+RETURNING *;
+
+-- name: CancelWaitlistEntry :one
+UPDATE waitlist_entries
+SET status = 'cancelled',
+    updated_at = NOW()
+WHERE id = $1 AND user_email = $2 AND status IN ('waiting', 'offered', 'claimed')
+RETURNING *;
+
+-- name: BookWaitlistHolds :exec
+UPDATE waitlist_entries
+SET status = 'booked',
+    updated_at = NOW()
+WHERE flight_id = sqlc.arg(flight_id)
+  AND flight_class = sqlc.arg(flight_class)
+  AND LOWER(user_email) = LOWER(sqlc.arg(user_email))
+  AND status IN ('offered', 'claimed');
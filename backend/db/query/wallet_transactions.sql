@@ -0,0 +1,77 @@
+-- name: CreateWalletTransaction :one
+INSERT INTO wallet_transactions (
+  user_id,
+  kind,
+  amount,
+  remaining,
+  booking_id,
+  refund_id,
+  issued_by,
+  description,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: ListWalletTransactions :many
+SELECT * FROM wallet_transactions
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountWalletTransactions :one
+SELECT COUNT(*) FROM wallet_transactions
+WHERE user_id = $1;
+
+-- name: ListOpenWalletLots :many
+SELECT * FROM wallet_transactions
+WHERE user_id = $1 AND remaining > 0
+ORDER BY expires_at, id
+FOR UPDATE;
+
+-- name: ListExpiredWalletLots :many
+SELECT * FROM wallet_transactions
+WHERE user_id = $1 AND remaining > 0 AND expires_at <= $2
+ORDER BY expires_at, id
+FOR UPDATE;
+
+-- name: SumExpiredWalletCredit :one
+SELECT COALESCE(SUM(remaining), 0)::bigint FROM wallet_transactions
+WHERE user_id = $1 AND remaining > 0 AND expires_at <= $2;
+
+-- name: UpdateWalletLotRemaining :exec
+UPDATE wallet_transactions
+SET remaining = $2
+WHERE id = $1;
+
+-- name: ListWalletPaymentsByBooking :many
+SELECT * FROM wallet_transactions
+WHERE booking_id = $1 AND kind IN ('payment', 'restore')
+ORDER BY id;
+
+-- name: SumWalletPaidByBooking :one
+SELECT COALESCE(-SUM(amount), 0)::bigint FROM wallet_transactions
+WHERE booking_id = $1 AND kind IN ('payment', 'restore');
+
+-- name: GetCustomerWalletBalanceForUpdate :one
+SELECT wallet_balance FROM customers
+WHERE user_id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: AddCustomerWalletBalance :exec
+UPDATE customers
+SET wallet_balance = wallet_balance + sqlc.arg(amount)
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: ListFlightCustomerTickets :many
+SELECT c.user_id, COUNT(t.ticket_id) AS tickets
+FROM tickets t
+  JOIN bookings b ON b.booking_id = t.booking_id
+  JOIN users u ON u.email = b.user_email
+  JOIN customers c ON c.user_id = u.user_id
+WHERE t.flight_id = $1
+  AND t.status = 'Active'
+  AND b.status = 'confirmed'
+GROUP BY c.user_id
+ORDER BY c.user_id;
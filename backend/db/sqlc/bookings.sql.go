@@ -7,20 +7,72 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countCustomerTrips = `-- name: CountCustomerTrips :one
+SELECT COUNT(*)
+FROM bookings b
+    JOIN (
+        SELECT bf.booking_id,
+            MIN(f.departure_time) AS first_departure_time,
+            MAX(f.departure_time) AS last_departure_time
+        FROM (
+                SELECT bs.booking_id, bs.flight_id
+                FROM booking_segments bs
+                UNION
+                SELECT tk.booking_id, tk.flight_id
+                FROM tickets tk
+                WHERE tk.booking_id IS NOT NULL
+            ) bf
+            JOIN flights f ON f.flight_id = bf.flight_id
+        GROUP BY bf.booking_id
+    ) t ON t.booking_id = b.booking_id
+WHERE b.user_email = $1
+    AND (t.last_departure_time >= $2::timestamptz) = $3::boolean
+    AND ($4::text = '' OR b.status::text = $4::text)
+    AND ($5::timestamptz IS NULL OR t.first_departure_time >= $5::timestamptz)
+    AND ($6::timestamptz IS NULL OR t.first_departure_time < $6::timestamptz)
+`
+
+type CountCustomerTripsParams struct {
+	UserEmail   pgtype.Text        `json:"user_email"`
+	Now         time.Time          `json:"now"`
+	Upcoming    bool               `json:"upcoming"`
+	Status      string             `json:"status"`
+	DepartsFrom pgtype.Timestamptz `json:"departs_from"`
+	DepartsTo   pgtype.Timestamptz `json:"departs_to"`
+}
+
+func (q *Queries) CountCustomerTrips(ctx context.Context, arg CountCustomerTripsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countCustomerTrips,
+		arg.UserEmail,
+		arg.Now,
+		arg.Upcoming,
+		arg.Status,
+		arg.DepartsFrom,
+		arg.DepartsTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createBooking = `-- name: CreateBooking :one
 INSERT INTO bookings (
   user_email,
   trip_type,
   departure_flight_id,
   return_flight_id,
-  status
+  status,
+  pnr
 ) VALUES (
-  $1, $2, $3, $4, $5
-) RETURNING booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (pnr) DO NOTHING
+RETURNING booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr
 `
 
 type CreateBookingParams struct {
@@ -29,6 +81,7 @@ type CreateBookingParams struct {
 	DepartureFlightID pgtype.Int8   `json:"departure_flight_id"`
 	ReturnFlightID    pgtype.Int8   `json:"return_flight_id"`
 	Status            BookingStatus `json:"status"`
+	Pnr               string        `json:"pnr"`
 }
 
 func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (Booking, error) {
@@ -38,6 +91,7 @@ func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (B
 		arg.DepartureFlightID,
 		arg.ReturnFlightID,
 		arg.Status,
+		arg.Pnr,
 	)
 	var i Booking
 	err := row.Scan(
@@ -49,6 +103,7 @@ func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (B
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Pnr,
 	)
 	return i, err
 }
@@ -64,7 +119,7 @@ func (q *Queries) DeleteBookings(ctx context.Context, bookingID int64) error {
 }
 
 const getBooking = `-- name: GetBooking :one
-SELECT booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at FROM bookings
+SELECT booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr FROM bookings
 WHERE booking_id = $1 LIMIT 1
 `
 
@@ -80,6 +135,87 @@ func (q *Queries) GetBooking(ctx context.Context, bookingID int64) (Booking, err
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Pnr,
+	)
+	return i, err
+}
+
+const getBookingByPNR = `-- name: GetBookingByPNR :one
+SELECT booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr FROM bookings
+WHERE pnr = $1 LIMIT 1
+`
+
+func (q *Queries) GetBookingByPNR(ctx context.Context, pnr string) (Booking, error) {
+	row := q.db.QueryRow(ctx, getBookingByPNR, pnr)
+	var i Booking
+	err := row.Scan(
+		&i.BookingID,
+		&i.UserEmail,
+		&i.TripType,
+		&i.DepartureFlightID,
+		&i.ReturnFlightID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Pnr,
+	)
+	return i, err
+}
+
+const getBookingByPNRAndLastName = `-- name: GetBookingByPNRAndLastName :one
+SELECT b.booking_id, b.user_email, b.trip_type, b.departure_flight_id, b.return_flight_id, b.status, b.created_at, b.updated_at, b.pnr FROM bookings b
+WHERE b.pnr = $1
+  AND EXISTS (
+    SELECT 1
+    FROM tickets t
+    JOIN ticketownersnapshots o ON o.ticket_id = t.ticket_id
+    WHERE t.booking_id = b.booking_id
+      AND lower(o.last_name) = lower($2::text)
+  )
+LIMIT 1
+`
+
+type GetBookingByPNRAndLastNameParams struct {
+	Pnr      string `json:"pnr"`
+	LastName string `json:"last_name"`
+}
+
+func (q *Queries) GetBookingByPNRAndLastName(ctx context.Context, arg GetBookingByPNRAndLastNameParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, getBookingByPNRAndLastName, arg.Pnr, arg.LastName)
+	var i Booking
+	err := row.Scan(
+		&i.BookingID,
+		&i.UserEmail,
+		&i.TripType,
+		&i.DepartureFlightID,
+		&i.ReturnFlightID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Pnr,
+	)
+	return i, err
+}
+
+const getBookingForUpdate = `-- name: GetBookingForUpdate :one
+SELECT booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr FROM bookings
+WHERE booking_id = $1 LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetBookingForUpdate(ctx context.Context, bookingID int64) (Booking, error) {
+	row := q.db.QueryRow(ctx, getBookingForUpdate, bookingID)
+	var i Booking
+	err := row.Scan(
+		&i.BookingID,
+		&i.UserEmail,
+		&i.TripType,
+		&i.DepartureFlightID,
+		&i.ReturnFlightID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Pnr,
 	)
 	return i, err
 }
@@ -112,7 +248,7 @@ func (q *Queries) GetBookingHistoryByUID(ctx context.Context, userID int64) ([]i
 }
 
 const listBookings = `-- name: ListBookings :many
-SELECT booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at FROM bookings
+SELECT booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr FROM bookings
 ORDER BY booking_id
 LIMIT $1
 OFFSET $2
@@ -141,6 +277,105 @@ func (q *Queries) ListBookings(ctx context.Context, arg ListBookingsParams) ([]B
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Pnr,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCustomerTrips = `-- name: ListCustomerTrips :many
+SELECT b.booking_id, b.user_email, b.trip_type, b.departure_flight_id, b.return_flight_id, b.status, b.created_at, b.updated_at, b.pnr,
+    t.first_departure_time::timestamptz AS first_departure_time,
+    t.last_departure_time::timestamptz AS last_departure_time
+FROM bookings b
+    JOIN (
+        SELECT bf.booking_id,
+            MIN(f.departure_time) AS first_departure_time,
+            MAX(f.departure_time) AS last_departure_time
+        FROM (
+                SELECT bs.booking_id, bs.flight_id
+                FROM booking_segments bs
+                UNION
+                SELECT tk.booking_id, tk.flight_id
+                FROM tickets tk
+                WHERE tk.booking_id IS NOT NULL
+            ) bf
+            JOIN flights f ON f.flight_id = bf.flight_id
+        GROUP BY bf.booking_id
+    ) t ON t.booking_id = b.booking_id
+WHERE b.user_email = $1
+    AND (t.last_departure_time >= $2::timestamptz) = $3::boolean
+    AND ($4::text = '' OR b.status::text = $4::text)
+    AND ($5::timestamptz IS NULL OR t.first_departure_time >= $5::timestamptz)
+    AND ($6::timestamptz IS NULL OR t.first_departure_time < $6::timestamptz)
+ORDER BY CASE WHEN $3::boolean THEN t.first_departure_time END ASC,
+    t.first_departure_time DESC,
+    b.booking_id
+LIMIT $7
+OFFSET $8
+`
+
+type ListCustomerTripsParams struct {
+	UserEmail   pgtype.Text        `json:"user_email"`
+	Now         time.Time          `json:"now"`
+	Upcoming    bool               `json:"upcoming"`
+	Status      string             `json:"status"`
+	DepartsFrom pgtype.Timestamptz `json:"departs_from"`
+	DepartsTo   pgtype.Timestamptz `json:"departs_to"`
+	Limit       int32              `json:"limit"`
+	Offset      int32              `json:"offset"`
+}
+
+type ListCustomerTripsRow struct {
+	BookingID          int64         `json:"booking_id"`
+	UserEmail          pgtype.Text   `json:"user_email"`
+	TripType           TripType      `json:"trip_type"`
+	DepartureFlightID  pgtype.Int8   `json:"departure_flight_id"`
+	ReturnFlightID     pgtype.Int8   `json:"return_flight_id"`
+	Status             BookingStatus `json:"status"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+	Pnr                string        `json:"pnr"`
+	FirstDepartureTime time.Time     `json:"first_departure_time"`
+	LastDepartureTime  time.Time     `json:"last_departure_time"`
+}
+
+func (q *Queries) ListCustomerTrips(ctx context.Context, arg ListCustomerTripsParams) ([]ListCustomerTripsRow, error) {
+	rows, err := q.db.Query(ctx, listCustomerTrips,
+		arg.UserEmail,
+		arg.Now,
+		arg.Upcoming,
+		arg.Status,
+		arg.DepartsFrom,
+		arg.DepartsTo,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCustomerTripsRow{}
+	for rows.Next() {
+		var i ListCustomerTripsRow
+		if err := rows.Scan(
+			&i.BookingID,
+			&i.UserEmail,
+			&i.TripType,
+			&i.DepartureFlightID,
+			&i.ReturnFlightID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Pnr,
+			&i.FirstDepartureTime,
+			&i.LastDepartureTime,
 		); err != nil {
 			return nil, err
 		}
@@ -163,3 +398,92 @@ func (q *Queries) RemoveUserFromBookings(ctx context.Context, userEmail pgtype.T
 	_, err := q.db.Exec(ctx, removeUserFromBookings, userEmail)
 	return err
 }
+
+const updateBookingDepartureFlight = `-- name: UpdateBookingDepartureFlight :one
+UPDATE bookings
+SET departure_flight_id = $2,
+    updated_at = NOW()
+WHERE booking_id = $1
+RETURNING booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr
+`
+
+type UpdateBookingDepartureFlightParams struct {
+	BookingID         int64       `json:"booking_id"`
+	DepartureFlightID pgtype.Int8 `json:"departure_flight_id"`
+}
+
+func (q *Queries) UpdateBookingDepartureFlight(ctx context.Context, arg UpdateBookingDepartureFlightParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, updateBookingDepartureFlight, arg.BookingID, arg.DepartureFlightID)
+	var i Booking
+	err := row.Scan(
+		&i.BookingID,
+		&i.UserEmail,
+		&i.TripType,
+		&i.DepartureFlightID,
+		&i.ReturnFlightID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Pnr,
+	)
+	return i, err
+}
+
+const updateBookingReturnFlight = `-- name: UpdateBookingReturnFlight :one
+UPDATE bookings
+SET return_flight_id = $2,
+    updated_at = NOW()
+WHERE booking_id = $1
+RETURNING booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr
+`
+
+type UpdateBookingReturnFlightParams struct {
+	BookingID      int64       `json:"booking_id"`
+	ReturnFlightID pgtype.Int8 `json:"return_flight_id"`
+}
+
+func (q *Queries) UpdateBookingReturnFlight(ctx context.Context, arg UpdateBookingReturnFlightParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, updateBookingReturnFlight, arg.BookingID, arg.ReturnFlightID)
+	var i Booking
+	err := row.Scan(
+		&i.BookingID,
+		&i.UserEmail,
+		&i.TripType,
+		&i.DepartureFlightID,
+		&i.ReturnFlightID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Pnr,
+	)
+	return i, err
+}
+
+const updateBookingStatus = `-- name: UpdateBookingStatus :one
+UPDATE bookings
+SET status = $2
+WHERE booking_id = $1
+RETURNING booking_id, user_email, trip_type, departure_flight_id, return_flight_id, status, created_at, updated_at, pnr
+`
+
+type UpdateBookingStatusParams struct {
+	BookingID int64         `json:"booking_id"`
+	Status    BookingStatus `json:"status"`
+}
+
+func (q *Queries) UpdateBookingStatus(ctx context.Context, arg UpdateBookingStatusParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, updateBookingStatus, arg.BookingID, arg.Status)
+	var i Booking
+	err := row.Scan(
+		&i.BookingID,
+		&i.UserEmail,
+		&i.TripType,
+		&i.DepartureFlightID,
+		&i.ReturnFlightID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Pnr,
+	)
+	return i, err
+}
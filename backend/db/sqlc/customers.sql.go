@@ -24,7 +24,7 @@ INSERT INTO customers (
     loyalty_points
   )
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING user_id, phone_number, gender, date_of_birth, passport_number, identification_number, address, loyalty_points
+RETURNING user_id, phone_number, gender, date_of_birth, passport_number, identification_number, address, loyalty_points, loyalty_tier, loyalty_tier_updated_at, wallet_balance
 `
 
 type CreateCustomerParams struct {
@@ -59,6 +59,9 @@ func (q *Queries) CreateCustomer(ctx context.Context, arg CreateCustomerParams)
 		&i.IdentificationNumber,
 		&i.Address,
 		&i.LoyaltyPoints,
+		&i.LoyaltyTier,
+		&i.LoyaltyTierUpdatedAt,
+		&i.WalletBalance,
 	)
 	return i, err
 }
@@ -77,7 +80,7 @@ func (q *Queries) DeleteCustomerByID(ctx context.Context, userID int64) (int64,
 }
 
 const getCustomer = `-- name: GetCustomer :one
-SELECT user_id, phone_number, gender, date_of_birth, passport_number, identification_number, address, loyalty_points
+SELECT user_id, phone_number, gender, date_of_birth, passport_number, identification_number, address, loyalty_points, loyalty_tier, loyalty_tier_updated_at, wallet_balance
 FROM customers
 WHERE user_id = $1
 LIMIT 1
@@ -95,12 +98,15 @@ func (q *Queries) GetCustomer(ctx context.Context, userID int64) (Customer, erro
 		&i.IdentificationNumber,
 		&i.Address,
 		&i.LoyaltyPoints,
+		&i.LoyaltyTier,
+		&i.LoyaltyTierUpdatedAt,
+		&i.WalletBalance,
 	)
 	return i, err
 }
 
 const getCustomerByEmail = `-- name: GetCustomerByEmail :one
-SELECT c.user_id, c.phone_number, c.gender, c.date_of_birth, c.passport_number, c.identification_number, c.address, c.loyalty_points
+SELECT c.user_id, c.phone_number, c.gender, c.date_of_birth, c.passport_number, c.identification_number, c.address, c.loyalty_points, c.loyalty_tier, c.loyalty_tier_updated_at, c.wallet_balance
 FROM customers c
   JOIN users u ON c.user_id = u.user_id
 WHERE u.email = $1
@@ -119,6 +125,9 @@ func (q *Queries) GetCustomerByEmail(ctx context.Context, email string) (Custome
 		&i.IdentificationNumber,
 		&i.Address,
 		&i.LoyaltyPoints,
+		&i.LoyaltyTier,
+		&i.LoyaltyTierUpdatedAt,
+		&i.WalletBalance,
 	)
 	return i, err
 }
@@ -174,7 +183,7 @@ func (q *Queries) GetCustomerByID(ctx context.Context, userID int64) (GetCustome
 }
 
 const listCustomers = `-- name: ListCustomers :many
-SELECT user_id, phone_number, gender, date_of_birth, passport_number, identification_number, address, loyalty_points
+SELECT user_id, phone_number, gender, date_of_birth, passport_number, identification_number, address, loyalty_points, loyalty_tier, loyalty_tier_updated_at, wallet_balance
 FROM customers
 ORDER BY user_id DESC
 LIMIT $1 OFFSET $2
@@ -203,6 +212,9 @@ func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([
 			&i.IdentificationNumber,
 			&i.Address,
 			&i.LoyaltyPoints,
+			&i.LoyaltyTier,
+			&i.LoyaltyTierUpdatedAt,
+			&i.WalletBalance,
 		); err != nil {
 			return nil, err
 		}
@@ -214,6 +226,17 @@ func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([
 	return items, nil
 }
 
+const lockCustomer = `-- name: LockCustomer :exec
+SELECT user_id FROM Customers
+WHERE user_id = $1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) LockCustomer(ctx context.Context, userID int64) error {
+	_, err := q.db.Exec(ctx, lockCustomer, userID)
+	return err
+}
+
 const updateCustomer = `-- name: UpdateCustomer :exec
 UPDATE customers
 SET phone_number = $1,
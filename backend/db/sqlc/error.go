@@ -0,0 +1,88 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrRecordNotFound is returned by :one queries when no row matches.
+var ErrRecordNotFound = pgx.ErrNoRows
+
+// ErrFlightChangeConflict is returned by ChangeFlightTx when the booking no longer
+// matches the quote the change was priced from.
+var ErrFlightChangeConflict = errors.New("booking changed since the flight change was quoted")
+
+// ErrSeatTaken is returned by CreateBookingTx and CompleteSeatSelectionTx when a requested
+// seat is already held on the flight.
+var ErrSeatTaken = errors.New("seat is already taken")
+
+// ErrCabinFull is returned by CreateBookingTx when a cabin of a flight has fewer seats
+// left than the booking asks for.
+var ErrCabinFull = errors.New("not enough seats left in the cabin")
+
+// ErrSeatSelectionConflict is returned by CompleteSeatSelectionTx when the tickets of a
+// paid seat selection changed between the request and the payment.
+var ErrSeatSelectionConflict = errors.New("booking changed since the seats were selected")
+
+// SeatCodeUniqueIndex keeps every seat code of a flight held by at most one active ticket.
+const SeatCodeUniqueIndex = "idx_seats_flight_seat_code"
+
+// IsUniqueViolation reports whether err violates the unique index or constraint named constraint.
+func IsUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
+// ErrGroupNamesClosed is returned by ReplaceGroupBookingPassengersTx when the group no
+// longer holds its seats or its name cutoff has passed.
+var ErrGroupNamesClosed = errors.New("group booking no longer accepts passenger names")
+
+// ErrGroupBookingChanged is returned by the group booking transactions when the group is
+// no longer in the state the step needs, e.g. its deposit deadline passed or it was
+// already ticketed.
+var ErrGroupBookingChanged = errors.New("group booking changed state")
+
+// ErrSpecialServiceFull is returned by AddTicketSpecialServiceTx when the flight already
+// carries as many requests for the service as it allows.
+var ErrSpecialServiceFull = errors.New("special service is fully booked on this flight")
+
+// ErrSpecialServiceExists is returned by AddTicketSpecialServiceTx when the passenger
+// already holds the code, or another code of its category.
+var ErrSpecialServiceExists = errors.New("special service is already requested for this passenger")
+
+// SpecialServiceTicketCodeKey keeps every special service code requested at most once per ticket.
+const SpecialServiceTicketCodeKey = "ticket_special_services_ticket_code_key"
+
+// ErrBookingNotChangeable is returned by the transactions changing a booking when the
+// booking is no longer pending or confirmed.
+var ErrBookingNotChangeable = errors.New("booking cannot be changed in its current status")
+
+// ErrTicketNotActive is returned by the transactions changing a ticket when the ticket
+// was cancelled or moved to another flight meanwhile.
+var ErrTicketNotActive = errors.New("ticket is not active on the flight")
+
+// ErrFlightDeparted is returned by the transactions changing a ticket when its flight
+// has already departed.
+var ErrFlightDeparted = errors.New("flight has already departed")
+
+// ErrTicketAlreadyCheckedIn is returned by CheckInTicketsTx when a passenger was checked
+// in meanwhile.
+var ErrTicketAlreadyCheckedIn = errors.New("ticket is already checked in")
+
+// ErrTicketNotCheckedIn is returned by UndoCheckInTx when a passenger's check-in was
+// undone meanwhile.
+var ErrTicketNotCheckedIn = errors.New("ticket is not checked in")
+
+// ErrPaymentSettled is returned by the transactions applying a payment when the
+// payment was already captured or failed, e.g. for a webhook delivered twice.
+var ErrPaymentSettled = errors.New("payment is already settled")
+
+// ErrBookingNotConfirmed is returned by the transactions charging a confirmed booking
+// for something more when the booking is no longer confirmed.
+var ErrBookingNotConfirmed = errors.New("booking is not confirmed")
+
+// ErrCompanionLimit is returned by CreateCompanionTx when the customer already saved as
+// many companion profiles as allowed.
+var ErrCompanionLimit = errors.New("companion profile limit reached")
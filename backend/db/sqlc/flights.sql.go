@@ -12,6 +12,73 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countSoldSeats = `-- name: CountSoldSeats :one
+SELECT COUNT(*) FROM tickets
+WHERE flight_id = $1
+  AND status = 'Active'
+  AND seat_id IS NOT NULL
+`
+
+func (q *Queries) CountSoldSeats(ctx context.Context, flightID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countSoldSeats, flightID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSoldSeatsByClass = `-- name: CountSoldSeatsByClass :many
+SELECT seats.flight_id, seats.flight_class, SUM(seats.sold)::bigint AS sold
+FROM (
+    SELECT t.flight_id, t.flight_class, COUNT(*) AS sold
+    FROM tickets t
+    WHERE t.flight_id = ANY($1::bigint[])
+      AND t.status = 'Active'
+      AND t.seat_id IS NOT NULL
+    GROUP BY t.flight_id, t.flight_class
+    UNION ALL
+    SELECT f.flight_id, g.flight_class, SUM(g.headcount) AS sold
+    FROM group_bookings g
+        JOIN unnest($1::bigint[]) AS f(flight_id)
+            ON f.flight_id = g.outbound_flight_id OR f.flight_id = g.return_flight_id
+    WHERE g.status = 'deposit_paid' OR (g.status = 'quoted' AND g.deposit_deadline > NOW())
+    GROUP BY f.flight_id, g.flight_class
+    UNION ALL
+    SELECT w.flight_id, w.flight_class, COUNT(*) AS sold
+    FROM waitlist_entries w
+    WHERE w.flight_id = ANY($1::bigint[])
+      AND w.status IN ('offered', 'claimed')
+      AND w.offer_expires_at > NOW()
+    GROUP BY w.flight_id, w.flight_class
+) seats
+GROUP BY seats.flight_id, seats.flight_class
+`
+
+type CountSoldSeatsByClassRow struct {
+	FlightID    int64       `json:"flight_id"`
+	FlightClass FlightClass `json:"flight_class"`
+	Sold        int64       `json:"sold"`
+}
+
+func (q *Queries) CountSoldSeatsByClass(ctx context.Context, flightIds []int64) ([]CountSoldSeatsByClassRow, error) {
+	rows, err := q.db.Query(ctx, countSoldSeatsByClass, flightIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountSoldSeatsByClassRow{}
+	for rows.Next() {
+		var i CountSoldSeatsByClassRow
+		if err := rows.Scan(&i.FlightID, &i.FlightClass, &i.Sold); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createFlight = `-- name: CreateFlight :one
 INSERT INTO flights (
     flight_number,
@@ -23,10 +90,12 @@ INSERT INTO flights (
     departure_time,
     arrival_time,
     base_price,
-    status
+    status,
+    departure_country,
+    arrival_country
   )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-RETURNING flight_id, flight_number, airline, aircraft_type, departure_city, arrival_city, departure_airport, arrival_airport, departure_time, arrival_time, base_price, total_seats_row, total_seats_column, status
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING flight_id, flight_number, airline, aircraft_type, departure_city, arrival_city, departure_airport, arrival_airport, departure_time, arrival_time, base_price, total_seats_row, total_seats_column, status, departure_country, arrival_country
 `
 
 type CreateFlightParams struct {
@@ -40,6 +109,8 @@ type CreateFlightParams struct {
 	ArrivalTime      time.Time    `json:"arrival_time"`
 	BasePrice        int32        `json:"base_price"`
 	Status           FlightStatus `json:"status"`
+	DepartureCountry string       `json:"departure_country"`
+	ArrivalCountry   string       `json:"arrival_country"`
 }
 
 func (q *Queries) CreateFlight(ctx context.Context, arg CreateFlightParams) (Flight, error) {
@@ -54,6 +125,8 @@ func (q *Queries) CreateFlight(ctx context.Context, arg CreateFlightParams) (Fli
 		arg.ArrivalTime,
 		arg.BasePrice,
 		arg.Status,
+		arg.DepartureCountry,
+		arg.ArrivalCountry,
 	)
 	var i Flight
 	err := row.Scan(
@@ -71,6 +144,8 @@ func (q *Queries) CreateFlight(ctx context.Context, arg CreateFlightParams) (Fli
 		&i.TotalSeatsRow,
 		&i.TotalSeatsColumn,
 		&i.Status,
+		&i.DepartureCountry,
+		&i.ArrivalCountry,
 	)
 	return i, err
 }
@@ -144,7 +219,7 @@ func (q *Queries) GetAllFlights(ctx context.Context) ([]GetAllFlightsRow, error)
 }
 
 const getFlight = `-- name: GetFlight :one
-SELECT flight_id, flight_number, airline, aircraft_type, departure_city, arrival_city, departure_airport, arrival_airport, departure_time, arrival_time, base_price, total_seats_row, total_seats_column, status
+SELECT flight_id, flight_number, airline, aircraft_type, departure_city, arrival_city, departure_airport, arrival_airport, departure_time, arrival_time, base_price, total_seats_row, total_seats_column, status, departure_country, arrival_country
 FROM flights
 WHERE flight_id = $1
 LIMIT 1
@@ -168,6 +243,8 @@ func (q *Queries) GetFlight(ctx context.Context, flightID int64) (Flight, error)
 		&i.TotalSeatsRow,
 		&i.TotalSeatsColumn,
 		&i.Status,
+		&i.DepartureCountry,
+		&i.ArrivalCountry,
 	)
 	return i, err
 }
@@ -186,6 +263,67 @@ func (q *Queries) GetFlightsByStatus(ctx context.Context, flightID int64) (Fligh
 	return status, err
 }
 
+const listAlternativeFlights = `-- name: ListAlternativeFlights :many
+SELECT flight_id, flight_number, airline, aircraft_type, departure_city, arrival_city, departure_airport, arrival_airport, departure_time, arrival_time, base_price, total_seats_row, total_seats_column, status, departure_country, arrival_country
+FROM flights
+WHERE departure_city = $1
+  AND arrival_city = $2
+  AND departure_time > $3
+  AND flight_id <> $4
+  AND status <> 'Cancelled'
+ORDER BY departure_time
+LIMIT 20
+`
+
+type ListAlternativeFlightsParams struct {
+	DepartureCity pgtype.Text `json:"departure_city"`
+	ArrivalCity   pgtype.Text `json:"arrival_city"`
+	DepartureTime time.Time   `json:"departure_time"`
+	FlightID      int64       `json:"flight_id"`
+}
+
+func (q *Queries) ListAlternativeFlights(ctx context.Context, arg ListAlternativeFlightsParams) ([]Flight, error) {
+	rows, err := q.db.Query(ctx, listAlternativeFlights,
+		arg.DepartureCity,
+		arg.ArrivalCity,
+		arg.DepartureTime,
+		arg.FlightID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Flight{}
+	for rows.Next() {
+		var i Flight
+		if err := rows.Scan(
+			&i.FlightID,
+			&i.FlightNumber,
+			&i.Airline,
+			&i.AircraftType,
+			&i.DepartureCity,
+			&i.ArrivalCity,
+			&i.DepartureAirport,
+			&i.ArrivalAirport,
+			&i.DepartureTime,
+			&i.ArrivalTime,
+			&i.BasePrice,
+			&i.TotalSeatsRow,
+			&i.TotalSeatsColumn,
+			&i.Status,
+			&i.DepartureCountry,
+			&i.ArrivalCountry,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFlights = `-- name: ListFlights :many
 SELECT flight_id,
   flight_number,
@@ -254,6 +392,60 @@ func (q *Queries) ListFlights(ctx context.Context, arg ListFlightsParams) ([]Lis
 	return items, nil
 }
 
+const listFlightsByIDs = `-- name: ListFlightsByIDs :many
+SELECT flight_id, flight_number, airline, aircraft_type, departure_city, arrival_city, departure_airport, arrival_airport, departure_time, arrival_time, base_price, total_seats_row, total_seats_column, status, departure_country, arrival_country
+FROM flights
+WHERE flight_id = ANY($1::bigint[])
+`
+
+func (q *Queries) ListFlightsByIDs(ctx context.Context, flightIds []int64) ([]Flight, error) {
+	rows, err := q.db.Query(ctx, listFlightsByIDs, flightIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Flight{}
+	for rows.Next() {
+		var i Flight
+		if err := rows.Scan(
+			&i.FlightID,
+			&i.FlightNumber,
+			&i.Airline,
+			&i.AircraftType,
+			&i.DepartureCity,
+			&i.ArrivalCity,
+			&i.DepartureAirport,
+			&i.ArrivalAirport,
+			&i.DepartureTime,
+			&i.ArrivalTime,
+			&i.BasePrice,
+			&i.TotalSeatsRow,
+			&i.TotalSeatsColumn,
+			&i.Status,
+			&i.DepartureCountry,
+			&i.ArrivalCountry,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockFlight = `-- name: LockFlight :exec
+SELECT flight_id FROM flights
+WHERE flight_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockFlight(ctx context.Context, flightID int64) error {
+	_, err := q.db.Exec(ctx, lockFlight, flightID)
+	return err
+}
+
 const searchFlights = `-- name: SearchFlights :many
 SELECT flight_id,
   flight_number,
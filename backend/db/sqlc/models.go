@@ -55,6 +55,49 @@ func (ns NullBookingStatus) Value() (driver.Value, error) {
 	return string(ns.BookingStatus), nil
 }
 
+type FareFamilyType string
+
+const (
+	FareFamilyTypeLite    FareFamilyType = "lite"
+	FareFamilyTypeClassic FareFamilyType = "classic"
+	FareFamilyTypeFlex    FareFamilyType = "flex"
+)
+
+func (e *FareFamilyType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FareFamilyType(s)
+	case string:
+		*e = FareFamilyType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FareFamilyType: %T", src)
+	}
+	return nil
+}
+
+type NullFareFamilyType struct {
+	FareFamilyType FareFamilyType `json:"fare_family_type"`
+	Valid          bool           `json:"valid"` // Valid is true if FareFamilyType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFareFamilyType) Scan(value interface{}) error {
+	if value == nil {
+		ns.FareFamilyType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FareFamilyType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFareFamilyType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FareFamilyType), nil
+}
+
 type FlightClass string
 
 const (
@@ -188,6 +231,49 @@ func (ns NullGenderType) Value() (driver.Value, error) {
 	return string(ns.GenderType), nil
 }
 
+type PassengerType string
+
+const (
+	PassengerTypeAdult  PassengerType = "adult"
+	PassengerTypeChild  PassengerType = "child"
+	PassengerTypeInfant PassengerType = "infant"
+)
+
+func (e *PassengerType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PassengerType(s)
+	case string:
+		*e = PassengerType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PassengerType: %T", src)
+	}
+	return nil
+}
+
+type NullPassengerType struct {
+	PassengerType PassengerType `json:"passenger_type"`
+	Valid         bool          `json:"valid"` // Valid is true if PassengerType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPassengerType) Scan(value interface{}) error {
+	if value == nil {
+		ns.PassengerType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PassengerType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPassengerType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PassengerType), nil
+}
+
 type TicketStatus string
 
 const (
@@ -235,6 +321,7 @@ type TripType string
 const (
 	TripTypeOneWay    TripType = "oneWay"
 	TripTypeRoundTrip TripType = "roundTrip"
+	TripTypeMultiCity TripType = "multiCity"
 )
 
 func (e *TripType) Scan(src interface{}) error {
@@ -318,6 +405,22 @@ type Admin struct {
 	UserID int64 `json:"user_id"`
 }
 
+type Ancillary struct {
+	ID            int64     `json:"id"`
+	Code          string    `json:"code"`
+	AncillaryType string    `json:"ancillary_type"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	DepartureCity string    `json:"departure_city"`
+	ArrivalCity   string    `json:"arrival_city"`
+	FlightClass   string    `json:"flight_class"`
+	Price         int64     `json:"price"`
+	MaxQuantity   int32     `json:"max_quantity"`
+	Active        bool      `json:"active"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
 type Booking struct {
 	BookingID         int64         `json:"booking_id"`
 	UserEmail         pgtype.Text   `json:"user_email"`
@@ -327,6 +430,40 @@ type Booking struct {
 	Status            BookingStatus `json:"status"`
 	CreatedAt         time.Time     `json:"created_at"`
 	UpdatedAt         time.Time     `json:"updated_at"`
+	Pnr               string        `json:"pnr"`
+}
+
+type BookingSegment struct {
+	ID           int64     `json:"id"`
+	BookingID    int64     `json:"booking_id"`
+	SegmentOrder int16     `json:"segment_order"`
+	FlightID     int64     `json:"flight_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type BookingStatusHistory struct {
+	ID         int64             `json:"id"`
+	BookingID  int64             `json:"booking_id"`
+	FromStatus NullBookingStatus `json:"from_status"`
+	ToStatus   BookingStatus     `json:"to_status"`
+	Actor      string            `json:"actor"`
+	Reason     string            `json:"reason"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+type Companion struct {
+	ID              int64      `json:"id"`
+	UserID          int64      `json:"user_id"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	Gender          GenderType `json:"gender"`
+	DateOfBirth     time.Time  `json:"date_of_birth"`
+	DocumentType    string     `json:"document_type"`
+	DocumentNumber  string     `json:"document_number"`
+	DocumentExpiry  time.Time  `json:"document_expiry"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DocumentCountry string     `json:"document_country"`
 }
 
 type Customer struct {
@@ -338,6 +475,24 @@ type Customer struct {
 	IdentificationNumber pgtype.Text `json:"identification_number"`
 	Address              pgtype.Text `json:"address"`
 	LoyaltyPoints        pgtype.Int4 `json:"loyalty_points"`
+	LoyaltyTier          string      `json:"loyalty_tier"`
+	LoyaltyTierUpdatedAt time.Time   `json:"loyalty_tier_updated_at"`
+	WalletBalance        int64       `json:"wallet_balance"`
+}
+
+type FareFamily struct {
+	ID               int64          `json:"id"`
+	FlightClass      FlightClass    `json:"flight_class"`
+	Family           FareFamilyType `json:"family"`
+	Name             string         `json:"name"`
+	FarePercent      int32          `json:"fare_percent"`
+	CabinBaggageKg   int32          `json:"cabin_baggage_kg"`
+	CheckedBaggageKg int32          `json:"checked_baggage_kg"`
+	Changeable       bool           `json:"changeable"`
+	ChangeFeeWaived  bool           `json:"change_fee_waived"`
+	Refundable       bool           `json:"refundable"`
+	FullRefund       bool           `json:"full_refund"`
+	SeatSelection    bool           `json:"seat_selection"`
 }
 
 type Flight struct {
@@ -355,6 +510,74 @@ type Flight struct {
 	TotalSeatsRow    int32        `json:"total_seats_row"`
 	TotalSeatsColumn int32        `json:"total_seats_column"`
 	Status           FlightStatus `json:"status"`
+	DepartureCountry string       `json:"departure_country"`
+	ArrivalCountry   string       `json:"arrival_country"`
+}
+
+type FlightChange struct {
+	ID           int64              `json:"id"`
+	BookingID    int64              `json:"booking_id"`
+	FromFlightID int64              `json:"from_flight_id"`
+	ToFlightID   int64              `json:"to_flight_id"`
+	Tickets      []byte             `json:"tickets"`
+	AmountDue    int64              `json:"amount_due"`
+	RefundAmount int64              `json:"refund_amount"`
+	Actor        string             `json:"actor"`
+	Status       string             `json:"status"`
+	CreatedAt    time.Time          `json:"created_at"`
+	CompletedAt  pgtype.Timestamptz `json:"completed_at"`
+}
+
+type GroupBooking struct {
+	ID               int64              `json:"id"`
+	UserEmail        string             `json:"user_email"`
+	DepartureCity    string             `json:"departure_city"`
+	ArrivalCity      string             `json:"arrival_city"`
+	DepartureDate    time.Time          `json:"departure_date"`
+	ReturnDate       pgtype.Timestamptz `json:"return_date"`
+	FlightClass      FlightClass        `json:"flight_class"`
+	Headcount        int32              `json:"headcount"`
+	Note             string             `json:"note"`
+	Status           string             `json:"status"`
+	OutboundFlightID pgtype.Int8        `json:"outbound_flight_id"`
+	ReturnFlightID   pgtype.Int8        `json:"return_flight_id"`
+	FarePerPassenger int64              `json:"fare_per_passenger"`
+	DepositAmount    int64              `json:"deposit_amount"`
+	DepositDeadline  pgtype.Timestamptz `json:"deposit_deadline"`
+	NameCutoff       pgtype.Timestamptz `json:"name_cutoff"`
+	DepositPaidAt    pgtype.Timestamptz `json:"deposit_paid_at"`
+	CreatedAt        time.Time          `json:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at"`
+	BookingID        pgtype.Int8        `json:"booking_id"`
+}
+
+type GroupBookingPassenger struct {
+	ID                   int64       `json:"id"`
+	GroupBookingID       int64       `json:"group_booking_id"`
+	FirstName            string      `json:"first_name"`
+	LastName             string      `json:"last_name"`
+	PhoneNumber          string      `json:"phone_number"`
+	Gender               GenderType  `json:"gender"`
+	DateOfBirth          time.Time   `json:"date_of_birth"`
+	IdentificationNumber string      `json:"identification_number"`
+	Address              string      `json:"address"`
+	PassportNumber       string      `json:"passport_number"`
+	DocumentExpiry       pgtype.Date `json:"document_expiry"`
+	DocumentCountry      string      `json:"document_country"`
+}
+
+type LoyaltyTransaction struct {
+	ID          int64              `json:"id"`
+	UserID      int64              `json:"user_id"`
+	Kind        string             `json:"kind"`
+	Points      int32              `json:"points"`
+	Remaining   int32              `json:"remaining"`
+	Amount      int64              `json:"amount"`
+	TicketID    pgtype.Int8        `json:"ticket_id"`
+	BookingID   pgtype.Int8        `json:"booking_id"`
+	Description string             `json:"description"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt   time.Time          `json:"created_at"`
 }
 
 type News struct {
@@ -368,6 +591,72 @@ type News struct {
 	UpdatedAt   time.Time   `json:"updated_at"`
 }
 
+type Payment struct {
+	ID             int64       `json:"id"`
+	BookingID      pgtype.Int8 `json:"booking_id"`
+	Purpose        string      `json:"purpose"`
+	ReferenceID    int64       `json:"reference_id"`
+	IntentID       string      `json:"intent_id"`
+	Amount         int64       `json:"amount"`
+	AmountReceived int64       `json:"amount_received"`
+	AmountRefunded int64       `json:"amount_refunded"`
+	Currency       string      `json:"currency"`
+	Status         string      `json:"status"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+type PricingCurve struct {
+	ID                   int64       `json:"id"`
+	DepartureCity        string      `json:"departure_city"`
+	ArrivalCity          string      `json:"arrival_city"`
+	FlightClass          FlightClass `json:"flight_class"`
+	LoadFactorSteps      []byte      `json:"load_factor_steps"`
+	DaysToDepartureSteps []byte      `json:"days_to_departure_steps"`
+	CreatedAt            time.Time   `json:"created_at"`
+	UpdatedAt            time.Time   `json:"updated_at"`
+}
+
+type PromoCode struct {
+	ID                 int64     `json:"id"`
+	Code               string    `json:"code"`
+	Description        string    `json:"description"`
+	DiscountType       string    `json:"discount_type"`
+	DiscountValue      int64     `json:"discount_value"`
+	MaxDiscount        int64     `json:"max_discount"`
+	ValidFrom          time.Time `json:"valid_from"`
+	ValidUntil         time.Time `json:"valid_until"`
+	DepartureCity      string    `json:"departure_city"`
+	ArrivalCity        string    `json:"arrival_city"`
+	FlightClasses      []string  `json:"flight_classes"`
+	MaxUses            int32     `json:"max_uses"`
+	MaxUsesPerCustomer int32     `json:"max_uses_per_customer"`
+	Stackable          bool      `json:"stackable"`
+	Active             bool      `json:"active"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type PromoRedemption struct {
+	ID          int64              `json:"id"`
+	PromoCodeID int64              `json:"promo_code_id"`
+	BookingID   int64              `json:"booking_id"`
+	UserEmail   string             `json:"user_email"`
+	Amount      int64              `json:"amount"`
+	CreatedAt   time.Time          `json:"created_at"`
+	ReleasedAt  pgtype.Timestamptz `json:"released_at"`
+}
+
+type Refund struct {
+	ID        int64     `json:"id"`
+	BookingID int64     `json:"booking_id"`
+	Amount    int64     `json:"amount"`
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+	Method    string    `json:"method"`
+}
+
 type Seat struct {
 	SeatID      int64       `json:"seat_id"`
 	FlightID    pgtype.Int8 `json:"flight_id"`
@@ -376,16 +665,96 @@ type Seat struct {
 	Class       FlightClass `json:"class"`
 }
 
+type SeatSelection struct {
+	ID          int64              `json:"id"`
+	BookingID   int64              `json:"booking_id"`
+	Selections  []byte             `json:"selections"`
+	AmountDue   int64              `json:"amount_due"`
+	Status      string             `json:"status"`
+	CreatedAt   time.Time          `json:"created_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+}
+
+type SeatZone struct {
+	ID           int64       `json:"id"`
+	FlightID     pgtype.Int8 `json:"flight_id"`
+	AircraftType string      `json:"aircraft_type"`
+	ZoneType     string      `json:"zone_type"`
+	Name         string      `json:"name"`
+	RowFrom      int32       `json:"row_from"`
+	RowTo        int32       `json:"row_to"`
+	Price        int64       `json:"price"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+type SpecialService struct {
+	Code        string `json:"code"`
+	Category    string `json:"category"`
+	Name        string `json:"name"`
+	FlightLimit int32  `json:"flight_limit"`
+	Active      bool   `json:"active"`
+}
+
 type Ticket struct {
-	TicketID    int64        `json:"ticket_id"`
-	SeatID      int64        `json:"seat_id"`
-	FlightClass FlightClass  `json:"flight_class"`
-	Price       int32        `json:"price"`
-	Status      TicketStatus `json:"status"`
-	BookingID   pgtype.Int8  `json:"booking_id"`
-	FlightID    int64        `json:"flight_id"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
+	TicketID             int64          `json:"ticket_id"`
+	SeatID               pgtype.Int8    `json:"seat_id"`
+	FlightClass          FlightClass    `json:"flight_class"`
+	Price                int32          `json:"price"`
+	Status               TicketStatus   `json:"status"`
+	BookingID            pgtype.Int8    `json:"booking_id"`
+	FlightID             int64          `json:"flight_id"`
+	CreatedAt            time.Time      `json:"created_at"`
+	UpdatedAt            time.Time      `json:"updated_at"`
+	TicketNumber         pgtype.Text    `json:"ticket_number"`
+	PassengerType        PassengerType  `json:"passenger_type"`
+	AccompanyingTicketID pgtype.Int8    `json:"accompanying_ticket_id"`
+	FareFamily           FareFamilyType `json:"fare_family"`
+}
+
+type TicketAncillary struct {
+	ID            int64              `json:"id"`
+	TicketID      int64              `json:"ticket_id"`
+	BookingID     int64              `json:"booking_id"`
+	AncillaryID   pgtype.Int8        `json:"ancillary_id"`
+	Code          string             `json:"code"`
+	AncillaryType string             `json:"ancillary_type"`
+	Name          string             `json:"name"`
+	Quantity      int32              `json:"quantity"`
+	UnitPrice     int64              `json:"unit_price"`
+	Amount        int64              `json:"amount"`
+	Status        string             `json:"status"`
+	CreatedAt     time.Time          `json:"created_at"`
+	CancelledAt   pgtype.Timestamptz `json:"cancelled_at"`
+}
+
+type TicketCheckIn struct {
+	TicketID    int64     `json:"ticket_id"`
+	BookingID   int64     `json:"booking_id"`
+	FlightID    int64     `json:"flight_id"`
+	CheckedInAt time.Time `json:"checked_in_at"`
+}
+
+type TicketFareItem struct {
+	ID          int64     `json:"id"`
+	TicketID    int64     `json:"ticket_id"`
+	ItemType    string    `json:"item_type"`
+	Code        string    `json:"code"`
+	Description string    `json:"description"`
+	Amount      int64     `json:"amount"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type TicketSpecialService struct {
+	ID        int64     `json:"id"`
+	TicketID  int64     `json:"ticket_id"`
+	BookingID int64     `json:"booking_id"`
+	FlightID  int64     `json:"flight_id"`
+	Code      string    `json:"code"`
+	Category  string    `json:"category"`
+	Name      string    `json:"name"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Ticketownersnapshot struct {
@@ -398,6 +767,9 @@ type Ticketownersnapshot struct {
 	PassportNumber       pgtype.Text `json:"passport_number"`
 	IdentificationNumber pgtype.Text `json:"identification_number"`
 	Address              pgtype.Text `json:"address"`
+	CompanionID          pgtype.Int8 `json:"companion_id"`
+	DocumentExpiry       pgtype.Date `json:"document_expiry"`
+	DocumentCountry      string      `json:"document_country"`
 }
 
 type User struct {
@@ -412,3 +784,30 @@ type User struct {
 	CreatedAt      time.Time          `json:"created_at"`
 	UpdatedAt      time.Time          `json:"updated_at"`
 }
+
+type WaitlistEntry struct {
+	ID             int64              `json:"id"`
+	FlightID       int64              `json:"flight_id"`
+	FlightClass    FlightClass        `json:"flight_class"`
+	UserEmail      string             `json:"user_email"`
+	Status         string             `json:"status"`
+	ClaimToken     pgtype.Text        `json:"claim_token"`
+	OfferExpiresAt pgtype.Timestamptz `json:"offer_expires_at"`
+	JoinedAt       time.Time          `json:"joined_at"`
+	UpdatedAt      time.Time          `json:"updated_at"`
+	OfferKey       pgtype.Text        `json:"offer_key"`
+}
+
+type WalletTransaction struct {
+	ID          int64              `json:"id"`
+	UserID      int64              `json:"user_id"`
+	Kind        string             `json:"kind"`
+	Amount      int64              `json:"amount"`
+	Remaining   int64              `json:"remaining"`
+	BookingID   pgtype.Int8        `json:"booking_id"`
+	RefundID    pgtype.Int8        `json:"refund_id"`
+	IssuedBy    string             `json:"issued_by"`
+	Description string             `json:"description"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
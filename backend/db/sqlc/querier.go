@@ -11,26 +11,76 @@ import (
 )
 
 type Querier interface {
+	ActivateTicketAncillary(ctx context.Context, id int64) (TicketAncillary, error)
+	AddCustomerLoyaltyPoints(ctx context.Context, arg AddCustomerLoyaltyPointsParams) error
+	AddCustomerWalletBalance(ctx context.Context, arg AddCustomerWalletBalanceParams) error
+	AddPaymentRefund(ctx context.Context, arg AddPaymentRefundParams) (Payment, error)
+	AttachGroupDepositPayments(ctx context.Context, arg AttachGroupDepositPaymentsParams) error
+	BookWaitlistHolds(ctx context.Context, arg BookWaitlistHoldsParams) error
 	CancelTicket(ctx context.Context, ticketID int64) (CancelTicketRow, error)
+	CancelTicketAncillary(ctx context.Context, arg CancelTicketAncillaryParams) (TicketAncillary, error)
+	CancelWaitlistEntry(ctx context.Context, arg CancelWaitlistEntryParams) (WaitlistEntry, error)
 	CheckSeatAvailability(ctx context.Context, arg CheckSeatAvailabilityParams) (bool, error)
+	ClaimWaitlistOffer(ctx context.Context, id int64) (WaitlistEntry, error)
+	CountCompanionsByUser(ctx context.Context, userID int64) (int64, error)
+	CountCustomerTrips(ctx context.Context, arg CountCustomerTripsParams) (int64, error)
+	CountFlightSpecialServices(ctx context.Context, arg CountFlightSpecialServicesParams) (int64, error)
+	CountGroupBlockedSeats(ctx context.Context, outboundFlightID pgtype.Int8) (int64, error)
+	CountLoyaltyTransactions(ctx context.Context, userID int64) (int64, error)
 	CountOccupiedSeats(ctx context.Context, flightID pgtype.Int8) (int64, error)
+	CountPromoRedemptions(ctx context.Context, promoCodeID int64) (int64, error)
+	CountPromoRedemptionsByEmail(ctx context.Context, arg CountPromoRedemptionsByEmailParams) (int64, error)
+	CountSoldSeats(ctx context.Context, flightID int64) (int64, error)
+	CountSoldSeatsByClass(ctx context.Context, flightIds []int64) ([]CountSoldSeatsByClassRow, error)
+	CountWalletTransactions(ctx context.Context, userID int64) (int64, error)
 	CreateAdmin(ctx context.Context, userID int64) (int64, error)
 	CreateBooking(ctx context.Context, arg CreateBookingParams) (Booking, error)
+	CreateBookingSegment(ctx context.Context, arg CreateBookingSegmentParams) (BookingSegment, error)
+	CreateBookingStatusHistory(ctx context.Context, arg CreateBookingStatusHistoryParams) (BookingStatusHistory, error)
+	CreateCompanion(ctx context.Context, arg CreateCompanionParams) (Companion, error)
 	CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Customer, error)
 	CreateFlight(ctx context.Context, arg CreateFlightParams) (Flight, error)
+	CreateFlightChange(ctx context.Context, arg CreateFlightChangeParams) (FlightChange, error)
+	CreateGroupBooking(ctx context.Context, arg CreateGroupBookingParams) (GroupBooking, error)
+	CreateGroupBookingPassenger(ctx context.Context, arg CreateGroupBookingPassengerParams) (GroupBookingPassenger, error)
+	CreateLoyaltyTransaction(ctx context.Context, arg CreateLoyaltyTransactionParams) (LoyaltyTransaction, error)
 	CreateNews(ctx context.Context, arg CreateNewsParams) (News, error)
+	CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error)
+	CreatePromoCode(ctx context.Context, arg CreatePromoCodeParams) (PromoCode, error)
+	CreatePromoRedemption(ctx context.Context, arg CreatePromoRedemptionParams) (PromoRedemption, error)
+	CreateRefund(ctx context.Context, arg CreateRefundParams) (Refund, error)
 	CreateSeat(ctx context.Context, arg CreateSeatParams) (Seat, error)
+	CreateSeatSelection(ctx context.Context, arg CreateSeatSelectionParams) (SeatSelection, error)
+	CreateSeatZone(ctx context.Context, arg CreateSeatZoneParams) (SeatZone, error)
 	CreateTicket(ctx context.Context, arg CreateTicketParams) (Ticket, error)
+	CreateTicketAncillary(ctx context.Context, arg CreateTicketAncillaryParams) (TicketAncillary, error)
+	CreateTicketCheckIn(ctx context.Context, arg CreateTicketCheckInParams) (TicketCheckIn, error)
+	CreateTicketFareItem(ctx context.Context, arg CreateTicketFareItemParams) (TicketFareItem, error)
 	CreateTicketOwnerSnapshot(ctx context.Context, arg CreateTicketOwnerSnapshotParams) (Ticketownersnapshot, error)
+	CreateTicketSpecialService(ctx context.Context, arg CreateTicketSpecialServiceParams) (TicketSpecialService, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWaitlistEntry(ctx context.Context, arg CreateWaitlistEntryParams) (WaitlistEntry, error)
+	CreateWalletTransaction(ctx context.Context, arg CreateWalletTransactionParams) (WalletTransaction, error)
+	DeactivatePromoCode(ctx context.Context, id int64) (PromoCode, error)
 	DeactivateUser(ctx context.Context, userID int64) error
 	DeleteAdmin(ctx context.Context, userID int64) error
+	DeleteAncillary(ctx context.Context, id int64) (Ancillary, error)
 	DeleteBookings(ctx context.Context, bookingID int64) error
+	DeleteCompanion(ctx context.Context, arg DeleteCompanionParams) (Companion, error)
 	DeleteCustomerByID(ctx context.Context, userID int64) (int64, error)
 	DeleteFlight(ctx context.Context, flightID int64) (int64, error)
+	DeleteGroupBookingPassengers(ctx context.Context, groupBookingID int64) error
 	DeleteNews(ctx context.Context, id int64) (int64, error)
+	DeletePricingCurve(ctx context.Context, id int64) (PricingCurve, error)
+	DeleteSeatZone(ctx context.Context, id int64) (SeatZone, error)
 	DeleteTicket(ctx context.Context, ticketID int64) error
+	DeleteTicketCheckIn(ctx context.Context, arg DeleteTicketCheckInParams) (TicketCheckIn, error)
+	DeleteTicketCheckInsByBookingID(ctx context.Context, bookingID int64) error
+	DeleteTicketCheckInsByTicketID(ctx context.Context, ticketID int64) error
+	DeleteTicketFareItems(ctx context.Context, ticketID int64) error
+	DeleteTicketSpecialService(ctx context.Context, arg DeleteTicketSpecialServiceParams) (TicketSpecialService, error)
 	DeleteUser(ctx context.Context, userID int64) error
+	ExpireWaitlistOffer(ctx context.Context, id int64) (WaitlistEntry, error)
 	GetAdmin(ctx context.Context, userID int64) (int64, error)
 	GetAdminByEmail(ctx context.Context, email string) (GetAdminByEmailRow, error)
 	GetAllFlights(ctx context.Context) ([]GetAllFlightsRow, error)
@@ -38,45 +88,142 @@ type Querier interface {
 	GetAllTicketOwnerSnapshots(ctx context.Context) ([]Ticketownersnapshot, error)
 	GetAllUser(ctx context.Context) ([]User, error)
 	GetBooking(ctx context.Context, bookingID int64) (Booking, error)
+	GetBookingByPNR(ctx context.Context, pnr string) (Booking, error)
+	GetBookingByPNRAndLastName(ctx context.Context, arg GetBookingByPNRAndLastNameParams) (Booking, error)
+	GetBookingForUpdate(ctx context.Context, bookingID int64) (Booking, error)
 	GetBookingHistoryByUID(ctx context.Context, userID int64) ([]int64, error)
+	GetCompanionByEmail(ctx context.Context, arg GetCompanionByEmailParams) (Companion, error)
 	GetCustomer(ctx context.Context, userID int64) (Customer, error)
 	GetCustomerByEmail(ctx context.Context, email string) (Customer, error)
 	GetCustomerByID(ctx context.Context, userID int64) (GetCustomerByIDRow, error)
+	GetCustomerLoyaltyPointsForUpdate(ctx context.Context, userID int64) (int32, error)
+	GetCustomerLoyaltyTier(ctx context.Context, userID int64) (string, error)
+	GetCustomerLoyaltyTierByEmail(ctx context.Context, email string) (string, error)
+	GetCustomerQualifyingPoints(ctx context.Context, arg GetCustomerQualifyingPointsParams) (int32, error)
+	GetCustomerWalletBalanceForUpdate(ctx context.Context, userID int64) (int64, error)
+	GetFareFamily(ctx context.Context, arg GetFareFamilyParams) (FareFamily, error)
 	GetFlight(ctx context.Context, flightID int64) (Flight, error)
+	GetFlightChangeForUpdate(ctx context.Context, id int64) (FlightChange, error)
 	GetFlightsByStatus(ctx context.Context, flightID int64) (FlightStatus, error)
+	GetGroupBooking(ctx context.Context, id int64) (GroupBooking, error)
+	GetGroupBookingForUpdate(ctx context.Context, id int64) (GroupBooking, error)
+	GetLoyaltyAccrualByTicket(ctx context.Context, ticketID pgtype.Int8) (LoyaltyTransaction, error)
 	GetNews(ctx context.Context, id int64) (News, error)
+	GetNextWaitlistEntry(ctx context.Context, arg GetNextWaitlistEntryParams) (WaitlistEntry, error)
+	GetPaymentByIntentID(ctx context.Context, intentID string) (Payment, error)
+	GetPaymentByIntentIDForUpdate(ctx context.Context, intentID string) (Payment, error)
+	GetPromoCodeByCode(ctx context.Context, code string) (PromoCode, error)
+	GetPromoCodeForUpdate(ctx context.Context, id int64) (PromoCode, error)
 	GetSeat(ctx context.Context, seatID int64) (Seat, error)
 	GetSeatByTicketID(ctx context.Context, ticketID int64) (GetSeatByTicketIDRow, error)
+	GetSeatSelectionForUpdate(ctx context.Context, id int64) (SeatSelection, error)
+	GetTicketAncillaryForUpdate(ctx context.Context, id int64) (TicketAncillary, error)
 	GetTicketByFlightId(ctx context.Context, flightID int64) ([]Ticket, error)
 	GetTicketByID(ctx context.Context, ticketID int64) (GetTicketByIDRow, error)
+	GetTicketByNumber(ctx context.Context, ticketNumber pgtype.Text) (GetTicketByNumberRow, error)
 	GetTicketOwnerSnapshot(ctx context.Context, ticketID int64) (Ticketownersnapshot, error)
 	GetTicketsByBookingIDAndType(ctx context.Context, arg GetTicketsByBookingIDAndTypeParams) ([]Ticket, error)
 	GetTicketsByFlightID(ctx context.Context, flightID int64) ([]GetTicketsByFlightIDRow, error)
 	GetUser(ctx context.Context, userID int64) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetWaitlistEntryByClaimToken(ctx context.Context, claimToken pgtype.Text) (WaitlistEntry, error)
+	GetWaitlistEntryByOfferKey(ctx context.Context, offerKey pgtype.Text) (WaitlistEntry, error)
 	IsAdmin(ctx context.Context, userID int64) (bool, error)
+	IsSeatCodeTaken(ctx context.Context, arg IsSeatCodeTakenParams) (bool, error)
 	ListAdmins(ctx context.Context, arg ListAdminsParams) ([]int64, error)
+	ListAlternativeFlights(ctx context.Context, arg ListAlternativeFlightsParams) ([]Flight, error)
+	ListAncillaries(ctx context.Context) ([]Ancillary, error)
+	ListBookingSegments(ctx context.Context, bookingID int64) ([]BookingSegment, error)
+	ListBookingSegmentsByBookingIDs(ctx context.Context, bookingIds []int64) ([]BookingSegment, error)
+	ListBookingStatusHistory(ctx context.Context, bookingID int64) ([]BookingStatusHistory, error)
 	ListBookings(ctx context.Context, arg ListBookingsParams) ([]Booking, error)
+	ListCapturedPaymentsByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]Payment, error)
+	ListCompanionsByUser(ctx context.Context, userID int64) ([]Companion, error)
+	ListCustomerTrips(ctx context.Context, arg ListCustomerTripsParams) ([]ListCustomerTripsRow, error)
 	ListCustomers(ctx context.Context, arg ListCustomersParams) ([]Customer, error)
+	ListExpiredLoyaltyLots(ctx context.Context, arg ListExpiredLoyaltyLotsParams) ([]LoyaltyTransaction, error)
+	ListExpiredWalletLots(ctx context.Context, arg ListExpiredWalletLotsParams) ([]WalletTransaction, error)
+	ListFareFamilies(ctx context.Context) ([]FareFamily, error)
+	ListFlightCustomerTickets(ctx context.Context, flightID int64) ([]ListFlightCustomerTicketsRow, error)
 	ListFlights(ctx context.Context, arg ListFlightsParams) ([]ListFlightsRow, error)
+	ListFlightsByIDs(ctx context.Context, flightIds []int64) ([]Flight, error)
+	ListGroupBookingPassengers(ctx context.Context, groupBookingID int64) ([]GroupBookingPassenger, error)
+	ListGroupBookings(ctx context.Context) ([]GroupBooking, error)
+	ListGroupBookingsByEmail(ctx context.Context, userEmail string) ([]GroupBooking, error)
+	ListInfantTicketsByAccompanyingTicketID(ctx context.Context, accompanyingTicketID pgtype.Int8) ([]ListInfantTicketsByAccompanyingTicketIDRow, error)
+	ListLoyaltyAccrualCandidates(ctx context.Context, flightID int64) ([]ListLoyaltyAccrualCandidatesRow, error)
+	ListLoyaltyRedemptionsByBooking(ctx context.Context, bookingID pgtype.Int8) ([]LoyaltyTransaction, error)
+	ListLoyaltyTierCandidates(ctx context.Context, arg ListLoyaltyTierCandidatesParams) ([]ListLoyaltyTierCandidatesRow, error)
+	ListLoyaltyTransactions(ctx context.Context, arg ListLoyaltyTransactionsParams) ([]LoyaltyTransaction, error)
 	ListNews(ctx context.Context, arg ListNewsParams) ([]News, error)
+	ListOpenLoyaltyLots(ctx context.Context, userID int64) ([]LoyaltyTransaction, error)
+	ListOpenWalletLots(ctx context.Context, userID int64) ([]WalletTransaction, error)
+	ListPricingCurves(ctx context.Context) ([]PricingCurve, error)
+	ListPricingCurvesByRoute(ctx context.Context, arg ListPricingCurvesByRouteParams) ([]PricingCurve, error)
+	ListPromoCodes(ctx context.Context) ([]PromoCode, error)
+	ListRefundsByBookingID(ctx context.Context, bookingID int64) ([]Refund, error)
+	ListSeatZones(ctx context.Context) ([]SeatZone, error)
+	ListSeatZonesForFlight(ctx context.Context, arg ListSeatZonesForFlightParams) ([]SeatZone, error)
 	ListSeatsWithFlightId(ctx context.Context, flightID pgtype.Int8) ([]Seat, error)
+	ListSpecialServices(ctx context.Context) ([]SpecialService, error)
+	ListTicketAncillariesByBookingID(ctx context.Context, bookingID int64) ([]TicketAncillary, error)
+	ListTicketCheckInsByBookingID(ctx context.Context, bookingID int64) ([]TicketCheckIn, error)
+	ListTicketCheckInsByFlightID(ctx context.Context, flightID int64) ([]TicketCheckIn, error)
+	ListTicketFareItemsByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]TicketFareItem, error)
 	ListTicketOwnerSnapshots(ctx context.Context, arg ListTicketOwnerSnapshotsParams) ([]Ticketownersnapshot, error)
+	ListTicketOwnersByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]ListTicketOwnersByBookingIDRow, error)
+	ListTicketSpecialServicesByBookingID(ctx context.Context, bookingID int64) ([]TicketSpecialService, error)
+	ListTicketSpecialServicesByFlightID(ctx context.Context, flightID int64) ([]TicketSpecialService, error)
 	ListTickets(ctx context.Context, arg ListTicketsParams) ([]Ticket, error)
+	ListTicketsByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]Ticket, error)
+	ListTripTicketsByBookingIDs(ctx context.Context, bookingIds []int64) ([]ListTripTicketsByBookingIDsRow, error)
+	ListUnnumberedTicketIDs(ctx context.Context) ([]int64, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListWaitlistEntriesByEmail(ctx context.Context, userEmail string) ([]WaitlistEntry, error)
+	ListWalletPaymentsByBooking(ctx context.Context, bookingID pgtype.Int8) ([]WalletTransaction, error)
+	ListWalletTransactions(ctx context.Context, arg ListWalletTransactionsParams) ([]WalletTransaction, error)
+	LockCustomer(ctx context.Context, userID int64) error
+	LockFlight(ctx context.Context, flightID int64) error
 	MarkSeatUnavailable(ctx context.Context, arg MarkSeatUnavailableParams) error
+	NextTicketSerial(ctx context.Context) (int64, error)
+	OfferWaitlistEntry(ctx context.Context, arg OfferWaitlistEntryParams) (WaitlistEntry, error)
+	PayGroupDeposit(ctx context.Context, id int64) (GroupBooking, error)
+	QuoteGroupBooking(ctx context.Context, arg QuoteGroupBookingParams) (GroupBooking, error)
+	ReleaseGroupBooking(ctx context.Context, id int64) (GroupBooking, error)
+	ReleasePromoRedemptions(ctx context.Context, bookingID int64) error
 	RemoveAuthorFromBlogPosts(ctx context.Context, authorID pgtype.Int8) error
 	RemoveUserFromBookings(ctx context.Context, userEmail pgtype.Text) error
 	SearchFlights(ctx context.Context, arg SearchFlightsParams) ([]SearchFlightsRow, error)
+	SetTicketNumber(ctx context.Context, arg SetTicketNumberParams) error
+	SumExpiredWalletCredit(ctx context.Context, arg SumExpiredWalletCreditParams) (int64, error)
+	SumLoyaltyRedeemedByBooking(ctx context.Context, bookingID pgtype.Int8) (int64, error)
+	SumWalletPaidByBooking(ctx context.Context, bookingID pgtype.Int8) (int64, error)
+	TicketGroupBooking(ctx context.Context, arg TicketGroupBookingParams) (GroupBooking, error)
+	UpdateBookingDepartureFlight(ctx context.Context, arg UpdateBookingDepartureFlightParams) (Booking, error)
+	UpdateBookingReturnFlight(ctx context.Context, arg UpdateBookingReturnFlightParams) (Booking, error)
+	UpdateBookingSegmentFlight(ctx context.Context, arg UpdateBookingSegmentFlightParams) (BookingSegment, error)
+	UpdateBookingStatus(ctx context.Context, arg UpdateBookingStatusParams) (Booking, error)
+	UpdateCompanion(ctx context.Context, arg UpdateCompanionParams) (Companion, error)
 	UpdateCustomer(ctx context.Context, arg UpdateCustomerParams) error
+	UpdateCustomerLoyaltyTier(ctx context.Context, arg UpdateCustomerLoyaltyTierParams) error
+	UpdateFlightChangeStatus(ctx context.Context, arg UpdateFlightChangeStatusParams) (FlightChange, error)
 	UpdateFlightTimes(ctx context.Context, arg UpdateFlightTimesParams) (UpdateFlightTimesRow, error)
+	UpdateLoyaltyLotRemaining(ctx context.Context, arg UpdateLoyaltyLotRemainingParams) error
 	UpdateNews(ctx context.Context, arg UpdateNewsParams) (News, error)
+	UpdatePaymentStatus(ctx context.Context, arg UpdatePaymentStatusParams) (Payment, error)
+	UpdateRefundStatus(ctx context.Context, arg UpdateRefundStatusParams) (Refund, error)
 	UpdateSeat(ctx context.Context, arg UpdateSeatParams) (Seat, error)
 	UpdateSeatAvailability(ctx context.Context, arg UpdateSeatAvailabilityParams) error
+	UpdateSeatSelectionStatus(ctx context.Context, arg UpdateSeatSelectionStatusParams) (SeatSelection, error)
 	UpdateTicket(ctx context.Context, arg UpdateTicketParams) error
+	UpdateTicketFlight(ctx context.Context, arg UpdateTicketFlightParams) (Ticket, error)
+	UpdateTicketSpecialServicesFlight(ctx context.Context, arg UpdateTicketSpecialServicesFlightParams) error
 	UpdateTicketStatus(ctx context.Context, arg UpdateTicketStatusParams) (Ticket, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpdateWalletLotRemaining(ctx context.Context, arg UpdateWalletLotRemainingParams) error
+	UpsertAncillary(ctx context.Context, arg UpsertAncillaryParams) (Ancillary, error)
+	UpsertPricingCurve(ctx context.Context, arg UpsertPricingCurveParams) (PricingCurve, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -150,6 +150,27 @@ func (q *Queries) GetSeatByTicketID(ctx context.Context, ticketID int64) (GetSea
 	return i, err
 }
 
+const isSeatCodeTaken = `-- name: IsSeatCodeTaken :one
+SELECT EXISTS (
+  SELECT 1 FROM "seats" s
+  JOIN Tickets t ON t.seat_id = s.seat_id
+  WHERE s.flight_id = $1 AND s.seat_code = $2
+    AND t.status = 'Active'
+) AS taken
+`
+
+type IsSeatCodeTakenParams struct {
+	FlightID pgtype.Int8 `json:"flight_id"`
+	SeatCode string      `json:"seat_code"`
+}
+
+func (q *Queries) IsSeatCodeTaken(ctx context.Context, arg IsSeatCodeTakenParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isSeatCodeTaken, arg.FlightID, arg.SeatCode)
+	var taken bool
+	err := row.Scan(&taken)
+	return taken, err
+}
+
 const listSeatsWithFlightId = `-- name: ListSeatsWithFlightId :many
 SELECT seat_id, flight_id, seat_code, is_available, class FROM "seats"
 WHERE flight_id = $1
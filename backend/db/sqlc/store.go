@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -16,6 +17,33 @@ type Store interface {
 	CreateAdminTx(ctx context.Context, arg CreateUserParams) (User, error)
 	DeleteAdminTx(ctx context.Context, arg DeleteAdminTxParams) (DeleteAdminTxResult, error)
 	CancelTicketTx(ctx context.Context, arg CancelTicketTxParams) (CancelTicketTxResult, error)
+	UpdateBookingStatusTx(ctx context.Context, arg UpdateBookingStatusTxParams) (UpdateBookingStatusTxResult, error)
+	CancelBookingTx(ctx context.Context, arg CancelBookingTxParams) (CancelBookingTxResult, error)
+	ChangeFlightTx(ctx context.Context, arg ChangeFlightTxParams) (ChangeFlightTxResult, error)
+	RequestFlightChangeTx(ctx context.Context, arg RequestFlightChangeTxParams) (RequestFlightChangeTxResult, error)
+	CompleteFlightChangeTx(ctx context.Context, arg SettlePaymentParams) (CompleteFlightChangeTxResult, error)
+	CancelTicketAncillaryTx(ctx context.Context, arg CancelTicketAncillaryTxParams) (CancelTicketAncillaryTxResult, error)
+	RequestTicketAncillaryTx(ctx context.Context, arg RequestTicketAncillaryTxParams) (RequestTicketAncillaryTxResult, error)
+	ActivateTicketAncillaryTx(ctx context.Context, arg SettlePaymentParams) (ActivateTicketAncillaryTxResult, error)
+	RequestSeatSelectionTx(ctx context.Context, arg RequestSeatSelectionTxParams) (RequestSeatSelectionTxResult, error)
+	CompleteSeatSelectionTx(ctx context.Context, arg SettlePaymentParams) (CompleteSeatSelectionTxResult, error)
+	OfferWaitlistSeatTx(ctx context.Context, arg OfferWaitlistSeatTxParams) (OfferWaitlistSeatTxResult, error)
+	ReplaceGroupBookingPassengersTx(ctx context.Context, arg ReplaceGroupBookingPassengersTxParams) (ReplaceGroupBookingPassengersTxResult, error)
+	RequestGroupDepositTx(ctx context.Context, arg RequestGroupDepositTxParams) (RequestGroupDepositTxResult, error)
+	CompleteGroupDepositTx(ctx context.Context, arg SettlePaymentParams) (CompleteGroupDepositTxResult, error)
+	IssueGroupBookingTx(ctx context.Context, arg IssueGroupBookingTxParams) (IssueGroupBookingTxResult, error)
+	AccrueFlightLoyaltyTx(ctx context.Context, arg AccrueFlightLoyaltyTxParams) (AccrueFlightLoyaltyTxResult, error)
+	RedeemLoyaltyPointsTx(ctx context.Context, arg RedeemLoyaltyPointsTxParams) (RedeemLoyaltyPointsTxResult, error)
+	ExpireLoyaltyPointsTx(ctx context.Context, userID int64, now time.Time) error
+	PayWithWalletTx(ctx context.Context, arg PayWithWalletTxParams) (PayWithWalletTxResult, error)
+	IssueWalletCreditTx(ctx context.Context, arg IssueWalletCreditTxParams) (WalletTransaction, error)
+	AddTicketSpecialServiceTx(ctx context.Context, arg AddTicketSpecialServiceTxParams) (TicketSpecialService, error)
+	CreateCompanionTx(ctx context.Context, arg CreateCompanionTxParams) (Companion, error)
+	AssignTicketNumbersTx(ctx context.Context, prefix string) (int, error)
+	CheckInTicketsTx(ctx context.Context, arg CheckInTicketsTxParams) ([]TicketCheckIn, error)
+	UndoCheckInTx(ctx context.Context, arg UndoCheckInTxParams) ([]TicketCheckIn, error)
+	ConfirmBookingPaymentTx(ctx context.Context, arg SettlePaymentParams) (ConfirmBookingPaymentTxResult, error)
+	FailPaymentTx(ctx context.Context, intentID string) (Payment, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
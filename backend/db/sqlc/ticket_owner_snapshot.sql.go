@@ -15,9 +15,10 @@ import (
 const createTicketOwnerSnapshot = `-- name: CreateTicketOwnerSnapshot :one
 INSERT INTO TicketOwnerSnapshots (
   ticket_id, first_name, last_name, phone_number, gender, date_of_birth,
-  passport_number, identification_number, address
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address
+  passport_number, identification_number, address, companion_id, document_expiry,
+  document_country
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address, companion_id, document_expiry, document_country
 `
 
 type CreateTicketOwnerSnapshotParams struct {
@@ -30,6 +31,9 @@ type CreateTicketOwnerSnapshotParams struct {
 	PassportNumber       pgtype.Text `json:"passport_number"`
 	IdentificationNumber pgtype.Text `json:"identification_number"`
 	Address              pgtype.Text `json:"address"`
+	CompanionID          pgtype.Int8 `json:"companion_id"`
+	DocumentExpiry       pgtype.Date `json:"document_expiry"`
+	DocumentCountry      string      `json:"document_country"`
 }
 
 func (q *Queries) CreateTicketOwnerSnapshot(ctx context.Context, arg CreateTicketOwnerSnapshotParams) (Ticketownersnapshot, error) {
@@ -43,6 +47,9 @@ func (q *Queries) CreateTicketOwnerSnapshot(ctx context.Context, arg CreateTicke
 		arg.PassportNumber,
 		arg.IdentificationNumber,
 		arg.Address,
+		arg.CompanionID,
+		arg.DocumentExpiry,
+		arg.DocumentCountry,
 	)
 	var i Ticketownersnapshot
 	err := row.Scan(
@@ -55,12 +62,15 @@ func (q *Queries) CreateTicketOwnerSnapshot(ctx context.Context, arg CreateTicke
 		&i.PassportNumber,
 		&i.IdentificationNumber,
 		&i.Address,
+		&i.CompanionID,
+		&i.DocumentExpiry,
+		&i.DocumentCountry,
 	)
 	return i, err
 }
 
 const getAllTicketOwnerSnapshots = `-- name: GetAllTicketOwnerSnapshots :many
-SELECT ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address FROM TicketOwnerSnapshots
+SELECT ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address, companion_id, document_expiry, document_country FROM TicketOwnerSnapshots
 `
 
 func (q *Queries) GetAllTicketOwnerSnapshots(ctx context.Context) ([]Ticketownersnapshot, error) {
@@ -82,6 +92,9 @@ func (q *Queries) GetAllTicketOwnerSnapshots(ctx context.Context) ([]Ticketowner
 			&i.PassportNumber,
 			&i.IdentificationNumber,
 			&i.Address,
+			&i.CompanionID,
+			&i.DocumentExpiry,
+			&i.DocumentCountry,
 		); err != nil {
 			return nil, err
 		}
@@ -94,7 +107,7 @@ func (q *Queries) GetAllTicketOwnerSnapshots(ctx context.Context) ([]Ticketowner
 }
 
 const getTicketOwnerSnapshot = `-- name: GetTicketOwnerSnapshot :one
-SELECT ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address FROM TicketOwnerSnapshots
+SELECT ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address, companion_id, document_expiry, document_country FROM TicketOwnerSnapshots
 WHERE ticket_id = $1
 LIMIT 1
 `
@@ -112,12 +125,15 @@ func (q *Queries) GetTicketOwnerSnapshot(ctx context.Context, ticketID int64) (T
 		&i.PassportNumber,
 		&i.IdentificationNumber,
 		&i.Address,
+		&i.CompanionID,
+		&i.DocumentExpiry,
+		&i.DocumentCountry,
 	)
 	return i, err
 }
 
 const listTicketOwnerSnapshots = `-- name: ListTicketOwnerSnapshots :many
-SELECT ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address FROM TicketOwnerSnapshots
+SELECT ticket_id, first_name, last_name, phone_number, gender, date_of_birth, passport_number, identification_number, address, companion_id, document_expiry, document_country FROM TicketOwnerSnapshots
 ORDER BY ticket_id
 LIMIT $1
 OFFSET $2
@@ -147,6 +163,9 @@ func (q *Queries) ListTicketOwnerSnapshots(ctx context.Context, arg ListTicketOw
 			&i.PassportNumber,
 			&i.IdentificationNumber,
 			&i.Address,
+			&i.CompanionID,
+			&i.DocumentExpiry,
+			&i.DocumentCountry,
 		); err != nil {
 			return nil, err
 		}
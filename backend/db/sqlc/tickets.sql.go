@@ -25,11 +25,14 @@ RETURNING ticket_id,
     booking_id,
     flight_id,
     updated_at,
-    (
-        SELECT seat_code
-        FROM Seats
-        WHERE seat_id = Tickets.seat_id
-    ) AS seat_code,
+    COALESCE(
+        (
+            SELECT seat_code
+            FROM Seats
+            WHERE seat_id = Tickets.seat_id
+        ),
+        ''
+    )::VARCHAR AS seat_code,
     (
         SELECT first_name
         FROM TicketOwnerSnapshots
@@ -87,19 +90,27 @@ INSERT INTO tickets (
         price,
         status,
         booking_id,
-        flight_id
+        flight_id,
+        ticket_number,
+        passenger_type,
+        accompanying_ticket_id,
+        fare_family
     )
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at, ticket_number, passenger_type, accompanying_ticket_id, fare_family
 `
 
 type CreateTicketParams struct {
-	SeatID      int64        `json:"seat_id"`
-	FlightClass FlightClass  `json:"flight_class"`
-	Price       int32        `json:"price"`
-	Status      TicketStatus `json:"status"`
-	BookingID   pgtype.Int8  `json:"booking_id"`
-	FlightID    int64        `json:"flight_id"`
+	SeatID               pgtype.Int8    `json:"seat_id"`
+	FlightClass          FlightClass    `json:"flight_class"`
+	Price                int32          `json:"price"`
+	Status               TicketStatus   `json:"status"`
+	BookingID            pgtype.Int8    `json:"booking_id"`
+	FlightID             int64          `json:"flight_id"`
+	TicketNumber         pgtype.Text    `json:"ticket_number"`
+	PassengerType        PassengerType  `json:"passenger_type"`
+	AccompanyingTicketID pgtype.Int8    `json:"accompanying_ticket_id"`
+	FareFamily           FareFamilyType `json:"fare_family"`
 }
 
 func (q *Queries) CreateTicket(ctx context.Context, arg CreateTicketParams) (Ticket, error) {
@@ -110,6 +121,10 @@ func (q *Queries) CreateTicket(ctx context.Context, arg CreateTicketParams) (Tic
 		arg.Status,
 		arg.BookingID,
 		arg.FlightID,
+		arg.TicketNumber,
+		arg.PassengerType,
+		arg.AccompanyingTicketID,
+		arg.FareFamily,
 	)
 	var i Ticket
 	err := row.Scan(
@@ -122,6 +137,10 @@ func (q *Queries) CreateTicket(ctx context.Context, arg CreateTicketParams) (Tic
 		&i.FlightID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TicketNumber,
+		&i.PassengerType,
+		&i.AccompanyingTicketID,
+		&i.FareFamily,
 	)
 	return i, err
 }
@@ -137,7 +156,7 @@ func (q *Queries) DeleteTicket(ctx context.Context, ticketID int64) error {
 }
 
 const getTicketByFlightId = `-- name: GetTicketByFlightId :many
-SELECT ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at
+SELECT ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at, ticket_number, passenger_type, accompanying_ticket_id, fare_family
 FROM tickets
 WHERE flight_id = $1
 ORDER BY ticket_id
@@ -162,6 +181,10 @@ func (q *Queries) GetTicketByFlightId(ctx context.Context, flightID int64) ([]Ti
 			&i.FlightID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TicketNumber,
+			&i.PassengerType,
+			&i.AccompanyingTicketID,
+			&i.FareFamily,
 		); err != nil {
 			return nil, err
 		}
@@ -182,6 +205,8 @@ SELECT t.ticket_id,
     t.flight_id,
     t.created_at,
     t.updated_at,
+    t.ticket_number,
+    t.fare_family,
     s.seat_code,
     s.seat_id,
     s.is_available,
@@ -194,10 +219,12 @@ SELECT t.ticket_id,
     o.date_of_birth AS owner_date_of_birth,
     o.passport_number AS owner_passport_number,
     o.identification_number AS owner_identification_number,
-    o.address AS owner_address
+    o.address AS owner_address,
+    b.pnr AS booking_pnr
 FROM Tickets t
     LEFT JOIN Seats s ON t.seat_id = s.seat_id
     LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Bookings b ON t.booking_id = b.booking_id
 WHERE t.ticket_id = $1
 `
 
@@ -210,6 +237,8 @@ type GetTicketByIDRow struct {
 	FlightID                  int64           `json:"flight_id"`
 	CreatedAt                 time.Time       `json:"created_at"`
 	UpdatedAt                 time.Time       `json:"updated_at"`
+	TicketNumber              pgtype.Text     `json:"ticket_number"`
+	FareFamily                FareFamilyType  `json:"fare_family"`
 	SeatCode                  pgtype.Text     `json:"seat_code"`
 	SeatID                    pgtype.Int8     `json:"seat_id"`
 	IsAvailable               pgtype.Bool     `json:"is_available"`
@@ -223,6 +252,7 @@ type GetTicketByIDRow struct {
 	OwnerPassportNumber       pgtype.Text     `json:"owner_passport_number"`
 	OwnerIdentificationNumber pgtype.Text     `json:"owner_identification_number"`
 	OwnerAddress              pgtype.Text     `json:"owner_address"`
+	BookingPnr                pgtype.Text     `json:"booking_pnr"`
 }
 
 func (q *Queries) GetTicketByID(ctx context.Context, ticketID int64) (GetTicketByIDRow, error) {
@@ -237,6 +267,8 @@ func (q *Queries) GetTicketByID(ctx context.Context, ticketID int64) (GetTicketB
 		&i.FlightID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TicketNumber,
+		&i.FareFamily,
 		&i.SeatCode,
 		&i.SeatID,
 		&i.IsAvailable,
@@ -250,6 +282,98 @@ func (q *Queries) GetTicketByID(ctx context.Context, ticketID int64) (GetTicketB
 		&i.OwnerPassportNumber,
 		&i.OwnerIdentificationNumber,
 		&i.OwnerAddress,
+		&i.BookingPnr,
+	)
+	return i, err
+}
+
+const getTicketByNumber = `-- name: GetTicketByNumber :one
+SELECT t.ticket_id,
+    t.status,
+    t.flight_class,
+    t.price,
+    t.booking_id,
+    t.flight_id,
+    t.created_at,
+    t.updated_at,
+    t.ticket_number,
+    t.fare_family,
+    s.seat_code,
+    s.seat_id,
+    s.is_available,
+    s.flight_id,
+    s.class AS seat_class,
+    o.first_name AS owner_first_name,
+    o.last_name AS owner_last_name,
+    o.gender AS owner_gender,
+    o.phone_number AS owner_phone_number,
+    o.date_of_birth AS owner_date_of_birth,
+    o.passport_number AS owner_passport_number,
+    o.identification_number AS owner_identification_number,
+    o.address AS owner_address,
+    b.pnr AS booking_pnr
+FROM Tickets t
+    LEFT JOIN Seats s ON t.seat_id = s.seat_id
+    LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Bookings b ON t.booking_id = b.booking_id
+WHERE t.ticket_number = $1
+`
+
+type GetTicketByNumberRow struct {
+	TicketID                  int64           `json:"ticket_id"`
+	Status                    TicketStatus    `json:"status"`
+	FlightClass               FlightClass     `json:"flight_class"`
+	Price                     int32           `json:"price"`
+	BookingID                 pgtype.Int8     `json:"booking_id"`
+	FlightID                  int64           `json:"flight_id"`
+	CreatedAt                 time.Time       `json:"created_at"`
+	UpdatedAt                 time.Time       `json:"updated_at"`
+	TicketNumber              pgtype.Text     `json:"ticket_number"`
+	FareFamily                FareFamilyType  `json:"fare_family"`
+	SeatCode                  pgtype.Text     `json:"seat_code"`
+	SeatID                    pgtype.Int8     `json:"seat_id"`
+	IsAvailable               pgtype.Bool     `json:"is_available"`
+	FlightID_2                pgtype.Int8     `json:"flight_id_2"`
+	SeatClass                 NullFlightClass `json:"seat_class"`
+	OwnerFirstName            pgtype.Text     `json:"owner_first_name"`
+	OwnerLastName             pgtype.Text     `json:"owner_last_name"`
+	OwnerGender               NullGenderType  `json:"owner_gender"`
+	OwnerPhoneNumber          pgtype.Text     `json:"owner_phone_number"`
+	OwnerDateOfBirth          time.Time       `json:"owner_date_of_birth"`
+	OwnerPassportNumber       pgtype.Text     `json:"owner_passport_number"`
+	OwnerIdentificationNumber pgtype.Text     `json:"owner_identification_number"`
+	OwnerAddress              pgtype.Text     `json:"owner_address"`
+	BookingPnr                pgtype.Text     `json:"booking_pnr"`
+}
+
+func (q *Queries) GetTicketByNumber(ctx context.Context, ticketNumber pgtype.Text) (GetTicketByNumberRow, error) {
+	row := q.db.QueryRow(ctx, getTicketByNumber, ticketNumber)
+	var i GetTicketByNumberRow
+	err := row.Scan(
+		&i.TicketID,
+		&i.Status,
+		&i.FlightClass,
+		&i.Price,
+		&i.BookingID,
+		&i.FlightID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TicketNumber,
+		&i.FareFamily,
+		&i.SeatCode,
+		&i.SeatID,
+		&i.IsAvailable,
+		&i.FlightID_2,
+		&i.SeatClass,
+		&i.OwnerFirstName,
+		&i.OwnerLastName,
+		&i.OwnerGender,
+		&i.OwnerPhoneNumber,
+		&i.OwnerDateOfBirth,
+		&i.OwnerPassportNumber,
+		&i.OwnerIdentificationNumber,
+		&i.OwnerAddress,
+		&i.BookingPnr,
 	)
 	return i, err
 }
@@ -263,7 +387,11 @@ SELECT ticket_id,
     booking_id,
     flight_id,
     created_at,
-    updated_at
+    updated_at,
+    ticket_number,
+    passenger_type,
+    accompanying_ticket_id,
+    fare_family
 FROM Tickets
 WHERE Tickets.booking_id = $1
     AND (
@@ -310,6 +438,10 @@ func (q *Queries) GetTicketsByBookingIDAndType(ctx context.Context, arg GetTicke
 			&i.FlightID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TicketNumber,
+			&i.PassengerType,
+			&i.AccompanyingTicketID,
+			&i.FareFamily,
 		); err != nil {
 			return nil, err
 		}
@@ -331,6 +463,8 @@ SELECT t.ticket_id,
     t.flight_id,
     t.created_at,
     t.updated_at,
+    t.ticket_number,
+    t.passenger_type,
     s.seat_code,
     s.is_available,
     s.class AS seat_class,
@@ -341,16 +475,18 @@ SELECT t.ticket_id,
     o.date_of_birth AS owner_date_of_birth,
     o.passport_number AS owner_passport_number,
     o.identification_number AS owner_identification_number,
-    o.address AS owner_address
+    o.address AS owner_address,
+    b.pnr AS booking_pnr
 FROM Tickets t
     LEFT JOIN Seats s ON t.seat_id = s.seat_id
     LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Bookings b ON t.booking_id = b.booking_id
 WHERE t.flight_id = $1
 `
 
 type GetTicketsByFlightIDRow struct {
 	TicketID                  int64           `json:"ticket_id"`
-	SeatID                    int64           `json:"seat_id"`
+	SeatID                    pgtype.Int8     `json:"seat_id"`
 	FlightClass               FlightClass     `json:"flight_class"`
 	Price                     int32           `json:"price"`
 	Status                    TicketStatus    `json:"status"`
@@ -358,6 +494,8 @@ type GetTicketsByFlightIDRow struct {
 	FlightID                  int64           `json:"flight_id"`
 	CreatedAt                 time.Time       `json:"created_at"`
 	UpdatedAt                 time.Time       `json:"updated_at"`
+	TicketNumber              pgtype.Text     `json:"ticket_number"`
+	PassengerType             PassengerType   `json:"passenger_type"`
 	SeatCode                  pgtype.Text     `json:"seat_code"`
 	IsAvailable               pgtype.Bool     `json:"is_available"`
 	SeatClass                 NullFlightClass `json:"seat_class"`
@@ -369,6 +507,7 @@ type GetTicketsByFlightIDRow struct {
 	OwnerPassportNumber       pgtype.Text     `json:"owner_passport_number"`
 	OwnerIdentificationNumber pgtype.Text     `json:"owner_identification_number"`
 	OwnerAddress              pgtype.Text     `json:"owner_address"`
+	BookingPnr                pgtype.Text     `json:"booking_pnr"`
 }
 
 func (q *Queries) GetTicketsByFlightID(ctx context.Context, flightID int64) ([]GetTicketsByFlightIDRow, error) {
@@ -390,6 +529,8 @@ func (q *Queries) GetTicketsByFlightID(ctx context.Context, flightID int64) ([]G
 			&i.FlightID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TicketNumber,
+			&i.PassengerType,
 			&i.SeatCode,
 			&i.IsAvailable,
 			&i.SeatClass,
@@ -401,6 +542,111 @@ func (q *Queries) GetTicketsByFlightID(ctx context.Context, flightID int64) ([]G
 			&i.OwnerPassportNumber,
 			&i.OwnerIdentificationNumber,
 			&i.OwnerAddress,
+			&i.BookingPnr,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInfantTicketsByAccompanyingTicketID = `-- name: ListInfantTicketsByAccompanyingTicketID :many
+SELECT ticket_id, status FROM Tickets
+WHERE accompanying_ticket_id = $1
+  AND status <> 'Cancelled'
+ORDER BY ticket_id
+FOR UPDATE
+`
+
+type ListInfantTicketsByAccompanyingTicketIDRow struct {
+	TicketID int64        `json:"ticket_id"`
+	Status   TicketStatus `json:"status"`
+}
+
+func (q *Queries) ListInfantTicketsByAccompanyingTicketID(ctx context.Context, accompanyingTicketID pgtype.Int8) ([]ListInfantTicketsByAccompanyingTicketIDRow, error) {
+	rows, err := q.db.Query(ctx, listInfantTicketsByAccompanyingTicketID, accompanyingTicketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListInfantTicketsByAccompanyingTicketIDRow{}
+	for rows.Next() {
+		var i ListInfantTicketsByAccompanyingTicketIDRow
+		if err := rows.Scan(&i.TicketID, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketOwnersByBookingID = `-- name: ListTicketOwnersByBookingID :many
+SELECT t.ticket_id,
+    s.seat_code,
+    o.first_name,
+    o.last_name,
+    o.phone_number,
+    o.gender,
+    o.date_of_birth,
+    o.passport_number,
+    o.identification_number,
+    o.address,
+    o.companion_id,
+    o.document_expiry,
+    o.document_country
+FROM Tickets t
+    JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Seats s ON t.seat_id = s.seat_id
+WHERE t.booking_id = $1
+ORDER BY t.ticket_id
+`
+
+type ListTicketOwnersByBookingIDRow struct {
+	TicketID             int64       `json:"ticket_id"`
+	SeatCode             pgtype.Text `json:"seat_code"`
+	FirstName            pgtype.Text `json:"first_name"`
+	LastName             pgtype.Text `json:"last_name"`
+	PhoneNumber          pgtype.Text `json:"phone_number"`
+	Gender               GenderType  `json:"gender"`
+	DateOfBirth          time.Time   `json:"date_of_birth"`
+	PassportNumber       pgtype.Text `json:"passport_number"`
+	IdentificationNumber pgtype.Text `json:"identification_number"`
+	Address              pgtype.Text `json:"address"`
+	CompanionID          pgtype.Int8 `json:"companion_id"`
+	DocumentExpiry       pgtype.Date `json:"document_expiry"`
+	DocumentCountry      string      `json:"document_country"`
+}
+
+func (q *Queries) ListTicketOwnersByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]ListTicketOwnersByBookingIDRow, error) {
+	rows, err := q.db.Query(ctx, listTicketOwnersByBookingID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTicketOwnersByBookingIDRow{}
+	for rows.Next() {
+		var i ListTicketOwnersByBookingIDRow
+		if err := rows.Scan(
+			&i.TicketID,
+			&i.SeatCode,
+			&i.FirstName,
+			&i.LastName,
+			&i.PhoneNumber,
+			&i.Gender,
+			&i.DateOfBirth,
+			&i.PassportNumber,
+			&i.IdentificationNumber,
+			&i.Address,
+			&i.CompanionID,
+			&i.DocumentExpiry,
+			&i.DocumentCountry,
 		); err != nil {
 			return nil, err
 		}
@@ -413,7 +659,7 @@ func (q *Queries) GetTicketsByFlightID(ctx context.Context, flightID int64) ([]G
 }
 
 const listTickets = `-- name: ListTickets :many
-SELECT ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at
+SELECT ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at, ticket_number, passenger_type, accompanying_ticket_id, fare_family
 FROM tickets
 ORDER BY ticket_id
 LIMIT $1 OFFSET $2
@@ -443,6 +689,51 @@ func (q *Queries) ListTickets(ctx context.Context, arg ListTicketsParams) ([]Tic
 			&i.FlightID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TicketNumber,
+			&i.PassengerType,
+			&i.AccompanyingTicketID,
+			&i.FareFamily,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketsByBookingID = `-- name: ListTicketsByBookingID :many
+SELECT ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at, ticket_number, passenger_type, accompanying_ticket_id, fare_family
+FROM tickets
+WHERE booking_id = $1
+ORDER BY ticket_id
+`
+
+func (q *Queries) ListTicketsByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]Ticket, error) {
+	rows, err := q.db.Query(ctx, listTicketsByBookingID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Ticket{}
+	for rows.Next() {
+		var i Ticket
+		if err := rows.Scan(
+			&i.TicketID,
+			&i.SeatID,
+			&i.FlightClass,
+			&i.Price,
+			&i.Status,
+			&i.BookingID,
+			&i.FlightID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TicketNumber,
+			&i.PassengerType,
+			&i.AccompanyingTicketID,
+			&i.FareFamily,
 		); err != nil {
 			return nil, err
 		}
@@ -454,9 +745,121 @@ func (q *Queries) ListTickets(ctx context.Context, arg ListTicketsParams) ([]Tic
 	return items, nil
 }
 
+const listTripTicketsByBookingIDs = `-- name: ListTripTicketsByBookingIDs :many
+SELECT t.ticket_id, t.seat_id, t.flight_class, t.price, t.status, t.booking_id, t.flight_id, t.created_at, t.updated_at, t.ticket_number, t.passenger_type, t.accompanying_ticket_id, t.fare_family,
+    s.seat_code,
+    o.first_name,
+    o.last_name
+FROM tickets t
+    LEFT JOIN TicketOwnerSnapshots o ON t.ticket_id = o.ticket_id
+    LEFT JOIN Seats s ON t.seat_id = s.seat_id
+WHERE t.booking_id = ANY($1::bigint[])
+ORDER BY t.ticket_id
+`
+
+type ListTripTicketsByBookingIDsRow struct {
+	Ticket    Ticket      `json:"ticket"`
+	SeatCode  pgtype.Text `json:"seat_code"`
+	FirstName pgtype.Text `json:"first_name"`
+	LastName  pgtype.Text `json:"last_name"`
+}
+
+func (q *Queries) ListTripTicketsByBookingIDs(ctx context.Context, bookingIds []int64) ([]ListTripTicketsByBookingIDsRow, error) {
+	rows, err := q.db.Query(ctx, listTripTicketsByBookingIDs, bookingIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTripTicketsByBookingIDsRow{}
+	for rows.Next() {
+		var i ListTripTicketsByBookingIDsRow
+		if err := rows.Scan(
+			&i.Ticket.TicketID,
+			&i.Ticket.SeatID,
+			&i.Ticket.FlightClass,
+			&i.Ticket.Price,
+			&i.Ticket.Status,
+			&i.Ticket.BookingID,
+			&i.Ticket.FlightID,
+			&i.Ticket.CreatedAt,
+			&i.Ticket.UpdatedAt,
+			&i.Ticket.TicketNumber,
+			&i.Ticket.PassengerType,
+			&i.Ticket.AccompanyingTicketID,
+			&i.Ticket.FareFamily,
+			&i.SeatCode,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnnumberedTicketIDs = `-- name: ListUnnumberedTicketIDs :many
+SELECT ticket_id FROM Tickets
+WHERE ticket_number IS NULL
+ORDER BY ticket_id
+FOR UPDATE
+`
+
+func (q *Queries) ListUnnumberedTicketIDs(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.Query(ctx, listUnnumberedTicketIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var ticket_id int64
+		if err := rows.Scan(&ticket_id); err != nil {
+			return nil, err
+		}
+		items = append(items, ticket_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nextTicketSerial = `-- name: NextTicketSerial :one
+SELECT nextval('ticket_number_seq')::BIGINT AS serial
+`
+
+func (q *Queries) NextTicketSerial(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, nextTicketSerial)
+	var serial int64
+	err := row.Scan(&serial)
+	return serial, err
+}
+
+const setTicketNumber = `-- name: SetTicketNumber :exec
+UPDATE Tickets
+SET ticket_number = $2
+WHERE ticket_id = $1
+  AND ticket_number IS NULL
+`
+
+type SetTicketNumberParams struct {
+	TicketID     int64       `json:"ticket_id"`
+	TicketNumber pgtype.Text `json:"ticket_number"`
+}
+
+func (q *Queries) SetTicketNumber(ctx context.Context, arg SetTicketNumberParams) error {
+	_, err := q.db.Exec(ctx, setTicketNumber, arg.TicketID, arg.TicketNumber)
+	return err
+}
+
 const updateSeat = `-- name: UpdateSeat :one
 UPDATE Seats
-SET seat_code = $2
+SET seat_code = $2,
+    is_available = false
 WHERE seat_id = (
         SELECT seat_id
         FROM Tickets
@@ -516,12 +919,55 @@ func (q *Queries) UpdateTicket(ctx context.Context, arg UpdateTicketParams) erro
 	return err
 }
 
+const updateTicketFlight = `-- name: UpdateTicketFlight :one
+UPDATE Tickets
+SET flight_id = $2,
+    seat_id = $3,
+    price = $4,
+    updated_at = NOW()
+WHERE ticket_id = $1
+RETURNING ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at, ticket_number, passenger_type, accompanying_ticket_id, fare_family
+`
+
+type UpdateTicketFlightParams struct {
+	TicketID int64       `json:"ticket_id"`
+	FlightID int64       `json:"flight_id"`
+	SeatID   pgtype.Int8 `json:"seat_id"`
+	Price    int32       `json:"price"`
+}
+
+func (q *Queries) UpdateTicketFlight(ctx context.Context, arg UpdateTicketFlightParams) (Ticket, error) {
+	row := q.db.QueryRow(ctx, updateTicketFlight,
+		arg.TicketID,
+		arg.FlightID,
+		arg.SeatID,
+		arg.Price,
+	)
+	var i Ticket
+	err := row.Scan(
+		&i.TicketID,
+		&i.SeatID,
+		&i.FlightClass,
+		&i.Price,
+		&i.Status,
+		&i.BookingID,
+		&i.FlightID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TicketNumber,
+		&i.PassengerType,
+		&i.AccompanyingTicketID,
+		&i.FareFamily,
+	)
+	return i, err
+}
+
 const updateTicketStatus = `-- name: UpdateTicketStatus :one
 UPDATE Tickets
 SET status = $2,
     updated_at = NOW()
 WHERE ticket_id = $1
-RETURNING ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at
+RETURNING ticket_id, seat_id, flight_class, price, status, booking_id, flight_id, created_at, updated_at, ticket_number, passenger_type, accompanying_ticket_id, fare_family
 `
 
 type UpdateTicketStatusParams struct {
@@ -542,6 +988,10 @@ func (q *Queries) UpdateTicketStatus(ctx context.Context, arg UpdateTicketStatus
 		&i.FlightID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TicketNumber,
+		&i.PassengerType,
+		&i.AccompanyingTicketID,
+		&i.FareFamily,
 	)
 	return i, err
 }
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// RequestTicketAncillaryTxParams chứa dịch vụ bổ trợ mua thêm và payment intent thu tiền dịch vụ
+type RequestTicketAncillaryTxParams struct {
+	Ancillary CreateTicketAncillaryParams
+	Payment   CreatePaymentParams
+}
+
+// RequestTicketAncillaryTxResult chứa dịch vụ đang chờ thanh toán và payment của nó
+type RequestTicketAncillaryTxResult struct {
+	Ancillary TicketAncillary
+	Payment   Payment
+}
+
+// RequestTicketAncillaryTx records an add-on bought for a ticket of a confirmed booking
+// as pending_payment, together with the payment collecting it. The add-on only becomes
+// active once ActivateTicketAncillaryTx runs for the captured payment. It returns
+// ErrBookingNotConfirmed when the booking is no longer confirmed.
+func (store *SQLStore) RequestTicketAncillaryTx(ctx context.Context, arg RequestTicketAncillaryTxParams) (RequestTicketAncillaryTxResult, error) {
+	var result RequestTicketAncillaryTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Booking phải còn được xác nhận khi mua thêm dịch vụ
+		booking, err := q.GetBookingForUpdate(ctx, arg.Ancillary.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		if booking.Status != BookingStatusConfirmed {
+			return ErrBookingNotConfirmed
+		}
+
+		// 2. Lưu dịch vụ ở trạng thái chờ thanh toán
+		ancillary := arg.Ancillary
+		ancillary.Status = string(entities.AncillaryStatusPendingPayment)
+		result.Ancillary, err = q.CreateTicketAncillary(ctx, ancillary)
+		if err != nil {
+			return fmt.Errorf("failed to create ticket ancillary: %w", err)
+		}
+
+		// 3. Ghi payment thu tiền dịch vụ, trỏ về dịch vụ vừa tạo
+		payment := arg.Payment
+		payment.BookingID = pgtype.Int8{Int64: arg.Ancillary.BookingID, Valid: true}
+		payment.Purpose = string(entities.PaymentPurposeAncillary)
+		payment.ReferenceID = result.Ancillary.ID
+		result.Payment, err = q.CreatePayment(ctx, payment)
+		if err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// ActivateTicketAncillaryTxResult chứa payment đã thu, dịch vụ của nó và khoản hoàn (nếu có)
+type ActivateTicketAncillaryTxResult struct {
+	Payment   Payment
+	Ancillary TicketAncillary
+	// Refund là khoản hoàn khi dịch vụ hoặc booking đã bị huỷ trước khi tiền về
+	Refund *Refund
+}
+
+// ActivateTicketAncillaryTx captures the payment of an add-on bought after booking and
+// makes the add-on active. When the add-on or its booking was cancelled before the money
+// arrived, the add-on stays cancelled and what was captured is recorded as a refund.
+func (store *SQLStore) ActivateTicketAncillaryTx(ctx context.Context, arg SettlePaymentParams) (ActivateTicketAncillaryTxResult, error) {
+	var result ActivateTicketAncillaryTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Ghi nhận tiền đã thu; webhook gửi lại lần hai dừng ở đây
+		var err error
+		result.Payment, err = settlePayment(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		// 2. Khoá booking trước dịch vụ, cùng thứ tự với khi huỷ booking
+		booking, err := q.GetBookingForUpdate(ctx, result.Payment.BookingID.Int64)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		result.Ancillary, err = q.GetTicketAncillaryForUpdate(ctx, result.Payment.ReferenceID)
+		if err != nil {
+			return fmt.Errorf("failed to lock ticket ancillary: %w", err)
+		}
+
+		// 3. Kích hoạt dịch vụ nếu booking vẫn được xác nhận
+		if booking.Status == BookingStatusConfirmed {
+			activated, err := q.ActivateTicketAncillary(ctx, result.Ancillary.ID)
+			if err == nil {
+				result.Ancillary = activated
+				return nil
+			}
+			if !errors.Is(err, ErrRecordNotFound) {
+				return fmt.Errorf("failed to activate ticket ancillary: %w", err)
+			}
+		} else if result.Ancillary.Status == string(entities.AncillaryStatusPendingPayment) {
+			result.Ancillary, err = q.CancelTicketAncillary(ctx, CancelTicketAncillaryParams{
+				ID:        result.Ancillary.ID,
+				BookingID: result.Ancillary.BookingID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to cancel ticket ancillary: %w", err)
+			}
+		}
+
+		// 4. Dịch vụ đã bị huỷ nên hoàn lại số tiền vừa thu
+		refund, err := q.CreateRefund(ctx, CreateRefundParams{
+			BookingID: result.Ancillary.BookingID,
+			Amount:    result.Payment.AmountReceived,
+			Status:    string(entities.RefundStatusPending),
+			Reason:    "payment received after ancillary " + result.Ancillary.Code + " was cancelled",
+			Method:    string(entities.RefundMethodOriginal),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create refund: %w", err)
+		}
+		result.Refund = &refund
+		return nil
+	})
+
+	return result, err
+}
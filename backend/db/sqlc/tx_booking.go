@@ -2,29 +2,76 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/pkg/utils"
 )
 
 type CreateBookingTxParams struct {
-	UserEmail           string
-	DepartureCity       string
-	ArrivalCity         string
-	DepartureFlightID   int64
-	ReturnFlightID      int64
-	TripType            string
-	DepartureTicketData []TicketData
-	ReturnTicketData    []TicketData
-	AfterCreate         func(booking entities.Booking) error
+	UserEmail          string
+	DepartureCity      string
+	ArrivalCity        string
+	TripType           string
+	Segments           []SegmentData
+	TicketNumberPrefix string
+	// Promotions là các mã khuyến mãi đã trừ vào giá vé, ghi lượt dùng cùng booking
+	Promotions  []PromoRedemptionData
+	AfterCreate func(booking entities.Booking, tickets []entities.Ticket) error
+}
+
+// PromoRedemptionData is a promo code discount already applied to the ticket prices
+type PromoRedemptionData struct {
+	PromoCodeID int64
+	Code        string
+	Amount      int64
+}
+
+// SegmentData is one flight of the itinerary with the tickets to issue on it
+type SegmentData struct {
+	FlightID   int64
+	TicketData []TicketData
+	// Capacity là số ghế của từng hạng trên chuyến bay; để trống thì không kiểm tra chỗ,
+	// như khi xuất vé cho đoàn đã giữ chỗ từ trước
+	Capacity map[string]int64
 }
 
 type TicketData struct {
-	Price       int64
-	FlightClass string
-	OwnerData   OwnerData
+	Price         int64
+	FlightClass   string
+	FareFamily    string
+	PassengerType string
+	// AccompanyingIndex là vị trí của vé người lớn đi kèm trong cùng chặng, chỉ dùng cho em bé
+	AccompanyingIndex int
+	OwnerData         OwnerData
+	// FareItems là chi tiết giá vé; Price là tổng của các dòng này
+	FareItems []FareItemData
+	// Ancillaries là các dịch vụ bổ trợ mua kèm vé, tính riêng với Price
+	Ancillaries []AncillaryData
+	// SeatCode là ghế chọn khi đặt vé, để trống nếu chưa chọn
+	SeatCode string
+}
+
+type FareItemData struct {
+	Type        string
+	Code        string
+	Description string
+	Amount      int64
+}
+
+// AncillaryData is an add-on bought with a ticket, already priced
+type AncillaryData struct {
+	AncillaryID int64
+	Code        string
+	Type        string
+	Name        string
+	Quantity    int32
+	UnitPrice   int64
+	Amount      int64
 }
 
 type OwnerData struct {
@@ -35,6 +82,12 @@ type OwnerData struct {
 	DateOfBirth        string
 	Gender             string
 	Address            string
+	// PassportNumber để trống thì số giấy tờ tuỳ thân được ghi vào cột hộ chiếu như trước
+	PassportNumber  string
+	DocumentExpiry  time.Time
+	DocumentCountry string
+	// CompanionID là hồ sơ người đi cùng mà thông tin hành khách được chép từ đó, 0 nếu khách tự nhập
+	CompanionID int64
 }
 
 type CreateBookingTxResult struct {
@@ -46,121 +99,469 @@ type CreateBookingTxResult struct {
 func (store *SQLStore) CreateBookingTx(ctx context.Context, arg CreateBookingTxParams) (CreateBookingTxResult, error) {
 	var result CreateBookingTxResult
 
+	if len(arg.Segments) == 0 || len(arg.Segments) > entities.MaxItinerarySegments {
+		return result, fmt.Errorf("booking must have between 1 and %d segments, got %d", entities.MaxItinerarySegments, len(arg.Segments))
+	}
+
 	err := store.execTx(ctx, func(q *Queries) error {
+		var allTickets []entities.Ticket
 		var err error
-		// Tạo booking
-		var returnFlightID pgtype.Int8
-		if arg.TripType == "roundTrip" && arg.ReturnFlightID != 0 {
-			returnFlightID = pgtype.Int8{Int64: arg.ReturnFlightID, Valid: true}
-		} else {
-			returnFlightID = pgtype.Int8{Int64: 0, Valid: false}
-		}
-
-		booking, err := q.CreateBooking(ctx, CreateBookingParams{
-			UserEmail:         pgtype.Text{String: arg.UserEmail, Valid: true},
-			TripType:          TripType(arg.TripType),
-			DepartureFlightID: pgtype.Int8{Int64: arg.DepartureFlightID, Valid: true},
-			ReturnFlightID:    returnFlightID,
-			Status:            BookingStatus(entities.BookingStatusPending),
+		result, allTickets, err = createBookingWithTickets(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+		return arg.AfterCreate(result.Booking, allTickets)
+	})
+
+	return result, err
+}
+
+// createBookingWithTickets creates a pending booking with its segments and tickets and records its
+// promo code redemptions. It returns the booking and every ticket issued.
+func createBookingWithTickets(ctx context.Context, q *Queries, arg CreateBookingTxParams) (CreateBookingTxResult, []entities.Ticket, error) {
+	var result CreateBookingTxResult
+
+	// Khoá các chuyến bay và kiểm tra hạng ghế còn đủ chỗ, kể cả chỗ đang giữ cho đoàn và danh sách chờ
+	if err := checkCabinCapacity(ctx, q, arg.UserEmail, arg.Segments); err != nil {
+		return result, nil, err
+	}
+
+	// Tạo booking; chặng đầu là chuyến đi, chặng thứ hai là chuyến về với roundTrip
+	var returnFlightID pgtype.Int8
+	if arg.TripType == string(entities.RoundTrip) && len(arg.Segments) == 2 {
+		returnFlightID = pgtype.Int8{Int64: arg.Segments[1].FlightID, Valid: true}
+	}
+
+	booking, err := createBookingWithPNR(ctx, q, CreateBookingParams{
+		UserEmail:         pgtype.Text{String: arg.UserEmail, Valid: true},
+		TripType:          TripType(arg.TripType),
+		DepartureFlightID: pgtype.Int8{Int64: arg.Segments[0].FlightID, Valid: true},
+		ReturnFlightID:    returnFlightID,
+		Status:            BookingStatus(entities.BookingStatusPending),
+	})
+	if err != nil {
+		return result, nil, fmt.Errorf("failed to create booking: %w", err)
+	}
+	_, err = q.CreateBookingStatusHistory(ctx, CreateBookingStatusHistoryParams{
+		BookingID: booking.BookingID,
+		ToStatus:  booking.Status,
+		Actor:     arg.UserEmail,
+		Reason:    "booking created",
+	})
+	if err != nil {
+		return result, nil, fmt.Errorf("failed to record booking status history: %w", err)
+	}
+	result.Booking = entities.Booking{
+		BookingID:         booking.BookingID,
+		PNR:               booking.Pnr,
+		UserEmail:         booking.UserEmail.String,
+		TripType:          entities.TripType(booking.TripType),
+		DepartureFlightID: booking.DepartureFlightID.Int64,
+		ReturnFlightID:    &booking.ReturnFlightID.Int64,
+		Status:            entities.BookingStatus(booking.Status),
+		CreatedAt:         booking.CreatedAt,
+		UpdatedAt:         booking.UpdatedAt,
+	}
+
+	// Tạo từng chặng bay theo thứ tự và vé cho chặng đó
+	var allTickets []entities.Ticket
+	for i, segmentData := range arg.Segments {
+		segment, err := q.CreateBookingSegment(ctx, CreateBookingSegmentParams{
+			BookingID:    booking.BookingID,
+			SegmentOrder: int16(i + 1),
+			FlightID:     segmentData.FlightID,
 		})
 		if err != nil {
-			return fmt.Errorf("failed to create booking: %w", err)
-		}
-		result.Booking = entities.Booking{
-			BookingID:         booking.BookingID,
-			UserEmail:         booking.UserEmail.String,
-			TripType:          entities.TripType(booking.TripType),
-			DepartureFlightID: booking.DepartureFlightID.Int64,
-			ReturnFlightID:    &booking.ReturnFlightID.Int64,
-			Status:            entities.BookingStatus(booking.Status),
-			CreatedAt:         booking.CreatedAt,
-			UpdatedAt:         booking.UpdatedAt,
-		}
-
-		// Tạo vé cho chuyến bay đi
-		for _, ticket := range arg.DepartureTicketData {
-			createdTicket, err := createTicketForBooking(ctx, q, booking.BookingID, arg.DepartureFlightID, ticket)
+			return result, nil, fmt.Errorf("failed to create booking segment %d: %w", i+1, err)
+		}
+
+		bookingSegment := entities.BookingSegment{
+			SegmentOrder: int(segment.SegmentOrder),
+			FlightID:     segment.FlightID,
+		}
+		// Vé người lớn và trẻ em được tạo trước để em bé có thể gắn với vé người lớn đi kèm
+		bookingSegment.Tickets = make([]entities.Ticket, len(segmentData.TicketData))
+		for j, ticket := range segmentData.TicketData {
+			if ticket.PassengerType == string(entities.PassengerTypeInfant) {
+				continue
+			}
+			bookingSegment.Tickets[j], err = createTicketForBooking(ctx, q, booking.BookingID, segment.FlightID, arg.TicketNumberPrefix, ticket, 0)
 			if err != nil {
-				return err
+				return result, nil, err
 			}
-			result.DepartureTickets = append(result.DepartureTickets, createdTicket)
 		}
+		for j, ticket := range segmentData.TicketData {
+			if ticket.PassengerType != string(entities.PassengerTypeInfant) {
+				continue
+			}
+			if ticket.AccompanyingIndex < 0 || ticket.AccompanyingIndex >= len(segmentData.TicketData) {
+				return result, nil, fmt.Errorf("infant on segment %d has no accompanying adult", i+1)
+			}
+			accompanyingTicketID := bookingSegment.Tickets[ticket.AccompanyingIndex].TicketID
+			bookingSegment.Tickets[j], err = createTicketForBooking(ctx, q, booking.BookingID, segment.FlightID, arg.TicketNumberPrefix, ticket, accompanyingTicketID)
+			if err != nil {
+				return result, nil, err
+			}
+		}
+		result.Booking.Segments = append(result.Booking.Segments, bookingSegment)
+		allTickets = append(allTickets, bookingSegment.Tickets...)
+	}
+
+	result.DepartureTickets = result.Booking.Segments[0].Tickets
+	if returnFlightID.Valid {
+		result.ReturnTickets = result.Booking.Segments[1].Tickets
+	}
 
-		// Tạo vé cho chuyến bay về (nếu có)
-		if arg.TripType == "roundTrip" && arg.ReturnFlightID != 0 {
-			for _, ticket := range arg.ReturnTicketData {
-				createdTicket, err := createTicketForBooking(ctx, q, booking.BookingID, arg.ReturnFlightID, ticket)
-				if err != nil {
-					return err
-				}
-				result.ReturnTickets = append(result.ReturnTickets, createdTicket)
+	// Ghi lượt dùng mã khuyến mãi; khóa mã để hai booking cùng lúc không vượt giới hạn
+	for _, promotion := range arg.Promotions {
+		if err := redeemPromoCode(ctx, q, booking.BookingID, arg.UserEmail, promotion); err != nil {
+			return result, nil, err
+		}
+	}
+
+	return result, allTickets, nil
+}
+
+// checkCabinCapacity locks the flights of the segments, in flight ID order so two bookings
+// never wait on each other, and returns ErrCabinFull when a cabin has fewer seats left than
+// the segment asks for. Seats blocked for groups or held for waitlist offers count as
+// taken, except the offers made to userEmail, which the booking takes up.
+func checkCabinCapacity(ctx context.Context, q *Queries, userEmail string, segments []SegmentData) error {
+	requested := make(map[int64]map[string]int64)
+	for _, segment := range segments {
+		if segment.Capacity == nil {
+			continue
+		}
+		if requested[segment.FlightID] == nil {
+			requested[segment.FlightID] = make(map[string]int64)
+		}
+		// Em bé ngồi cùng người lớn nên không chiếm ghế
+		for _, ticket := range segment.TicketData {
+			if ticket.PassengerType != string(entities.PassengerTypeInfant) {
+				requested[segment.FlightID][ticket.FlightClass]++
 			}
 		}
+	}
+	if len(requested) == 0 {
+		return nil
+	}
 
-		return arg.AfterCreate(result.Booking)
-	})
+	flightIDs := make([]int64, 0, len(requested))
+	for flightID := range requested {
+		flightIDs = append(flightIDs, flightID)
+	}
+	slices.Sort(flightIDs)
+	for _, flightID := range flightIDs {
+		if err := q.LockFlight(ctx, flightID); err != nil {
+			return fmt.Errorf("failed to lock flight %d: %w", flightID, err)
+		}
+		// Ghế được mời từ danh sách chờ chuyển thành vé của chính khách được mời
+		for class := range requested[flightID] {
+			err := q.BookWaitlistHolds(ctx, BookWaitlistHoldsParams{
+				FlightID:    flightID,
+				FlightClass: FlightClass(class),
+				UserEmail:   userEmail,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to book waitlist offers: %w", err)
+			}
+		}
+	}
 
-	return result, err
+	rows, err := q.CountSoldSeatsByClass(ctx, flightIDs)
+	if err != nil {
+		return fmt.Errorf("failed to count sold seats: %w", err)
+	}
+	for _, row := range rows {
+		requested[row.FlightID][string(row.FlightClass)] += row.Sold
+	}
+	for _, segment := range segments {
+		for class, taken := range requested[segment.FlightID] {
+			if segment.Capacity != nil && taken > segment.Capacity[class] {
+				return fmt.Errorf("%w: flight %d, %s", ErrCabinFull, segment.FlightID, class)
+			}
+		}
+	}
+	return nil
 }
 
-func createTicketForBooking(ctx context.Context, q *Queries, bookingID int64, flightID int64, ticket TicketData) (entities.Ticket, error) {
-	createdSeat, err := q.CreateSeat(ctx, CreateSeatParams{
-		IsAvailable: true,
-		Class:       FlightClass(ticket.FlightClass),
-		FlightID:    pgtype.Int8{Int64: flightID, Valid: true},
+// redeemPromoCode records one use of a promo code by the booking. The code row is locked
+// so concurrent bookings see each other's redemptions before the usage caps are checked.
+func redeemPromoCode(ctx context.Context, q *Queries, bookingID int64, userEmail string, promotion PromoRedemptionData) error {
+	promo, err := q.GetPromoCodeForUpdate(ctx, promotion.PromoCodeID)
+	if err != nil {
+		return fmt.Errorf("failed to lock promo code %s: %w", promotion.Code, err)
+	}
+	now := time.Now()
+	if !promo.Active || now.Before(promo.ValidFrom) || !now.Before(promo.ValidUntil) {
+		return &entities.PromoCodeError{Code: promo.Code, Reason: "the code is no longer valid"}
+	}
+
+	if promo.MaxUses > 0 {
+		used, err := q.CountPromoRedemptions(ctx, promo.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count promo code redemptions: %w", err)
+		}
+		if used >= int64(promo.MaxUses) {
+			return &entities.PromoCodeError{Code: promo.Code, Reason: "the code has been fully redeemed"}
+		}
+	}
+	if promo.MaxUsesPerCustomer > 0 {
+		used, err := q.CountPromoRedemptionsByEmail(ctx, CountPromoRedemptionsByEmailParams{
+			PromoCodeID: promo.ID,
+			UserEmail:   userEmail,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count promo code redemptions: %w", err)
+		}
+		if used >= int64(promo.MaxUsesPerCustomer) {
+			return &entities.PromoCodeError{Code: promo.Code, Reason: "you have already used this code"}
+		}
+	}
+
+	_, err = q.CreatePromoRedemption(ctx, CreatePromoRedemptionParams{
+		PromoCodeID: promo.ID,
+		BookingID:   bookingID,
+		UserEmail:   userEmail,
+		Amount:      promotion.Amount,
 	})
+	if err != nil {
+		return fmt.Errorf("failed to record promo code redemption: %w", err)
+	}
+	return nil
+}
+
+// maxPNRAttempts bounds how many record locators we draw before giving up
+const maxPNRAttempts = 5
+
+// createBookingWithPNR inserts the booking under a freshly generated PNR. The insert uses
+// ON CONFLICT (pnr) DO NOTHING, so a collision returns no row and we draw another locator
+// without aborting the surrounding transaction.
+func createBookingWithPNR(ctx context.Context, q *Queries, arg CreateBookingParams) (Booking, error) {
+	for attempt := 0; attempt < maxPNRAttempts; attempt++ {
+		pnr, err := utils.GeneratePNR()
+		if err != nil {
+			return Booking{}, err
+		}
+		arg.Pnr = pnr
+
+		booking, err := q.CreateBooking(ctx, arg)
+		if errors.Is(err, ErrRecordNotFound) {
+			continue
+		}
+		return booking, err
+	}
+	return Booking{}, fmt.Errorf("could not allocate a unique PNR after %d attempts", maxPNRAttempts)
+}
+
+// createTicketForBooking issues one ticket with its owner snapshot. Infants sit on the
+// lap of the adult holding accompanyingTicketID and get no seat of their own.
+func createTicketForBooking(ctx context.Context, q *Queries, bookingID int64, flightID int64, ticketNumberPrefix string, ticket TicketData, accompanyingTicketID int64) (entities.Ticket, error) {
+	passengerType := PassengerType(ticket.PassengerType)
+	if passengerType == "" {
+		passengerType = PassengerTypeAdult
+	}
+
+	fareFamily := FareFamilyType(ticket.FareFamily)
+	if fareFamily == "" {
+		fareFamily = FareFamilyTypeClassic
+	}
+
+	var seatID pgtype.Int8
+	if passengerType != PassengerTypeInfant {
+		if ticket.SeatCode != "" {
+			taken, err := q.IsSeatCodeTaken(ctx, IsSeatCodeTakenParams{
+				FlightID: pgtype.Int8{Int64: flightID, Valid: true},
+				SeatCode: ticket.SeatCode,
+			})
+			if err != nil {
+				return entities.Ticket{}, fmt.Errorf("failed to check seat: %w", err)
+			}
+			if taken {
+				return entities.Ticket{}, fmt.Errorf("%w: %s", ErrSeatTaken, ticket.SeatCode)
+			}
+		}
+		// Ghế đã có mã thì được giữ cho vé; chỉ mục duy nhất chặn hai booking giữ cùng một ghế
+		createdSeat, err := q.CreateSeat(ctx, CreateSeatParams{
+			SeatCode:    ticket.SeatCode,
+			IsAvailable: ticket.SeatCode == "",
+			Class:       FlightClass(ticket.FlightClass),
+			FlightID:    pgtype.Int8{Int64: flightID, Valid: true},
+		})
+		if err != nil {
+			if IsUniqueViolation(err, SeatCodeUniqueIndex) {
+				return entities.Ticket{}, fmt.Errorf("%w: %s", ErrSeatTaken, ticket.SeatCode)
+			}
+			return entities.Ticket{}, fmt.Errorf("failed to create seat: %w", err)
+		}
+		seatID = pgtype.Int8{Int64: createdSeat.SeatID, Valid: true}
+	}
 
+	// Cấp số vé điện tử từ sequence
+	serial, err := q.NextTicketSerial(ctx)
 	if err != nil {
-		return entities.Ticket{}, fmt.Errorf("failed to create seat: %w", err)
+		return entities.Ticket{}, fmt.Errorf("failed to allocate ticket serial: %w", err)
+	}
+	ticketNumber, err := utils.FormatTicketNumber(ticketNumberPrefix, serial)
+	if err != nil {
+		return entities.Ticket{}, err
 	}
 
 	createdTicket, err := q.CreateTicket(ctx, CreateTicketParams{
-		SeatID:      createdSeat.SeatID,
-		FlightClass: FlightClass(ticket.FlightClass),
-		Price:       int32(ticket.Price),
-		Status:      TicketStatusActive,
-		BookingID:   pgtype.Int8{Int64: bookingID, Valid: true},
-		FlightID:    flightID,
+		SeatID:               seatID,
+		FlightClass:          FlightClass(ticket.FlightClass),
+		Price:                int32(ticket.Price),
+		Status:               TicketStatusActive,
+		BookingID:            pgtype.Int8{Int64: bookingID, Valid: true},
+		FlightID:             flightID,
+		TicketNumber:         pgtype.Text{String: ticketNumber, Valid: true},
+		PassengerType:        passengerType,
+		AccompanyingTicketID: pgtype.Int8{Int64: accompanyingTicketID, Valid: accompanyingTicketID != 0},
+		FareFamily:           fareFamily,
 	})
 
 	if err != nil {
 		return entities.Ticket{}, fmt.Errorf("failed to create ticket: %w", err)
 	}
 
+	fareItems, err := createTicketFareItems(ctx, q, createdTicket.TicketID, ticket.FareItems)
+	if err != nil {
+		return entities.Ticket{}, err
+	}
+
+	ancillaries, err := createTicketAncillaries(ctx, q, createdTicket.TicketID, bookingID, ticket.Ancillaries)
+	if err != nil {
+		return entities.Ticket{}, err
+	}
+
+	dateOfBirth, err := parseDate(ticket.OwnerData.DateOfBirth)
+	if err != nil {
+		return entities.Ticket{}, fmt.Errorf("invalid date of birth %q: %w", ticket.OwnerData.DateOfBirth, err)
+	}
+	passportNumber := ticket.OwnerData.PassportNumber
+	if passportNumber == "" {
+		passportNumber = ticket.OwnerData.IdentityCardNumber
+	}
 	_, err = q.CreateTicketOwnerSnapshot(ctx, CreateTicketOwnerSnapshotParams{
-		TicketID:       createdTicket.TicketID,
-		FirstName:      pgtype.Text{String: ticket.OwnerData.FirstName, Valid: true},
-		LastName:       pgtype.Text{String: ticket.OwnerData.LastName, Valid: true},
-		PhoneNumber:    pgtype.Text{String: ticket.OwnerData.PhoneNumber, Valid: true},
-		Gender:         GenderType(ticket.OwnerData.Gender),
-		DateOfBirth:    parseDate(ticket.OwnerData.DateOfBirth),
-		PassportNumber: pgtype.Text{String: ticket.OwnerData.IdentityCardNumber, Valid: true},
-		Address:        pgtype.Text{String: ticket.OwnerData.Address, Valid: true},
+		TicketID:             createdTicket.TicketID,
+		FirstName:            pgtype.Text{String: ticket.OwnerData.FirstName, Valid: true},
+		LastName:             pgtype.Text{String: ticket.OwnerData.LastName, Valid: true},
+		PhoneNumber:          pgtype.Text{String: ticket.OwnerData.PhoneNumber, Valid: true},
+		Gender:               GenderType(ticket.OwnerData.Gender),
+		DateOfBirth:          dateOfBirth,
+		PassportNumber:       pgtype.Text{String: passportNumber, Valid: true},
+		IdentificationNumber: pgtype.Text{String: ticket.OwnerData.IdentityCardNumber, Valid: ticket.OwnerData.IdentityCardNumber != ""},
+		Address:              pgtype.Text{String: ticket.OwnerData.Address, Valid: true},
+		CompanionID:          pgtype.Int8{Int64: ticket.OwnerData.CompanionID, Valid: ticket.OwnerData.CompanionID != 0},
+		DocumentExpiry:       pgtype.Date{Time: ticket.OwnerData.DocumentExpiry, Valid: !ticket.OwnerData.DocumentExpiry.IsZero()},
+		DocumentCountry:      ticket.OwnerData.DocumentCountry,
 	})
 	if err != nil {
 		return entities.Ticket{}, fmt.Errorf("failed to insert ticket owner data: %w", err)
 	}
 
 	return entities.Ticket{
-		TicketID:    createdTicket.TicketID,
-		BookingID:   createdTicket.BookingID.Int64,
-		FlightID:    createdTicket.FlightID,
-		Price:       createdTicket.Price,
-		FlightClass: entities.FlightClass(createdTicket.FlightClass),
+		TicketID:             createdTicket.TicketID,
+		TicketNumber:         createdTicket.TicketNumber.String,
+		SeatID:               createdTicket.SeatID.Int64,
+		Seat:                 entities.Seat{SeatID: createdTicket.SeatID.Int64, FlightID: flightID, SeatCode: ticket.SeatCode, IsAvailable: ticket.SeatCode == "", Class: entities.FlightClass(createdTicket.FlightClass)},
+		BookingID:            createdTicket.BookingID.Int64,
+		FlightID:             createdTicket.FlightID,
+		Price:                createdTicket.Price,
+		FlightClass:          entities.FlightClass(createdTicket.FlightClass),
+		FareFamily:           entities.FareFamilyCode(createdTicket.FareFamily),
+		PassengerType:        entities.PassengerType(createdTicket.PassengerType),
+		AccompanyingTicketID: createdTicket.AccompanyingTicketID.Int64,
+		FareItems:            fareItems,
+		Ancillaries:          ancillaries,
 		Owner: entities.TicketOwner{
 			FirstName:            ticket.OwnerData.FirstName,
 			LastName:             ticket.OwnerData.LastName,
 			PhoneNumber:          ticket.OwnerData.PhoneNumber,
-			DateOfBirth:          parseDate(ticket.OwnerData.DateOfBirth),
+			DateOfBirth:          dateOfBirth,
 			Gender:               entities.GenderType(ticket.OwnerData.Gender),
-			PassportNumber:       ticket.OwnerData.IdentityCardNumber,
+			PassportNumber:       passportNumber,
 			IdentificationNumber: ticket.OwnerData.IdentityCardNumber,
 			Address:              ticket.OwnerData.Address,
+			DocumentExpiry:       ticket.OwnerData.DocumentExpiry,
+			DocumentCountry:      ticket.OwnerData.DocumentCountry,
+			CompanionID:          ticket.OwnerData.CompanionID,
 		},
 	}, nil
 }
 
-func parseDate(dateStr string) time.Time {
-	parsedDate, _ := time.Parse("2006-01-02", dateStr)
-	return parsedDate
+// createTicketFareItems stores the price breakdown of a ticket
+func createTicketFareItems(ctx context.Context, q *Queries, ticketID int64, items []FareItemData) ([]entities.FareItem, error) {
+	fareItems := make([]entities.FareItem, 0, len(items))
+	for _, item := range items {
+		created, err := q.CreateTicketFareItem(ctx, CreateTicketFareItemParams{
+			TicketID:    ticketID,
+			ItemType:    item.Type,
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      item.Amount,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create fare item: %w", err)
+		}
+		fareItems = append(fareItems, entities.FareItem{
+			Type:        entities.FareItemType(created.ItemType),
+			Code:        created.Code,
+			Description: created.Description,
+			Amount:      created.Amount,
+		})
+	}
+	return fareItems, nil
+}
+
+// createTicketAncillaries stores the add-ons bought together with a ticket
+func createTicketAncillaries(ctx context.Context, q *Queries, ticketID int64, bookingID int64, items []AncillaryData) ([]entities.TicketAncillary, error) {
+	ancillaries := make([]entities.TicketAncillary, 0, len(items))
+	for _, item := range items {
+		created, err := q.CreateTicketAncillary(ctx, CreateTicketAncillaryParams{
+			TicketID:      ticketID,
+			BookingID:     bookingID,
+			AncillaryID:   pgtype.Int8{Int64: item.AncillaryID, Valid: item.AncillaryID != 0},
+			Code:          item.Code,
+			AncillaryType: item.Type,
+			Name:          item.Name,
+			Quantity:      item.Quantity,
+			UnitPrice:     item.UnitPrice,
+			Amount:        item.Amount,
+			Status:        string(entities.AncillaryStatusActive),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ticket ancillary: %w", err)
+		}
+		ancillaries = append(ancillaries, mapTicketAncillaryToEntity(created))
+	}
+	return ancillaries, nil
+}
+
+// mapTicketAncillaryToEntity converts a stored add-on to its domain form
+func mapTicketAncillaryToEntity(item TicketAncillary) entities.TicketAncillary {
+	ancillary := entities.TicketAncillary{
+		TicketAncillaryID: item.ID,
+		TicketID:          item.TicketID,
+		BookingID:         item.BookingID,
+		AncillaryID:       item.AncillaryID.Int64,
+		Code:              item.Code,
+		Type:              entities.AncillaryType(item.AncillaryType),
+		Name:              item.Name,
+		Quantity:          item.Quantity,
+		UnitPrice:         item.UnitPrice,
+		Amount:            item.Amount,
+		Status:            entities.AncillaryStatus(item.Status),
+		CreatedAt:         item.CreatedAt,
+	}
+	if item.CancelledAt.Valid {
+		cancelledAt := item.CancelledAt.Time
+		ancillary.CancelledAt = &cancelledAt
+	}
+	return ancillary
+}
+
+// parseDate parses a YYYY-MM-DD date of the booking request
+func parseDate(dateStr string) (time.Time, error) {
+	return time.Parse("2006-01-02", dateStr)
 }
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// CancelTicketAncillaryTxParams chứa thông tin cần thiết để huỷ một dịch vụ bổ trợ
+type CancelTicketAncillaryTxParams struct {
+	TicketAncillaryID int64
+	BookingID         int64
+	Reason            string
+}
+
+// CancelTicketAncillaryTxResult chứa dịch vụ đã huỷ và khoản hoàn tiền (nếu có)
+type CancelTicketAncillaryTxResult struct {
+	Ancillary TicketAncillary
+	Refund    *Refund
+}
+
+// CancelTicketAncillaryTx cancels one add-on of a booking that is active or still waiting
+// for its payment. When the add-on had been paid on a confirmed booking, a pending refund
+// of its price is recorded, limited to what was captured for the booking and not yet paid
+// back. It returns ErrRecordNotFound when the add-on does not belong to the booking or is
+// already cancelled.
+func (store *SQLStore) CancelTicketAncillaryTx(ctx context.Context, arg CancelTicketAncillaryTxParams) (CancelTicketAncillaryTxResult, error) {
+	var result CancelTicketAncillaryTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá booking rồi đến dịch vụ, cùng thứ tự với khi thu tiền dịch vụ
+		booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		current, err := q.GetTicketAncillaryForUpdate(ctx, arg.TicketAncillaryID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Huỷ dịch vụ, chỉ áp dụng cho dịch vụ chưa bị huỷ
+		result.Ancillary, err = q.CancelTicketAncillary(ctx, CancelTicketAncillaryParams{
+			ID:        arg.TicketAncillaryID,
+			BookingID: arg.BookingID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 3. Dịch vụ chưa thanh toán thì không có gì để hoàn
+		if current.Status != string(entities.AncillaryStatusActive) || booking.Status != BookingStatusConfirmed {
+			return nil
+		}
+		captured, err := capturedRefundable(ctx, q, arg.BookingID)
+		if err != nil {
+			return err
+		}
+		amount := min(result.Ancillary.Amount, captured)
+		if amount <= 0 {
+			return nil
+		}
+
+		// 4. Ghi nhận khoản hoàn tiền
+		refund, err := q.CreateRefund(ctx, CreateRefundParams{
+			BookingID: arg.BookingID,
+			Amount:    amount,
+			Status:    string(entities.RefundStatusPending),
+			Reason:    arg.Reason,
+			Method:    string(entities.RefundMethodOriginal),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create refund: %w", err)
+		}
+		result.Refund = &refund
+
+		return nil
+	})
+
+	return result, err
+}
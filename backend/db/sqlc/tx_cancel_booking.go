@@ -0,0 +1,273 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// CancelBookingTxParams chứa thông tin cần thiết để huỷ toàn bộ booking
+type CancelBookingTxParams struct {
+	BookingID int64
+	Actor     string
+	Reason    string
+	// RefundPolicy, FareFamilies và CancelledAt dùng để tính số tiền hoàn cho từng vé
+	RefundPolicy entities.RefundPolicy
+	FareFamilies entities.FareFamilyCatalog
+	CancelledAt  time.Time
+	// RefundToWallet hoàn tiền vào ví tín dụng thay vì phương thức thanh toán ban đầu;
+	// tín dụng hết hạn sau WalletCreditTTL
+	RefundToWallet  bool
+	WalletCreditTTL time.Duration
+}
+
+// CancelBookingTxResult chứa booking đã huỷ cùng danh sách vé bị huỷ theo
+type CancelBookingTxResult struct {
+	Booking          Booking
+	History          BookingStatusHistory
+	CancelledTickets []Ticket
+	// Refund là nil nếu booking chưa được thanh toán (chưa confirmed)
+	Refund *Refund
+	// WalletCredit là khoản tín dụng ghi vào ví khi khách chọn hoàn vào ví
+	WalletCredit *WalletTransaction
+}
+
+// CancelBookingTx cancels a booking together with all of its active tickets and
+// releases their seats. The booking transition is validated like any other status change.
+// Unused ancillaries are cancelled as well and the promo codes it redeemed get their
+// use back. When the booking had been confirmed, the
+// refundable amount of every cancelled ticket and of its seat fee is computed from the
+// rules of its fare family and arg.RefundPolicy, other paid ancillaries of flights not yet
+// departed are refunded in full, and the total is recorded as a single pending refund. The
+// share of the loyalty points and travel credit that paid the booking matching the refund
+// is given back to them and its value is left out of the refund. With arg.RefundToWallet
+// the refund is credited to the customer's wallet at once and recorded as completed;
+// guest bookings have no wallet and cannot choose it.
+func (store *SQLStore) CancelBookingTx(ctx context.Context, arg CancelBookingTxParams) (CancelBookingTxResult, error) {
+	var result CancelBookingTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		// 1. Chuyển trạng thái booking sang cancelled (đã khoá booking)
+		result.Booking, result.History, err = transitionBookingStatus(ctx, q, UpdateBookingStatusTxParams{
+			BookingID: arg.BookingID,
+			ToStatus:  entities.BookingStatusCancelled,
+			Actor:     arg.Actor,
+			Reason:    arg.Reason,
+		})
+		if err != nil {
+			return err
+		}
+		// Mã khuyến mãi booking đã dùng được trả lại lượt
+		if err := q.ReleasePromoRedemptions(ctx, arg.BookingID); err != nil {
+			return fmt.Errorf("failed to release promo redemptions: %w", err)
+		}
+
+		// Giá trị các vé và dịch vụ còn hiệu lực, dùng để chia phần hoàn giữa điểm, ví và tiền
+		value, err := activeBookingValue(ctx, q, arg.BookingID)
+		if err != nil {
+			return err
+		}
+
+		// 2. Huỷ các vé còn hiệu lực, trả ghế và cộng dồn số tiền được hoàn
+		tickets, err := q.ListTicketsByBookingID(ctx, pgtype.Int8{Int64: arg.BookingID, Valid: true})
+		if err != nil {
+			return fmt.Errorf("failed to list booking tickets: %w", err)
+		}
+		var refundAmount int64
+		departureTimeOf := departureTimeLookup(ctx, q)
+		for _, ticket := range tickets {
+			if ticket.Status != TicketStatusActive {
+				continue
+			}
+			if err := cancelTicketAndReleaseSeat(ctx, q, ticket.TicketID, ticket.Status); err != nil {
+				return err
+			}
+			ticket.Status = TicketStatusCancelled
+			result.CancelledTickets = append(result.CancelledTickets, ticket)
+
+			departureTime, err := departureTimeOf(ticket.FlightID)
+			if err != nil {
+				return err
+			}
+			refundAmount += ticketFareFamily(arg.FareFamilies, ticket).RefundAmount(arg.RefundPolicy, int64(ticket.Price), departureTime, arg.CancelledAt)
+		}
+
+		// 3. Huỷ các dịch vụ bổ trợ chưa dùng của booking
+		ticketsByID := make(map[int64]Ticket, len(tickets))
+		for _, ticket := range tickets {
+			ticketsByID[ticket.TicketID] = ticket
+		}
+		_, ancillaryRefund, err := cancelUnusedAncillaries(ctx, q, arg.BookingID, ticketsByID, departureTimeOf, arg.RefundPolicy, arg.FareFamilies, arg.CancelledAt)
+		if err != nil {
+			return err
+		}
+		refundAmount += ancillaryRefund
+
+		// 4. Trả lại phần điểm thưởng đã dùng tương ứng với phần được hoàn; phần tiền điểm đã trả
+		// không được hoàn thành tiền. Booking chưa thanh toán thì được trả lại toàn bộ
+		refundable := refundAmount
+		if result.History.FromStatus.BookingStatus != BookingStatusConfirmed {
+			refundable = value
+		}
+		redeemedAmount, err := restoreLoyaltyRedemptions(ctx, q, arg.BookingID, refundable, value, "Booking cancelled")
+		if err != nil {
+			return err
+		}
+		// Phần tiền đã trả bằng ví tương ứng với phần được hoàn được trả lại vào ví, không hoàn thành tiền
+		walletAmount, err := restoreWalletPayments(ctx, q, arg.BookingID, refundable, value, "Booking cancelled")
+		if err != nil {
+			return err
+		}
+		refundAmount = max(refundAmount-redeemedAmount-walletAmount, 0)
+
+		// 5. Ghi nhận khoản hoàn tiền nếu booking đã được thanh toán
+		if result.History.FromStatus.BookingStatus != BookingStatusConfirmed {
+			return nil
+		}
+		if !arg.RefundToWallet {
+			refund, err := q.CreateRefund(ctx, CreateRefundParams{
+				BookingID: arg.BookingID,
+				Amount:    refundAmount,
+				Status:    string(entities.RefundStatusPending),
+				Reason:    arg.Reason,
+				Method:    string(entities.RefundMethodOriginal),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create refund: %w", err)
+			}
+			result.Refund = &refund
+			return nil
+		}
+
+		// 6. Hoàn vào ví: khoản hoàn hoàn tất ngay và được ghi có vào ví của chủ booking
+		customer, err := q.GetCustomerByEmail(ctx, result.Booking.UserEmail.String)
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return &entities.WalletError{Reason: "only bookings made from a customer account can be refunded to the wallet"}
+			}
+			return fmt.Errorf("failed to get booking customer: %w", err)
+		}
+		refund, err := q.CreateRefund(ctx, CreateRefundParams{
+			BookingID: arg.BookingID,
+			Amount:    refundAmount,
+			Status:    string(entities.RefundStatusCompleted),
+			Reason:    arg.Reason,
+			Method:    string(entities.RefundMethodWallet),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create refund: %w", err)
+		}
+		result.Refund = &refund
+		if refundAmount == 0 {
+			return nil
+		}
+		credit, err := creditWallet(ctx, q, IssueWalletCreditTxParams{
+			UserID:      customer.UserID,
+			Kind:        entities.WalletRefund,
+			Amount:      refundAmount,
+			BookingID:   arg.BookingID,
+			RefundID:    refund.ID,
+			Description: "Refund of booking " + result.Booking.Pnr,
+			ExpiresAt:   arg.CancelledAt.Add(arg.WalletCreditTTL),
+		})
+		if err != nil {
+			return err
+		}
+		result.WalletCredit = &credit
+
+		return nil
+	})
+
+	return result, err
+}
+
+// ticketFareFamily returns the fare family of ticket; the general refund rules apply
+// when the family is no longer in the catalog.
+func ticketFareFamily(fareFamilies entities.FareFamilyCatalog, ticket Ticket) entities.FareFamily {
+	family, ok := fareFamilies.Find(entities.FlightClass(ticket.FlightClass), entities.FareFamilyCode(ticket.FareFamily))
+	if !ok {
+		family = entities.FareFamily{FlightClass: entities.FlightClass(ticket.FlightClass), Refundable: true}
+	}
+	return family
+}
+
+// departureTimeLookup returns a function giving the departure time of a flight, loading
+// each flight once.
+func departureTimeLookup(ctx context.Context, q *Queries) func(flightID int64) (time.Time, error) {
+	departureTimes := make(map[int64]time.Time)
+	return func(flightID int64) (time.Time, error) {
+		departureTime, ok := departureTimes[flightID]
+		if !ok {
+			flight, err := q.GetFlight(ctx, flightID)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("failed to get flight %d: %w", flightID, err)
+			}
+			departureTime = flight.DepartureTime
+			departureTimes[flightID] = departureTime
+		}
+		return departureTime, nil
+	}
+}
+
+// cancelUnusedAncillaries cancels the active and unpaid ancillaries of the booking bought for
+// tickets. It returns what was paid for them and how much of it is refundable under the rules
+// of each ticket's fare family. Unpaid ancillaries are not refunded; if their payment arrives
+// later it is refunded when it is recorded.
+func cancelUnusedAncillaries(ctx context.Context, q *Queries, bookingID int64, tickets map[int64]Ticket, departureTimeOf func(flightID int64) (time.Time, error), policy entities.RefundPolicy, fareFamilies entities.FareFamilyCatalog, cancelledAt time.Time) (paid int64, refundable int64, err error) {
+	ancillaries, err := q.ListTicketAncillariesByBookingID(ctx, bookingID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list booking ancillaries: %w", err)
+	}
+	for _, ancillary := range ancillaries {
+		ticket, ok := tickets[ancillary.TicketID]
+		if !ok {
+			continue
+		}
+		if ancillary.Status != string(entities.AncillaryStatusActive) && ancillary.Status != string(entities.AncillaryStatusPendingPayment) {
+			continue
+		}
+		if _, err := q.CancelTicketAncillary(ctx, CancelTicketAncillaryParams{ID: ancillary.ID, BookingID: bookingID}); err != nil {
+			return 0, 0, fmt.Errorf("failed to cancel ancillary %d: %w", ancillary.ID, err)
+		}
+		if ancillary.Status != string(entities.AncillaryStatusActive) {
+			continue
+		}
+		departureTime, err := departureTimeOf(ticket.FlightID)
+		if err != nil {
+			return 0, 0, err
+		}
+		paid += ancillary.Amount
+		refundable += ticketFareFamily(fareFamilies, ticket).AncillaryRefundAmount(policy, entities.AncillaryType(ancillary.AncillaryType), ancillary.Amount, departureTime, cancelledAt)
+	}
+	return paid, refundable, nil
+}
+
+// activeBookingValue returns what the active tickets and paid add-ons of a booking are worth.
+func activeBookingValue(ctx context.Context, q *Queries, bookingID int64) (int64, error) {
+	tickets, err := q.ListTicketsByBookingID(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list booking tickets: %w", err)
+	}
+	var value int64
+	for _, ticket := range tickets {
+		if ticket.Status == TicketStatusActive {
+			value += int64(ticket.Price)
+		}
+	}
+	ancillaries, err := q.ListTicketAncillariesByBookingID(ctx, bookingID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list booking ancillaries: %w", err)
+	}
+	for _, ancillary := range ancillaries {
+		if ancillary.Status == string(entities.AncillaryStatusActive) {
+			value += ancillary.Amount
+		}
+	}
+	return value, nil
+}
@@ -2,14 +2,21 @@ package db
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 )
 
 // CancelTicketTxParams chứa thông tin cần thiết để hủy vé
 type CancelTicketTxParams struct {
 	TicketID int64 `json:"ticket_id"`
+	// RefundPolicy, FareFamilies và CancelledAt quyết định phần điểm thưởng và tiền ví được trả lại
+	RefundPolicy entities.RefundPolicy      `json:"-"`
+	FareFamilies entities.FareFamilyCatalog `json:"-"`
+	CancelledAt  time.Time                  `json:"-"`
 }
 
 // CancelTicketTxResult chứa kết quả của transaction hủy vé
@@ -18,9 +25,17 @@ type CancelTicketTxResult struct {
 	UpdatedSeatID   int64            `json:"updated_seat_id"`
 	Success         bool             `json:"success"`
 	TransactionTime time.Time        `json:"transaction_time"`
+	// InfantTicketIDs là vé của các em bé ngồi cùng hành khách, bị huỷ theo
+	InfantTicketIDs []int64 `json:"infant_ticket_ids"`
+	// Refund là nil nếu booking chưa được thanh toán (chưa confirmed)
+	Refund *Refund `json:"refund"`
 }
 
-// CancelTicketTx thực hiện transaction hủy vé và cập nhật trạng thái ghế
+// CancelTicketTx thực hiện transaction hủy vé và cập nhật trạng thái ghế, huỷ luôn vé của
+// các em bé ngồi cùng hành khách cùng các dịch vụ bổ trợ chưa dùng của những vé này. Phần
+// điểm thưởng và tiền ví đã trả cho booking tương ứng với số tiền được hoàn của các vé bị huỷ
+// được trả lại cho khách; khi booking đã thanh toán, phần còn lại được ghi nhận thành một
+// khoản hoàn tiền chờ xử lý về phương thức thanh toán ban đầu, như khi huỷ cả booking
 func (store *SQLStore) CancelTicketTx(ctx context.Context, arg CancelTicketTxParams) (CancelTicketTxResult, error) {
 	var result CancelTicketTxResult
 
@@ -30,42 +45,81 @@ func (store *SQLStore) CancelTicketTx(ctx context.Context, arg CancelTicketTxPar
 		// 1. Kiểm tra xem vé có tồn tại không
 		ticket, err := q.GetTicketByID(ctx, arg.TicketID)
 		if err != nil {
-			if err == sql.ErrNoRows {
-				return fmt.Errorf("ticket with ID %d not found", arg.TicketID)
+			if errors.Is(err, ErrRecordNotFound) {
+				return fmt.Errorf("ticket with ID %d not found: %w", arg.TicketID, err)
 			}
 			return err
 		}
 
-		// 2. Kiểm tra xem vé có thể hủy được không
-		if ticket.Status != "Booked" {
-			return fmt.Errorf("ticket with ID %d cannot be cancelled due to its current status: %s", arg.TicketID, ticket.Status)
+		// Khoá booking và tính giá trị còn hiệu lực trước khi huỷ, cùng thứ tự khoá với khi huỷ booking
+		var booking Booking
+		var value int64
+		if ticket.BookingID.Valid {
+			booking, err = q.GetBookingForUpdate(ctx, ticket.BookingID.Int64)
+			if err != nil {
+				return fmt.Errorf("failed to lock booking: %w", err)
+			}
+			value, err = activeBookingValue(ctx, q, booking.BookingID)
+			if err != nil {
+				return err
+			}
 		}
 
-		// 3. Cập nhật trạng thái vé
-		_, err = q.UpdateTicketStatus(ctx, UpdateTicketStatusParams{
-			TicketID: arg.TicketID,
-			Status:   "cancelled",
-		})
-		if err != nil {
-			return fmt.Errorf("failed to update ticket status: %w", err)
+		// 2-4. Kiểm tra trạng thái, huỷ vé và trả ghế
+		if err := cancelTicketAndReleaseSeat(ctx, q, ticket.TicketID, ticket.Status); err != nil {
+			return err
 		}
 
-		// 4. Cập nhật trạng thái ghế thành có sẵn
-		err = q.UpdateSeatAvailability(ctx, UpdateSeatAvailabilityParams{
-			TicketID:    ticket.TicketID,
-			IsAvailable: true,
-		})
+		// 5. Em bé không thể bay khi người lớn đi kèm đã huỷ vé nên được huỷ theo
+		infants, err := q.ListInfantTicketsByAccompanyingTicketID(ctx, pgtype.Int8{Int64: ticket.TicketID, Valid: true})
 		if err != nil {
-			return fmt.Errorf("failed to update seat availability: %w", err)
+			return fmt.Errorf("failed to list accompanied infant tickets: %w", err)
+		}
+		for _, infant := range infants {
+			if err := cancelTicketAndReleaseSeat(ctx, q, infant.TicketID, infant.Status); err != nil {
+				return fmt.Errorf("failed to cancel infant ticket %d: %w", infant.TicketID, err)
+			}
+			result.InfantTicketIDs = append(result.InfantTicketIDs, infant.TicketID)
 		}
 
-		// 5. Lấy thông tin vé đã cập nhật đầy đủ cho kết quả
+		// 5b. Huỷ dịch vụ bổ trợ của các vé vừa huỷ, trả lại phần điểm thưởng và tiền ví ứng với
+		// số tiền được hoàn và ghi nhận phần còn lại thành khoản hoàn tiền
+		if ticket.BookingID.Valid {
+			cancelledIDs := append([]int64{ticket.TicketID}, result.InfantTicketIDs...)
+			refundable, err := cancelledTicketsRefundable(ctx, q, booking, cancelledIDs, arg)
+			if err != nil {
+				return err
+			}
+			redeemedAmount, err := restoreLoyaltyRedemptions(ctx, q, booking.BookingID, refundable, value, "Ticket cancelled")
+			if err != nil {
+				return err
+			}
+			walletAmount, err := restoreWalletPayments(ctx, q, booking.BookingID, refundable, value, "Ticket cancelled")
+			if err != nil {
+				return err
+			}
+			if booking.Status == BookingStatusConfirmed {
+				refund, err := q.CreateRefund(ctx, CreateRefundParams{
+					BookingID: booking.BookingID,
+					Amount:    max(refundable-redeemedAmount-walletAmount, 0),
+					Status:    string(entities.RefundStatusPending),
+					Reason:    "Ticket cancelled",
+					Method:    string(entities.RefundMethodOriginal),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create refund: %w", err)
+				}
+				result.Refund = &refund
+			}
+		}
+
+		// 6. Lấy thông tin vé đã cập nhật đầy đủ cho kết quả
 		updatedTicketDetails, err := q.GetTicketByID(ctx, arg.TicketID)
 		if err != nil {
 			return fmt.Errorf("failed to get updated ticket details: %w", err)
 		}
 
-		// 6. Cập nhật kết quả
+		// 7. Cập nhật kết quả
 		result.Ticket = updatedTicketDetails
 		result.Success = true
 		result.TransactionTime = time.Now()
@@ -75,3 +129,78 @@ func (store *SQLStore) CancelTicketTx(ctx context.Context, arg CancelTicketTxPar
 
 	return result, err
 }
+
+// cancelledTicketsRefundable cancels the unused ancillaries of the cancelled tickets of booking
+// and returns how much of their fares and ancillaries is refundable. A booking that was not
+// paid yet gets them back in full.
+func cancelledTicketsRefundable(ctx context.Context, q *Queries, booking Booking, ticketIDs []int64, arg CancelTicketTxParams) (int64, error) {
+	tickets, err := q.ListTicketsByBookingID(ctx, pgtype.Int8{Int64: booking.BookingID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list booking tickets: %w", err)
+	}
+	wanted := make(map[int64]bool, len(ticketIDs))
+	for _, ticketID := range ticketIDs {
+		wanted[ticketID] = true
+	}
+
+	paid := booking.Status == BookingStatusConfirmed
+	departureTimeOf := departureTimeLookup(ctx, q)
+	cancelled := make(map[int64]Ticket, len(ticketIDs))
+	var refundable int64
+	for _, ticket := range tickets {
+		if !wanted[ticket.TicketID] {
+			continue
+		}
+		cancelled[ticket.TicketID] = ticket
+		if !paid {
+			refundable += int64(ticket.Price)
+			continue
+		}
+		departureTime, err := departureTimeOf(ticket.FlightID)
+		if err != nil {
+			return 0, err
+		}
+		refundable += ticketFareFamily(arg.FareFamilies, ticket).RefundAmount(arg.RefundPolicy, int64(ticket.Price), departureTime, arg.CancelledAt)
+	}
+
+	ancillaryPaid, ancillaryRefund, err := cancelUnusedAncillaries(ctx, q, booking.BookingID, cancelled, departureTimeOf, arg.RefundPolicy, arg.FareFamilies, arg.CancelledAt)
+	if err != nil {
+		return 0, err
+	}
+	if !paid {
+		return refundable + ancillaryPaid, nil
+	}
+	return refundable + ancillaryRefund, nil
+}
+
+// cancelTicketAndReleaseSeat validates the ticket transition, cancels the ticket, releases its
+// seat, drops its check-in and takes back the loyalty points it earned.
+func cancelTicketAndReleaseSeat(ctx context.Context, q *Queries, ticketID int64, status TicketStatus) error {
+	if err := entities.TicketStatus(status).ValidateTransition(entities.TicketStatusCancelled); err != nil {
+		return err
+	}
+
+	_, err := q.UpdateTicketStatus(ctx, UpdateTicketStatusParams{
+		TicketID: ticketID,
+		Status:   TicketStatusCancelled,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update ticket status: %w", err)
+	}
+
+	err = q.UpdateSeatAvailability(ctx, UpdateSeatAvailabilityParams{
+		TicketID:    ticketID,
+		IsAvailable: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update seat availability: %w", err)
+	}
+
+	// Vé đã huỷ không còn được làm thủ tục
+	if err := q.DeleteTicketCheckInsByTicketID(ctx, ticketID); err != nil {
+		return fmt.Errorf("failed to delete check-in: %w", err)
+	}
+
+	// Thu hồi điểm thưởng nếu vé đã được cộng điểm
+	return reverseLoyaltyAccrual(ctx, q, ticketID)
+}
@@ -0,0 +1,385 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// ChangeFlightTxParams chứa thông tin cần thiết để chuyển các vé của một chặng sang chuyến bay khác
+type ChangeFlightTxParams struct {
+	BookingID    int64
+	FromFlightID int64
+	ToFlightID   int64
+	// TicketFares là giá mới của từng vé được chuyển, theo ticket ID
+	TicketFares map[int64]int64
+	// TicketFareItems là chi tiết giá mới của từng vé, theo ticket ID
+	TicketFareItems map[int64][]FareItemData
+	AmountDue       int64
+	RefundAmount    int64
+	// Actor là người yêu cầu đổi chuyến, được lưu cùng yêu cầu
+	Actor  string
+	Reason string
+}
+
+// ChangeFlightTxResult chứa yêu cầu đổi chuyến, booking và các vé sau khi đổi chuyến
+type ChangeFlightTxResult struct {
+	FlightChange FlightChange
+	Booking      Booking
+	Tickets      []Ticket
+	// Refund là nil nếu không phát sinh tiền hoàn
+	Refund *Refund
+}
+
+// ChangeFlightTx moves every active ticket of a booking segment to another flight,
+// keeping the booking, the passengers and the e-ticket numbers, and records the change
+// as completed. Only changes with nothing to pay go through here; the others are
+// requested with RequestFlightChangeTx and applied once paid.
+func (store *SQLStore) ChangeFlightTx(ctx context.Context, arg ChangeFlightTxParams) (ChangeFlightTxResult, error) {
+	var result ChangeFlightTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = changeFlight(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		result.FlightChange, err = recordFlightChange(ctx, q, arg, entities.FlightChangeStatusCompleted)
+		return err
+	})
+
+	return result, err
+}
+
+// RequestFlightChangeTxParams chứa yêu cầu đổi chuyến và payment intent thu tiền chênh lệch
+type RequestFlightChangeTxParams struct {
+	ChangeFlightTxParams
+	Payment CreatePaymentParams
+}
+
+// RequestFlightChangeTxResult chứa yêu cầu đổi chuyến đang chờ thanh toán
+type RequestFlightChangeTxResult struct {
+	FlightChange FlightChange
+	Payment      Payment
+}
+
+// RequestFlightChangeTx records a flight change that waits for its amount due, together
+// with the payment collecting it. The tickets stay on their flight until
+// CompleteFlightChangeTx runs for the captured payment.
+func (store *SQLStore) RequestFlightChangeTx(ctx context.Context, arg RequestFlightChangeTxParams) (RequestFlightChangeTxResult, error) {
+	var result RequestFlightChangeTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Booking phải còn được xác nhận khi tạo yêu cầu
+		booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		if booking.Status != BookingStatusConfirmed {
+			return ErrFlightChangeConflict
+		}
+
+		// 2. Lưu yêu cầu đổi chuyến cùng báo giá
+		result.FlightChange, err = recordFlightChange(ctx, q, arg.ChangeFlightTxParams, entities.FlightChangeStatusPending)
+		if err != nil {
+			return err
+		}
+
+		// 3. Ghi payment thu tiền chênh lệch, trỏ về yêu cầu đổi chuyến
+		payment := arg.Payment
+		payment.BookingID = pgtype.Int8{Int64: arg.BookingID, Valid: true}
+		payment.Purpose = string(entities.PaymentPurposeFlightChange)
+		payment.ReferenceID = result.FlightChange.ID
+		result.Payment, err = q.CreatePayment(ctx, payment)
+		if err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// CompleteFlightChangeTxResult chứa payment đã thu và kết quả đổi chuyến
+type CompleteFlightChangeTxResult struct {
+	Payment Payment
+	ChangeFlightTxResult
+}
+
+// CompleteFlightChangeTx captures the payment of a pending flight change and moves the
+// tickets as quoted. When the booking no longer matches the quote the change fails and
+// what was captured is recorded as a refund instead.
+func (store *SQLStore) CompleteFlightChangeTx(ctx context.Context, arg SettlePaymentParams) (CompleteFlightChangeTxResult, error) {
+	var result CompleteFlightChangeTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Ghi nhận tiền đã thu; webhook gửi lại lần hai dừng ở đây
+		var err error
+		result.Payment, err = settlePayment(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		// 2. Khoá yêu cầu đổi chuyến của payment
+		change, err := q.GetFlightChangeForUpdate(ctx, result.Payment.ReferenceID)
+		if err != nil {
+			return fmt.Errorf("failed to lock flight change: %w", err)
+		}
+		params, err := flightChangeParams(change)
+		if err != nil {
+			return err
+		}
+
+		// 3. Chuyển vé như báo giá; mọi kiểm tra xung đột chạy trước khi ghi nên có thể tiếp tục transaction
+		changed := change.Status == string(entities.FlightChangeStatusPending)
+		if changed {
+			var changeResult ChangeFlightTxResult
+			changeResult, err = changeFlight(ctx, q, params)
+			if err != nil && !errors.Is(err, ErrFlightChangeConflict) {
+				return err
+			}
+			changed = err == nil
+			result.ChangeFlightTxResult = changeResult
+		}
+
+		status := entities.FlightChangeStatusCompleted
+		if !changed {
+			// 4. Không đổi được thì hoàn lại toàn bộ số tiền đã thu
+			status = entities.FlightChangeStatusFailed
+			refund, err := q.CreateRefund(ctx, CreateRefundParams{
+				BookingID: change.BookingID,
+				Amount:    result.Payment.AmountReceived,
+				Status:    string(entities.RefundStatusPending),
+				Reason:    fmt.Sprintf("flight change %d -> %d could not be applied", change.FromFlightID, change.ToFlightID),
+				Method:    string(entities.RefundMethodOriginal),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create refund: %w", err)
+			}
+			result.Refund = &refund
+		}
+		result.FlightChange, err = q.UpdateFlightChangeStatus(ctx, UpdateFlightChangeStatusParams{
+			ID:          change.ID,
+			Status:      string(status),
+			CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update flight change: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// changeFlight moves the tickets of a booking segment to the new flight. Every check that
+// can fail with ErrFlightChangeConflict runs before the first write.
+func changeFlight(ctx context.Context, q *Queries, arg ChangeFlightTxParams) (ChangeFlightTxResult, error) {
+	var result ChangeFlightTxResult
+
+	// 1. Khoá booking và kiểm tra booking vẫn đúng như lúc báo giá
+	booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+	if err != nil {
+		return result, fmt.Errorf("failed to lock booking: %w", err)
+	}
+	if booking.Status != BookingStatusConfirmed {
+		return result, ErrFlightChangeConflict
+	}
+
+	// 2. Các vé còn hiệu lực của chặng phải khớp với báo giá
+	tickets, err := q.ListTicketsByBookingID(ctx, pgtype.Int8{Int64: arg.BookingID, Valid: true})
+	if err != nil {
+		return result, fmt.Errorf("failed to list booking tickets: %w", err)
+	}
+	var segmentTickets []Ticket
+	for _, ticket := range tickets {
+		if ticket.FlightID == arg.FromFlightID && ticket.Status == TicketStatusActive {
+			segmentTickets = append(segmentTickets, ticket)
+		}
+	}
+	if len(segmentTickets) == 0 || len(segmentTickets) != len(arg.TicketFares) {
+		return result, ErrFlightChangeConflict
+	}
+	for _, ticket := range segmentTickets {
+		if _, ok := arg.TicketFares[ticket.TicketID]; !ok {
+			return result, ErrFlightChangeConflict
+		}
+	}
+
+	// 3. Chặng bị đổi phải thuộc hành trình của booking
+	_, err = q.UpdateBookingSegmentFlight(ctx, UpdateBookingSegmentFlightParams{
+		NewFlightID: arg.ToFlightID,
+		BookingID:   arg.BookingID,
+		OldFlightID: arg.FromFlightID,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return result, ErrFlightChangeConflict
+		}
+		return result, fmt.Errorf("failed to update booking segment: %w", err)
+	}
+
+	// 4. Trả ghế cũ, cấp ghế mới trên chuyến bay mới và cập nhật vé
+	for _, ticket := range segmentTickets {
+		fare := arg.TicketFares[ticket.TicketID]
+
+		// Em bé ngồi cùng người lớn nên không có ghế để trả hay cấp mới
+		var seatID pgtype.Int8
+		if ticket.SeatID.Valid {
+			err = q.UpdateSeatAvailability(ctx, UpdateSeatAvailabilityParams{
+				TicketID:    ticket.TicketID,
+				IsAvailable: true,
+			})
+			if err != nil {
+				return result, fmt.Errorf("failed to release seat: %w", err)
+			}
+
+			seat, err := q.CreateSeat(ctx, CreateSeatParams{
+				IsAvailable: true,
+				Class:       ticket.FlightClass,
+				FlightID:    pgtype.Int8{Int64: arg.ToFlightID, Valid: true},
+			})
+			if err != nil {
+				return result, fmt.Errorf("failed to create seat: %w", err)
+			}
+			seatID = pgtype.Int8{Int64: seat.SeatID, Valid: true}
+		}
+
+		updated, err := q.UpdateTicketFlight(ctx, UpdateTicketFlightParams{
+			TicketID: ticket.TicketID,
+			FlightID: arg.ToFlightID,
+			SeatID:   seatID,
+			Price:    int32(fare),
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to move ticket: %w", err)
+		}
+
+		// Thay chi tiết giá cũ bằng chi tiết giá trên chuyến bay mới
+		if err := q.DeleteTicketFareItems(ctx, ticket.TicketID); err != nil {
+			return result, fmt.Errorf("failed to delete fare items: %w", err)
+		}
+		if _, err := createTicketFareItems(ctx, q, ticket.TicketID, arg.TicketFareItems[ticket.TicketID]); err != nil {
+			return result, err
+		}
+
+		// Dịch vụ đặc biệt đi theo vé sang chuyến bay mới
+		err = q.UpdateTicketSpecialServicesFlight(ctx, UpdateTicketSpecialServicesFlightParams{
+			TicketID: ticket.TicketID,
+			FlightID: arg.ToFlightID,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to move special services: %w", err)
+		}
+
+		// Hành khách làm thủ tục lại trên chuyến bay mới
+		if err := q.DeleteTicketCheckInsByTicketID(ctx, ticket.TicketID); err != nil {
+			return result, fmt.Errorf("failed to delete check-in: %w", err)
+		}
+		result.Tickets = append(result.Tickets, updated)
+	}
+
+	// 5. Giữ departure/return của booking khớp với chặng vừa đổi
+	switch {
+	case booking.DepartureFlightID.Valid && booking.DepartureFlightID.Int64 == arg.FromFlightID:
+		result.Booking, err = q.UpdateBookingDepartureFlight(ctx, UpdateBookingDepartureFlightParams{
+			BookingID:         arg.BookingID,
+			DepartureFlightID: pgtype.Int8{Int64: arg.ToFlightID, Valid: true},
+		})
+	case booking.ReturnFlightID.Valid && booking.ReturnFlightID.Int64 == arg.FromFlightID:
+		result.Booking, err = q.UpdateBookingReturnFlight(ctx, UpdateBookingReturnFlightParams{
+			BookingID:      arg.BookingID,
+			ReturnFlightID: pgtype.Int8{Int64: arg.ToFlightID, Valid: true},
+		})
+	default:
+		result.Booking = booking
+	}
+	if err != nil {
+		return result, fmt.Errorf("failed to update booking flight: %w", err)
+	}
+
+	// 6. Ghi nhận khoản hoàn tiền chênh lệch
+	if arg.RefundAmount > 0 {
+		refund, err := q.CreateRefund(ctx, CreateRefundParams{
+			BookingID: arg.BookingID,
+			Amount:    arg.RefundAmount,
+			Status:    string(entities.RefundStatusPending),
+			Reason:    arg.Reason,
+			Method:    string(entities.RefundMethodOriginal),
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to create refund: %w", err)
+		}
+		result.Refund = &refund
+	}
+
+	return result, nil
+}
+
+// flightChangeTicket là giá mới của một vé trong yêu cầu đổi chuyến, lưu trong flight_changes.tickets
+type flightChangeTicket struct {
+	TicketID  int64
+	Fare      int64
+	FareItems []FareItemData
+}
+
+// recordFlightChange records a flight change with the new fare of every ticket.
+func recordFlightChange(ctx context.Context, q *Queries, arg ChangeFlightTxParams, status entities.FlightChangeStatus) (FlightChange, error) {
+	tickets := make([]flightChangeTicket, 0, len(arg.TicketFares))
+	for ticketID, fare := range arg.TicketFares {
+		tickets = append(tickets, flightChangeTicket{TicketID: ticketID, Fare: fare, FareItems: arg.TicketFareItems[ticketID]})
+	}
+	data, err := json.Marshal(tickets)
+	if err != nil {
+		return FlightChange{}, fmt.Errorf("failed to encode flight change tickets: %w", err)
+	}
+
+	var completedAt pgtype.Timestamptz
+	if status != entities.FlightChangeStatusPending {
+		completedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	}
+	change, err := q.CreateFlightChange(ctx, CreateFlightChangeParams{
+		BookingID:    arg.BookingID,
+		FromFlightID: arg.FromFlightID,
+		ToFlightID:   arg.ToFlightID,
+		Tickets:      data,
+		AmountDue:    arg.AmountDue,
+		RefundAmount: arg.RefundAmount,
+		Actor:        arg.Actor,
+		Status:       string(status),
+		CompletedAt:  completedAt,
+	})
+	if err != nil {
+		return FlightChange{}, fmt.Errorf("failed to create flight change: %w", err)
+	}
+	return change, nil
+}
+
+// flightChangeParams rebuilds the parameters of a recorded flight change.
+func flightChangeParams(change FlightChange) (ChangeFlightTxParams, error) {
+	var tickets []flightChangeTicket
+	if err := json.Unmarshal(change.Tickets, &tickets); err != nil {
+		return ChangeFlightTxParams{}, fmt.Errorf("failed to decode flight change tickets: %w", err)
+	}
+	params := ChangeFlightTxParams{
+		BookingID:       change.BookingID,
+		FromFlightID:    change.FromFlightID,
+		ToFlightID:      change.ToFlightID,
+		TicketFares:     make(map[int64]int64, len(tickets)),
+		TicketFareItems: make(map[int64][]FareItemData, len(tickets)),
+		AmountDue:       change.AmountDue,
+		RefundAmount:    change.RefundAmount,
+		Actor:           change.Actor,
+	}
+	for _, ticket := range tickets {
+		params.TicketFares[ticket.TicketID] = ticket.Fare
+		params.TicketFareItems[ticket.TicketID] = ticket.FareItems
+	}
+	return params, nil
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CheckInTicketsTxParams chứa các vé cần làm thủ tục trong cùng một lần
+type CheckInTicketsTxParams struct {
+	CheckIns []CreateTicketCheckInParams
+}
+
+// CheckInTicketsTx checks in several passengers at once: either all of them are checked
+// in or none is. It returns ErrTicketAlreadyCheckedIn when one of them was checked in by
+// another request meanwhile.
+func (store *SQLStore) CheckInTicketsTx(ctx context.Context, arg CheckInTicketsTxParams) ([]TicketCheckIn, error) {
+	result := []TicketCheckIn{}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		for _, checkIn := range arg.CheckIns {
+			created, err := q.CreateTicketCheckIn(ctx, checkIn)
+			if err != nil {
+				if errors.Is(err, ErrRecordNotFound) {
+					return ErrTicketAlreadyCheckedIn
+				}
+				return fmt.Errorf("failed to check in ticket %d: %w", checkIn.TicketID, err)
+			}
+			result = append(result, created)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// UndoCheckInTxParams chứa các vé cần huỷ làm thủ tục của một booking
+type UndoCheckInTxParams struct {
+	BookingID int64
+	TicketIDs []int64
+}
+
+// UndoCheckInTx removes the check-in of several passengers of a booking at once. It
+// returns ErrTicketNotCheckedIn when one of them is not checked in.
+func (store *SQLStore) UndoCheckInTx(ctx context.Context, arg UndoCheckInTxParams) ([]TicketCheckIn, error) {
+	result := []TicketCheckIn{}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		for _, ticketID := range arg.TicketIDs {
+			deleted, err := q.DeleteTicketCheckIn(ctx, DeleteTicketCheckInParams{
+				TicketID:  ticketID,
+				BookingID: arg.BookingID,
+			})
+			if err != nil {
+				if errors.Is(err, ErrRecordNotFound) {
+					return ErrTicketNotCheckedIn
+				}
+				return fmt.Errorf("failed to undo check-in of ticket %d: %w", ticketID, err)
+			}
+			result = append(result, deleted)
+		}
+		return nil
+	})
+
+	return result, err
+}
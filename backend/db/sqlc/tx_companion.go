@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateCompanionTxParams chứa hồ sơ người đi cùng cần lưu và số hồ sơ tối đa của một khách
+type CreateCompanionTxParams struct {
+	CreateCompanionParams
+	MaxCompanions int64
+}
+
+// CreateCompanionTx saves a companion profile for arg.UserID. The customer is locked while
+// their profiles are counted, so two requests cannot both take the last place. It returns
+// ErrCompanionLimit when the customer already has arg.MaxCompanions profiles.
+func (store *SQLStore) CreateCompanionTx(ctx context.Context, arg CreateCompanionTxParams) (Companion, error) {
+	var result Companion
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá khách hàng để các yêu cầu lưu hồ sơ đồng thời đếm lần lượt
+		if err := q.LockCustomer(ctx, arg.UserID); err != nil {
+			return fmt.Errorf("failed to lock customer: %w", err)
+		}
+
+		// 2. Kiểm tra số hồ sơ đã lưu
+		count, err := q.CountCompanionsByUser(ctx, arg.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to count companions: %w", err)
+		}
+		if count >= arg.MaxCompanions {
+			return ErrCompanionLimit
+		}
+
+		// 3. Lưu hồ sơ mới
+		result, err = q.CreateCompanion(ctx, arg.CreateCompanionParams)
+		if err != nil {
+			return fmt.Errorf("failed to create companion: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ReplaceGroupBookingPassengersTxParams chứa danh sách tên khách mới của đoàn
+type ReplaceGroupBookingPassengersTxParams struct {
+	GroupBookingID int64
+	Passengers     []CreateGroupBookingPassengerParams
+}
+
+// ReplaceGroupBookingPassengersTxResult chứa đoàn và danh sách tên khách sau khi cập nhật
+type ReplaceGroupBookingPassengersTxResult struct {
+	GroupBooking GroupBooking
+	Passengers   []GroupBookingPassenger
+}
+
+// ReplaceGroupBookingPassengersTx replaces the passenger names of a group. The group is
+// locked first so the names cannot land after its block was released or its cutoff passed.
+func (store *SQLStore) ReplaceGroupBookingPassengersTx(ctx context.Context, arg ReplaceGroupBookingPassengersTxParams) (ReplaceGroupBookingPassengersTxResult, error) {
+	var result ReplaceGroupBookingPassengersTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá đoàn và kiểm tra đoàn vẫn còn giữ chỗ trước hạn chốt tên
+		group, err := q.GetGroupBookingForUpdate(ctx, arg.GroupBookingID)
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return fmt.Errorf("group booking with ID %d not found: %w", arg.GroupBookingID, err)
+			}
+			return err
+		}
+		if (group.Status != "quoted" && group.Status != "deposit_paid") || !group.NameCutoff.Time.After(time.Now()) {
+			return ErrGroupNamesClosed
+		}
+
+		// 2. Thay toàn bộ danh sách tên khách
+		if err := q.DeleteGroupBookingPassengers(ctx, group.ID); err != nil {
+			return fmt.Errorf("failed to delete group passengers: %w", err)
+		}
+		result.Passengers = make([]GroupBookingPassenger, 0, len(arg.Passengers))
+		for _, passenger := range arg.Passengers {
+			passenger.GroupBookingID = group.ID
+			created, err := q.CreateGroupBookingPassenger(ctx, passenger)
+			if err != nil {
+				return fmt.Errorf("failed to create group passenger: %w", err)
+			}
+			result.Passengers = append(result.Passengers, created)
+		}
+		result.GroupBooking = group
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// RequestGroupDepositTxParams chứa đoàn cần đặt cọc và payment intent thu tiền cọc
+type RequestGroupDepositTxParams struct {
+	GroupBookingID int64
+	Payment        CreatePaymentParams
+}
+
+// RequestGroupDepositTxResult chứa đoàn và payment đang chờ thu tiền cọc
+type RequestGroupDepositTxResult struct {
+	GroupBooking GroupBooking
+	Payment      Payment
+}
+
+// RequestGroupDepositTx records the payment collecting the deposit of a quoted group. The
+// group only counts as having paid once CompleteGroupDepositTx runs for the captured
+// payment. It returns ErrGroupBookingChanged when the group is no longer quoted or its
+// deposit deadline has passed.
+func (store *SQLStore) RequestGroupDepositTx(ctx context.Context, arg RequestGroupDepositTxParams) (RequestGroupDepositTxResult, error) {
+	var result RequestGroupDepositTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá đoàn; chỉ đoàn đã báo giá và còn hạn cọc mới được đặt cọc
+		var err error
+		result.GroupBooking, err = q.GetGroupBookingForUpdate(ctx, arg.GroupBookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock group booking: %w", err)
+		}
+		if result.GroupBooking.Status != string(entities.GroupBookingStatusQuoted) || !result.GroupBooking.DepositDeadline.Time.After(time.Now()) {
+			return ErrGroupBookingChanged
+		}
+
+		// 2. Ghi payment thu tiền cọc, trỏ về đoàn; đoàn chưa có booking nên payment chưa gắn booking
+		payment := arg.Payment
+		payment.Purpose = string(entities.PaymentPurposeGroupDeposit)
+		payment.ReferenceID = result.GroupBooking.ID
+		result.Payment, err = q.CreatePayment(ctx, payment)
+		if err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// CompleteGroupDepositTxResult chứa payment đã thu và đoàn sau khi ghi nhận tiền cọc
+type CompleteGroupDepositTxResult struct {
+	Payment      Payment
+	GroupBooking GroupBooking
+	// Paid là true nếu chính payment này trả tiền cọc; ngược lại số tiền đã thu phải được hoàn
+	Paid bool
+}
+
+// CompleteGroupDepositTx captures the deposit payment of a group and marks the deposit
+// paid while the group is still quoted, so a payment started before the deadline is
+// honoured even if it arrives just after. A group released meanwhile, or whose deposit
+// another payment already paid, is left as it is and Paid is false.
+func (store *SQLStore) CompleteGroupDepositTx(ctx context.Context, arg SettlePaymentParams) (CompleteGroupDepositTxResult, error) {
+	var result CompleteGroupDepositTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Ghi nhận tiền đã thu; webhook gửi lại lần hai dừng ở đây
+		var err error
+		result.Payment, err = settlePayment(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		// 2. Ghi nhận đã cọc nếu đoàn vẫn đang chờ cọc
+		result.GroupBooking, err = q.PayGroupDeposit(ctx, result.Payment.ReferenceID)
+		if err == nil {
+			result.Paid = true
+			return nil
+		}
+		if !errors.Is(err, ErrRecordNotFound) {
+			return fmt.Errorf("failed to record group deposit: %w", err)
+		}
+		result.GroupBooking, err = q.GetGroupBooking(ctx, result.Payment.ReferenceID)
+		if err != nil {
+			return fmt.Errorf("failed to get group booking: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// IssueGroupBookingTxParams chứa đoàn cần xuất vé và booking được tạo cho đoàn
+type IssueGroupBookingTxParams struct {
+	GroupBookingID int64
+	// Booking là booking của đoàn; các chặng không có Capacity vì chỗ đã được giữ khi báo giá
+	Booking CreateBookingTxParams
+}
+
+// IssueGroupBookingTxResult chứa đoàn đã xuất vé, booking của đoàn và số tiền còn phải trả
+type IssueGroupBookingTxResult struct {
+	GroupBooking GroupBooking
+	CreateBookingTxResult
+	// AmountDue là tổng tiền vé trừ tiền cọc đã thu; booking được xác nhận ngay khi bằng 0
+	AmountDue int64
+}
+
+// IssueGroupBookingTx turns a group whose deposit is paid into a booking with a ticket
+// for every named passenger. The seats blocked for the group become the tickets of the
+// booking, so they are counted once. The deposit payments move to the booking and are
+// deducted from what is left to pay; when nothing is left the booking is confirmed
+// straight away. It returns ErrGroupBookingChanged when the group is no longer waiting
+// to be ticketed.
+func (store *SQLStore) IssueGroupBookingTx(ctx context.Context, arg IssueGroupBookingTxParams) (IssueGroupBookingTxResult, error) {
+	var result IssueGroupBookingTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá đoàn; chỉ đoàn đã cọc mới được xuất vé, và chỉ một lần
+		group, err := q.GetGroupBookingForUpdate(ctx, arg.GroupBookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock group booking: %w", err)
+		}
+		if group.Status != string(entities.GroupBookingStatusDepositPaid) {
+			return ErrGroupBookingChanged
+		}
+
+		// 2. Tạo booking và vé cho từng khách của đoàn
+		var tickets []entities.Ticket
+		result.CreateBookingTxResult, tickets, err = createBookingWithTickets(ctx, q, arg.Booking)
+		if err != nil {
+			return err
+		}
+		bookingID := result.Booking.BookingID
+
+		// 3. Đoàn chuyển sang đã xuất vé nên không còn được tính là chỗ đang giữ
+		result.GroupBooking, err = q.TicketGroupBooking(ctx, TicketGroupBookingParams{
+			ID:        group.ID,
+			BookingID: pgtype.Int8{Int64: bookingID, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to ticket group booking: %w", err)
+		}
+
+		// 4. Tiền cọc đã thu trở thành tiền đã trả của booking
+		err = q.AttachGroupDepositPayments(ctx, AttachGroupDepositPaymentsParams{
+			BookingID:      pgtype.Int8{Int64: bookingID, Valid: true},
+			GroupBookingID: group.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to attach group deposit: %w", err)
+		}
+		deposit, err := capturedRefundable(ctx, q, bookingID)
+		if err != nil {
+			return err
+		}
+		for _, ticket := range tickets {
+			result.AmountDue += ticket.AmountDue()
+		}
+		result.AmountDue = max(result.AmountDue-deposit, 0)
+
+		// 5. Tiền cọc đã trả đủ thì xác nhận booking như khi thanh toán xong
+		if result.AmountDue == 0 {
+			booking, _, err := transitionBookingStatus(ctx, q, UpdateBookingStatusTxParams{
+				BookingID: bookingID,
+				ToStatus:  entities.BookingStatusConfirmed,
+				Actor:     "payment",
+				Reason:    "Paid by the group deposit",
+			})
+			if err != nil {
+				return err
+			}
+			result.Booking.Status = entities.BookingStatus(booking.Status)
+		}
+		return nil
+	})
+
+	return result, err
+}
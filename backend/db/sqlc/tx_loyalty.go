@@ -0,0 +1,402 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// AccrueFlightLoyaltyTxParams chứa chuyến bay vừa hạ cánh và quy tắc cộng điểm
+type AccrueFlightLoyaltyTxParams struct {
+	FlightID int64
+	Rules    entities.LoyaltyRules
+	Now      time.Time
+}
+
+// AccrueFlightLoyaltyTxResult chứa các lô điểm vừa được cộng
+type AccrueFlightLoyaltyTxResult struct {
+	Accruals []LoyaltyTransaction
+}
+
+// AccrueFlightLoyaltyTx credits points to the customers of every confirmed, active ticket
+// of a landed flight that has not earned points yet. A ticket earns on the part of its fare
+// paid in money; the share of the booking paid with points or travel credit earns nothing. Each ticket is credited in its own
+// transaction so one customer's failure does not hold back the others' points.
+func (store *SQLStore) AccrueFlightLoyaltyTx(ctx context.Context, arg AccrueFlightLoyaltyTxParams) (AccrueFlightLoyaltyTxResult, error) {
+	var result AccrueFlightLoyaltyTxResult
+
+	candidates, err := store.ListLoyaltyAccrualCandidates(ctx, arg.FlightID)
+	if err != nil {
+		return result, fmt.Errorf("failed to list tickets to credit: %w", err)
+	}
+
+	expiresAt := pgtype.Timestamptz{Time: arg.Now.Add(arg.Rules.PointsTTL), Valid: true}
+	cashShares := make(map[int64]bookingCashShare)
+	for _, candidate := range candidates {
+		// Chỉ phần giá vé trả bằng tiền mới được cộng điểm
+		share, ok := cashShares[candidate.BookingID.Int64]
+		if !ok {
+			share, err = getBookingCashShare(ctx, store.Queries, candidate.BookingID.Int64)
+			if err != nil {
+				return result, err
+			}
+			cashShares[candidate.BookingID.Int64] = share
+		}
+		price := int64(candidate.Price)
+		points := arg.Rules.EarnedPoints(price - entities.ProRata(price, share.nonCash, share.value))
+		if points == 0 {
+			continue
+		}
+		err := store.execTx(ctx, func(q *Queries) error {
+			accrual, err := q.CreateLoyaltyTransaction(ctx, CreateLoyaltyTransactionParams{
+				UserID:      candidate.UserID,
+				Kind:        string(entities.LoyaltyAccrual),
+				Points:      points,
+				Remaining:   points,
+				TicketID:    pgtype.Int8{Int64: candidate.TicketID, Valid: true},
+				BookingID:   candidate.BookingID,
+				Description: fmt.Sprintf("Flight %d", arg.FlightID),
+				ExpiresAt:   expiresAt,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record points of ticket %d: %w", candidate.TicketID, err)
+			}
+			err = q.AddCustomerLoyaltyPoints(ctx, AddCustomerLoyaltyPointsParams{
+				Points: points,
+				UserID: candidate.UserID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to credit points: %w", err)
+			}
+			result.Accruals = append(result.Accruals, accrual)
+			return nil
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// bookingCashShare là phần booking trả bằng điểm và ví so với giá trị booking
+type bookingCashShare struct {
+	nonCash int64
+	value   int64
+}
+
+// getBookingCashShare returns how much of a booking is paid with points and travel credit,
+// and what its active tickets and add-ons are worth.
+func getBookingCashShare(ctx context.Context, q *Queries, bookingID int64) (bookingCashShare, error) {
+	redeemed, err := q.SumLoyaltyRedeemedByBooking(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return bookingCashShare{}, fmt.Errorf("failed to sum redeemed points: %w", err)
+	}
+	walletPaid, err := q.SumWalletPaidByBooking(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return bookingCashShare{}, fmt.Errorf("failed to sum wallet payments: %w", err)
+	}
+	value, err := activeBookingValue(ctx, q, bookingID)
+	if err != nil {
+		return bookingCashShare{}, err
+	}
+	return bookingCashShare{nonCash: redeemed + walletPaid, value: value}, nil
+}
+
+// RedeemLoyaltyPointsTxParams chứa số điểm khách muốn dùng để thanh toán booking
+type RedeemLoyaltyPointsTxParams struct {
+	UserID     int64
+	BookingID  int64
+	Points     int32
+	PointValue int64
+	// AmountDue là số tiền booking phải trả trước khi trừ điểm
+	AmountDue int64
+	Now       time.Time
+}
+
+// RedeemLoyaltyPointsTxResult chứa giao dịch dùng điểm, số dư còn lại và số tiền còn phải trả
+type RedeemLoyaltyPointsTxResult struct {
+	Transaction LoyaltyTransaction
+	Balance     int32
+	AmountDue   int64
+	// Booking là booking sau khi trả; Confirmed là true nếu điểm trả hết và booking được xác nhận
+	Booking   Booking
+	Confirmed bool
+}
+
+// RedeemLoyaltyPointsTx pays part or all of an unpaid booking with points. The booking and
+// the customer are locked, lapsed points are expired first, and the points are taken from
+// the lots that expire soonest. Points never pay more than what is still due. When they
+// pay what was left, the booking is confirmed in the same transaction.
+func (store *SQLStore) RedeemLoyaltyPointsTx(ctx context.Context, arg RedeemLoyaltyPointsTxParams) (RedeemLoyaltyPointsTxResult, error) {
+	var result RedeemLoyaltyPointsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá booking; chỉ booking chưa thanh toán mới được trừ điểm
+		booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		if booking.Status != BookingStatusPending {
+			return &entities.LoyaltyError{Reason: "points can only pay a booking that is awaiting payment"}
+		}
+
+		// 2. Khoá số dư và cho hết hạn các lô điểm quá hạn
+		balance, err := q.GetCustomerLoyaltyPointsForUpdate(ctx, arg.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to lock loyalty balance: %w", err)
+		}
+		balance, err = expireLoyaltyLots(ctx, q, arg.UserID, balance, arg.Now)
+		if err != nil {
+			return err
+		}
+
+		// 3. Kiểm tra số điểm và số tiền còn phải trả sau các lần dùng điểm trước
+		redeemed, err := q.SumLoyaltyRedeemedByBooking(ctx, pgtype.Int8{Int64: arg.BookingID, Valid: true})
+		if err != nil {
+			return fmt.Errorf("failed to sum redeemed points: %w", err)
+		}
+		rules := entities.LoyaltyRules{PointValue: arg.PointValue}
+		amount, err := rules.CheckRedemption(arg.Points, balance, arg.AmountDue-redeemed)
+		if err != nil {
+			return err
+		}
+
+		// 4. Trừ điểm từ các lô sắp hết hạn trước và ghi sổ
+		expiresAt, err := consumeLoyaltyLots(ctx, q, arg.UserID, arg.Points, 0)
+		if err != nil {
+			return err
+		}
+		result.Transaction, err = q.CreateLoyaltyTransaction(ctx, CreateLoyaltyTransactionParams{
+			UserID:      arg.UserID,
+			Kind:        string(entities.LoyaltyRedemption),
+			Points:      -arg.Points,
+			Amount:      amount,
+			BookingID:   pgtype.Int8{Int64: arg.BookingID, Valid: true},
+			Description: "Booking " + booking.Pnr,
+			ExpiresAt:   expiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record redemption: %w", err)
+		}
+		err = q.AddCustomerLoyaltyPoints(ctx, AddCustomerLoyaltyPointsParams{
+			Points: -arg.Points,
+			UserID: arg.UserID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to debit points: %w", err)
+		}
+
+		result.Balance = balance - arg.Points
+		result.AmountDue = arg.AmountDue - redeemed - amount
+		result.Booking = booking
+		if result.AmountDue > 0 {
+			return nil
+		}
+
+		// 5. Điểm đã trả hết nên booking được xác nhận như khi thanh toán thẻ thành công
+		result.Booking, _, err = transitionBookingStatus(ctx, q, UpdateBookingStatusTxParams{
+			BookingID: arg.BookingID,
+			ToStatus:  entities.BookingStatusConfirmed,
+			Actor:     "loyalty",
+			Reason:    "Paid with loyalty points",
+		})
+		if err != nil {
+			return err
+		}
+		result.Confirmed = true
+		return nil
+	})
+
+	return result, err
+}
+
+// ExpireLoyaltyPointsTx removes the points of a customer whose lots have lapsed.
+func (store *SQLStore) ExpireLoyaltyPointsTx(ctx context.Context, userID int64, now time.Time) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		balance, err := q.GetCustomerLoyaltyPointsForUpdate(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to lock loyalty balance: %w", err)
+		}
+		_, err = expireLoyaltyLots(ctx, q, userID, balance, now)
+		return err
+	})
+}
+
+// expireLoyaltyLots empties the lots of userID that expired by now and returns the new
+// balance. The customer row must already be locked.
+func expireLoyaltyLots(ctx context.Context, q *Queries, userID int64, balance int32, now time.Time) (int32, error) {
+	lots, err := q.ListExpiredLoyaltyLots(ctx, ListExpiredLoyaltyLotsParams{
+		UserID:    userID,
+		ExpiresAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return balance, fmt.Errorf("failed to list expired points: %w", err)
+	}
+
+	var expired int32
+	for _, lot := range lots {
+		if err := q.UpdateLoyaltyLotRemaining(ctx, UpdateLoyaltyLotRemainingParams{ID: lot.ID, Remaining: 0}); err != nil {
+			return balance, fmt.Errorf("failed to expire points: %w", err)
+		}
+		// Số dư có thể đã bị admin chỉnh thấp hơn tổng các lô
+		points := min(lot.Remaining, balance-expired)
+		if points <= 0 {
+			continue
+		}
+		_, err := q.CreateLoyaltyTransaction(ctx, CreateLoyaltyTransactionParams{
+			UserID:      userID,
+			Kind:        string(entities.LoyaltyExpiry),
+			Points:      -points,
+			Description: "Points earned " + lot.CreatedAt.Format("2006-01-02") + " expired",
+		})
+		if err != nil {
+			return balance, fmt.Errorf("failed to record expired points: %w", err)
+		}
+		expired += points
+	}
+
+	if expired == 0 {
+		return balance, nil
+	}
+	err = q.AddCustomerLoyaltyPoints(ctx, AddCustomerLoyaltyPointsParams{Points: -expired, UserID: userID})
+	if err != nil {
+		return balance, fmt.Errorf("failed to debit expired points: %w", err)
+	}
+	return balance - expired, nil
+}
+
+// consumeLoyaltyLots takes points from the open lots of userID, starting with preferredLotID
+// when set and then the lots that expire soonest. It returns the latest expiry of the lots
+// used, which points given back later keep.
+func consumeLoyaltyLots(ctx context.Context, q *Queries, userID int64, points int32, preferredLotID int64) (pgtype.Timestamptz, error) {
+	var latestExpiry pgtype.Timestamptz
+	lots, err := q.ListOpenLoyaltyLots(ctx, userID)
+	if err != nil {
+		return latestExpiry, fmt.Errorf("failed to list open points: %w", err)
+	}
+	for i, lot := range lots {
+		if lot.ID == preferredLotID {
+			copy(lots[1:i+1], lots[:i])
+			lots[0] = lot
+			break
+		}
+	}
+
+	for _, lot := range lots {
+		if points == 0 {
+			break
+		}
+		taken := min(lot.Remaining, points)
+		err := q.UpdateLoyaltyLotRemaining(ctx, UpdateLoyaltyLotRemainingParams{ID: lot.ID, Remaining: lot.Remaining - taken})
+		if err != nil {
+			return latestExpiry, fmt.Errorf("failed to use points: %w", err)
+		}
+		points -= taken
+		if lot.ExpiresAt.Valid && (!latestExpiry.Valid || lot.ExpiresAt.Time.After(latestExpiry.Time)) {
+			latestExpiry = lot.ExpiresAt
+		}
+	}
+	return latestExpiry, nil
+}
+
+// reverseLoyaltyAccrual takes back the points a cancelled ticket earned, as far as the
+// customer still has them.
+func reverseLoyaltyAccrual(ctx context.Context, q *Queries, ticketID int64) error {
+	accrual, err := q.GetLoyaltyAccrualByTicket(ctx, pgtype.Int8{Int64: ticketID, Valid: true})
+	if errors.Is(err, ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get points earned by ticket: %w", err)
+	}
+
+	balance, err := q.GetCustomerLoyaltyPointsForUpdate(ctx, accrual.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to lock loyalty balance: %w", err)
+	}
+	points := min(accrual.Points, balance)
+	if points <= 0 {
+		return nil
+	}
+	if _, err := consumeLoyaltyLots(ctx, q, accrual.UserID, points, accrual.ID); err != nil {
+		return err
+	}
+	_, err = q.CreateLoyaltyTransaction(ctx, CreateLoyaltyTransactionParams{
+		UserID:      accrual.UserID,
+		Kind:        string(entities.LoyaltyReversal),
+		Points:      -points,
+		TicketID:    accrual.TicketID,
+		BookingID:   accrual.BookingID,
+		Description: "Ticket cancelled",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record reversed points: %w", err)
+	}
+	err = q.AddCustomerLoyaltyPoints(ctx, AddCustomerLoyaltyPointsParams{Points: -points, UserID: accrual.UserID})
+	if err != nil {
+		return fmt.Errorf("failed to debit reversed points: %w", err)
+	}
+	return nil
+}
+
+// restoreLoyaltyRedemptions gives back the share of the points that paid a booking which
+// matches refundable out of value, the worth of what was still active, and returns the
+// amount those points had paid. Points come back with the expiry of the latest lot they
+// were taken from; points given back before are not given back twice.
+func restoreLoyaltyRedemptions(ctx context.Context, q *Queries, bookingID int64, refundable int64, value int64, description string) (int64, error) {
+	redemptions, err := q.ListLoyaltyRedemptionsByBooking(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list redeemed points: %w", err)
+	}
+	if len(redemptions) == 0 {
+		return 0, nil
+	}
+
+	// Điểm và số tiền còn đang trả cho booking sau các lần trả lại trước
+	var netPoints, netAmount int64
+	var expiresAt pgtype.Timestamptz
+	for _, redemption := range redemptions {
+		netPoints -= int64(redemption.Points)
+		if redemption.Kind != string(entities.LoyaltyRedemption) {
+			netAmount -= redemption.Amount
+			continue
+		}
+		netAmount += redemption.Amount
+		if redemption.ExpiresAt.Valid {
+			expiresAt = redemption.ExpiresAt
+		}
+	}
+	points := int32(entities.ProRata(netPoints, refundable, value))
+	amount := entities.ProRata(netAmount, refundable, value)
+	if points <= 0 {
+		return 0, nil
+	}
+
+	userID := redemptions[0].UserID
+	if _, err := q.GetCustomerLoyaltyPointsForUpdate(ctx, userID); err != nil {
+		return 0, fmt.Errorf("failed to lock loyalty balance: %w", err)
+	}
+	_, err = q.CreateLoyaltyTransaction(ctx, CreateLoyaltyTransactionParams{
+		UserID:      userID,
+		Kind:        string(entities.LoyaltyRefund),
+		Points:      points,
+		Remaining:   points,
+		Amount:      amount,
+		BookingID:   pgtype.Int8{Int64: bookingID, Valid: true},
+		Description: description,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to record restored points: %w", err)
+	}
+	err = q.AddCustomerLoyaltyPoints(ctx, AddCustomerLoyaltyPointsParams{Points: points, UserID: userID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to credit restored points: %w", err)
+	}
+	return amount, nil
+}
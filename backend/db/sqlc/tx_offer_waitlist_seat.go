@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// OfferWaitlistSeatTxParams chứa thông tin để mời khách tiếp theo trong danh sách chờ
+type OfferWaitlistSeatTxParams struct {
+	FlightID    int64
+	FlightClass FlightClass
+	// Capacity là số ghế của hạng trên chuyến bay
+	Capacity int64
+	// ExpiredEntryID là lượt chờ có lời mời vừa hết hạn, 0 khi ghế được giải phóng do huỷ vé
+	ExpiredEntryID int64
+	// OfferKey định danh ghế trống được mời; task chạy lại với cùng khoá không mời thêm ai
+	OfferKey   string
+	ClaimToken string
+	ExpiresAt  time.Time
+	// PriorityTiers là các hạng thành viên được mời trước những người chờ khác
+	PriorityTiers []string
+}
+
+// OfferWaitlistSeatTxResult chứa lượt chờ được mời, nil khi không còn ai chờ hoặc không còn ghế trống
+type OfferWaitlistSeatTxResult struct {
+	Entry *WaitlistEntry
+}
+
+// OfferWaitlistSeatTx offers a free seat of the cabin to the next waiting customer and
+// holds it for them until the offer expires. When it follows an expired offer, the
+// offer is expired first; an offer that has been booked or cancelled in the meantime
+// means there is nothing left to hand on. Nobody is invited unless the cabin still has
+// a seat that is neither sold, blocked nor held. Running it again with the same
+// arg.OfferKey returns the open offer already made for that key instead of inviting
+// another customer.
+func (store *SQLStore) OfferWaitlistSeatTx(ctx context.Context, arg OfferWaitlistSeatTxParams) (OfferWaitlistSeatTxResult, error) {
+	var result OfferWaitlistSeatTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		offerKey := pgtype.Text{String: arg.OfferKey, Valid: true}
+
+		// 1. Khoá chuyến bay; ghế này đã được mời ở lần chạy trước thì chỉ trả về lời mời đó
+		if err := q.LockFlight(ctx, arg.FlightID); err != nil {
+			return fmt.Errorf("failed to lock flight: %w", err)
+		}
+		offered, err := q.GetWaitlistEntryByOfferKey(ctx, offerKey)
+		if err == nil {
+			if offered.Status == string(entities.WaitlistStatusOffered) {
+				result.Entry = &offered
+			}
+			return nil
+		}
+		if !errors.Is(err, ErrRecordNotFound) {
+			return fmt.Errorf("failed to get waitlist offer: %w", err)
+		}
+
+		// 2. Hết hạn lời mời cũ nếu khách chưa đặt chỗ
+		if arg.ExpiredEntryID != 0 {
+			_, err := q.ExpireWaitlistOffer(ctx, arg.ExpiredEntryID)
+			if errors.Is(err, ErrRecordNotFound) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to expire waitlist offer: %w", err)
+			}
+		}
+
+		// 3. Hạng ghế phải còn ghế trống, sau khi trừ ghế đã bán, giữ cho đoàn và đang mời
+		rows, err := q.CountSoldSeatsByClass(ctx, []int64{arg.FlightID})
+		if err != nil {
+			return fmt.Errorf("failed to count sold seats: %w", err)
+		}
+		var taken int64
+		for _, row := range rows {
+			if row.FlightClass == arg.FlightClass {
+				taken = row.Sold
+			}
+		}
+		if taken >= arg.Capacity {
+			return nil
+		}
+
+		// 4. Chọn khách tiếp theo: hạng được ưu tiên trước, sau đó theo thời điểm đăng ký
+		next, err := q.GetNextWaitlistEntry(ctx, GetNextWaitlistEntryParams{
+			FlightID:      arg.FlightID,
+			FlightClass:   arg.FlightClass,
+			PriorityTiers: arg.PriorityTiers,
+		})
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get next waitlist entry: %w", err)
+		}
+
+		// 5. Gửi lời mời có thời hạn; ghế được giữ cho khách đến khi lời mời hết hạn
+		offered, err = q.OfferWaitlistEntry(ctx, OfferWaitlistEntryParams{
+			ID:             next.ID,
+			ClaimToken:     pgtype.Text{String: arg.ClaimToken, Valid: true},
+			OfferExpiresAt: pgtype.Timestamptz{Time: arg.ExpiresAt, Valid: true},
+			OfferKey:       offerKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to offer waitlist seat: %w", err)
+		}
+		result.Entry = &offered
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// SettlePaymentParams chứa payment intent được cổng thanh toán xác nhận và số tiền đã thu
+type SettlePaymentParams struct {
+	IntentID       string
+	AmountReceived int64
+}
+
+// settlePayment marks the payment of an intent as captured. Every transaction applying
+// what a payment paid for starts with it, so a webhook delivered twice is applied once.
+func settlePayment(ctx context.Context, q *Queries, arg SettlePaymentParams) (Payment, error) {
+	payment, err := q.GetPaymentByIntentIDForUpdate(ctx, arg.IntentID)
+	if err != nil {
+		return Payment{}, fmt.Errorf("failed to lock payment: %w", err)
+	}
+	if payment.Status != string(entities.PaymentStatusPending) {
+		return payment, ErrPaymentSettled
+	}
+
+	payment, err = q.UpdatePaymentStatus(ctx, UpdatePaymentStatusParams{
+		ID:             payment.ID,
+		Status:         string(entities.PaymentStatusSucceeded),
+		AmountReceived: arg.AmountReceived,
+	})
+	if err != nil {
+		return Payment{}, fmt.Errorf("failed to update payment: %w", err)
+	}
+	return payment, nil
+}
+
+// capturedRefundable returns what can still be paid back against the payments captured
+// for a booking.
+func capturedRefundable(ctx context.Context, q *Queries, bookingID int64) (int64, error) {
+	payments, err := q.ListCapturedPaymentsByBookingID(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list captured payments: %w", err)
+	}
+	var refundable int64
+	for _, payment := range payments {
+		refundable += payment.AmountReceived - payment.AmountRefunded
+	}
+	return refundable, nil
+}
+
+// ConfirmBookingPaymentTxResult chứa payment đã thu và booking sau khi xác nhận
+type ConfirmBookingPaymentTxResult struct {
+	Payment Payment
+	Booking Booking
+	// Confirmed là true nếu chính payment này xác nhận booking
+	Confirmed bool
+	// Refund là khoản hoàn khi booking đã bị huỷ hoặc đã được thanh toán trước khi tiền về
+	Refund *Refund
+}
+
+// ConfirmBookingPaymentTx captures the payment of a booking and confirms the booking
+// if it was still pending. A booking cancelled before the money arrived, or already paid
+// through another payment, gets what was captured recorded as a refund.
+func (store *SQLStore) ConfirmBookingPaymentTx(ctx context.Context, arg SettlePaymentParams) (ConfirmBookingPaymentTxResult, error) {
+	var result ConfirmBookingPaymentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Ghi nhận tiền đã thu; webhook gửi lại lần hai dừng ở đây
+		var err error
+		result.Payment, err = settlePayment(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		// 2. Khoá booking của payment
+		result.Booking, err = q.GetBookingForUpdate(ctx, result.Payment.BookingID.Int64)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+
+		switch result.Booking.Status {
+		case BookingStatusPending:
+			// 3. Xác nhận booking như khi admin xác nhận thủ công
+			result.Booking, _, err = transitionBookingStatus(ctx, q, UpdateBookingStatusTxParams{
+				BookingID: result.Booking.BookingID,
+				ToStatus:  entities.BookingStatusConfirmed,
+				Actor:     "payment",
+				Reason:    "Paid through the payment gateway",
+			})
+			if err != nil {
+				return err
+			}
+			result.Confirmed = true
+		default:
+			// 3. Booking đã huỷ hoặc đã được thanh toán bằng payment khác nên hoàn lại số tiền vừa thu
+			reason := "payment received after the booking was cancelled"
+			if result.Booking.Status == BookingStatusConfirmed {
+				reason = "booking had already been paid"
+			}
+			refund, err := q.CreateRefund(ctx, CreateRefundParams{
+				BookingID: result.Booking.BookingID,
+				Amount:    result.Payment.AmountReceived,
+				Status:    string(entities.RefundStatusPending),
+				Reason:    reason,
+				Method:    string(entities.RefundMethodOriginal),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create refund: %w", err)
+			}
+			result.Refund = &refund
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// FailPaymentTx records that the payment of an intent failed and releases what was
+// waiting for it.
+func (store *SQLStore) FailPaymentTx(ctx context.Context, intentID string) (Payment, error) {
+	var result Payment
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá payment; payment đã xử lý thì không đổi nữa
+		payment, err := q.GetPaymentByIntentIDForUpdate(ctx, intentID)
+		if err != nil {
+			return fmt.Errorf("failed to lock payment: %w", err)
+		}
+		if payment.Status != string(entities.PaymentStatusPending) {
+			result = payment
+			return ErrPaymentSettled
+		}
+
+		result, err = q.UpdatePaymentStatus(ctx, UpdatePaymentStatusParams{
+			ID:     payment.ID,
+			Status: string(entities.PaymentStatusFailed),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		// 2. Huỷ thứ đang chờ payment này
+		switch entities.PaymentPurpose(payment.Purpose) {
+		case entities.PaymentPurposeFlightChange:
+			_, err = q.UpdateFlightChangeStatus(ctx, UpdateFlightChangeStatusParams{
+				ID:          payment.ReferenceID,
+				Status:      string(entities.FlightChangeStatusFailed),
+				CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update flight change: %w", err)
+			}
+		case entities.PaymentPurposeSeatSelection:
+			_, err = q.UpdateSeatSelectionStatus(ctx, UpdateSeatSelectionStatusParams{
+				ID:     payment.ReferenceID,
+				Status: string(entities.SeatSelectionStatusFailed),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update seat selection: %w", err)
+			}
+		case entities.PaymentPurposeAncillary:
+			// Dịch vụ chưa được thanh toán thì huỷ; dịch vụ đã bị huỷ trước đó thì bỏ qua
+			_, err = q.CancelTicketAncillary(ctx, CancelTicketAncillaryParams{
+				ID:        payment.ReferenceID,
+				BookingID: payment.BookingID.Int64,
+			})
+			if err != nil && !errors.Is(err, ErrRecordNotFound) {
+				return fmt.Errorf("failed to cancel ticket ancillary: %w", err)
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
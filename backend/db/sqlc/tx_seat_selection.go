@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// SeatSelectionData là ghế được chọn cho một vé cùng phí chọn ghế phải trả
+type SeatSelectionData struct {
+	TicketID int64  `json:"ticket_id"`
+	SeatCode string `json:"seat_code"`
+	Fee      int64  `json:"fee"`
+	// FeeName là tên dòng phí chọn ghế ghi trên vé
+	FeeName string `json:"fee_name"`
+}
+
+// RequestSeatSelectionTxParams chứa các ghế được chọn và payment intent thu phí chọn ghế
+type RequestSeatSelectionTxParams struct {
+	BookingID  int64
+	Selections []SeatSelectionData
+	AmountDue  int64
+	Payment    CreatePaymentParams
+}
+
+// RequestSeatSelectionTxResult chứa yêu cầu chọn ghế đang chờ thanh toán
+type RequestSeatSelectionTxResult struct {
+	SeatSelection SeatSelection
+	Payment       Payment
+}
+
+// RequestSeatSelectionTx records a paid seat selection of a confirmed booking together
+// with the payment collecting its fees. The seats stay as they are until
+// CompleteSeatSelectionTx runs for the captured payment. It returns
+// ErrBookingNotConfirmed when the booking is no longer confirmed.
+func (store *SQLStore) RequestSeatSelectionTx(ctx context.Context, arg RequestSeatSelectionTxParams) (RequestSeatSelectionTxResult, error) {
+	var result RequestSeatSelectionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Booking phải còn được xác nhận khi tạo yêu cầu
+		booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		if booking.Status != BookingStatusConfirmed {
+			return ErrBookingNotConfirmed
+		}
+
+		// 2. Lưu các ghế được chọn cùng phí
+		data, err := json.Marshal(arg.Selections)
+		if err != nil {
+			return fmt.Errorf("failed to encode seat selections: %w", err)
+		}
+		result.SeatSelection, err = q.CreateSeatSelection(ctx, CreateSeatSelectionParams{
+			BookingID:  arg.BookingID,
+			Selections: data,
+			AmountDue:  arg.AmountDue,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create seat selection: %w", err)
+		}
+
+		// 3. Ghi payment thu phí chọn ghế, trỏ về yêu cầu chọn ghế
+		payment := arg.Payment
+		payment.BookingID = pgtype.Int8{Int64: arg.BookingID, Valid: true}
+		payment.Purpose = string(entities.PaymentPurposeSeatSelection)
+		payment.ReferenceID = result.SeatSelection.ID
+		result.Payment, err = q.CreatePayment(ctx, payment)
+		if err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// CompleteSeatSelectionTxResult chứa payment đã thu, yêu cầu chọn ghế và khoản hoàn (nếu có)
+type CompleteSeatSelectionTxResult struct {
+	Payment       Payment
+	SeatSelection SeatSelection
+	// Refund là khoản hoàn khi không đổi được ghế
+	Refund *Refund
+}
+
+// CompleteSeatSelectionTx captures the payment of a pending seat selection, gives the
+// tickets their seats and records the seat fees on them. When the booking is no longer
+// confirmed, a ticket changed or a seat was taken meanwhile, the selection fails and what
+// was captured is recorded as a refund instead.
+func (store *SQLStore) CompleteSeatSelectionTx(ctx context.Context, arg SettlePaymentParams) (CompleteSeatSelectionTxResult, error) {
+	var result CompleteSeatSelectionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Ghi nhận tiền đã thu; webhook gửi lại lần hai dừng ở đây
+		var err error
+		result.Payment, err = settlePayment(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		// 2. Khoá yêu cầu chọn ghế và booking của nó
+		selection, err := q.GetSeatSelectionForUpdate(ctx, result.Payment.ReferenceID)
+		if err != nil {
+			return fmt.Errorf("failed to lock seat selection: %w", err)
+		}
+		var selections []SeatSelectionData
+		if err := json.Unmarshal(selection.Selections, &selections); err != nil {
+			return fmt.Errorf("failed to decode seat selections: %w", err)
+		}
+		booking, err := q.GetBookingForUpdate(ctx, selection.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+
+		// 3. Đổi ghế; mọi kiểm tra xung đột chạy trước khi ghi nên có thể tiếp tục transaction
+		applied := selection.Status == string(entities.SeatSelectionStatusPending) && booking.Status == BookingStatusConfirmed
+		if applied {
+			err = applySeatSelection(ctx, q, selection.BookingID, selections)
+			if err != nil && !errors.Is(err, ErrSeatSelectionConflict) && !errors.Is(err, ErrSeatTaken) {
+				return err
+			}
+			applied = err == nil
+		}
+
+		status := entities.SeatSelectionStatusCompleted
+		if !applied {
+			// 4. Không đổi được thì hoàn lại toàn bộ số tiền đã thu
+			status = entities.SeatSelectionStatusFailed
+			refund, err := q.CreateRefund(ctx, CreateRefundParams{
+				BookingID: selection.BookingID,
+				Amount:    result.Payment.AmountReceived,
+				Status:    string(entities.RefundStatusPending),
+				Reason:    fmt.Sprintf("seat selection %d could not be applied", selection.ID),
+				Method:    string(entities.RefundMethodOriginal),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create refund: %w", err)
+			}
+			result.Refund = &refund
+		}
+		result.SeatSelection, err = q.UpdateSeatSelectionStatus(ctx, UpdateSeatSelectionStatusParams{
+			ID:     selection.ID,
+			Status: string(status),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update seat selection: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
+
+// applySeatSelection gives the tickets of a booking their selected seats and records the
+// seat fees. Every check that can fail with ErrSeatSelectionConflict or ErrSeatTaken runs
+// before the first write.
+func applySeatSelection(ctx context.Context, q *Queries, bookingID int64, selections []SeatSelectionData) error {
+	// 1. Vé phải còn hiệu lực, có ghế, chưa làm thủ tục và ghế mới còn trống
+	checkIns, err := q.ListTicketCheckInsByBookingID(ctx, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to list check-ins: %w", err)
+	}
+	checkedIn := make(map[int64]bool, len(checkIns))
+	for _, checkIn := range checkIns {
+		checkedIn[checkIn.TicketID] = true
+	}
+	type seatKey struct {
+		flightID int64
+		seatCode string
+	}
+	chosen := make(map[seatKey]bool, len(selections))
+	for _, selection := range selections {
+		ticket, err := q.GetTicketByID(ctx, selection.TicketID)
+		if err != nil {
+			return fmt.Errorf("failed to get ticket %d: %w", selection.TicketID, err)
+		}
+		if ticket.BookingID.Int64 != bookingID || ticket.Status != TicketStatusActive || !ticket.SeatID.Valid || checkedIn[ticket.TicketID] {
+			return ErrSeatSelectionConflict
+		}
+		key := seatKey{flightID: ticket.FlightID, seatCode: selection.SeatCode}
+		if chosen[key] {
+			return fmt.Errorf("%w: %s", ErrSeatTaken, selection.SeatCode)
+		}
+		chosen[key] = true
+		if ticket.SeatCode.String == selection.SeatCode {
+			continue
+		}
+		taken, err := q.IsSeatCodeTaken(ctx, IsSeatCodeTakenParams{
+			FlightID: pgtype.Int8{Int64: ticket.FlightID, Valid: true},
+			SeatCode: selection.SeatCode,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check seat: %w", err)
+		}
+		if taken {
+			return fmt.Errorf("%w: %s", ErrSeatTaken, selection.SeatCode)
+		}
+	}
+
+	// 2. Đổi ghế và ghi phí chọn ghế trên vé
+	for _, selection := range selections {
+		if _, err := q.UpdateSeat(ctx, UpdateSeatParams{TicketID: selection.TicketID, SeatCode: selection.SeatCode}); err != nil {
+			return fmt.Errorf("failed to update seat of ticket %d: %w", selection.TicketID, err)
+		}
+		if selection.Fee <= 0 {
+			continue
+		}
+		_, err := q.CreateTicketAncillary(ctx, CreateTicketAncillaryParams{
+			TicketID:      selection.TicketID,
+			BookingID:     bookingID,
+			Code:          "SEAT",
+			AncillaryType: string(entities.AncillaryTypeSeat),
+			Name:          selection.FeeName,
+			Quantity:      1,
+			UnitPrice:     selection.Fee,
+			Amount:        selection.Fee,
+			Status:        string(entities.AncillaryStatusActive),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record seat fee of ticket %d: %w", selection.TicketID, err)
+		}
+	}
+	return nil
+}
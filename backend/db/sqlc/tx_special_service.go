@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AddTicketSpecialServiceTxParams chứa yêu cầu dịch vụ đặc biệt cho một vé
+type AddTicketSpecialServiceTxParams struct {
+	CreateTicketSpecialServiceParams
+	// FlightLimit là số yêu cầu tối đa của mã này trên chuyến bay, 0 là không giới hạn
+	FlightLimit int32
+}
+
+// AddTicketSpecialServiceTx records a special service request for a ticket. The booking
+// is locked while the ticket, its flight and the services the passenger already holds
+// are checked, and the flight row is locked while the requests already made on it are
+// counted, so two bookings cannot both take the last place of a limited service. It
+// returns ErrBookingNotChangeable, ErrTicketNotActive, ErrFlightDeparted,
+// ErrSpecialServiceExists or ErrSpecialServiceFull when the request cannot be taken.
+func (store *SQLStore) AddTicketSpecialServiceTx(ctx context.Context, arg AddTicketSpecialServiceTxParams) (TicketSpecialService, error) {
+	var result TicketSpecialService
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá booking; chỉ booking đang chờ thanh toán hoặc đã xác nhận mới nhận yêu cầu
+		booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		if booking.Status != BookingStatusPending && booking.Status != BookingStatusConfirmed {
+			return ErrBookingNotChangeable
+		}
+
+		// 2. Vé phải còn hiệu lực và vẫn ở trên chuyến bay của yêu cầu
+		tickets, err := q.ListTicketsByBookingID(ctx, pgtype.Int8{Int64: arg.BookingID, Valid: true})
+		if err != nil {
+			return fmt.Errorf("failed to list booking tickets: %w", err)
+		}
+		if !slices.ContainsFunc(tickets, func(ticket Ticket) bool {
+			return ticket.TicketID == arg.TicketID && ticket.Status == TicketStatusActive && ticket.FlightID == arg.FlightID
+		}) {
+			return ErrTicketNotActive
+		}
+
+		// 3. Chuyến bay chưa khởi hành
+		flight, err := q.GetFlight(ctx, arg.FlightID)
+		if err != nil {
+			return fmt.Errorf("failed to get flight: %w", err)
+		}
+		if !flight.DepartureTime.After(time.Now()) {
+			return ErrFlightDeparted
+		}
+
+		// 4. Hành khách giữ tối đa một mã mỗi nhóm dịch vụ
+		held, err := q.ListTicketSpecialServicesByBookingID(ctx, arg.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to list special services: %w", err)
+		}
+		for _, item := range held {
+			if item.TicketID == arg.TicketID && (item.Code == arg.Code || item.Category == arg.Category) {
+				return ErrSpecialServiceExists
+			}
+		}
+
+		// 5. Khoá chuyến bay và kiểm tra số chỗ còn lại của dịch vụ
+		if arg.FlightLimit > 0 {
+			if err := q.LockFlight(ctx, arg.FlightID); err != nil {
+				return fmt.Errorf("failed to lock flight: %w", err)
+			}
+			booked, err := q.CountFlightSpecialServices(ctx, CountFlightSpecialServicesParams{
+				FlightID: arg.FlightID,
+				Code:     arg.Code,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to count special services: %w", err)
+			}
+			if booked >= int64(arg.FlightLimit) {
+				return ErrSpecialServiceFull
+			}
+		}
+
+		// 6. Ghi nhận yêu cầu cho vé
+		result, err = q.CreateTicketSpecialService(ctx, arg.CreateTicketSpecialServiceParams)
+		if err != nil {
+			if IsUniqueViolation(err, SpecialServiceTicketCodeKey) {
+				return ErrSpecialServiceExists
+			}
+			return fmt.Errorf("failed to create special service request: %w", err)
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/pkg/utils"
+)
+
+// AssignTicketNumbersTx gives an e-ticket number with the airline prefix to every ticket
+// issued before ticket numbers existed. It returns how many tickets were numbered; running
+// it again once every ticket has a number does nothing.
+func (store *SQLStore) AssignTicketNumbersTx(ctx context.Context, prefix string) (int, error) {
+	var assigned int
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá các vé chưa có số để hai tiến trình không cấp số trùng nhau
+		ticketIDs, err := q.ListUnnumberedTicketIDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list unnumbered tickets: %w", err)
+		}
+
+		// 2. Cấp số theo thứ tự vé từ cùng sequence với vé mới
+		for _, ticketID := range ticketIDs {
+			serial, err := q.NextTicketSerial(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to allocate ticket serial: %w", err)
+			}
+			ticketNumber, err := utils.FormatTicketNumber(prefix, serial)
+			if err != nil {
+				return err
+			}
+			if err := q.SetTicketNumber(ctx, SetTicketNumberParams{
+				TicketID:     ticketID,
+				TicketNumber: pgtype.Text{String: ticketNumber, Valid: true},
+			}); err != nil {
+				return fmt.Errorf("failed to number ticket %d: %w", ticketID, err)
+			}
+		}
+		assigned = len(ticketIDs)
+		return nil
+	})
+
+	return assigned, err
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// UpdateBookingStatusTxParams chứa thông tin cần thiết để chuyển trạng thái booking
+type UpdateBookingStatusTxParams struct {
+	BookingID int64
+	ToStatus  entities.BookingStatus
+	Actor     string
+	Reason    string
+}
+
+// UpdateBookingStatusTxResult chứa booking sau khi chuyển trạng thái và bản ghi lịch sử tương ứng
+type UpdateBookingStatusTxResult struct {
+	Booking Booking
+	History BookingStatusHistory
+}
+
+// UpdateBookingStatusTx moves a booking to a new status, enforcing the domain
+// transition rules under a row lock and recording the change in booking_status_history.
+func (store *SQLStore) UpdateBookingStatusTx(ctx context.Context, arg UpdateBookingStatusTxParams) (UpdateBookingStatusTxResult, error) {
+	var result UpdateBookingStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Booking, result.History, err = transitionBookingStatus(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// transitionBookingStatus is shared by every transaction that changes a booking status.
+func transitionBookingStatus(ctx context.Context, q *Queries, arg UpdateBookingStatusTxParams) (Booking, BookingStatusHistory, error) {
+	// 1. Khoá booking để tránh cập nhật trạng thái đồng thời
+	booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+	if err != nil {
+		return Booking{}, BookingStatusHistory{}, fmt.Errorf("failed to lock booking: %w", err)
+	}
+
+	// 2. Kiểm tra chuyển trạng thái có hợp lệ không
+	if err := entities.BookingStatus(booking.Status).ValidateTransition(arg.ToStatus); err != nil {
+		return Booking{}, BookingStatusHistory{}, err
+	}
+
+	// 3. Cập nhật trạng thái
+	updated, err := q.UpdateBookingStatus(ctx, UpdateBookingStatusParams{
+		BookingID: arg.BookingID,
+		Status:    BookingStatus(arg.ToStatus),
+	})
+	if err != nil {
+		return Booking{}, BookingStatusHistory{}, fmt.Errorf("failed to update booking status: %w", err)
+	}
+
+	// 4. Ghi lịch sử
+	history, err := q.CreateBookingStatusHistory(ctx, CreateBookingStatusHistoryParams{
+		BookingID:  arg.BookingID,
+		FromStatus: NullBookingStatus{BookingStatus: booking.Status, Valid: true},
+		ToStatus:   BookingStatus(arg.ToStatus),
+		Actor:      arg.Actor,
+		Reason:     arg.Reason,
+	})
+	if err != nil {
+		return Booking{}, BookingStatusHistory{}, fmt.Errorf("failed to record booking status history: %w", err)
+	}
+
+	return updated, history, nil
+}
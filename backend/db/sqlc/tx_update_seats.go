@@ -21,6 +21,10 @@ func (store *SQLStore) UpdateSeats(ctx context.Context, bookingID int64, seats [
 			if err != nil || ticket.BookingID == (pgtype.Int8{Int64: 0, Valid: false}) || ticket.BookingID.Int64 != bookingID {
 				return fmt.Errorf("invalid ticket_id %d for booking_id %d", seat.TicketID, bookingID)
 			}
+			// Em bé ngồi cùng người lớn nên không được chọn ghế
+			if !ticket.SeatID.Valid {
+				return fmt.Errorf("ticket %d has no seat to assign", seat.TicketID)
+			}
 
 			// Kiểm tra ghế có còn trống không
 			isAvailable, err := store.CheckSeatAvailability(ctx, CheckSeatAvailabilityParams{
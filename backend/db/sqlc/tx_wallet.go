@@ -0,0 +1,282 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// PayWithWalletTxParams chứa số tiền khách muốn trả bằng ví cho booking
+type PayWithWalletTxParams struct {
+	UserID    int64
+	BookingID int64
+	Amount    int64
+	// AmountDue là số tiền booking phải trả trước khi dùng ví
+	AmountDue int64
+	Now       time.Time
+}
+
+// PayWithWalletTxResult chứa giao dịch trừ ví, số dư còn lại và số tiền còn phải trả
+type PayWithWalletTxResult struct {
+	Transaction WalletTransaction
+	Balance     int64
+	AmountDue   int64
+	// Booking là booking sau khi trả; Confirmed là true nếu ví trả hết và booking được xác nhận
+	Booking   Booking
+	Confirmed bool
+}
+
+// PayWithWalletTx pays part or all of an unpaid booking with travel credit. The booking
+// and the customer are locked, lapsed credit is expired first, and the amount is taken
+// from the lots that expire soonest. The wallet never pays more than what is still due.
+// When it pays what was left, the booking is confirmed in the same transaction.
+func (store *SQLStore) PayWithWalletTx(ctx context.Context, arg PayWithWalletTxParams) (PayWithWalletTxResult, error) {
+	var result PayWithWalletTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// 1. Khoá booking; chỉ booking chưa thanh toán mới được trả bằng ví
+		booking, err := q.GetBookingForUpdate(ctx, arg.BookingID)
+		if err != nil {
+			return fmt.Errorf("failed to lock booking: %w", err)
+		}
+		if booking.Status != BookingStatusPending {
+			return &entities.WalletError{Reason: "travel credit can only pay a booking that is awaiting payment"}
+		}
+
+		// 2. Khoá số dư và cho hết hạn các lô tín dụng quá hạn
+		balance, err := q.GetCustomerWalletBalanceForUpdate(ctx, arg.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to lock wallet balance: %w", err)
+		}
+		balance, err = expireWalletLots(ctx, q, arg.UserID, balance, arg.Now)
+		if err != nil {
+			return err
+		}
+
+		// 3. Kiểm tra số dư và số tiền còn phải trả sau các lần trả bằng ví trước
+		paid, err := q.SumWalletPaidByBooking(ctx, pgtype.Int8{Int64: arg.BookingID, Valid: true})
+		if err != nil {
+			return fmt.Errorf("failed to sum wallet payments: %w", err)
+		}
+		if err := entities.CheckWalletPayment(arg.Amount, balance, arg.AmountDue-paid); err != nil {
+			return err
+		}
+
+		// 4. Trừ tiền từ các lô sắp hết hạn trước và ghi sổ
+		expiresAt, err := consumeWalletLots(ctx, q, arg.UserID, arg.Amount)
+		if err != nil {
+			return err
+		}
+		result.Transaction, err = q.CreateWalletTransaction(ctx, CreateWalletTransactionParams{
+			UserID:      arg.UserID,
+			Kind:        string(entities.WalletPayment),
+			Amount:      -arg.Amount,
+			BookingID:   pgtype.Int8{Int64: arg.BookingID, Valid: true},
+			Description: "Booking " + booking.Pnr,
+			ExpiresAt:   expiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record wallet payment: %w", err)
+		}
+		err = q.AddCustomerWalletBalance(ctx, AddCustomerWalletBalanceParams{Amount: -arg.Amount, UserID: arg.UserID})
+		if err != nil {
+			return fmt.Errorf("failed to debit wallet: %w", err)
+		}
+
+		result.Balance = balance - arg.Amount
+		result.AmountDue = arg.AmountDue - paid - arg.Amount
+		result.Booking = booking
+		if result.AmountDue > 0 {
+			return nil
+		}
+
+		// 5. Ví đã trả hết nên booking được xác nhận như khi thanh toán thẻ thành công
+		result.Booking, _, err = transitionBookingStatus(ctx, q, UpdateBookingStatusTxParams{
+			BookingID: arg.BookingID,
+			ToStatus:  entities.BookingStatusConfirmed,
+			Actor:     "wallet",
+			Reason:    "Paid with travel credit",
+		})
+		if err != nil {
+			return err
+		}
+		result.Confirmed = true
+		return nil
+	})
+
+	return result, err
+}
+
+// IssueWalletCreditTxParams chứa khoản tín dụng ghi có vào ví của khách hàng
+type IssueWalletCreditTxParams struct {
+	UserID      int64
+	Kind        entities.WalletTransactionKind
+	Amount      int64
+	BookingID   int64
+	RefundID    int64
+	IssuedBy    string
+	Description string
+	ExpiresAt   time.Time
+}
+
+// IssueWalletCreditTx credits a customer's wallet with a new lot of travel credit.
+func (store *SQLStore) IssueWalletCreditTx(ctx context.Context, arg IssueWalletCreditTxParams) (WalletTransaction, error) {
+	var result WalletTransaction
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = creditWallet(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// creditWallet locks the customer, records a lot of arg.Amount that expires at
+// arg.ExpiresAt and adds it to the balance.
+func creditWallet(ctx context.Context, q *Queries, arg IssueWalletCreditTxParams) (WalletTransaction, error) {
+	if _, err := q.GetCustomerWalletBalanceForUpdate(ctx, arg.UserID); err != nil {
+		return WalletTransaction{}, fmt.Errorf("failed to lock wallet balance: %w", err)
+	}
+	credit, err := q.CreateWalletTransaction(ctx, CreateWalletTransactionParams{
+		UserID:      arg.UserID,
+		Kind:        string(arg.Kind),
+		Amount:      arg.Amount,
+		Remaining:   arg.Amount,
+		BookingID:   pgtype.Int8{Int64: arg.BookingID, Valid: arg.BookingID != 0},
+		RefundID:    pgtype.Int8{Int64: arg.RefundID, Valid: arg.RefundID != 0},
+		IssuedBy:    arg.IssuedBy,
+		Description: arg.Description,
+		ExpiresAt:   pgtype.Timestamptz{Time: arg.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return WalletTransaction{}, fmt.Errorf("failed to record wallet credit: %w", err)
+	}
+	err = q.AddCustomerWalletBalance(ctx, AddCustomerWalletBalanceParams{Amount: arg.Amount, UserID: arg.UserID})
+	if err != nil {
+		return WalletTransaction{}, fmt.Errorf("failed to credit wallet: %w", err)
+	}
+	return credit, nil
+}
+
+// expireWalletLots empties the lots of userID that expired by now and returns the new
+// balance. The customer row must already be locked.
+func expireWalletLots(ctx context.Context, q *Queries, userID int64, balance int64, now time.Time) (int64, error) {
+	lots, err := q.ListExpiredWalletLots(ctx, ListExpiredWalletLotsParams{
+		UserID:    userID,
+		ExpiresAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return balance, fmt.Errorf("failed to list expired credit: %w", err)
+	}
+
+	var expired int64
+	for _, lot := range lots {
+		if err := q.UpdateWalletLotRemaining(ctx, UpdateWalletLotRemainingParams{ID: lot.ID, Remaining: 0}); err != nil {
+			return balance, fmt.Errorf("failed to expire credit: %w", err)
+		}
+		amount := min(lot.Remaining, balance-expired)
+		if amount <= 0 {
+			continue
+		}
+		_, err := q.CreateWalletTransaction(ctx, CreateWalletTransactionParams{
+			UserID:      userID,
+			Kind:        string(entities.WalletExpiry),
+			Amount:      -amount,
+			Description: "Credit issued " + lot.CreatedAt.Format("2006-01-02") + " expired",
+		})
+		if err != nil {
+			return balance, fmt.Errorf("failed to record expired credit: %w", err)
+		}
+		expired += amount
+	}
+
+	if expired == 0 {
+		return balance, nil
+	}
+	err = q.AddCustomerWalletBalance(ctx, AddCustomerWalletBalanceParams{Amount: -expired, UserID: userID})
+	if err != nil {
+		return balance, fmt.Errorf("failed to debit expired credit: %w", err)
+	}
+	return balance - expired, nil
+}
+
+// consumeWalletLots takes amount from the open lots of userID, the ones that expire
+// soonest first. It returns the latest expiry of the lots used, which credit given back
+// later keeps.
+func consumeWalletLots(ctx context.Context, q *Queries, userID int64, amount int64) (pgtype.Timestamptz, error) {
+	var latestExpiry pgtype.Timestamptz
+	lots, err := q.ListOpenWalletLots(ctx, userID)
+	if err != nil {
+		return latestExpiry, fmt.Errorf("failed to list open credit: %w", err)
+	}
+
+	for _, lot := range lots {
+		if amount == 0 {
+			break
+		}
+		taken := min(lot.Remaining, amount)
+		err := q.UpdateWalletLotRemaining(ctx, UpdateWalletLotRemainingParams{ID: lot.ID, Remaining: lot.Remaining - taken})
+		if err != nil {
+			return latestExpiry, fmt.Errorf("failed to use credit: %w", err)
+		}
+		amount -= taken
+		if lot.ExpiresAt.Valid && (!latestExpiry.Valid || lot.ExpiresAt.Time.After(latestExpiry.Time)) {
+			latestExpiry = lot.ExpiresAt
+		}
+	}
+	return latestExpiry, nil
+}
+
+// restoreWalletPayments gives back the share of the travel credit that paid a booking
+// which matches refundable out of value, the worth of what was still active, and returns
+// that amount. The credit comes back with the expiry of the latest lot it was taken from;
+// credit given back before is not given back twice.
+func restoreWalletPayments(ctx context.Context, q *Queries, bookingID int64, refundable int64, value int64, description string) (int64, error) {
+	payments, err := q.ListWalletPaymentsByBooking(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list wallet payments: %w", err)
+	}
+	if len(payments) == 0 {
+		return 0, nil
+	}
+
+	// Số tiền ví còn đang trả cho booking sau các lần trả lại trước
+	var paid int64
+	var expiresAt pgtype.Timestamptz
+	for _, payment := range payments {
+		paid -= payment.Amount
+		if payment.Kind == string(entities.WalletPayment) && payment.ExpiresAt.Valid {
+			expiresAt = payment.ExpiresAt
+		}
+	}
+	amount := entities.ProRata(paid, refundable, value)
+	if amount <= 0 {
+		return 0, nil
+	}
+
+	userID := payments[0].UserID
+	if _, err := q.GetCustomerWalletBalanceForUpdate(ctx, userID); err != nil {
+		return 0, fmt.Errorf("failed to lock wallet balance: %w", err)
+	}
+	_, err = q.CreateWalletTransaction(ctx, CreateWalletTransactionParams{
+		UserID:      userID,
+		Kind:        string(entities.WalletRestore),
+		Amount:      amount,
+		Remaining:   amount,
+		BookingID:   pgtype.Int8{Int64: bookingID, Valid: true},
+		Description: description,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to record restored credit: %w", err)
+	}
+	err = q.AddCustomerWalletBalance(ctx, AddCustomerWalletBalanceParams{Amount: amount, UserID: userID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to credit restored credit: %w", err)
+	}
+	return amount, nil
+}
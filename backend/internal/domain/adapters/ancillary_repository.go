@@ -0,0 +1,30 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	ErrAncillaryNotFound = errors.New("ancillary not found")
+	// ErrTicketAncillaryNotFound is returned when a purchased add-on does not exist or is no longer active.
+	ErrTicketAncillaryNotFound = errors.New("ancillary item not found or already cancelled")
+)
+
+type IAncillaryRepository interface {
+	UpsertAncillary(ctx context.Context, ancillary entities.Ancillary) (entities.Ancillary, error)
+	ListAncillaries(ctx context.Context) (entities.AncillaryCatalog, error)
+	DeleteAncillary(ctx context.Context, ancillaryID int64) error
+	// AddTicketAncillary stores an add-on bought for a ticket of a booking not paid yet;
+	// it is paid together with the booking.
+	AddTicketAncillary(ctx context.Context, item entities.TicketAncillary) (entities.TicketAncillary, error)
+	// RequestTicketAncillary stores an add-on bought for a ticket of a confirmed booking as
+	// pending_payment, together with the payment collecting it. It returns
+	// ErrBookingNotChangeable when the booking is no longer confirmed.
+	RequestTicketAncillary(ctx context.Context, item entities.TicketAncillary, payment entities.Payment) (entities.TicketAncillary, error)
+	// ActivateTicketAncillary captures the payment of a pending add-on and activates it.
+	ActivateTicketAncillary(ctx context.Context, event entities.PaymentEvent) (entities.AncillaryPaymentResult, error)
+	CancelTicketAncillary(ctx context.Context, arg entities.CancelAncillaryParams) (entities.CancelAncillaryResult, error)
+}
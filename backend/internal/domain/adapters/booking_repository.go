@@ -10,9 +10,37 @@ import (
 var (
 	ErrInvalidBooking  = errors.New("invalid booking data")
 	ErrBookingNotFound = errors.New("booking not found")
+	// ErrBookingNotChangeable is returned when a booking cannot be moved to another flight.
+	ErrBookingNotChangeable = errors.New("booking cannot be changed")
+	ErrInvalidFlightChange  = errors.New("flight is not a valid alternative for this booking")
+	// ErrBookingNotConfirmed is returned when an operation needs a paid booking.
+	ErrBookingNotConfirmed = errors.New("booking is not confirmed")
+	// ErrPriceMismatch is returned when the total shown to the customer differs from the server price.
+	ErrPriceMismatch = errors.New("booking total does not match the current price")
+	// ErrCancelThroughCancelBooking is returned when a status update tries to cancel a booking;
+	// cancelling also cancels tickets, frees seats and refunds, which only the cancel flow does.
+	ErrCancelThroughCancelBooking = errors.New("bookings are cancelled through the cancel booking flow")
 )
 
 type IBookingRepository interface {
 	CreateBookingTx(ctx context.Context, booking entities.CreateBookingParams) (entities.Booking, []entities.Ticket, []entities.Ticket, error)
 	GetBookingByID(ctx context.Context, bookingID int64) (entities.Booking, []entities.Ticket, []entities.Ticket, error)
+	GetBookingByPNR(ctx context.Context, pnr string) (entities.Booking, []entities.Ticket, []entities.Ticket, error)
+	GetBookingByPNRAndLastName(ctx context.Context, pnr string, lastName string) (entities.Booking, error)
+	// GetBookingIDByPNR returns the ID of the booking with pnr without loading its tickets.
+	GetBookingIDByPNR(ctx context.Context, pnr string) (int64, error)
+	UpdateBookingStatus(ctx context.Context, arg entities.UpdateBookingStatusParams) (entities.Booking, error)
+	CancelBooking(ctx context.Context, arg entities.CancelBookingParams) (entities.CancelBookingResult, error)
+	// ChangeFlight moves the tickets straight away; only changes with nothing to pay use it.
+	ChangeFlight(ctx context.Context, arg entities.ChangeFlightParams) (entities.ChangeFlightResult, error)
+	// RequestFlightChange records a change waiting for payment, which collects its amount due.
+	RequestFlightChange(ctx context.Context, arg entities.ChangeFlightParams, payment entities.Payment) (entities.ChangeFlightResult, error)
+	// CompleteFlightChange captures the payment of the event and moves the tickets of its
+	// pending change, or records a refund of the payment when the change no longer applies.
+	CompleteFlightChange(ctx context.Context, event entities.PaymentEvent) (entities.ChangeFlightResult, error)
+	UpdateRefundStatus(ctx context.Context, refundID int64, status entities.RefundStatus) (entities.Refund, error)
+	// ListCustomerTrips returns the bookings made with email that match filter, with their
+	// tickets, seats and passengers; flights are left for the caller to load.
+	ListCustomerTrips(ctx context.Context, email string, filter entities.TripFilter) ([]entities.Trip, error)
+	CountCustomerTrips(ctx context.Context, email string, filter entities.TripFilter) (int64, error)
 }
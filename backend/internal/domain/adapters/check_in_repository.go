@@ -0,0 +1,22 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	// ErrTicketAlreadyCheckedIn is returned when a passenger was checked in by another request meanwhile.
+	ErrTicketAlreadyCheckedIn = errors.New("ticket is already checked in")
+	// ErrTicketNotCheckedIn is returned when the check-in to undo does not exist in the booking.
+	ErrTicketNotCheckedIn = errors.New("ticket is not checked in")
+)
+
+type ICheckInRepository interface {
+	// CheckInTickets stores the check-ins together; none is stored when one of them fails.
+	CheckInTickets(ctx context.Context, checkIns []entities.TicketCheckIn) ([]entities.TicketCheckIn, error)
+	// UndoCheckIn removes the check-ins of the tickets of the booking together.
+	UndoCheckIn(ctx context.Context, bookingID int64, ticketIDs []int64) ([]entities.TicketCheckIn, error)
+}
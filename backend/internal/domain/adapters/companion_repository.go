@@ -0,0 +1,21 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var ErrCompanionNotFound = errors.New("companion profile not found")
+
+type ICompanionRepository interface {
+	ListCompanions(ctx context.Context, userID int64) ([]entities.Companion, error)
+	// GetCompanionByEmail returns a profile only when it belongs to the customer with email.
+	GetCompanionByEmail(ctx context.Context, companionID int64, email string) (entities.Companion, error)
+	// CreateCompanion saves a profile, or returns *entities.CompanionError when the customer
+	// already has entities.MaxCompanions of them.
+	CreateCompanion(ctx context.Context, companion entities.Companion) (entities.Companion, error)
+	UpdateCompanion(ctx context.Context, companion entities.Companion) (entities.Companion, error)
+	DeleteCompanion(ctx context.Context, companionID int64, userID int64) (entities.Companion, error)
+}
@@ -0,0 +1,11 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IFareFamilyRepository interface {
+	ListFareFamilies(ctx context.Context) (entities.FareFamilyCatalog, error)
+}
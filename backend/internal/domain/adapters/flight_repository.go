@@ -17,9 +17,15 @@ var (
 type IFlightRepository interface {
 	CreateFlight(ctx context.Context, flight entities.Flight) (entities.Flight, error)
 	GetFlightByID(ctx context.Context, flightID int64) (*entities.Flight, error)
+	// ListFlightsByIDs returns the flights among flightIDs that exist, keyed by flight ID.
+	ListFlightsByIDs(ctx context.Context, flightIDs []int64) (map[int64]entities.Flight, error)
 	UpdateFlightTimes(ctx context.Context, flightID int64, departureTime, arrivalTime time.Time) (*entities.Flight, error)
 	GetAllFlights(ctx context.Context) ([]entities.Flight, error)
 	DeleteFlightByID(ctx context.Context, flightID int64) error
 	SearchFlights(ctx context.Context, departureCity, arrivalCity string, flightDate time.Time) ([]entities.Flight, error)
 	ListFlights(ctx context.Context, page int, limit int) ([]entities.Flight, error)
+	ListAlternativeFlights(ctx context.Context, flight entities.Flight, after time.Time) ([]entities.Flight, error)
+	CountSoldSeats(ctx context.Context, flightID int64) (int64, error)
+	// CountSoldSeatsByClass returns the seats sold, blocked or held for a waitlist offer in every cabin of each flight.
+	CountSoldSeatsByClass(ctx context.Context, flightIDs []int64) (map[int64]map[entities.FlightClass]int64, error)
 }
@@ -0,0 +1,31 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	ErrGroupBookingNotFound = errors.New("group booking not found")
+	// ErrGroupBookingConflict is returned when a group changed state while it was being updated.
+	ErrGroupBookingConflict = errors.New("group booking changed, please try again")
+	// ErrNotEnoughSeats is returned when a cabin has fewer seats left than a booking or a group quote needs.
+	ErrNotEnoughSeats = errors.New("not enough seats left on the flight")
+)
+
+type IGroupBookingRepository interface {
+	CreateGroupBooking(ctx context.Context, group entities.GroupBooking) (entities.GroupBooking, error)
+	GetGroupBooking(ctx context.Context, groupBookingID int64) (entities.GroupBooking, error)
+	// ListGroupBookings lists the groups requested by email, or every group when email is empty.
+	ListGroupBookings(ctx context.Context, email string) ([]entities.GroupBooking, error)
+	QuoteGroupBooking(ctx context.Context, groupBookingID int64, quote entities.GroupQuote) (entities.GroupBooking, error)
+	// RequestGroupDeposit records payment as collecting the deposit of a quoted group.
+	RequestGroupDeposit(ctx context.Context, groupBookingID int64, payment entities.Payment) (entities.GroupBooking, error)
+	// CompleteGroupDeposit captures the deposit payment of the event and marks the deposit paid.
+	CompleteGroupDeposit(ctx context.Context, event entities.PaymentEvent) (entities.GroupDepositPaymentResult, error)
+	// IssueGroupBooking creates booking for a group whose deposit is paid and marks the group ticketed.
+	IssueGroupBooking(ctx context.Context, groupBookingID int64, booking entities.CreateBookingParams) (entities.GroupTicketingResult, error)
+	ReplaceGroupPassengers(ctx context.Context, groupBookingID int64, passengers []entities.TicketOwner) ([]entities.TicketOwner, error)
+}
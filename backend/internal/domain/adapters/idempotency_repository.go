@@ -0,0 +1,18 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IIdempotencyRepository interface {
+	// Reserve atomically claims key for a request with the given fingerprint. When the key
+	// was already claimed it returns the stored record and reserved is false.
+	Reserve(ctx context.Context, key string, fingerprint string, ttl time.Duration) (existing *entities.IdempotencyRecord, reserved bool, err error)
+	// Complete stores the response of the request that reserved key.
+	Complete(ctx context.Context, key string, record entities.IdempotencyRecord, ttl time.Duration) error
+	// Release forgets key so that the request can be retried.
+	Release(ctx context.Context, key string) error
+}
@@ -0,0 +1,30 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type ILoyaltyRepository interface {
+	// GetBalance expires lapsed points and returns what the customer has left.
+	GetBalance(ctx context.Context, userID int64, now time.Time) (int32, error)
+	ListTransactions(ctx context.Context, userID int64, limit int32, offset int32) (entities.LoyaltyStatement, error)
+	RedeemPoints(ctx context.Context, arg entities.RedeemLoyaltyParams) (entities.RedeemLoyaltyResult, error)
+	// GetRedeemedAmount returns how much of a booking is paid with points, less what was
+	// given back when tickets were cancelled.
+	GetRedeemedAmount(ctx context.Context, bookingID int64) (int64, error)
+	GetTier(ctx context.Context, userID int64) (entities.LoyaltyTier, error)
+	// GetTierByEmail returns the tier of the customer account with email; guests are members.
+	GetTierByEmail(ctx context.Context, email string) (entities.LoyaltyTier, error)
+	// GetQualifyingPoints sums the points earned on flown tickets since the start of the window.
+	GetQualifyingPoints(ctx context.Context, userID int64, since time.Time) (int32, error)
+}
+
+// ILoyaltyScheduler credits the passengers of a flight with their points once it lands.
+type ILoyaltyScheduler interface {
+	// ScheduleAccrual schedules the points of flight for its arrival time. Scheduling a
+	// flight that already has its accrual scheduled is not an error.
+	ScheduleAccrual(ctx context.Context, flight entities.Flight) error
+}
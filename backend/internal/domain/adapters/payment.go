@@ -1,5 +1,41 @@
 package adapters
 
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	// ErrInvalidPaymentEvent is returned when a webhook payload is not signed by the payment gateway.
+	ErrInvalidPaymentEvent = errors.New("invalid payment event")
+	ErrPaymentNotFound     = errors.New("payment not found")
+	// ErrPaymentSettled is returned when a payment was already captured or failed, e.g.
+	// for a webhook delivered twice.
+	ErrPaymentSettled = errors.New("payment is already settled")
+	// ErrBookingNotPayable is returned when a payment is requested for a booking that is
+	// not waiting for its payment, e.g. one already paid or cancelled.
+	ErrBookingNotPayable = errors.New("booking is not awaiting payment")
+)
+
 type PaymentGateway interface {
-	CreatePaymentIntent(amount int64, currency string, metadata map[string]string) (clientSecret string, err error)
+	CreatePaymentIntent(amount int64, currency string, metadata map[string]string) (entities.PaymentIntent, error)
+	// CreateRefund pays amount back against the captured payment intent intentID.
+	CreateRefund(intentID string, amount int64, metadata map[string]string) (refundID string, err error)
+	// ParseEvent verifies a webhook payload against its signature and returns the payment event it carries.
+	ParseEvent(payload []byte, signature string) (entities.PaymentEvent, error)
+}
+
+type IPaymentRepository interface {
+	CreatePayment(ctx context.Context, payment entities.Payment) (entities.Payment, error)
+	GetPaymentByIntentID(ctx context.Context, intentID string) (entities.Payment, error)
+	// ListCapturedPayments returns the payments of the booking that can still be refunded, oldest first.
+	ListCapturedPayments(ctx context.Context, bookingID int64) ([]entities.Payment, error)
+	// RecordRefund adds amount to what was paid back of the payment.
+	RecordRefund(ctx context.Context, paymentID int64, amount int64) (entities.Payment, error)
+	// ConfirmBookingPayment captures the booking payment of the event and confirms the booking.
+	ConfirmBookingPayment(ctx context.Context, event entities.PaymentEvent) (entities.BookingPaymentResult, error)
+	// FailPayment marks the payment of intentID as failed and releases what was waiting for it.
+	FailPayment(ctx context.Context, intentID string) (entities.Payment, error)
 }
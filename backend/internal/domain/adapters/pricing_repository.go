@@ -0,0 +1,37 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	ErrPricingCurveNotFound = errors.New("pricing curve not found")
+	ErrFareQuoteNotFound    = errors.New("fare quote not found or expired")
+	ErrFareQuoteMismatch    = errors.New("fare quote was issued for another flight")
+)
+
+type IPricingCurveRepository interface {
+	UpsertPricingCurve(ctx context.Context, curve entities.PricingCurve) (entities.PricingCurve, error)
+	ListPricingCurves(ctx context.Context) ([]entities.PricingCurve, error)
+	ListPricingCurvesByRoute(ctx context.Context, departureCity, arrivalCity string) ([]entities.PricingCurve, error)
+	DeletePricingCurve(ctx context.Context, curveID int64) error
+}
+
+type IFareQuoteRepository interface {
+	// SaveFareQuote keeps quote until ttl elapses.
+	SaveFareQuote(ctx context.Context, quote entities.FareQuote, ttl time.Duration) error
+	GetFareQuote(ctx context.Context, quoteID string) (*entities.FareQuote, error)
+	// ReserveFareQuote sets the quote aside for the booking about to be written, so that no
+	// other booking can use the locked fares meanwhile; ErrFareQuoteNotFound is returned when
+	// it is gone or already reserved.
+	ReserveFareQuote(ctx context.Context, quoteID string) error
+	// ReleaseFareQuote makes a reserved quote usable again when its booking was not written.
+	ReleaseFareQuote(ctx context.Context, quoteID string) error
+	// ConsumeFareQuote deletes a reserved quote once its booking is written, so that the
+	// locked fares are sold only once.
+	ConsumeFareQuote(ctx context.Context, quoteID string) error
+}
@@ -0,0 +1,21 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	ErrPromoCodeNotFound = errors.New("promo code not found")
+	// ErrPromoCodeAlreadyExists is returned when a code is created twice.
+	ErrPromoCodeAlreadyExists = errors.New("promo code already exists")
+)
+
+type IPromoCodeRepository interface {
+	CreatePromoCode(ctx context.Context, promo entities.PromoCode) (entities.PromoCode, error)
+	GetPromoCodeByCode(ctx context.Context, code string) (entities.PromoCode, error)
+	ListPromoCodes(ctx context.Context) ([]entities.PromoCode, error)
+	DeactivatePromoCode(ctx context.Context, promoCodeID int64) (entities.PromoCode, error)
+}
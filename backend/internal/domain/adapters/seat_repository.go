@@ -7,7 +7,11 @@ import (
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 )
 
-var ErrSeatNotFound = errors.New("seat not found")
+var (
+	ErrSeatNotFound = errors.New("seat not found")
+	// ErrSeatUnavailable is returned when the seat is already held by another ticket of the flight.
+	ErrSeatUnavailable = errors.New("seat is already taken")
+)
 
 type ISeatRepository interface {
 	GetSeatByID(ctx context.Context, seatID int64) (*entities.Seat, error)
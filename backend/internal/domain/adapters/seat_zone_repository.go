@@ -0,0 +1,18 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var ErrSeatZoneNotFound = errors.New("seat zone not found")
+
+type ISeatZoneRepository interface {
+	CreateSeatZone(ctx context.Context, zone entities.SeatZone) (entities.SeatZone, error)
+	ListSeatZones(ctx context.Context) ([]entities.SeatZone, error)
+	// ListSeatZonesForFlight returns the zones of the flight and of its aircraft type.
+	ListSeatZonesForFlight(ctx context.Context, flight entities.Flight) ([]entities.SeatZone, error)
+	DeleteSeatZone(ctx context.Context, seatZoneID int64) error
+}
@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	// ErrTicketSpecialServiceNotFound is returned when a special service request does not exist in the booking.
+	ErrTicketSpecialServiceNotFound = errors.New("special service request not found")
+	// ErrSpecialServiceFull is returned when the flight already carries as many requests for the code as it allows.
+	ErrSpecialServiceFull = errors.New("special service is fully booked on this flight")
+	// ErrSpecialServiceExists is returned when the passenger already holds the code or another code of its category.
+	ErrSpecialServiceExists = errors.New("special service is already requested for this passenger")
+)
+
+type ISpecialServiceRepository interface {
+	ListSpecialServices(ctx context.Context) (entities.SpecialServiceCatalog, error)
+	// ListFlightSpecialServices returns every special service requested on the flight.
+	ListFlightSpecialServices(ctx context.Context, flightID int64) ([]entities.TicketSpecialService, error)
+	// AddTicketSpecialService stores a request unless the flight already carries flightLimit
+	// requests for the code; flightLimit 0 means unlimited. It returns
+	// ErrBookingNotChangeable, ErrTicketNotFound or a *entities.SpecialServiceError when
+	// the booking, the ticket or the flight changed since they were read.
+	AddTicketSpecialService(ctx context.Context, item entities.TicketSpecialService, flightLimit int32) (entities.TicketSpecialService, error)
+	RemoveTicketSpecialService(ctx context.Context, arg entities.RemoveSpecialServiceParams) (entities.TicketSpecialService, error)
+}
@@ -11,11 +11,20 @@ var (
 	ErrTicketNotFound          = errors.New("ticket not found")
 	ErrTicketCannotBeCancelled = errors.New("ticket cannot be cancelled due to its current status")
 	ErrInvalidSeat             = errors.New("invalid seat data")
+	ErrInvalidTicketNumber     = errors.New("invalid e-ticket number")
 )
 
 type ITicketRepository interface {
 	GetTicketsByFlightID(ctx context.Context, flightID int64) ([]entities.Ticket, error)
 	GetTicketByID(ctx context.Context, ticketID int64) (*entities.Ticket, error)
-	CancelTicket(ctx context.Context, ticketID int64) (*entities.Ticket, error)
+	GetTicketByNumber(ctx context.Context, ticketNumber string) (*entities.Ticket, error)
+	CancelTicket(ctx context.Context, params entities.CancelTicketParams) (entities.CancelTicketResult, error)
 	UpdateSeat(ctx context.Context, ticketID int64, seatCode string) (*entities.Ticket, error)
+	IsSeatTaken(ctx context.Context, flightID int64, seatCode string) (bool, error)
+	// RequestSeatSelection records a paid seat selection of a confirmed booking together
+	// with the payment collecting its fees. It returns ErrBookingNotChangeable when the
+	// booking is no longer confirmed.
+	RequestSeatSelection(ctx context.Context, bookingID int64, selections []entities.SeatSelection, payment entities.Payment) error
+	// CompleteSeatSelection captures the fees of a pending seat selection and gives the seats.
+	CompleteSeatSelection(ctx context.Context, event entities.PaymentEvent) (entities.SeatSelectionPaymentResult, error)
 }
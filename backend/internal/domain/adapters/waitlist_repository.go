@@ -0,0 +1,33 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+var (
+	ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	ErrAlreadyWaitlisted     = errors.New("already on the waitlist for this cabin")
+	// ErrCabinNotFull is returned when joining the waitlist of a cabin that still has seats.
+	ErrCabinNotFull = errors.New("seats are still available in this cabin")
+)
+
+type IWaitlistRepository interface {
+	CreateWaitlistEntry(ctx context.Context, flightID int64, class entities.FlightClass, email string) (entities.WaitlistEntry, error)
+	ListWaitlistEntriesByEmail(ctx context.Context, email string) ([]entities.WaitlistEntry, error)
+	GetWaitlistEntryByClaimToken(ctx context.Context, claimToken string) (entities.WaitlistEntry, error)
+	// ClaimWaitlistOffer marks an open offer as claimed; it returns ErrWaitlistEntryNotFound once the offer is gone.
+	ClaimWaitlistOffer(ctx context.Context, entryID int64) (entities.WaitlistEntry, error)
+	CancelWaitlistEntry(ctx context.Context, entryID int64, email string) (entities.WaitlistEntry, error)
+}
+
+// IWaitlistScheduler hands freed seats on to the customers waitlisted in their cabin.
+type IWaitlistScheduler interface {
+	// OfferTicketSeat offers the seat freed by cancelling ticket. Tickets without a seat,
+	// such as infants on an adult's lap, free nothing.
+	OfferTicketSeat(ctx context.Context, ticket entities.Ticket) error
+	// OfferDeclinedSeat hands on the seat held for entry, whose customer left the waitlist.
+	OfferDeclinedSeat(ctx context.Context, entry entities.WaitlistEntry) error
+}
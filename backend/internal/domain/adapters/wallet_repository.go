@@ -0,0 +1,23 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IWalletRepository interface {
+	// GetBalance returns the credit the customer can still spend at now, leaving out lapsed
+	// credit without writing anything.
+	GetBalance(ctx context.Context, userID int64, now time.Time) (int64, error)
+	ListTransactions(ctx context.Context, userID int64, limit int32, offset int32) (entities.WalletStatement, error)
+	PayBooking(ctx context.Context, arg entities.PayWithWalletParams) (entities.PayWithWalletResult, error)
+	// GetPaidAmount returns how much of a booking is paid from the wallet, less what was
+	// given back when tickets were cancelled.
+	GetPaidAmount(ctx context.Context, bookingID int64) (int64, error)
+	IssueCredit(ctx context.Context, arg entities.IssueWalletCreditParams) (entities.WalletTransaction, error)
+	// ListFlightCustomers returns the customer accounts holding active tickets on a flight,
+	// with how many tickets each of them holds.
+	ListFlightCustomers(ctx context.Context, flightID int64) ([]entities.FlightWalletCredit, error)
+}
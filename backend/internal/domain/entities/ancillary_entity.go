@@ -0,0 +1,232 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type AncillaryType string
+
+const (
+	AncillaryTypeBaggage          AncillaryType = "baggage"
+	AncillaryTypeMeal             AncillaryType = "meal"
+	AncillaryTypePriorityBoarding AncillaryType = "priority_boarding"
+	// AncillaryTypeSeat ghi nhận phí chọn ghế, không bán qua danh mục
+	AncillaryTypeSeat AncillaryType = "seat"
+)
+
+// Valid reports whether t is one of the ancillary types on sale in the catalog.
+func (t AncillaryType) Valid() bool {
+	switch t {
+	case AncillaryTypeBaggage, AncillaryTypeMeal, AncillaryTypePriorityBoarding:
+		return true
+	}
+	return false
+}
+
+// ErrInvalidAncillary is returned when a catalog entry is malformed.
+var ErrInvalidAncillary = errors.New("invalid ancillary")
+
+// Ancillary is an add-on sold on top of a ticket. An empty DepartureCity, ArrivalCity
+// or FlightClass applies the entry to every route or cabin, so one code may have a
+// default price and cheaper or dearer prices on specific routes and cabins.
+type Ancillary struct {
+	AncillaryID   int64         `json:"ancillary_id"`
+	Code          string        `json:"code"`
+	Type          AncillaryType `json:"type"`
+	Name          string        `json:"name"`
+	Description   string        `json:"description"`
+	DepartureCity string        `json:"departure_city"`
+	ArrivalCity   string        `json:"arrival_city"`
+	FlightClass   FlightClass   `json:"flight_class"`
+	Price         int64         `json:"price"`
+	// MaxQuantity là số lượng tối đa một vé được mua
+	MaxQuantity int32     `json:"max_quantity"`
+	Active      bool      `json:"active"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Validate checks that the entry can be sold.
+func (a Ancillary) Validate() error {
+	switch {
+	case a.Code == "" || a.Name == "":
+		return fmt.Errorf("%w: code and name are required", ErrInvalidAncillary)
+	case !a.Type.Valid():
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidAncillary, a.Type)
+	case a.FlightClass != "" && !a.FlightClass.Valid():
+		return fmt.Errorf("%w: unknown cabin class %q", ErrInvalidAncillary, a.FlightClass)
+	case a.Price < 0:
+		return fmt.Errorf("%w: price must not be negative", ErrInvalidAncillary)
+	case a.MaxQuantity <= 0:
+		return fmt.Errorf("%w: max quantity must be positive", ErrInvalidAncillary)
+	}
+	return nil
+}
+
+// Matches reports whether the entry applies to the route and cabin.
+func (a Ancillary) Matches(departureCity, arrivalCity string, class FlightClass) bool {
+	return (a.DepartureCity == "" || a.DepartureCity == departureCity) &&
+		(a.ArrivalCity == "" || a.ArrivalCity == arrivalCity) &&
+		(a.FlightClass == "" || a.FlightClass == class)
+}
+
+// specificity ranks entries of the same code: the route counts more than the cabin.
+func (a Ancillary) specificity() int {
+	score := 0
+	if a.DepartureCity != "" {
+		score += 2
+	}
+	if a.ArrivalCity != "" {
+		score += 2
+	}
+	if a.FlightClass != "" {
+		score++
+	}
+	return score
+}
+
+// AncillaryCatalog lists every add-on entry, active or not.
+type AncillaryCatalog []Ancillary
+
+// Offers returns, for every code on sale on the route and cabin, the most specific
+// active entry, ordered by code.
+func (c AncillaryCatalog) Offers(departureCity, arrivalCity string, class FlightClass) []Ancillary {
+	best := make(map[string]Ancillary)
+	for _, ancillary := range c {
+		if !ancillary.Matches(departureCity, arrivalCity, class) {
+			continue
+		}
+		current, ok := best[ancillary.Code]
+		if !ok || ancillary.specificity() > current.specificity() {
+			best[ancillary.Code] = ancillary
+		}
+	}
+
+	offers := make([]Ancillary, 0, len(best))
+	for _, ancillary := range best {
+		// Mục cụ thể nhất bị tắt thì không bán mã đó trên tuyến này
+		if ancillary.Active {
+			offers = append(offers, ancillary)
+		}
+	}
+	sort.Slice(offers, func(i, j int) bool { return offers[i].Code < offers[j].Code })
+	return offers
+}
+
+// Sell prices quantity units of code for a ticket flying the route in class.
+// It returns an *AncillaryError when the code is not on sale there or the quantity
+// is outside what the entry allows.
+func (c AncillaryCatalog) Sell(code string, quantity int32, departureCity, arrivalCity string, class FlightClass) (TicketAncillary, error) {
+	for _, offer := range c.Offers(departureCity, arrivalCity, class) {
+		if offer.Code != code {
+			continue
+		}
+		if quantity <= 0 || quantity > offer.MaxQuantity {
+			return TicketAncillary{}, &AncillaryError{Code: code, Reason: fmt.Sprintf("quantity must be between 1 and %d", offer.MaxQuantity)}
+		}
+		return TicketAncillary{
+			AncillaryID: offer.AncillaryID,
+			Code:        offer.Code,
+			Type:        offer.Type,
+			Name:        offer.Name,
+			Quantity:    quantity,
+			UnitPrice:   offer.Price,
+			Amount:      offer.Price * int64(quantity),
+			Status:      AncillaryStatusActive,
+		}, nil
+	}
+	return TicketAncillary{}, &AncillaryError{Code: code, Reason: "not available on this flight"}
+}
+
+type AncillaryStatus string
+
+const (
+	AncillaryStatusActive AncillaryStatus = "active"
+	// AncillaryStatusPendingPayment là dịch vụ mua sau khi booking đã thanh toán, chờ webhook xác nhận tiền
+	AncillaryStatusPendingPayment AncillaryStatus = "pending_payment"
+	AncillaryStatusCancelled      AncillaryStatus = "cancelled"
+)
+
+// TicketAncillary is an add-on bought for one ticket, at the price of the day it was bought.
+type TicketAncillary struct {
+	TicketAncillaryID int64           `json:"ticket_ancillary_id"`
+	TicketID          int64           `json:"ticket_id"`
+	BookingID         int64           `json:"booking_id"`
+	AncillaryID       int64           `json:"ancillary_id"`
+	Code              string          `json:"code"`
+	Type              AncillaryType   `json:"type"`
+	Name              string          `json:"name"`
+	Quantity          int32           `json:"quantity"`
+	UnitPrice         int64           `json:"unit_price"`
+	Amount            int64           `json:"amount"`
+	Status            AncillaryStatus `json:"status"`
+	CreatedAt         time.Time       `json:"created_at"`
+	CancelledAt       *time.Time      `json:"cancelled_at,omitempty"`
+}
+
+// CheckCancellable returns an *AncillaryError unless the add-on is still unused:
+// not cancelled yet and its flight has not departed at now. An add-on still waiting
+// for its payment can be cancelled as well.
+func (a TicketAncillary) CheckCancellable(departureTime, now time.Time) error {
+	if a.Status == AncillaryStatusCancelled {
+		return &AncillaryError{Code: a.Code, Reason: "already cancelled"}
+	}
+	if a.Type == AncillaryTypeSeat {
+		return &AncillaryError{Code: a.Code, Reason: "seat fees are not refundable"}
+	}
+	if !departureTime.After(now) {
+		return &AncillaryError{Code: a.Code, Reason: "flight has already departed"}
+	}
+	return nil
+}
+
+// AncillaryError is returned when an add-on cannot be sold or cancelled.
+type AncillaryError struct {
+	Code   string
+	Reason string
+}
+
+func (e *AncillaryError) Error() string {
+	return fmt.Sprintf("ancillary %s: %s", e.Code, e.Reason)
+}
+
+// PurchaseAncillaryParams identifies the add-on a customer buys for a ticket after booking.
+type PurchaseAncillaryParams struct {
+	BookingID      int64
+	TicketID       int64
+	Code           string
+	Quantity       int32
+	RequesterEmail string
+}
+
+// PurchaseAncillaryResult is the add-on bought; PaymentClientSecret is set when the booking
+// had already been paid and the add-on is charged on its own. Such an add-on stays
+// pending_payment until the payment is captured.
+type PurchaseAncillaryResult struct {
+	Ancillary           TicketAncillary
+	PaymentClientSecret string
+}
+
+// CancelAncillaryParams identifies the add-on to cancel.
+type CancelAncillaryParams struct {
+	BookingID         int64
+	TicketAncillaryID int64
+	RequesterEmail    string
+	Reason            string
+}
+
+// CancelAncillaryResult is the cancelled add-on and, when it had been paid, its refund.
+type CancelAncillaryResult struct {
+	Ancillary TicketAncillary
+	Refund    *Refund
+}
+
+// AncillaryPaymentResult is the outcome of capturing the payment of an add-on bought
+// after booking. Refund is set when the add-on or its booking was cancelled before the
+// money arrived.
+type AncillaryPaymentResult struct {
+	Ancillary TicketAncillary
+	Refund    *Refund
+}
@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAncillaries = AncillaryCatalog{
+	{AncillaryID: 1, Code: "BAG20", Type: AncillaryTypeBaggage, Name: "Extra baggage 20kg", Price: 250000, MaxQuantity: 3, Active: true},
+	{AncillaryID: 2, Code: "BAG20", Type: AncillaryTypeBaggage, Name: "Extra baggage 20kg", DepartureCity: "Hanoi", ArrivalCity: "Tokyo", Price: 500000, MaxQuantity: 3, Active: true},
+	{AncillaryID: 3, Code: "MEAL", Type: AncillaryTypeMeal, Name: "Hot meal", Price: 120000, MaxQuantity: 2, Active: true},
+	{AncillaryID: 4, Code: "MEAL", Type: AncillaryTypeMeal, Name: "Hot meal", FlightClass: FlightClassBusiness, Price: 0, MaxQuantity: 2, Active: true},
+	{AncillaryID: 5, Code: "PRIORITY", Type: AncillaryTypePriorityBoarding, Name: "Priority boarding", Price: 80000, MaxQuantity: 1, Active: true},
+	{AncillaryID: 6, Code: "PRIORITY", Type: AncillaryTypePriorityBoarding, Name: "Priority boarding", FlightClass: FlightClassFirstClass, Price: 80000, MaxQuantity: 1, Active: false},
+}
+
+func TestAncillaryCatalogOffers(t *testing.T) {
+	offers := testAncillaries.Offers("Hanoi", "Tokyo", FlightClassEconomy)
+	require.Len(t, offers, 3)
+	assert.Equal(t, int64(2), offers[0].AncillaryID)
+	assert.Equal(t, int64(3), offers[1].AncillaryID)
+
+	offers = testAncillaries.Offers("Hanoi", "Saigon", FlightClassBusiness)
+	require.Len(t, offers, 3)
+	assert.Equal(t, int64(1), offers[0].AncillaryID)
+	assert.Equal(t, int64(4), offers[1].AncillaryID)
+
+	// Mục cụ thể nhất bị tắt thì mã đó không được bán
+	offers = testAncillaries.Offers("Hanoi", "Saigon", FlightClassFirstClass)
+	require.Len(t, offers, 2)
+	assert.Equal(t, "MEAL", offers[1].Code)
+}
+
+func TestAncillaryCatalogSell(t *testing.T) {
+	item, err := testAncillaries.Sell("BAG20", 2, "Hanoi", "Tokyo", FlightClassEconomy)
+	require.NoError(t, err)
+	assert.Equal(t, int64(500000), item.UnitPrice)
+	assert.Equal(t, int64(1000000), item.Amount)
+	assert.Equal(t, AncillaryStatusActive, item.Status)
+
+	var ancillaryErr *AncillaryError
+	_, err = testAncillaries.Sell("BAG20", 4, "Hanoi", "Tokyo", FlightClassEconomy)
+	require.ErrorAs(t, err, &ancillaryErr)
+
+	_, err = testAncillaries.Sell("PRIORITY", 1, "Hanoi", "Tokyo", FlightClassFirstClass)
+	require.ErrorAs(t, err, &ancillaryErr)
+	assert.Equal(t, "PRIORITY", ancillaryErr.Code)
+}
+
+func TestTicketAncillaryCheckCancellable(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	item := TicketAncillary{Code: "MEAL", Status: AncillaryStatusActive}
+
+	assert.NoError(t, item.CheckCancellable(now.Add(time.Hour), now))
+	assert.Error(t, item.CheckCancellable(now.Add(-time.Hour), now))
+
+	// Dịch vụ chưa thanh toán vẫn huỷ được
+	item.Status = AncillaryStatusPendingPayment
+	assert.NoError(t, item.CheckCancellable(now.Add(time.Hour), now))
+
+	item.Status = AncillaryStatusCancelled
+	assert.Error(t, item.CheckCancellable(now.Add(time.Hour), now))
+}
+
+func TestTicketAmountDue(t *testing.T) {
+	ticket := Ticket{
+		Price:  1000,
+		Status: TicketStatusActive,
+		Ancillaries: []TicketAncillary{
+			{Amount: 200, Status: AncillaryStatusActive},
+			{Amount: 300, Status: AncillaryStatusCancelled},
+			{Amount: 400, Status: AncillaryStatusPendingPayment},
+		},
+	}
+	assert.Equal(t, int64(1200), ticket.AmountDue())
+
+	ticket.Status = TicketStatusCancelled
+	assert.Equal(t, int64(0), ticket.AmountDue())
+}
+
+func TestAncillaryValidate(t *testing.T) {
+	assert.NoError(t, testAncillaries[0].Validate())
+
+	invalid := testAncillaries[0]
+	invalid.Type = "lounge"
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidAncillary)
+
+	invalid = testAncillaries[0]
+	invalid.MaxQuantity = 0
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidAncillary)
+}
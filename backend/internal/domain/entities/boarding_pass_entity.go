@@ -0,0 +1,74 @@
+package entities
+
+import "time"
+
+// BoardingPass is what a passenger shows at the airport for one ticket: the flight, the
+// seat, the checked baggage allowance and the perks the booking's loyalty tier and
+// add-ons give at the gate.
+type BoardingPass struct {
+	TicketID      int64          `json:"ticket_id"`
+	TicketNumber  string         `json:"ticket_number"`
+	BookingPNR    string         `json:"booking_pnr"`
+	FirstName     string         `json:"first_name"`
+	LastName      string         `json:"last_name"`
+	PassengerType PassengerType  `json:"passenger_type"`
+	FlightID      int64          `json:"flight_id"`
+	FlightNumber  string         `json:"flight_number"`
+	DepartureCity string         `json:"departure_city"`
+	ArrivalCity   string         `json:"arrival_city"`
+	DepartureTime time.Time      `json:"departure_time"`
+	SeatCode      string         `json:"seat_code"`
+	FlightClass   FlightClass    `json:"flight_class"`
+	FareFamily    FareFamilyCode `json:"fare_family"`
+	// CheckedBaggageKg gồm hành lý của gói giá và phần cộng thêm theo hạng thành viên
+	CheckedBaggageKg int32       `json:"checked_baggage_kg"`
+	LoyaltyTier      LoyaltyTier `json:"loyalty_tier"`
+	PriorityBoarding bool        `json:"priority_boarding"`
+	LoungeAccess     bool        `json:"lounge_access"`
+	// Ancillaries là tên các dịch vụ bổ trợ còn hiệu lực của vé, ví dụ hành lý mua thêm
+	Ancillaries []string `json:"ancillaries,omitempty"`
+	// SpecialServices là các mã SSR của hành khách để nhân viên mặt đất và tổ bay phục vụ
+	SpecialServices []string `json:"special_services,omitempty"`
+	// CheckedIn cho biết hành khách đã làm thủ tục trực tuyến hay còn phải làm tại quầy
+	CheckedIn bool `json:"checked_in"`
+}
+
+// NewBoardingPass builds the boarding pass of ticket on flight, sold in family, for a
+// booking whose account holds tier with benefits. Priority boarding comes with the tier
+// or with a priority boarding add-on bought for the ticket.
+func NewBoardingPass(ticket Ticket, flight Flight, family FareFamily, tier LoyaltyTier, benefits TierBenefits) BoardingPass {
+	pass := BoardingPass{
+		TicketID:         ticket.TicketID,
+		TicketNumber:     ticket.TicketNumber,
+		BookingPNR:       ticket.BookingPNR,
+		FirstName:        ticket.Owner.FirstName,
+		LastName:         ticket.Owner.LastName,
+		PassengerType:    ticket.PassengerType,
+		FlightID:         flight.FlightID,
+		FlightNumber:     flight.FlightNumber,
+		DepartureCity:    flight.DepartureCity,
+		ArrivalCity:      flight.ArrivalCity,
+		DepartureTime:    flight.DepartureTime,
+		SeatCode:         ticket.Seat.SeatCode,
+		FlightClass:      ticket.FlightClass,
+		FareFamily:       ticket.FareFamily,
+		CheckedBaggageKg: family.CheckedBaggageKg + benefits.ExtraCheckedBaggageKg,
+		LoyaltyTier:      tier,
+		PriorityBoarding: benefits.PriorityBoarding,
+		LoungeAccess:     benefits.LoungeAccess,
+		CheckedIn:        ticket.CheckedInAt != nil,
+	}
+	for _, ancillary := range ticket.Ancillaries {
+		if ancillary.Status != AncillaryStatusActive || ancillary.Type == AncillaryTypeSeat {
+			continue
+		}
+		if ancillary.Type == AncillaryTypePriorityBoarding {
+			pass.PriorityBoarding = true
+		}
+		pass.Ancillaries = append(pass.Ancillaries, ancillary.Name)
+	}
+	for _, service := range ticket.SpecialServices {
+		pass.SpecialServices = append(pass.SpecialServices, service.Code)
+	}
+	return pass
+}
@@ -13,8 +13,9 @@ const (
 type TripType string
 
 const (
-	OneWayTrip TripType = "oneWay"
-	RoundTrip  TripType = "roundTrip"
+	OneWayTrip    TripType = "oneWay"
+	RoundTrip     TripType = "roundTrip"
+	MultiCityTrip TripType = "multiCity"
 )
 
 const (
@@ -23,25 +24,91 @@ const (
 	BookingStatusPending   BookingStatus = "pending"
 )
 
+// bookingTransitions lists, for every booking status, the statuses it may move to.
+// Cancelling a confirmed booking is allowed; the refund is handled by the caller.
+var bookingTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusPending:   {BookingStatusConfirmed, BookingStatusCancelled},
+	BookingStatusConfirmed: {BookingStatusCancelled},
+	BookingStatusCancelled: {},
+}
+
+// CanTransitionTo reports whether a booking in status s may move to next.
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	for _, allowed := range bookingTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns a *StatusTransitionError when s cannot move to next.
+func (s BookingStatus) ValidateTransition(next BookingStatus) error {
+	if !s.CanTransitionTo(next) {
+		return &StatusTransitionError{Entity: "booking", From: string(s), To: string(next)}
+	}
+	return nil
+}
+
 type Booking struct {
-	BookingID         int64         `json:"booking_id"`
-	UserEmail         string        `json:"user_email"`
-	TripType          TripType      `json:"trip_type"`
-	DepartureFlightID int64         `json:"departure_flight_id"`
-	ReturnFlightID    *int64        `json:"return_flight_id,omitempty"`
-	Status            BookingStatus `json:"status"`
-	CreatedAt         time.Time     `json:"created_at"`
-	UpdatedAt         time.Time     `json:"updated_at"`
+	BookingID         int64                 `json:"booking_id"`
+	PNR               string                `json:"pnr"`
+	UserEmail         string                `json:"user_email"`
+	TripType          TripType              `json:"trip_type"`
+	DepartureFlightID int64                 `json:"departure_flight_id"`
+	ReturnFlightID    *int64                `json:"return_flight_id,omitempty"`
+	Segments          []BookingSegment      `json:"segments,omitempty"`
+	Status            BookingStatus         `json:"status"`
+	StatusHistory     []BookingStatusChange `json:"status_history,omitempty"`
+	CreatedAt         time.Time             `json:"created_at"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+}
+
+// BookingStatusChange is one entry of the booking_status_history table.
+// FromStatus is empty for the entry written when the booking is created.
+type BookingStatusChange struct {
+	ID         int64         `json:"id"`
+	BookingID  int64         `json:"booking_id"`
+	FromStatus BookingStatus `json:"from_status"`
+	ToStatus   BookingStatus `json:"to_status"`
+	Actor      string        `json:"actor"`
+	Reason     string        `json:"reason"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+type UpdateBookingStatusParams struct {
+	BookingID int64
+	Status    BookingStatus
+	Actor     string
+	Reason    string
+}
+
+type CancelBookingParams struct {
+	BookingID int64
+	Actor     string
+	Reason    string
+	// RequesterEmail, khi khác rỗng, phải trùng với email của booking
+	RequesterEmail string
+	RefundPolicy   RefundPolicy
+	FareFamilies   FareFamilyCatalog
+	CancelledAt    time.Time
+	// RefundToWallet hoàn tiền vào ví tín dụng; WalletCreditTTL là hạn dùng của khoản tín dụng
+	RefundToWallet  bool
+	WalletCreditTTL time.Duration
 }
 
 type CreateBookingParams struct {
-	Email                   string   `json:"email"`
-	DepartureCity           string   `json:"departureCity"`
-	ArrivalCity             string   `json:"arrivalCity"`
-	DepartureFlightID       string   `json:"departureFlightId"`
-	ReturnFlightID          string   `json:"returnFlightId"`
-	TripType                TripType `json:"tripType"`
-	DepartureTicketDataList []Ticket `json:"departureTicketDataList"`
-	ReturnTicketDataList    []Ticket `json:"returnTicketDataList"`
-	AfterCreate             func(booking Booking) error
+	Email         string   `json:"email"`
+	DepartureCity string   `json:"departureCity"`
+	ArrivalCity   string   `json:"arrivalCity"`
+	TripType      TripType `json:"tripType"`
+	// Segments lists the flights in travel order, each with the tickets to issue on it
+	Segments []BookingSegment `json:"segments"`
+	// Capacity là số ghế của từng hạng trên chuyến bay của mỗi chặng, theo thứ tự chặng;
+	// chặng không có Capacity thì không kiểm tra chỗ trống
+	Capacity           []map[FlightClass]int64 `json:"-"`
+	TicketNumberPrefix string                  `json:"-"`
+	// Promotions are the promo codes already discounted from the ticket prices
+	Promotions  []PromoRedemption `json:"promotions"`
+	AfterCreate func(booking Booking, tickets []Ticket) error
 }
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingStatusTransitions(t *testing.T) {
+	tests := []struct {
+		from     BookingStatus
+		to       BookingStatus
+		expected bool
+	}{
+		{BookingStatusPending, BookingStatusConfirmed, true},
+		{BookingStatusPending, BookingStatusCancelled, true},
+		{BookingStatusConfirmed, BookingStatusCancelled, true},
+		{BookingStatusConfirmed, BookingStatusPending, false},
+		{BookingStatusConfirmed, BookingStatusConfirmed, false},
+		{BookingStatusCancelled, BookingStatusConfirmed, false},
+		{BookingStatusCancelled, BookingStatusPending, false},
+		{BookingStatus("unknown"), BookingStatusConfirmed, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.from.CanTransitionTo(test.to), "Failed for %s -> %s", test.from, test.to)
+	}
+}
+
+func TestBookingStatusValidateTransition(t *testing.T) {
+	require.NoError(t, BookingStatusPending.ValidateTransition(BookingStatusConfirmed))
+
+	err := BookingStatusCancelled.ValidateTransition(BookingStatusConfirmed)
+	var transitionErr *StatusTransitionError
+	require.True(t, errors.As(err, &transitionErr))
+	assert.Equal(t, "booking", transitionErr.Entity)
+	assert.Equal(t, "cancelled", transitionErr.From)
+	assert.Equal(t, "confirmed", transitionErr.To)
+}
+
+func TestTicketStatusTransitions(t *testing.T) {
+	assert.True(t, TicketStatusActive.CanTransitionTo(TicketStatusCancelled))
+	assert.False(t, TicketStatusCancelled.CanTransitionTo(TicketStatusActive))
+	assert.Error(t, TicketStatusCancelled.ValidateTransition(TicketStatusCancelled))
+}
@@ -0,0 +1,159 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// CheckInPolicy is the online check-in window of a flight: it opens OpensBefore the
+// departure and closes ClosesBefore it, e.g. from 24h to 1h before the flight leaves.
+// The documents on file of every passenger are checked against Documents.
+type CheckInPolicy struct {
+	OpensBefore  time.Duration
+	ClosesBefore time.Duration
+	Documents    DocumentPolicy
+}
+
+// CheckWindow returns a *CheckInError unless online check-in of flight is open at now.
+func (p CheckInPolicy) CheckWindow(flight Flight, now time.Time) error {
+	if flight.Status == FlightCanceledStatus {
+		return &CheckInError{FlightID: flight.FlightID, Reason: "flight is cancelled"}
+	}
+	opensAt := flight.DepartureTime.Add(-p.OpensBefore)
+	closesAt := flight.DepartureTime.Add(-p.ClosesBefore)
+	if now.Before(opensAt) {
+		return &CheckInError{FlightID: flight.FlightID, Reason: fmt.Sprintf("check-in opens at %s", opensAt.UTC().Format(time.RFC3339))}
+	}
+	if !now.Before(closesAt) {
+		return &CheckInError{FlightID: flight.FlightID, Reason: "check-in for this flight has closed"}
+	}
+	return nil
+}
+
+// CheckIn returns the check-ins of the tickets with ticketIDs on segment, flown by
+// flight, part of an international itinerary or not whose last flight leaves on
+// returnDate. Every passenger needs a seat,
+// except infants who sit on an adult's lap and must be checked in with or after that
+// adult, and valid travel documents on file for the flight. Problems with the
+// documents are returned together as a *DocumentError whose fields are named
+// tickets[<ticket ID>].<field>.
+func (p CheckInPolicy) CheckIn(segment BookingSegment, flight Flight, ticketIDs []int64, international bool, returnDate time.Time, now time.Time) ([]TicketCheckIn, error) {
+	if err := p.CheckWindow(flight, now); err != nil {
+		return nil, err
+	}
+
+	requested := make(map[int64]bool, len(ticketIDs))
+	for _, id := range ticketIDs {
+		requested[id] = true
+	}
+	checkIns := []TicketCheckIn{}
+	var documentFields []FieldError
+	for _, ticket := range segment.Tickets {
+		if !requested[ticket.TicketID] {
+			continue
+		}
+		if ticket.CheckedInAt != nil {
+			return nil, &CheckInError{TicketID: ticket.TicketID, FlightID: flight.FlightID, Reason: "passenger is already checked in"}
+		}
+		if ticket.PassengerType == PassengerTypeInfant {
+			// Em bé ngồi cùng người lớn nên người lớn phải làm thủ tục trước hoặc cùng lúc
+			adult, ok := findSegmentTicket(segment, ticket.AccompanyingTicketID)
+			if !ok || (adult.CheckedInAt == nil && !requested[adult.TicketID]) {
+				return nil, &CheckInError{TicketID: ticket.TicketID, FlightID: flight.FlightID, Reason: "the accompanying adult must be checked in with the infant"}
+			}
+		} else if ticket.Seat.SeatCode == "" {
+			return nil, &CheckInError{TicketID: ticket.TicketID, FlightID: flight.FlightID, Reason: "a seat must be assigned before check-in"}
+		}
+
+		// Giấy tờ đã lưu của hành khách phải hợp lệ cho chuyến bay này
+		for _, field := range p.Documents.CheckPassenger(ticket.Owner, international, flight.DepartureTime, returnDate) {
+			field.Field = fmt.Sprintf("tickets[%d].%s", ticket.TicketID, field.Field)
+			documentFields = append(documentFields, field)
+		}
+		checkIns = append(checkIns, TicketCheckIn{
+			TicketID:    ticket.TicketID,
+			BookingID:   ticket.BookingID,
+			FlightID:    flight.FlightID,
+			CheckedInAt: now,
+		})
+	}
+	if len(documentFields) > 0 {
+		return nil, &DocumentError{Fields: documentFields}
+	}
+	return checkIns, nil
+}
+
+// UndoCheckIn returns the check-ins of the tickets with ticketIDs on segment to remove.
+// Check-in can be undone until the window closes; an adult's check-in is kept while the
+// infant on their lap stays checked in.
+func (p CheckInPolicy) UndoCheckIn(segment BookingSegment, flight Flight, ticketIDs []int64, now time.Time) ([]TicketCheckIn, error) {
+	if err := p.CheckWindow(flight, now); err != nil {
+		return nil, err
+	}
+
+	requested := make(map[int64]bool, len(ticketIDs))
+	for _, id := range ticketIDs {
+		requested[id] = true
+	}
+	checkIns := []TicketCheckIn{}
+	for _, ticket := range segment.Tickets {
+		if !requested[ticket.TicketID] {
+			continue
+		}
+		if ticket.CheckedInAt == nil {
+			return nil, &CheckInError{TicketID: ticket.TicketID, FlightID: flight.FlightID, Reason: "passenger is not checked in"}
+		}
+		for _, other := range segment.Tickets {
+			if other.PassengerType == PassengerTypeInfant && other.AccompanyingTicketID == ticket.TicketID && other.CheckedInAt != nil && !requested[other.TicketID] {
+				return nil, &CheckInError{TicketID: ticket.TicketID, FlightID: flight.FlightID, Reason: "undo the check-in of the infant travelling with this passenger first"}
+			}
+		}
+		checkIns = append(checkIns, TicketCheckIn{
+			TicketID:    ticket.TicketID,
+			BookingID:   ticket.BookingID,
+			FlightID:    flight.FlightID,
+			CheckedInAt: *ticket.CheckedInAt,
+		})
+	}
+	return checkIns, nil
+}
+
+// findSegmentTicket returns the active ticket of segment with ticketID.
+func findSegmentTicket(segment BookingSegment, ticketID int64) (Ticket, bool) {
+	for _, ticket := range segment.Tickets {
+		if ticket.TicketID == ticketID && ticket.Status == TicketStatusActive {
+			return ticket, true
+		}
+	}
+	return Ticket{}, false
+}
+
+// TicketCheckIn records that the passenger of a ticket checked in for its flight.
+type TicketCheckIn struct {
+	TicketID    int64     `json:"ticket_id"`
+	BookingID   int64     `json:"booking_id"`
+	FlightID    int64     `json:"flight_id"`
+	CheckedInAt time.Time `json:"checked_in_at"`
+}
+
+// CheckInError is returned when passengers cannot be checked in or their check-in
+// cannot be undone.
+type CheckInError struct {
+	TicketID int64
+	FlightID int64
+	Reason   string
+}
+
+func (e *CheckInError) Error() string {
+	if e.TicketID != 0 {
+		return fmt.Sprintf("check-in of ticket %d: %s", e.TicketID, e.Reason)
+	}
+	return fmt.Sprintf("check-in for flight %d: %s", e.FlightID, e.Reason)
+}
+
+// CheckInParams identifies the passengers of a booking to check in, or whose check-in
+// is undone.
+type CheckInParams struct {
+	BookingID int64
+	TicketIDs []int64
+}
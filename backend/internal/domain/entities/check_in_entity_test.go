@@ -0,0 +1,132 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCheckInPolicy = CheckInPolicy{
+	OpensBefore:  24 * time.Hour,
+	ClosesBefore: time.Hour,
+	Documents:    DocumentPolicy{PassportValidityMonths: 6},
+}
+
+func TestCheckInPolicyCheckWindow(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	flight := Flight{FlightID: 3, DepartureTime: now.Add(6 * time.Hour), Status: FlightOnTimeStatus}
+	assert.NoError(t, testCheckInPolicy.CheckWindow(flight, now))
+
+	var checkInErr *CheckInError
+	early := flight
+	early.DepartureTime = now.Add(25 * time.Hour)
+	assert.ErrorAs(t, testCheckInPolicy.CheckWindow(early, now), &checkInErr)
+
+	// Cửa sổ đóng đúng 1 giờ trước giờ khởi hành
+	late := flight
+	late.DepartureTime = now.Add(time.Hour)
+	assert.ErrorAs(t, testCheckInPolicy.CheckWindow(late, now), &checkInErr)
+
+	cancelled := flight
+	cancelled.Status = FlightCanceledStatus
+	assert.ErrorAs(t, testCheckInPolicy.CheckWindow(cancelled, now), &checkInErr)
+}
+
+func TestCheckInPolicyCheckIn(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	flight := Flight{FlightID: 3, DepartureTime: now.Add(6 * time.Hour), Status: FlightOnTimeStatus}
+	passport := TicketOwner{
+		FirstName:       "Nguyen",
+		LastName:        "An",
+		DateOfBirth:     time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		PassportNumber:  "B1234567",
+		DocumentCountry: "VN",
+		DocumentExpiry:  now.AddDate(2, 0, 0),
+	}
+	infantOwner := passport
+	infantOwner.DateOfBirth = now.AddDate(-1, 0, 0)
+	infantOwner.PassportNumber = "C7654321"
+	adult := Ticket{TicketID: 1, BookingID: 7, FlightID: 3, Status: TicketStatusActive, PassengerType: PassengerTypeAdult, Seat: Seat{SeatCode: "12A"}, Owner: passport}
+	child := Ticket{TicketID: 2, BookingID: 7, FlightID: 3, Status: TicketStatusActive, PassengerType: PassengerTypeChild, Owner: passport}
+	infant := Ticket{TicketID: 3, BookingID: 7, FlightID: 3, Status: TicketStatusActive, PassengerType: PassengerTypeInfant, AccompanyingTicketID: 1, Owner: infantOwner}
+	segment := BookingSegment{FlightID: 3, Tickets: []Ticket{adult, child, infant}}
+
+	checkIns, err := testCheckInPolicy.CheckIn(segment, flight, []int64{1, 3}, true, flight.DepartureTime, now)
+	require.NoError(t, err)
+	require.Len(t, checkIns, 2)
+	assert.Equal(t, int64(1), checkIns[0].TicketID)
+	assert.Equal(t, int64(7), checkIns[0].BookingID)
+	assert.Equal(t, now, checkIns[1].CheckedInAt)
+
+	var checkInErr *CheckInError
+	// Trẻ em chưa có ghế
+	_, err = testCheckInPolicy.CheckIn(segment, flight, []int64{2}, true, flight.DepartureTime, now)
+	assert.ErrorAs(t, err, &checkInErr)
+	// Em bé không thể làm thủ tục trước người lớn đi kèm
+	_, err = testCheckInPolicy.CheckIn(segment, flight, []int64{3}, true, flight.DepartureTime, now)
+	assert.ErrorAs(t, err, &checkInErr)
+
+	segment.Tickets[0].CheckedInAt = &now
+	_, err = testCheckInPolicy.CheckIn(segment, flight, []int64{3}, true, flight.DepartureTime, now)
+	assert.NoError(t, err)
+	_, err = testCheckInPolicy.CheckIn(segment, flight, []int64{1}, true, flight.DepartureTime, now)
+	assert.ErrorAs(t, err, &checkInErr)
+}
+
+func TestCheckInPolicyCheckInDocuments(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	flight := Flight{FlightID: 3, DepartureTime: now.Add(6 * time.Hour), Status: FlightOnTimeStatus}
+	owner := TicketOwner{
+		FirstName:            "Nguyen",
+		LastName:             "An",
+		DateOfBirth:          time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		IdentificationNumber: "001090012345",
+	}
+	ticket := Ticket{TicketID: 1, BookingID: 7, FlightID: 3, Status: TicketStatusActive, PassengerType: PassengerTypeAdult, Seat: Seat{SeatCode: "12A"}, Owner: owner}
+	segment := BookingSegment{FlightID: 3, Tickets: []Ticket{ticket}}
+
+	// Chuyến nội địa chấp nhận giấy tờ tuỳ thân
+	_, err := testCheckInPolicy.CheckIn(segment, flight, []int64{1}, false, flight.DepartureTime, now)
+	assert.NoError(t, err)
+
+	// Chuyến quốc tế bắt buộc hộ chiếu
+	var documentErr *DocumentError
+	_, err = testCheckInPolicy.CheckIn(segment, flight, []int64{1}, true, flight.DepartureTime, now)
+	require.ErrorAs(t, err, &documentErr)
+	assert.Equal(t, "tickets[1].passportNumber", documentErr.Fields[0].Field)
+
+	// Hộ chiếu phải còn hạn đủ số tháng sau ngày bay
+	segment.Tickets[0].Owner.PassportNumber = "B1234567"
+	segment.Tickets[0].Owner.DocumentCountry = "VN"
+	segment.Tickets[0].Owner.DocumentExpiry = now.AddDate(0, 3, 0)
+	_, err = testCheckInPolicy.CheckIn(segment, flight, []int64{1}, true, flight.DepartureTime, now)
+	require.ErrorAs(t, err, &documentErr)
+	assert.Equal(t, "tickets[1].passportExpiry", documentErr.Fields[0].Field)
+}
+
+func TestCheckInPolicyUndoCheckIn(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	checkedInAt := now.Add(-time.Hour)
+	flight := Flight{FlightID: 3, DepartureTime: now.Add(6 * time.Hour), Status: FlightOnTimeStatus}
+	adult := Ticket{TicketID: 1, BookingID: 7, Status: TicketStatusActive, PassengerType: PassengerTypeAdult, CheckedInAt: &checkedInAt}
+	infant := Ticket{TicketID: 3, BookingID: 7, Status: TicketStatusActive, PassengerType: PassengerTypeInfant, AccompanyingTicketID: 1, CheckedInAt: &checkedInAt}
+	child := Ticket{TicketID: 2, BookingID: 7, Status: TicketStatusActive, PassengerType: PassengerTypeChild}
+	segment := BookingSegment{FlightID: 3, Tickets: []Ticket{adult, child, infant}}
+
+	checkIns, err := testCheckInPolicy.UndoCheckIn(segment, flight, []int64{1, 3}, now)
+	require.NoError(t, err)
+	assert.Len(t, checkIns, 2)
+
+	var checkInErr *CheckInError
+	// Người lớn còn em bé đã làm thủ tục đi kèm
+	_, err = testCheckInPolicy.UndoCheckIn(segment, flight, []int64{1}, now)
+	assert.ErrorAs(t, err, &checkInErr)
+	_, err = testCheckInPolicy.UndoCheckIn(segment, flight, []int64{2}, now)
+	assert.ErrorAs(t, err, &checkInErr)
+
+	// Không huỷ được sau khi cửa sổ đã đóng
+	_, err = testCheckInPolicy.UndoCheckIn(segment, flight, []int64{3}, flight.DepartureTime.Add(-30*time.Minute))
+	assert.ErrorAs(t, err, &checkInErr)
+}
@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// DocumentType is the travel document a passenger is booked with.
+type DocumentType string
+
+const (
+	DocumentPassport DocumentType = "passport"
+	// DocumentIDCard là căn cước công dân, dùng cho chuyến bay nội địa
+	DocumentIDCard DocumentType = "id_card"
+)
+
+func (t DocumentType) Valid() bool {
+	return t == DocumentPassport || t == DocumentIDCard
+}
+
+// MaxCompanions bounds how many companion profiles one customer can save.
+const MaxCompanions = 20
+
+// Companion is a travel companion saved by a customer, such as a family member, whose
+// details fill in a passenger when booking.
+type Companion struct {
+	CompanionID    int64        `json:"companion_id"`
+	UserID         int64        `json:"user_id"`
+	FirstName      string       `json:"first_name"`
+	LastName       string       `json:"last_name"`
+	Gender         GenderType   `json:"gender"`
+	DateOfBirth    time.Time    `json:"date_of_birth"`
+	DocumentType   DocumentType `json:"document_type"`
+	DocumentNumber string       `json:"document_number"`
+	DocumentExpiry time.Time    `json:"document_expiry"`
+	// DocumentCountry là nước cấp hộ chiếu (ISO 3166-1 alpha-2), bắt buộc với hộ chiếu
+	DocumentCountry string    `json:"document_country"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Validate checks a profile before it is saved at now.
+func (c Companion) Validate(now time.Time) error {
+	switch {
+	case strings.TrimSpace(c.FirstName) == "" || strings.TrimSpace(c.LastName) == "":
+		return &CompanionError{Reason: "first and last name are required"}
+	case c.Gender != GenderMale && c.Gender != GenderFemale && c.Gender != GenderOther:
+		return &CompanionError{Reason: "gender must be Male, Female or Other"}
+	case c.DateOfBirth.IsZero() || c.DateOfBirth.After(now):
+		return &CompanionError{Reason: "date of birth must be in the past"}
+	case !c.DocumentType.Valid():
+		return &CompanionError{Reason: "document type must be passport or id_card"}
+	case strings.TrimSpace(c.DocumentNumber) == "":
+		return &CompanionError{Reason: "document number is required"}
+	case !c.DocumentExpiry.After(now):
+		return &CompanionError{Reason: "the document has expired"}
+	}
+	if c.DocumentType == DocumentPassport {
+		if err := CheckPassportNumber(c.DocumentCountry, c.DocumentNumber); err != nil {
+			return &CompanionError{Reason: err.Error()}
+		}
+	}
+	return nil
+}
+
+// Owner returns the passenger details of a ticket booked for the companion.
+func (c Companion) Owner() TicketOwner {
+	owner := TicketOwner{
+		FirstName:      c.FirstName,
+		LastName:       c.LastName,
+		Gender:         c.Gender,
+		DateOfBirth:    c.DateOfBirth,
+		DocumentExpiry: c.DocumentExpiry,
+		CompanionID:    c.CompanionID,
+	}
+	if c.DocumentType == DocumentPassport {
+		owner.PassportNumber = c.DocumentNumber
+		owner.DocumentCountry = c.DocumentCountry
+	} else {
+		owner.IdentificationNumber = c.DocumentNumber
+	}
+	return owner
+}
+
+// CompanionError is returned when a companion profile cannot be saved.
+type CompanionError struct {
+	Reason string
+}
+
+func (e *CompanionError) Error() string {
+	return "companion: " + e.Reason
+}
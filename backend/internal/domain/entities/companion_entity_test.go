@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompanionValidate(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	companion := Companion{
+		FirstName:       "An",
+		LastName:        "Nguyen",
+		Gender:          GenderFemale,
+		DateOfBirth:     time.Date(2018, 3, 4, 0, 0, 0, 0, time.UTC),
+		DocumentType:    DocumentPassport,
+		DocumentNumber:  "C1234567",
+		DocumentExpiry:  time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		DocumentCountry: "VN",
+	}
+	require.NoError(t, companion.Validate(now))
+
+	var companionErr *CompanionError
+	invalid := companion
+	invalid.LastName = " "
+	assert.ErrorAs(t, invalid.Validate(now), &companionErr)
+
+	invalid = companion
+	invalid.DateOfBirth = now.AddDate(0, 0, 1)
+	assert.ErrorAs(t, invalid.Validate(now), &companionErr)
+
+	invalid = companion
+	invalid.DocumentType = "visa"
+	assert.ErrorAs(t, invalid.Validate(now), &companionErr)
+
+	invalid = companion
+	invalid.DocumentExpiry = now.AddDate(0, 0, -1)
+	assert.ErrorAs(t, invalid.Validate(now), &companionErr)
+
+	invalid = companion
+	invalid.DocumentNumber = "12345"
+	assert.ErrorAs(t, invalid.Validate(now), &companionErr)
+}
+
+func TestCompanionOwner(t *testing.T) {
+	companion := Companion{
+		CompanionID:    7,
+		FirstName:      "An",
+		LastName:       "Nguyen",
+		DocumentType:   DocumentPassport,
+		DocumentNumber: "C1234567",
+	}
+	owner := companion.Owner()
+	assert.Equal(t, int64(7), owner.CompanionID)
+	assert.Equal(t, "C1234567", owner.PassportNumber)
+	assert.Empty(t, owner.IdentificationNumber)
+
+	companion.DocumentType = DocumentIDCard
+	owner = companion.Owner()
+	assert.Empty(t, owner.PassportNumber)
+	assert.Equal(t, "C1234567", owner.IdentificationNumber)
+}
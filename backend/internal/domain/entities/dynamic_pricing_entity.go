@@ -0,0 +1,168 @@
+package entities
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// PriceStep raises the fare to Percent of the cabin fare once its threshold is reached.
+type PriceStep struct {
+	Threshold int   `json:"threshold"`
+	Percent   int64 `json:"percent"`
+}
+
+// PricingCurve is the revenue-management curve of one route and cabin.
+// LoadFactorSteps apply once the flight's load factor (percent of seats sold)
+// reaches the threshold; DaysToDepartureSteps apply once the flight departs in
+// at most threshold days. The two percentages are multiplied together.
+type PricingCurve struct {
+	CurveID              int64       `json:"curve_id"`
+	DepartureCity        string      `json:"departure_city"`
+	ArrivalCity          string      `json:"arrival_city"`
+	FlightClass          FlightClass `json:"flight_class"`
+	LoadFactorSteps      []PriceStep `json:"load_factor_steps"`
+	DaysToDepartureSteps []PriceStep `json:"days_to_departure_steps"`
+	UpdatedAt            time.Time   `json:"updated_at"`
+}
+
+// ErrInvalidPricingCurve is returned by PricingCurve.Validate.
+var ErrInvalidPricingCurve = errors.New("invalid pricing curve")
+
+// Validate checks the route, the cabin and that every step has a positive percentage;
+// load factor thresholds must lie between 0 and 100 and day thresholds must not be negative.
+func (c PricingCurve) Validate() error {
+	if c.DepartureCity == "" || c.ArrivalCity == "" || !c.FlightClass.Valid() {
+		return ErrInvalidPricingCurve
+	}
+	for _, step := range c.LoadFactorSteps {
+		if step.Threshold < 0 || step.Threshold > 100 || step.Percent <= 0 {
+			return ErrInvalidPricingCurve
+		}
+	}
+	for _, step := range c.DaysToDepartureSteps {
+		if step.Threshold < 0 || step.Percent <= 0 {
+			return ErrInvalidPricingCurve
+		}
+	}
+	return nil
+}
+
+// Percent returns the multiplier, in percent, for a flight with the given load
+// factor departing in daysToDeparture days.
+func (c PricingCurve) Percent(loadFactor int, daysToDeparture int) int64 {
+	loadPercent := int64(100)
+	loadSteps := sortedSteps(c.LoadFactorSteps)
+	for _, step := range loadSteps {
+		if loadFactor >= step.Threshold {
+			loadPercent = step.Percent
+		}
+	}
+
+	daysPercent := int64(100)
+	daySteps := sortedSteps(c.DaysToDepartureSteps)
+	for i := len(daySteps) - 1; i >= 0; i-- {
+		if daysToDeparture <= daySteps[i].Threshold {
+			daysPercent = daySteps[i].Percent
+		}
+	}
+	return loadPercent * daysPercent / 100
+}
+
+func sortedSteps(steps []PriceStep) []PriceStep {
+	sorted := append([]PriceStep(nil), steps...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Threshold < sorted[j].Threshold })
+	return sorted
+}
+
+// FlightLoad is how full a flight is.
+type FlightLoad struct {
+	SoldSeats int64
+	Capacity  int64
+}
+
+// LoadFactor returns the percentage of seats sold, capped at 100.
+func (l FlightLoad) LoadFactor() int {
+	if l.Capacity <= 0 {
+		return 0
+	}
+	factor := l.SoldSeats * 100 / l.Capacity
+	if factor > 100 {
+		factor = 100
+	}
+	return int(factor)
+}
+
+// Capacity returns the number of seats on the flight.
+func (f Flight) Capacity() int64 {
+	return int64(f.TotalSeatsRow) * int64(f.TotalSeatsColumn)
+}
+
+// CabinLayout splits the seat rows of a flight between cabins: the first FirstClassRows
+// rows are first class, the next BusinessRows business and the remaining rows economy.
+type CabinLayout struct {
+	FirstClassRows int32
+	BusinessRows   int32
+}
+
+// Capacity returns the number of seats of class on flight.
+func (l CabinLayout) Capacity(flight Flight, class FlightClass) int64 {
+	firstClassRows := min(max(l.FirstClassRows, 0), flight.TotalSeatsRow)
+	businessRows := min(max(l.BusinessRows, 0), flight.TotalSeatsRow-firstClassRows)
+	rows := flight.TotalSeatsRow - firstClassRows - businessRows
+	switch class {
+	case FlightClassFirstClass:
+		rows = firstClassRows
+	case FlightClassBusiness:
+		rows = businessRows
+	}
+	return int64(rows) * int64(flight.TotalSeatsColumn)
+}
+
+// Loads returns how full every cabin of flight is given the seats sold in each cabin.
+func (l CabinLayout) Loads(flight Flight, soldSeats map[FlightClass]int64) map[FlightClass]FlightLoad {
+	loads := make(map[FlightClass]FlightLoad, len(FlightClasses))
+	for _, class := range FlightClasses {
+		loads[class] = FlightLoad{SoldSeats: soldSeats[class], Capacity: l.Capacity(flight, class)}
+	}
+	return loads
+}
+
+// CurrentClassFare returns what one adult seat in class currently sells for on flight.
+// Without a curve the static cabin fare applies.
+func CurrentClassFare(flight Flight, class FlightClass, cabins CabinFares, curve *PricingCurve, load FlightLoad, now time.Time) int64 {
+	fare := cabins.Fare(flight, class)
+	if curve == nil {
+		return fare
+	}
+	daysToDeparture := int(flight.DepartureTime.Sub(now).Hours() / 24)
+	if daysToDeparture < 0 {
+		daysToDeparture = 0
+	}
+	return fare * curve.Percent(load.LoadFactor(), daysToDeparture) / 100
+}
+
+// CurrentFares returns the current adult fare of every cabin on flight given the
+// curves configured for its route and the load of each cabin.
+func CurrentFares(flight Flight, cabins CabinFares, curves []PricingCurve, loads map[FlightClass]FlightLoad, now time.Time) map[FlightClass]int64 {
+	fares := make(map[FlightClass]int64, len(FlightClasses))
+	for _, class := range FlightClasses {
+		var classCurve *PricingCurve
+		for i := range curves {
+			if curves[i].FlightClass == class {
+				classCurve = &curves[i]
+				break
+			}
+		}
+		fares[class] = CurrentClassFare(flight, class, cabins, classCurve, loads[class], now)
+	}
+	return fares
+}
+
+// FareQuote locks the current fares of a flight until ExpiresAt.
+type FareQuote struct {
+	QuoteID   string                `json:"quote_id"`
+	FlightID  int64                 `json:"flight_id"`
+	Fares     map[FlightClass]int64 `json:"fares"`
+	ExpiresAt time.Time             `json:"expires_at"`
+}
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingCurvePercent(t *testing.T) {
+	curve := PricingCurve{
+		LoadFactorSteps:      []PriceStep{{Threshold: 80, Percent: 150}, {Threshold: 50, Percent: 120}},
+		DaysToDepartureSteps: []PriceStep{{Threshold: 3, Percent: 150}, {Threshold: 14, Percent: 110}},
+	}
+
+	assert.Equal(t, int64(100), curve.Percent(10, 30))
+	assert.Equal(t, int64(120), curve.Percent(50, 30))
+	assert.Equal(t, int64(150), curve.Percent(95, 30))
+	assert.Equal(t, int64(110), curve.Percent(10, 14))
+	assert.Equal(t, int64(150), curve.Percent(10, 2))
+	assert.Equal(t, int64(180), curve.Percent(60, 3))
+}
+
+func TestCurrentClassFare(t *testing.T) {
+	now := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	flight := Flight{FlightID: 1, BasePrice: 1000000, DepartureTime: now.Add(5 * 24 * time.Hour), TotalSeatsRow: 10, TotalSeatsColumn: 10}
+	curve := &PricingCurve{
+		FlightClass:          FlightClassBusiness,
+		LoadFactorSteps:      []PriceStep{{Threshold: 50, Percent: 120}},
+		DaysToDepartureSteps: []PriceStep{{Threshold: 7, Percent: 110}},
+	}
+
+	assert.Equal(t, int64(1500000), CurrentClassFare(flight, FlightClassBusiness, testCabinFares, nil, FlightLoad{}, now))
+	assert.Equal(t, int64(1650000), CurrentClassFare(flight, FlightClassBusiness, testCabinFares, curve, FlightLoad{SoldSeats: 10, Capacity: flight.Capacity()}, now))
+	assert.Equal(t, int64(1980000), CurrentClassFare(flight, FlightClassBusiness, testCabinFares, curve, FlightLoad{SoldSeats: 60, Capacity: flight.Capacity()}, now))
+}
+
+func TestCabinLayoutLoads(t *testing.T) {
+	now := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	flight := Flight{FlightID: 1, BasePrice: 1000000, DepartureTime: now.Add(30 * 24 * time.Hour), TotalSeatsRow: 10, TotalSeatsColumn: 4}
+	layout := CabinLayout{FirstClassRows: 1, BusinessRows: 2}
+
+	loads := layout.Loads(flight, map[FlightClass]int64{FlightClassBusiness: 8, FlightClassEconomy: 7})
+	assert.Equal(t, FlightLoad{SoldSeats: 0, Capacity: 4}, loads[FlightClassFirstClass])
+	assert.Equal(t, FlightLoad{SoldSeats: 8, Capacity: 8}, loads[FlightClassBusiness])
+	assert.Equal(t, FlightLoad{SoldSeats: 7, Capacity: 28}, loads[FlightClassEconomy])
+
+	// Hạng thương gia đã kín nhưng hạng phổ thông còn trống nên chỉ giá thương gia tăng
+	curves := []PricingCurve{
+		{FlightClass: FlightClassBusiness, LoadFactorSteps: []PriceStep{{Threshold: 80, Percent: 150}}},
+		{FlightClass: FlightClassEconomy, LoadFactorSteps: []PriceStep{{Threshold: 80, Percent: 150}}},
+	}
+	fares := CurrentFares(flight, testCabinFares, curves, loads, now)
+	assert.Equal(t, int64(2250000), fares[FlightClassBusiness])
+	assert.Equal(t, int64(1000000), fares[FlightClassEconomy])
+
+	// Số hàng cấu hình vượt quá số hàng của máy bay được cắt bớt
+	assert.Equal(t, int64(0), CabinLayout{FirstClassRows: 12}.Capacity(flight, FlightClassEconomy))
+}
+
+func TestPricingCurveValidate(t *testing.T) {
+	valid := PricingCurve{DepartureCity: "Hà Nội", ArrivalCity: "Hồ Chí Minh", FlightClass: FlightClassEconomy, LoadFactorSteps: []PriceStep{{Threshold: 50, Percent: 120}}}
+	assert.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.LoadFactorSteps = []PriceStep{{Threshold: 120, Percent: 120}}
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidPricingCurve)
+
+	invalid = valid
+	invalid.FlightClass = "premium"
+	assert.ErrorIs(t, invalid.Validate(), ErrInvalidPricingCurve)
+}
@@ -0,0 +1,127 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+type FareFamilyCode string
+
+const (
+	FareFamilyLite    FareFamilyCode = "lite"
+	FareFamilyClassic FareFamilyCode = "classic"
+	FareFamilyFlex    FareFamilyCode = "flex"
+)
+
+// FareFamily is a branded fare sold within one cabin together with the rules
+// attached to it. Its fare is FarePercent of the cabin fare.
+type FareFamily struct {
+	FareFamilyID     int64          `json:"fare_family_id"`
+	FlightClass      FlightClass    `json:"flight_class"`
+	Code             FareFamilyCode `json:"code"`
+	Name             string         `json:"name"`
+	FarePercent      int64          `json:"fare_percent"`
+	CabinBaggageKg   int32          `json:"cabin_baggage_kg"`
+	CheckedBaggageKg int32          `json:"checked_baggage_kg"`
+	// Changeable cho phép đổi chuyến; ChangeFeeWaived miễn phí đổi
+	Changeable      bool `json:"changeable"`
+	ChangeFeeWaived bool `json:"change_fee_waived"`
+	// Refundable cho phép hoàn tiền khi huỷ; FullRefund hoàn toàn bộ trước giờ khởi hành
+	Refundable    bool `json:"refundable"`
+	FullRefund    bool `json:"full_refund"`
+	SeatSelection bool `json:"seat_selection"`
+}
+
+// Fare returns the price of the family given the current cabin fare.
+func (f FareFamily) Fare(cabinFare int64) int64 {
+	return cabinFare * f.FarePercent / 100
+}
+
+// RefundAmount returns how much of price is refunded when a ticket sold in the family
+// is cancelled at now. Non-refundable families return nothing, families with a full
+// refund return the whole fare until departure and the others follow policy.
+func (f FareFamily) RefundAmount(policy RefundPolicy, price int64, departureTime, now time.Time) int64 {
+	switch {
+	case !f.Refundable:
+		return 0
+	case f.FullRefund && departureTime.After(now):
+		return price
+	default:
+		return policy.RefundAmount(f.FlightClass, price, departureTime, now)
+	}
+}
+
+// AncillaryRefundAmount returns how much of amount, paid for an unused add-on of type kind
+// on a ticket sold in the family, is refunded when it is cancelled at now. Seat fees follow
+// the refund rules of the fare; other add-ons are refunded in full until departure.
+func (f FareFamily) AncillaryRefundAmount(policy RefundPolicy, kind AncillaryType, amount int64, departureTime, now time.Time) int64 {
+	if kind == AncillaryTypeSeat {
+		return f.RefundAmount(policy, amount, departureTime, now)
+	}
+	if departureTime.After(now) {
+		return amount
+	}
+	return 0
+}
+
+// FareRuleError is returned when an operation is not allowed by the fare family of a ticket.
+type FareRuleError struct {
+	TicketID int64
+	Family   string
+	Reason   string
+}
+
+func (e *FareRuleError) Error() string {
+	return fmt.Sprintf("ticket %d (%s): %s", e.TicketID, e.Family, e.Reason)
+}
+
+// FareFamilyCatalog lists every fare family on sale.
+type FareFamilyCatalog []FareFamily
+
+// Find returns the family with the given code in class.
+func (c FareFamilyCatalog) Find(class FlightClass, code FareFamilyCode) (FareFamily, bool) {
+	for _, family := range c {
+		if family.FlightClass == class && family.Code == code {
+			return family, true
+		}
+	}
+	return FareFamily{}, false
+}
+
+// ByClass returns the families sold in class, in catalog order.
+func (c FareFamilyCatalog) ByClass(class FlightClass) []FareFamily {
+	var families []FareFamily
+	for _, family := range c {
+		if family.FlightClass == class {
+			families = append(families, family)
+		}
+	}
+	return families
+}
+
+// CheckChange reports a *FareRuleError for the first ticket whose family does not allow
+// changing flights, and otherwise whether the change fee is waived for every ticket.
+func (c FareFamilyCatalog) CheckChange(tickets []Ticket) (feeWaived bool, err error) {
+	feeWaived = len(tickets) > 0
+	for _, ticket := range tickets {
+		family, ok := c.Find(ticket.FlightClass, ticket.FareFamily)
+		if !ok {
+			return false, &FareRuleError{TicketID: ticket.TicketID, Family: string(ticket.FareFamily), Reason: "unknown fare family"}
+		}
+		if !family.Changeable {
+			return false, &FareRuleError{TicketID: ticket.TicketID, Family: family.Name, Reason: "flight changes are not allowed"}
+		}
+		feeWaived = feeWaived && family.ChangeFeeWaived
+	}
+	return feeWaived, nil
+}
+
+// CheckSeatSelection reports a *FareRuleError when the fare family of ticket does not
+// include choosing a seat. Tickets of an unknown family follow the Classic rules.
+func (c FareFamilyCatalog) CheckSeatSelection(ticket Ticket) error {
+	family, ok := c.Find(ticket.FlightClass, ticket.FareFamily)
+	if ok && !family.SeatSelection {
+		return &FareRuleError{TicketID: ticket.TicketID, Family: family.Name, Reason: "seat selection is not included"}
+	}
+	return nil
+}
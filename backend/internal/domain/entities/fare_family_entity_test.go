@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFareFamilies = FareFamilyCatalog{
+	{FlightClass: FlightClassEconomy, Code: FareFamilyLite, Name: "Economy Lite", FarePercent: 85},
+	{FlightClass: FlightClassEconomy, Code: FareFamilyClassic, Name: "Economy Classic", FarePercent: 100, Changeable: true, Refundable: true, SeatSelection: true},
+	{FlightClass: FlightClassEconomy, Code: FareFamilyFlex, Name: "Economy Flex", FarePercent: 130, Changeable: true, ChangeFeeWaived: true, Refundable: true, FullRefund: true, SeatSelection: true},
+}
+
+func TestFareFamilyRefundAmount(t *testing.T) {
+	policy := RefundPolicy{
+		FullRefundBefore:     7 * 24 * time.Hour,
+		PartialRefundBefore:  24 * time.Hour,
+		PartialRefundPercent: map[FlightClass]int{FlightClassEconomy: 50},
+	}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	lite, _ := testFareFamilies.Find(FlightClassEconomy, FareFamilyLite)
+	classic, _ := testFareFamilies.Find(FlightClassEconomy, FareFamilyClassic)
+	flex, _ := testFareFamilies.Find(FlightClassEconomy, FareFamilyFlex)
+
+	assert.Equal(t, int64(0), lite.RefundAmount(policy, 1000, now.Add(30*24*time.Hour), now))
+	assert.Equal(t, int64(500), classic.RefundAmount(policy, 1000, now.Add(48*time.Hour), now))
+	assert.Equal(t, int64(1000), flex.RefundAmount(policy, 1000, now.Add(time.Hour), now))
+	assert.Equal(t, int64(0), flex.RefundAmount(policy, 1000, now.Add(-time.Hour), now))
+}
+
+func TestFareFamilyAncillaryRefundAmount(t *testing.T) {
+	policy := RefundPolicy{
+		FullRefundBefore:     7 * 24 * time.Hour,
+		PartialRefundBefore:  24 * time.Hour,
+		PartialRefundPercent: map[FlightClass]int{FlightClassEconomy: 50},
+	}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	lite, _ := testFareFamilies.Find(FlightClassEconomy, FareFamilyLite)
+	classic, _ := testFareFamilies.Find(FlightClassEconomy, FareFamilyClassic)
+
+	// Phí chọn ghế được hoàn như tiền vé
+	assert.Equal(t, int64(100), classic.AncillaryRefundAmount(policy, AncillaryTypeSeat, 200, now.Add(48*time.Hour), now))
+	assert.Equal(t, int64(0), lite.AncillaryRefundAmount(policy, AncillaryTypeSeat, 200, now.Add(30*24*time.Hour), now))
+
+	// Dịch vụ khác được hoàn toàn bộ trước giờ khởi hành, kể cả với gói không hoàn vé
+	assert.Equal(t, int64(300), lite.AncillaryRefundAmount(policy, AncillaryTypeBaggage, 300, now.Add(time.Hour), now))
+	assert.Equal(t, int64(0), classic.AncillaryRefundAmount(policy, AncillaryTypeBaggage, 300, now.Add(-time.Hour), now))
+}
+
+func TestFareFamilyCatalogCheckChange(t *testing.T) {
+	feeWaived, err := testFareFamilies.CheckChange([]Ticket{
+		{TicketID: 1, FlightClass: FlightClassEconomy, FareFamily: FareFamilyFlex},
+		{TicketID: 2, FlightClass: FlightClassEconomy, FareFamily: FareFamilyFlex},
+	})
+	require.NoError(t, err)
+	assert.True(t, feeWaived)
+
+	feeWaived, err = testFareFamilies.CheckChange([]Ticket{
+		{TicketID: 1, FlightClass: FlightClassEconomy, FareFamily: FareFamilyFlex},
+		{TicketID: 2, FlightClass: FlightClassEconomy, FareFamily: FareFamilyClassic},
+	})
+	require.NoError(t, err)
+	assert.False(t, feeWaived)
+
+	_, err = testFareFamilies.CheckChange([]Ticket{{TicketID: 3, FlightClass: FlightClassEconomy, FareFamily: FareFamilyLite}})
+	var fareRuleErr *FareRuleError
+	require.ErrorAs(t, err, &fareRuleErr)
+	assert.Equal(t, int64(3), fareRuleErr.TicketID)
+}
+
+func TestFareFamilyCatalogCheckSeatSelection(t *testing.T) {
+	assert.NoError(t, testFareFamilies.CheckSeatSelection(Ticket{FlightClass: FlightClassEconomy, FareFamily: FareFamilyClassic}))
+
+	var fareRuleErr *FareRuleError
+	assert.ErrorAs(t, testFareFamilies.CheckSeatSelection(Ticket{FlightClass: FlightClassEconomy, FareFamily: FareFamilyLite}), &fareRuleErr)
+}
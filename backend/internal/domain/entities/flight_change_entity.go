@@ -0,0 +1,85 @@
+package entities
+
+// FlightChangeQuote describes what moving the tickets of one booking segment to
+// another flight costs. A positive AmountDue is charged to the customer, a
+// positive RefundAmount is paid back; at most one of them is non-zero.
+type FlightChangeQuote struct {
+	Flight         Flight
+	CurrentFare    int64
+	NewFare        int64
+	ChangeFee      int64
+	FareDifference int64
+	AmountDue      int64
+	RefundAmount   int64
+	// TicketFares holds the new fare of every ticket being moved, keyed by ticket ID.
+	TicketFares map[int64]int64
+	// TicketFareItems holds the breakdown of every new fare, keyed by ticket ID.
+	TicketFareItems map[int64][]FareItem
+}
+
+// QuoteFlightChange prices moving the given tickets to flight, whose cabins currently
+// sell for fares, under pricing. Every ticket keeps its fare family on the new flight.
+func QuoteFlightChange(tickets []Ticket, flight Flight, fares map[FlightClass]int64, families FareFamilyCatalog, changeFee int64, pricing PricingRules) FlightChangeQuote {
+	quote := FlightChangeQuote{
+		Flight:          flight,
+		ChangeFee:       changeFee,
+		TicketFares:     make(map[int64]int64, len(tickets)),
+		TicketFareItems: make(map[int64][]FareItem, len(tickets)),
+	}
+	for _, ticket := range tickets {
+		cabinFare, ok := fares[ticket.FlightClass]
+		if !ok {
+			cabinFare = pricing.Cabins.Fare(flight, ticket.FlightClass)
+		}
+		if family, ok := families.Find(ticket.FlightClass, ticket.FareFamily); ok {
+			cabinFare = family.Fare(cabinFare)
+		}
+		breakdown := pricing.PriceTicket(cabinFare, ticket.PassengerType)
+		quote.CurrentFare += int64(ticket.Price)
+		quote.NewFare += breakdown.Total
+		quote.TicketFares[ticket.TicketID] = breakdown.Total
+		quote.TicketFareItems[ticket.TicketID] = breakdown.Items
+	}
+	quote.FareDifference = quote.NewFare - quote.CurrentFare
+
+	total := quote.FareDifference + changeFee
+	if total > 0 {
+		quote.AmountDue = total
+	} else {
+		quote.RefundAmount = -total
+	}
+	return quote
+}
+
+// FlightChangeStatus is where a flight change stands: a change with an amount due waits
+// for its payment, the others complete straight away.
+type FlightChangeStatus string
+
+const (
+	FlightChangeStatusPending   FlightChangeStatus = "pending"
+	FlightChangeStatusCompleted FlightChangeStatus = "completed"
+	// FlightChangeStatusFailed là yêu cầu không thực hiện được: thanh toán thất bại hoặc booking đã thay đổi
+	FlightChangeStatusFailed FlightChangeStatus = "failed"
+)
+
+type ChangeFlightParams struct {
+	BookingID    int64
+	FromFlightID int64
+	ToFlightID   int64
+	Actor        string
+	// RequesterEmail, khi khác rỗng, phải trùng với email của booking
+	RequesterEmail string
+	Quote          FlightChangeQuote
+}
+
+// ChangeFlightResult is the outcome of a flight change. While Status is pending the
+// tickets have not moved yet and the customer pays with PaymentClientSecret.
+type ChangeFlightResult struct {
+	FlightChangeID      int64
+	Status              FlightChangeStatus
+	Booking             Booking
+	Tickets             []Ticket
+	Quote               FlightChangeQuote
+	PaymentClientSecret string
+	Refund              *Refund
+}
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testCabinFares = CabinFares{
+	FlightClassEconomy:    100,
+	FlightClassBusiness:   150,
+	FlightClassFirstClass: 200,
+}
+
+func TestCabinFaresFare(t *testing.T) {
+	flight := Flight{BasePrice: 1000}
+
+	assert.Equal(t, int64(1000), testCabinFares.Fare(flight, FlightClassEconomy))
+	assert.Equal(t, int64(1500), testCabinFares.Fare(flight, FlightClassBusiness))
+	assert.Equal(t, int64(2000), testCabinFares.Fare(flight, FlightClassFirstClass))
+	assert.Equal(t, int64(1000), testCabinFares.Fare(flight, FlightClass("unknown")))
+}
+
+func TestQuoteFlightChange(t *testing.T) {
+	tickets := []Ticket{
+		{TicketID: 1, FlightClass: FlightClassEconomy, Price: 1000},
+		{TicketID: 2, FlightClass: FlightClassBusiness, Price: 1500},
+	}
+
+	t.Run("more expensive flight", func(t *testing.T) {
+		quote := QuoteFlightChange(tickets, Flight{FlightID: 9, BasePrice: 1200}, nil, nil, 100, PricingRules{Cabins: testCabinFares})
+		assert.Equal(t, int64(2500), quote.CurrentFare)
+		assert.Equal(t, int64(3000), quote.NewFare)
+		assert.Equal(t, int64(500), quote.FareDifference)
+		assert.Equal(t, int64(600), quote.AmountDue)
+		assert.Zero(t, quote.RefundAmount)
+		assert.Equal(t, map[int64]int64{1: 1200, 2: 1800}, quote.TicketFares)
+	})
+
+	t.Run("cheaper flight", func(t *testing.T) {
+		quote := QuoteFlightChange(tickets, Flight{FlightID: 9, BasePrice: 800}, nil, nil, 100, PricingRules{Cabins: testCabinFares})
+		assert.Equal(t, int64(-500), quote.FareDifference)
+		assert.Zero(t, quote.AmountDue)
+		assert.Equal(t, int64(400), quote.RefundAmount)
+	})
+
+	t.Run("fee covers the difference", func(t *testing.T) {
+		quote := QuoteFlightChange(tickets, Flight{FlightID: 9, BasePrice: 960}, nil, nil, 100, PricingRules{Cabins: testCabinFares})
+		assert.Zero(t, quote.AmountDue)
+		assert.Zero(t, quote.RefundAmount)
+	})
+}
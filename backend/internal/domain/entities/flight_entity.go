@@ -23,6 +23,8 @@ type Flight struct {
 	ArrivalCity      string       `json:"arrival_city"`
 	DepartureAirport string       `json:"departure_airport"`
 	ArrivalAirport   string       `json:"arrival_airport"`
+	DepartureCountry string       `json:"departure_country"`
+	ArrivalCountry   string       `json:"arrival_country"`
 	DepartureTime    time.Time    `json:"departure_time"`
 	ArrivalTime      time.Time    `json:"arrival_time"`
 	BasePrice        int32        `json:"base_price"`
@@ -0,0 +1,242 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type GroupBookingStatus string
+
+const (
+	// GroupBookingStatusRequested là yêu cầu khách vừa gửi, chưa được báo giá
+	GroupBookingStatusRequested   GroupBookingStatus = "requested"
+	GroupBookingStatusQuoted      GroupBookingStatus = "quoted"
+	GroupBookingStatusDepositPaid GroupBookingStatus = "deposit_paid"
+	// GroupBookingStatusReleased là đoàn không đặt cọc kịp hạn, chỗ đã giữ được trả lại
+	GroupBookingStatusReleased GroupBookingStatus = "released"
+	// GroupBookingStatusTicketed là đoàn đã được xuất vé thành một booking; chỗ giữ đã chuyển thành vé
+	GroupBookingStatusTicketed GroupBookingStatus = "ticketed"
+)
+
+// ErrInvalidGroupBooking is returned when a group request or quote is malformed.
+var ErrInvalidGroupBooking = errors.New("invalid group booking")
+
+// groupDateLayout is the layout of the travel dates of a group request.
+const groupDateLayout = "2006-01-02"
+
+// GroupBooking is a request to fly a group of passengers together. An admin answers it
+// with a quote that blocks seats on the chosen flights until the deposit deadline;
+// passenger names can be given up to the name cutoff. Once the deposit is paid and every
+// passenger named, the group is ticketed as a booking.
+type GroupBooking struct {
+	GroupBookingID int64              `json:"group_booking_id"`
+	UserEmail      string             `json:"user_email"`
+	DepartureCity  string             `json:"departure_city"`
+	ArrivalCity    string             `json:"arrival_city"`
+	DepartureDate  time.Time          `json:"departure_date"`
+	ReturnDate     *time.Time         `json:"return_date,omitempty"`
+	FlightClass    FlightClass        `json:"flight_class"`
+	Headcount      int32              `json:"headcount"`
+	Note           string             `json:"note"`
+	Status         GroupBookingStatus `json:"status"`
+	// Các trường dưới đây chỉ có sau khi admin báo giá
+	OutboundFlightID int64         `json:"outbound_flight_id,omitempty"`
+	ReturnFlightID   int64         `json:"return_flight_id,omitempty"`
+	FarePerPassenger int64         `json:"fare_per_passenger"`
+	DepositAmount    int64         `json:"deposit_amount"`
+	DepositDeadline  *time.Time    `json:"deposit_deadline,omitempty"`
+	NameCutoff       *time.Time    `json:"name_cutoff,omitempty"`
+	DepositPaidAt    *time.Time    `json:"deposit_paid_at,omitempty"`
+	BookingID        int64         `json:"booking_id,omitempty"`
+	Passengers       []TicketOwner `json:"passengers"`
+	CreatedAt        time.Time     `json:"created_at"`
+	UpdatedAt        time.Time     `json:"updated_at"`
+}
+
+// ValidateRequest checks a new request for at least minPassengers travelling on future dates.
+func (g GroupBooking) ValidateRequest(minPassengers int32, now time.Time) error {
+	today := now.Format(groupDateLayout)
+	switch {
+	case g.DepartureCity == "" || g.ArrivalCity == "":
+		return fmt.Errorf("%w: departure and arrival cities are required", ErrInvalidGroupBooking)
+	case g.DepartureCity == g.ArrivalCity:
+		return fmt.Errorf("%w: departure and arrival cities must differ", ErrInvalidGroupBooking)
+	case !g.FlightClass.Valid():
+		return fmt.Errorf("%w: unknown cabin class %q", ErrInvalidGroupBooking, g.FlightClass)
+	case g.Headcount < minPassengers:
+		return fmt.Errorf("%w: a group needs at least %d passengers", ErrInvalidGroupBooking, minPassengers)
+	case g.DepartureDate.Format(groupDateLayout) <= today:
+		return fmt.Errorf("%w: departure date must be in the future", ErrInvalidGroupBooking)
+	case g.ReturnDate != nil && g.ReturnDate.Before(g.DepartureDate):
+		return fmt.Errorf("%w: return date must not be before the departure date", ErrInvalidGroupBooking)
+	}
+	return nil
+}
+
+// TotalFare is the group fare for every passenger of the group.
+func (g GroupBooking) TotalFare() int64 {
+	return g.FarePerPassenger * int64(g.Headcount)
+}
+
+// HoldsSeats reports whether the group's seats are blocked at now.
+func (g GroupBooking) HoldsSeats(now time.Time) bool {
+	switch g.Status {
+	case GroupBookingStatusDepositPaid:
+		return true
+	case GroupBookingStatusQuoted:
+		return g.DepositDeadline != nil && g.DepositDeadline.After(now)
+	}
+	return false
+}
+
+// GroupQuote is the offer an admin makes for a group request.
+type GroupQuote struct {
+	OutboundFlightID int64
+	ReturnFlightID   int64
+	FarePerPassenger int64
+	DepositAmount    int64
+	DepositDeadline  time.Time
+	NameCutoff       time.Time
+}
+
+// CheckQuote validates quote against the request. outbound and inbound are the quoted
+// flights; inbound is nil for a one-way group.
+func (g GroupBooking) CheckQuote(quote GroupQuote, outbound Flight, inbound *Flight, now time.Time) error {
+	if g.Status != GroupBookingStatusRequested && g.Status != GroupBookingStatusQuoted {
+		return &GroupBookingError{Reason: fmt.Sprintf("a %s group cannot be quoted", g.Status)}
+	}
+	if err := checkGroupFlight(outbound, g.DepartureCity, g.ArrivalCity, g.DepartureDate); err != nil {
+		return err
+	}
+	switch {
+	case g.ReturnDate == nil && inbound != nil:
+		return fmt.Errorf("%w: the group travels one way", ErrInvalidGroupBooking)
+	case g.ReturnDate != nil && inbound == nil:
+		return fmt.Errorf("%w: a return flight is required", ErrInvalidGroupBooking)
+	case inbound != nil:
+		if err := checkGroupFlight(*inbound, g.ArrivalCity, g.DepartureCity, *g.ReturnDate); err != nil {
+			return err
+		}
+	}
+
+	total := quote.FarePerPassenger * int64(g.Headcount)
+	switch {
+	case quote.FarePerPassenger <= 0:
+		return fmt.Errorf("%w: the group fare must be positive", ErrInvalidGroupBooking)
+	case quote.DepositAmount <= 0 || quote.DepositAmount > total:
+		return fmt.Errorf("%w: the deposit must be positive and at most the total fare", ErrInvalidGroupBooking)
+	case !quote.DepositDeadline.After(now):
+		return fmt.Errorf("%w: the deposit deadline must be in the future", ErrInvalidGroupBooking)
+	case quote.NameCutoff.Before(quote.DepositDeadline):
+		return fmt.Errorf("%w: the name cutoff must not be before the deposit deadline", ErrInvalidGroupBooking)
+	case !quote.NameCutoff.Before(outbound.DepartureTime):
+		return fmt.Errorf("%w: the name cutoff must be before departure", ErrInvalidGroupBooking)
+	}
+	return nil
+}
+
+// checkGroupFlight checks that flight flies the requested leg on the requested date.
+func checkGroupFlight(flight Flight, from, to string, date time.Time) error {
+	switch {
+	case flight.Status == FlightCanceledStatus:
+		return fmt.Errorf("%w: flight %s is cancelled", ErrInvalidGroupBooking, flight.FlightNumber)
+	case flight.DepartureCity != from || flight.ArrivalCity != to:
+		return fmt.Errorf("%w: flight %s does not fly %s - %s", ErrInvalidGroupBooking, flight.FlightNumber, from, to)
+	case flight.DepartureTime.Format(groupDateLayout) != date.Format(groupDateLayout):
+		return fmt.Errorf("%w: flight %s does not depart on %s", ErrInvalidGroupBooking, flight.FlightNumber, date.Format(groupDateLayout))
+	}
+	return nil
+}
+
+// CheckDepositPayable returns a *GroupBookingError unless the deposit can be paid at now.
+func (g GroupBooking) CheckDepositPayable(now time.Time) error {
+	switch {
+	case g.Status == GroupBookingStatusDepositPaid:
+		return &GroupBookingError{Reason: "the deposit has already been paid"}
+	case g.Status != GroupBookingStatusQuoted:
+		return &GroupBookingError{Reason: fmt.Sprintf("a %s group has no deposit to pay", g.Status)}
+	case !g.HoldsSeats(now):
+		return &GroupBookingError{Reason: "the deposit deadline has passed"}
+	}
+	return nil
+}
+
+// CheckPassengers returns a *GroupBookingError unless passengers can be named on the
+// group at now: the seats must still be held, the name cutoff not passed and the list
+// no longer than the headcount.
+func (g GroupBooking) CheckPassengers(passengers []TicketOwner, now time.Time) error {
+	switch {
+	case !g.HoldsSeats(now):
+		return &GroupBookingError{Reason: "the group no longer holds seats"}
+	case g.NameCutoff == nil || !g.NameCutoff.After(now):
+		return &GroupBookingError{Reason: "the name cutoff has passed"}
+	case len(passengers) > int(g.Headcount):
+		return &GroupBookingError{Reason: fmt.Sprintf("the group has %d seats", g.Headcount)}
+	}
+	for i, passenger := range passengers {
+		if passenger.FirstName == "" || passenger.LastName == "" {
+			return &GroupBookingError{Reason: fmt.Sprintf("passenger %d: first and last names are required", i+1)}
+		}
+		// Mỗi khách của đoàn giữ một ghế, em bé ngồi cùng người lớn nên không thể nhận chỗ của đoàn
+		if !passenger.DateOfBirth.IsZero() && PassengerTypeAt(passenger.DateOfBirth, g.DepartureDate) == PassengerTypeInfant {
+			return &GroupBookingError{Reason: fmt.Sprintf("passenger %d: an infant travels on an adult's lap and cannot hold a group seat", i+1)}
+		}
+	}
+	return nil
+}
+
+// CheckTicketing returns a *GroupBookingError unless the group can be ticketed: its
+// deposit must be paid and every passenger named.
+func (g GroupBooking) CheckTicketing() error {
+	switch {
+	case g.Status == GroupBookingStatusTicketed:
+		return &GroupBookingError{Reason: "the group has already been ticketed"}
+	case g.Status != GroupBookingStatusDepositPaid:
+		return &GroupBookingError{Reason: fmt.Sprintf("a %s group cannot be ticketed", g.Status)}
+	case len(g.Passengers) != int(g.Headcount):
+		return &GroupBookingError{Reason: fmt.Sprintf("all %d passengers must be named before ticketing", g.Headcount)}
+	}
+	return nil
+}
+
+// SegmentFare returns the share of the group fare charged on flight segment (0-based)
+// of segments. The first segment carries what does not divide evenly.
+func (g GroupBooking) SegmentFare(segment int, segments int) int64 {
+	share := g.FarePerPassenger / int64(segments)
+	if segment == 0 {
+		share += g.FarePerPassenger % int64(segments)
+	}
+	return share
+}
+
+// GroupBookingError is returned when a group booking cannot move on in its current state.
+type GroupBookingError struct {
+	Reason string
+}
+
+func (e *GroupBookingError) Error() string {
+	return "group booking: " + e.Reason
+}
+
+// GroupDepositResult is the group with the payment intent collecting its deposit.
+type GroupDepositResult struct {
+	GroupBooking        GroupBooking
+	PaymentClientSecret string
+}
+
+// GroupDepositPaymentResult is the outcome of capturing a group deposit. Paid tells
+// whether this payment paid the deposit; otherwise what was captured is paid back.
+type GroupDepositPaymentResult struct {
+	GroupBooking GroupBooking
+	Payment      Payment
+	Paid         bool
+}
+
+// GroupTicketingResult is a group turned into a booking, with what is left to pay on
+// the booking once the deposit is deducted.
+type GroupTicketingResult struct {
+	GroupBooking GroupBooking
+	Booking      Booking
+	AmountDue    int64
+}
@@ -0,0 +1,131 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testGroupBooking(now time.Time) GroupBooking {
+	returnDate := now.AddDate(0, 1, 7)
+	return GroupBooking{
+		GroupBookingID: 1,
+		DepartureCity:  "Hà Nội",
+		ArrivalCity:    "Đà Nẵng",
+		DepartureDate:  now.AddDate(0, 1, 0),
+		ReturnDate:     &returnDate,
+		FlightClass:    FlightClassEconomy,
+		Headcount:      12,
+		Status:         GroupBookingStatusRequested,
+	}
+}
+
+func TestGroupBookingValidateRequest(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	assert.NoError(t, testGroupBooking(now).ValidateRequest(10, now))
+
+	small := testGroupBooking(now)
+	small.Headcount = 9
+	assert.ErrorIs(t, small.ValidateRequest(10, now), ErrInvalidGroupBooking)
+
+	today := testGroupBooking(now)
+	today.DepartureDate = now
+	assert.ErrorIs(t, today.ValidateRequest(10, now), ErrInvalidGroupBooking)
+
+	reversed := testGroupBooking(now)
+	earlier := now.AddDate(0, 0, 20)
+	reversed.ReturnDate = &earlier
+	assert.ErrorIs(t, reversed.ValidateRequest(10, now), ErrInvalidGroupBooking)
+}
+
+func TestGroupBookingCheckQuote(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	group := testGroupBooking(now)
+	outbound := Flight{FlightID: 1, DepartureCity: "Hà Nội", ArrivalCity: "Đà Nẵng", DepartureTime: group.DepartureDate.Add(8 * time.Hour)}
+	inbound := Flight{FlightID: 2, DepartureCity: "Đà Nẵng", ArrivalCity: "Hà Nội", DepartureTime: group.ReturnDate.Add(6 * time.Hour)}
+	quote := GroupQuote{
+		OutboundFlightID: 1,
+		ReturnFlightID:   2,
+		FarePerPassenger: 1200000,
+		DepositAmount:    3000000,
+		DepositDeadline:  now.AddDate(0, 0, 7),
+		NameCutoff:       now.AddDate(0, 0, 21),
+	}
+	require.NoError(t, group.CheckQuote(quote, outbound, &inbound, now))
+
+	// Chuyến về phải đi ngược chiều và đúng ngày về
+	assert.ErrorIs(t, group.CheckQuote(quote, outbound, &outbound, now), ErrInvalidGroupBooking)
+	assert.ErrorIs(t, group.CheckQuote(quote, outbound, nil, now), ErrInvalidGroupBooking)
+
+	late := quote
+	late.NameCutoff = outbound.DepartureTime
+	assert.ErrorIs(t, group.CheckQuote(late, outbound, &inbound, now), ErrInvalidGroupBooking)
+
+	tooMuch := quote
+	tooMuch.DepositAmount = quote.FarePerPassenger*int64(group.Headcount) + 1
+	assert.ErrorIs(t, group.CheckQuote(tooMuch, outbound, &inbound, now), ErrInvalidGroupBooking)
+
+	var groupErr *GroupBookingError
+	group.Status = GroupBookingStatusReleased
+	assert.ErrorAs(t, group.CheckQuote(quote, outbound, &inbound, now), &groupErr)
+}
+
+func TestGroupBookingDepositAndNames(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	deadline := now.Add(48 * time.Hour)
+	cutoff := now.AddDate(0, 0, 20)
+	group := testGroupBooking(now)
+	group.Status = GroupBookingStatusQuoted
+	group.DepositDeadline = &deadline
+	group.NameCutoff = &cutoff
+
+	assert.True(t, group.HoldsSeats(now))
+	assert.NoError(t, group.CheckDepositPayable(now))
+	assert.NoError(t, group.CheckPassengers([]TicketOwner{{FirstName: "An", LastName: "Nguyễn"}}, now))
+
+	// Quá hạn cọc thì chỗ không còn được giữ
+	var groupErr *GroupBookingError
+	after := deadline.Add(time.Minute)
+	assert.False(t, group.HoldsSeats(after))
+	assert.ErrorAs(t, group.CheckDepositPayable(after), &groupErr)
+	assert.ErrorAs(t, group.CheckPassengers(nil, after), &groupErr)
+
+	// Đã cọc thì được đặt tên đến hạn chốt tên
+	group.Status = GroupBookingStatusDepositPaid
+	assert.True(t, group.HoldsSeats(after))
+	assert.NoError(t, group.CheckPassengers(nil, after))
+	assert.ErrorAs(t, group.CheckPassengers(nil, cutoff), &groupErr)
+	assert.ErrorAs(t, group.CheckPassengers(make([]TicketOwner, 13), after), &groupErr)
+	assert.ErrorAs(t, group.CheckPassengers([]TicketOwner{{FirstName: "An"}}, after), &groupErr)
+
+	// Em bé không thể nhận một ghế của đoàn
+	infant := TicketOwner{FirstName: "Bình", LastName: "Nguyễn", DateOfBirth: group.DepartureDate.AddDate(-1, 0, 0)}
+	assert.ErrorAs(t, group.CheckPassengers([]TicketOwner{infant}, after), &groupErr)
+}
+
+func TestGroupBookingTicketing(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	group := testGroupBooking(now)
+	group.Headcount = 2
+	group.Status = GroupBookingStatusDepositPaid
+	group.Passengers = []TicketOwner{{FirstName: "An", LastName: "Nguyễn"}}
+
+	// Phải đặt tên đủ cả đoàn mới xuất vé
+	var groupErr *GroupBookingError
+	assert.ErrorAs(t, group.CheckTicketing(), &groupErr)
+	group.Passengers = append(group.Passengers, TicketOwner{FirstName: "Bình", LastName: "Trần"})
+	assert.NoError(t, group.CheckTicketing())
+
+	group.Status = GroupBookingStatusQuoted
+	assert.ErrorAs(t, group.CheckTicketing(), &groupErr)
+	group.Status = GroupBookingStatusTicketed
+	assert.ErrorAs(t, group.CheckTicketing(), &groupErr)
+
+	// Chặng đầu nhận phần lẻ của giá đoàn
+	group.FarePerPassenger = 1000001
+	assert.Equal(t, int64(500001), group.SegmentFare(0, 2))
+	assert.Equal(t, int64(500000), group.SegmentFare(1, 2))
+	assert.Equal(t, int64(1000001), group.SegmentFare(0, 1))
+}
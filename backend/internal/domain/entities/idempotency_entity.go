@@ -0,0 +1,11 @@
+package entities
+
+// IdempotencyRecord is what is remembered about a request sent with an Idempotency-Key.
+// Completed is false while the first request is still being processed.
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
@@ -0,0 +1,83 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxItinerarySegments caps how many flights a single booking may contain.
+const MaxItinerarySegments = 6
+
+// BookingSegment is one leg of a booking's itinerary. SegmentOrder starts at 1
+// and follows the order in which the flights are travelled.
+type BookingSegment struct {
+	SegmentOrder int      `json:"segment_order"`
+	FlightID     int64    `json:"flight_id"`
+	Tickets      []Ticket `json:"tickets,omitempty"`
+}
+
+// ItineraryError explains why a list of flights cannot be booked together.
+// Segment is the 1-based position of the offending flight, or 0 when the
+// itinerary as a whole is invalid.
+type ItineraryError struct {
+	Segment int
+	Reason  string
+}
+
+func (e *ItineraryError) Error() string {
+	if e.Segment == 0 {
+		return fmt.Sprintf("invalid itinerary: %s", e.Reason)
+	}
+	return fmt.Sprintf("invalid itinerary segment %d: %s", e.Segment, e.Reason)
+}
+
+// ValidateItinerary checks that flights, in travel order, form a bookable itinerary
+// for tripType: the segment count matches the trip type, no flight is repeated, no
+// flight is cancelled or already gone, and every flight departs at least
+// minConnection after the previous one lands.
+func ValidateItinerary(tripType TripType, flights []Flight, minConnection time.Duration, now time.Time) error {
+	switch tripType {
+	case OneWayTrip:
+		if len(flights) != 1 {
+			return &ItineraryError{Reason: "a one-way trip has exactly one flight"}
+		}
+	case RoundTrip:
+		if len(flights) != 2 {
+			return &ItineraryError{Reason: "a round trip has exactly two flights"}
+		}
+	case MultiCityTrip:
+		if len(flights) < 2 || len(flights) > MaxItinerarySegments {
+			return &ItineraryError{Reason: fmt.Sprintf("a multi-city trip has between 2 and %d flights", MaxItinerarySegments)}
+		}
+	default:
+		return &ItineraryError{Reason: fmt.Sprintf("unknown trip type %q", tripType)}
+	}
+
+	seen := make(map[int64]bool, len(flights))
+	for i, flight := range flights {
+		segment := i + 1
+		if seen[flight.FlightID] {
+			return &ItineraryError{Segment: segment, Reason: "flight is already part of the itinerary"}
+		}
+		seen[flight.FlightID] = true
+
+		if flight.Status == FlightCanceledStatus {
+			return &ItineraryError{Segment: segment, Reason: "flight is cancelled"}
+		}
+		if !flight.DepartureTime.After(now) {
+			return &ItineraryError{Segment: segment, Reason: "flight has already departed"}
+		}
+		if i == 0 {
+			continue
+		}
+
+		earliest := flights[i-1].ArrivalTime.Add(minConnection)
+		if flight.DepartureTime.Before(earliest) {
+			if !flight.DepartureTime.After(flights[i-1].DepartureTime) {
+				return &ItineraryError{Segment: segment, Reason: "flights are not in chronological order"}
+			}
+			return &ItineraryError{Segment: segment, Reason: fmt.Sprintf("connection is shorter than %s", minConnection)}
+		}
+	}
+	return nil
+}
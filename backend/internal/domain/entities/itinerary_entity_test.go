@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateItinerary(t *testing.T) {
+	now := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	flight := func(id int64, departIn, duration time.Duration) Flight {
+		return Flight{
+			FlightID:      id,
+			DepartureTime: now.Add(departIn),
+			ArrivalTime:   now.Add(departIn + duration),
+			Status:        FlightOnTimeStatus,
+		}
+	}
+	hanSgn := flight(1, 24*time.Hour, 2*time.Hour)
+	sgnDad := flight(2, 27*time.Hour, time.Hour)
+	dadHan := flight(3, 72*time.Hour, time.Hour)
+	tightConnection := flight(4, 26*time.Hour+30*time.Minute, time.Hour)
+	cancelled := flight(5, 96*time.Hour, time.Hour)
+	cancelled.Status = FlightCanceledStatus
+	departed := flight(6, -time.Hour, time.Hour)
+
+	tests := []struct {
+		name     string
+		tripType TripType
+		flights  []Flight
+		segment  int
+	}{
+		{"one way", OneWayTrip, []Flight{hanSgn}, -1},
+		{"round trip", RoundTrip, []Flight{hanSgn, dadHan}, -1},
+		{"multi city", MultiCityTrip, []Flight{hanSgn, sgnDad, dadHan}, -1},
+		{"one way with two flights", OneWayTrip, []Flight{hanSgn, dadHan}, 0},
+		{"multi city with one flight", MultiCityTrip, []Flight{hanSgn}, 0},
+		{"too many segments", MultiCityTrip, make([]Flight, MaxItinerarySegments+1), 0},
+		{"unknown trip type", TripType("openJaw"), []Flight{hanSgn}, 0},
+		{"repeated flight", MultiCityTrip, []Flight{hanSgn, sgnDad, hanSgn}, 3},
+		{"out of order", MultiCityTrip, []Flight{dadHan, hanSgn}, 2},
+		{"short connection", MultiCityTrip, []Flight{hanSgn, tightConnection}, 2},
+		{"cancelled flight", MultiCityTrip, []Flight{hanSgn, cancelled}, 2},
+		{"departed flight", OneWayTrip, []Flight{departed}, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateItinerary(test.tripType, test.flights, 45*time.Minute, now)
+			if test.segment < 0 {
+				require.NoError(t, err)
+				return
+			}
+			var itineraryErr *ItineraryError
+			require.True(t, errors.As(err, &itineraryErr), "expected ItineraryError, got %v", err)
+			assert.Equal(t, test.segment, itineraryErr.Segment)
+		})
+	}
+}
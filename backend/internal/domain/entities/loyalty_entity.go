@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+type LoyaltyTransactionKind string
+
+const (
+	// LoyaltyAccrual là điểm cộng khi chuyến bay của vé hạ cánh, có hạn dùng
+	LoyaltyAccrual LoyaltyTransactionKind = "accrual"
+	// LoyaltyRedemption là điểm dùng để thanh toán booking
+	LoyaltyRedemption LoyaltyTransactionKind = "redemption"
+	// LoyaltyReversal là điểm bị thu hồi khi vé đã cộng điểm bị huỷ
+	LoyaltyReversal LoyaltyTransactionKind = "reversal"
+	// LoyaltyExpiry là điểm hết hạn chưa dùng
+	LoyaltyExpiry LoyaltyTransactionKind = "expiry"
+	// LoyaltyRefund là điểm đã dùng được trả lại khi booking bị huỷ
+	LoyaltyRefund LoyaltyTransactionKind = "refund"
+)
+
+// LoyaltyTransaction is one line of a customer's points statement. Points is
+// positive for credits and negative for debits.
+type LoyaltyTransaction struct {
+	TransactionID int64                  `json:"transaction_id"`
+	Kind          LoyaltyTransactionKind `json:"kind"`
+	Points        int32                  `json:"points"`
+	// Amount là số tiền tương ứng khi dùng điểm thanh toán
+	Amount      int64      `json:"amount"`
+	TicketID    int64      `json:"ticket_id"`
+	BookingID   int64      `json:"booking_id"`
+	Description string     `json:"description"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// LoyaltyRules sets how points are earned on the fare paid and what they are worth.
+type LoyaltyRules struct {
+	// AmountPerPoint là số tiền vé cần trả để được 1 điểm
+	AmountPerPoint int64
+	// PointValue là số tiền 1 điểm được trừ khi thanh toán
+	PointValue int64
+	// PointsTTL là thời hạn dùng của điểm kể từ ngày cộng
+	PointsTTL time.Duration
+}
+
+// EarnedPoints returns the points a ticket earns on its fare.
+func (r LoyaltyRules) EarnedPoints(fare int64) int32 {
+	if r.AmountPerPoint <= 0 || fare <= 0 {
+		return 0
+	}
+	return int32(fare / r.AmountPerPoint)
+}
+
+// CheckRedemption validates redeeming points against balance and the amount still due on
+// the booking, and returns the amount the points pay.
+func (r LoyaltyRules) CheckRedemption(points int32, balance int32, amountDue int64) (int64, error) {
+	if points <= 0 {
+		return 0, &LoyaltyError{Reason: "points to redeem must be positive"}
+	}
+	if points > balance {
+		return 0, &LoyaltyError{Reason: fmt.Sprintf("only %d points are available", balance)}
+	}
+	amount := int64(points) * r.PointValue
+	if amount > amountDue {
+		return 0, &LoyaltyError{Reason: fmt.Sprintf("the points are worth %d but only %d is due on the booking", amount, amountDue)}
+	}
+	return amount, nil
+}
+
+// LoyaltyAccount is the points balance of a customer and what it can pay.
+type LoyaltyAccount struct {
+	Points int32 `json:"points"`
+	Value  int64 `json:"value"`
+}
+
+// LoyaltyStatement is a page of a customer's transactions, newest first.
+type LoyaltyStatement struct {
+	Transactions []LoyaltyTransaction `json:"transactions"`
+	Total        int64                `json:"total"`
+}
+
+// RedeemLoyaltyParams asks to pay part of a booking with points.
+type RedeemLoyaltyParams struct {
+	UserID     int64
+	BookingID  int64
+	Points     int32
+	PointValue int64
+	// AmountDue là số tiền booking phải trả trước khi dùng điểm
+	AmountDue int64
+}
+
+// RedeemLoyaltyResult is the redemption and what is left to pay.
+type RedeemLoyaltyResult struct {
+	Transaction LoyaltyTransaction `json:"transaction"`
+	Balance     int32              `json:"balance"`
+	AmountDue   int64              `json:"amount_due"`
+	// Booking là booking sau khi thanh toán; được xác nhận khi điểm trả hết số tiền còn lại
+	Booking Booking `json:"booking"`
+	// Confirmed là true nếu chính lần trả này xác nhận booking
+	Confirmed bool `json:"confirmed"`
+}
+
+// LoyaltyError is returned when points cannot be redeemed.
+type LoyaltyError struct {
+	Reason string
+}
+
+func (e *LoyaltyError) Error() string {
+	return "loyalty points: " + e.Reason
+}
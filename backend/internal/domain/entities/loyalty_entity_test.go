@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoyaltyRulesEarnedPoints(t *testing.T) {
+	rules := LoyaltyRules{AmountPerPoint: 10000, PointValue: 100}
+	assert.Equal(t, int32(123), rules.EarnedPoints(1234567))
+	assert.Equal(t, int32(0), rules.EarnedPoints(9999))
+	assert.Equal(t, int32(0), LoyaltyRules{}.EarnedPoints(1000000))
+}
+
+func TestLoyaltyRulesCheckRedemption(t *testing.T) {
+	rules := LoyaltyRules{AmountPerPoint: 10000, PointValue: 100}
+
+	amount, err := rules.CheckRedemption(500, 800, 100000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50000), amount)
+
+	var loyaltyErr *LoyaltyError
+	_, err = rules.CheckRedemption(900, 800, 1000000)
+	assert.ErrorAs(t, err, &loyaltyErr)
+
+	// Không dùng điểm vượt quá số tiền còn phải trả
+	_, err = rules.CheckRedemption(500, 800, 40000)
+	assert.ErrorAs(t, err, &loyaltyErr)
+
+	_, err = rules.CheckRedemption(0, 800, 40000)
+	assert.ErrorAs(t, err, &loyaltyErr)
+}
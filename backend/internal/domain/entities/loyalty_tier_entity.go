@@ -0,0 +1,161 @@
+package entities
+
+import "time"
+
+// TierPolicy sets how many qualifying points a customer needs over a rolling window to
+// hold each tier, and what each tier gives. Qualifying points are the points earned on
+// flown tickets in the window; points of cancelled tickets do not count, and redeeming
+// or letting points expire does not lower them.
+type TierPolicy struct {
+	// Window là khoảng thời gian tính điểm xét hạng, tính lùi từ thời điểm xét
+	Window         time.Duration
+	SilverPoints   int32
+	GoldPoints     int32
+	PlatinumPoints int32
+	// Số kg hành lý ký gửi cộng thêm cho từng hạng
+	SilverExtraBaggageKg   int32
+	GoldExtraBaggageKg     int32
+	PlatinumExtraBaggageKg int32
+	// Hạng thấp nhất được hưởng từng quyền lợi; để trống thì không hạng nào được hưởng
+	FreeSeatSelectionTier LoyaltyTier
+	WaitlistPriorityTier  LoyaltyTier
+	PriorityBoardingTier  LoyaltyTier
+	LoungeAccessTier      LoyaltyTier
+}
+
+// WindowStart returns the start of the qualifying window ending at now.
+func (p TierPolicy) WindowStart(now time.Time) time.Time {
+	return now.Add(-p.Window)
+}
+
+// TierFor returns the tier earned with points qualifying points.
+func (p TierPolicy) TierFor(points int32) LoyaltyTier {
+	switch {
+	case p.PlatinumPoints > 0 && points >= p.PlatinumPoints:
+		return LoyaltyTierPlatinum
+	case p.GoldPoints > 0 && points >= p.GoldPoints:
+		return LoyaltyTierGold
+	case p.SilverPoints > 0 && points >= p.SilverPoints:
+		return LoyaltyTierSilver
+	}
+	return LoyaltyTierMember
+}
+
+// threshold returns the qualifying points needed for tier; member needs none.
+func (p TierPolicy) threshold(tier LoyaltyTier) int32 {
+	switch tier {
+	case LoyaltyTierSilver:
+		return p.SilverPoints
+	case LoyaltyTierGold:
+		return p.GoldPoints
+	case LoyaltyTierPlatinum:
+		return p.PlatinumPoints
+	}
+	return 0
+}
+
+// Status describes where a customer holding tier stands with points qualifying points
+// earned in the window ending at now.
+func (p TierPolicy) Status(tier LoyaltyTier, points int32, now time.Time) TierStatus {
+	status := TierStatus{
+		Tier:             tier,
+		QualifyingPoints: points,
+		QualifiedTier:    p.TierFor(points),
+		Benefits:         p.Benefits(tier),
+		WindowStart:      p.WindowStart(now),
+	}
+	for _, next := range []LoyaltyTier{LoyaltyTierSilver, LoyaltyTierGold, LoyaltyTierPlatinum} {
+		if next.Rank() <= tier.Rank() || p.threshold(next) <= 0 {
+			continue
+		}
+		status.NextTier = next
+		status.PointsToNextTier = max(p.threshold(next)-points, 0)
+		break
+	}
+	return status
+}
+
+// TierBenefits are the perks a tier gives on every booking made from the customer's account.
+type TierBenefits struct {
+	// ExtraCheckedBaggageKg được cộng thêm vào hành lý ký gửi của gói giá
+	ExtraCheckedBaggageKg int32 `json:"extra_checked_baggage_kg"`
+	// FreeSeatSelection miễn phí chọn ghế ở các vùng ghế thu phí
+	FreeSeatSelection bool `json:"free_seat_selection"`
+	// WaitlistPriority xếp khách lên trước những người chờ không có quyền lợi này
+	WaitlistPriority bool `json:"waitlist_priority"`
+	PriorityBoarding bool `json:"priority_boarding"`
+	LoungeAccess     bool `json:"lounge_access"`
+}
+
+// Benefits returns the perks of tier under the policy.
+func (p TierPolicy) Benefits(tier LoyaltyTier) TierBenefits {
+	benefits := TierBenefits{
+		FreeSeatSelection: tier.reaches(p.FreeSeatSelectionTier),
+		WaitlistPriority:  tier.reaches(p.WaitlistPriorityTier),
+		PriorityBoarding:  tier.reaches(p.PriorityBoardingTier),
+		LoungeAccess:      tier.reaches(p.LoungeAccessTier),
+	}
+	switch tier {
+	case LoyaltyTierSilver:
+		benefits.ExtraCheckedBaggageKg = p.SilverExtraBaggageKg
+	case LoyaltyTierGold:
+		benefits.ExtraCheckedBaggageKg = p.GoldExtraBaggageKg
+	case LoyaltyTierPlatinum:
+		benefits.ExtraCheckedBaggageKg = p.PlatinumExtraBaggageKg
+	}
+	return benefits
+}
+
+// WaitlistPriorityTiers returns the tiers whose customers are offered a waitlisted seat
+// before everyone else, from the lowest tier that has the benefit up.
+func (p TierPolicy) WaitlistPriorityTiers() []LoyaltyTier {
+	var tiers []LoyaltyTier
+	for _, tier := range []LoyaltyTier{LoyaltyTierMember, LoyaltyTierSilver, LoyaltyTierGold, LoyaltyTierPlatinum} {
+		if tier.reaches(p.WaitlistPriorityTier) {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers
+}
+
+// reaches reports whether t is at least the tier lowest; an empty or unknown lowest
+// tier is reached by no one.
+func (t LoyaltyTier) reaches(lowest LoyaltyTier) bool {
+	if lowest != LoyaltyTierMember && lowest.Rank() == 0 {
+		return false
+	}
+	return t.Rank() >= lowest.Rank()
+}
+
+// ApplyToSeat waives the seat fee of selection when the tier includes free seat selection.
+func (b TierBenefits) ApplyToSeat(selection SeatSelection) SeatSelection {
+	if b.FreeSeatSelection {
+		selection.Fee = 0
+	}
+	return selection
+}
+
+// TierStatus is a customer's tier, the points that back it and what the next tier needs.
+// QualifiedTier is the tier the points earn today; Tier only follows it when tiers are
+// recalculated.
+type TierStatus struct {
+	Tier             LoyaltyTier  `json:"tier"`
+	QualifyingPoints int32        `json:"qualifying_points"`
+	QualifiedTier    LoyaltyTier  `json:"qualified_tier"`
+	NextTier         LoyaltyTier  `json:"next_tier,omitempty"`
+	PointsToNextTier int32        `json:"points_to_next_tier"`
+	Benefits         TierBenefits `json:"benefits"`
+	WindowStart      time.Time    `json:"window_start"`
+}
+
+// TierChange records a customer moving from one tier to another at a recalculation.
+type TierChange struct {
+	UserID int64
+	From   LoyaltyTier
+	To     LoyaltyTier
+}
+
+// Upgrade reports whether the change moves the customer to a higher tier.
+func (c TierChange) Upgrade() bool {
+	return c.To.Rank() > c.From.Rank()
+}
@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testTierPolicy = TierPolicy{
+	Window:                 365 * 24 * time.Hour,
+	SilverPoints:           2000,
+	GoldPoints:             5000,
+	PlatinumPoints:         10000,
+	SilverExtraBaggageKg:   10,
+	GoldExtraBaggageKg:     15,
+	PlatinumExtraBaggageKg: 20,
+	FreeSeatSelectionTier:  LoyaltyTierGold,
+	WaitlistPriorityTier:   LoyaltyTierSilver,
+	PriorityBoardingTier:   LoyaltyTierGold,
+	LoungeAccessTier:       LoyaltyTierGold,
+}
+
+func TestTierPolicyTierFor(t *testing.T) {
+	policy := testTierPolicy
+	assert.Equal(t, LoyaltyTierMember, policy.TierFor(1999))
+	assert.Equal(t, LoyaltyTierSilver, policy.TierFor(2000))
+	assert.Equal(t, LoyaltyTierGold, policy.TierFor(9999))
+	assert.Equal(t, LoyaltyTierPlatinum, policy.TierFor(12000))
+
+	// Hạng có ngưỡng 0 không được xét
+	assert.Equal(t, LoyaltyTierGold, TierPolicy{SilverPoints: 2000, GoldPoints: 5000}.TierFor(50000))
+}
+
+func TestTierPolicyStatus(t *testing.T) {
+	policy := testTierPolicy
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	status := policy.Status(LoyaltyTierSilver, 5500, now)
+	assert.Equal(t, LoyaltyTierSilver, status.Tier)
+	assert.Equal(t, LoyaltyTierGold, status.QualifiedTier)
+	assert.Equal(t, LoyaltyTierGold, status.NextTier)
+	assert.Equal(t, int32(0), status.PointsToNextTier)
+	assert.Equal(t, now.Add(-365*24*time.Hour), status.WindowStart)
+
+	status = policy.Status(LoyaltyTierGold, 6000, now)
+	assert.Equal(t, LoyaltyTierPlatinum, status.NextTier)
+	assert.Equal(t, int32(4000), status.PointsToNextTier)
+	assert.True(t, status.Benefits.LoungeAccess)
+
+	status = policy.Status(LoyaltyTierPlatinum, 12000, now)
+	assert.Empty(t, status.NextTier)
+}
+
+func TestTierBenefitsApplyToSeat(t *testing.T) {
+	selection := SeatSelection{TicketID: 1, SeatCode: "1A", Fee: 150000}
+	assert.Equal(t, int64(150000), testTierPolicy.Benefits(LoyaltyTierSilver).ApplyToSeat(selection).Fee)
+	assert.Equal(t, int64(0), testTierPolicy.Benefits(LoyaltyTierGold).ApplyToSeat(selection).Fee)
+	assert.False(t, testTierPolicy.Benefits(LoyaltyTierMember).WaitlistPriority)
+}
+
+func TestTierPolicyBenefits(t *testing.T) {
+	assert.Equal(t, TierBenefits{ExtraCheckedBaggageKg: 10, WaitlistPriority: true}, testTierPolicy.Benefits(LoyaltyTierSilver))
+	assert.Equal(t, int32(20), testTierPolicy.Benefits(LoyaltyTierPlatinum).ExtraCheckedBaggageKg)
+	assert.True(t, testTierPolicy.Benefits(LoyaltyTierPlatinum).LoungeAccess)
+
+	// Quyền lợi không cấu hình hạng thì không hạng nào được hưởng
+	assert.Equal(t, TierBenefits{}, TierPolicy{}.Benefits(LoyaltyTierPlatinum))
+	assert.True(t, TierPolicy{WaitlistPriorityTier: LoyaltyTierMember}.Benefits(LoyaltyTierMember).WaitlistPriority)
+}
+
+func TestTierPolicyWaitlistPriorityTiers(t *testing.T) {
+	assert.Equal(t, []LoyaltyTier{LoyaltyTierSilver, LoyaltyTierGold, LoyaltyTierPlatinum}, testTierPolicy.WaitlistPriorityTiers())
+	assert.Equal(t, []LoyaltyTier{LoyaltyTierPlatinum}, TierPolicy{WaitlistPriorityTier: LoyaltyTierPlatinum}.WaitlistPriorityTiers())
+
+	// Không cấu hình hạng thì danh sách chờ chỉ xếp theo thời điểm đăng ký
+	assert.Empty(t, TierPolicy{}.WaitlistPriorityTiers())
+}
+
+func TestNewBoardingPass(t *testing.T) {
+	ticket := Ticket{
+		TicketID:    7,
+		FlightClass: FlightClassEconomy,
+		FareFamily:  FareFamilyClassic,
+		Seat:        Seat{SeatCode: "12C"},
+		Owner:       TicketOwner{FirstName: "An", LastName: "Nguyen"},
+		Ancillaries: []TicketAncillary{
+			{Type: AncillaryTypeBaggage, Name: "Extra baggage 20kg", Status: AncillaryStatusActive},
+			{Type: AncillaryTypeSeat, Name: "Seat 12C", Status: AncillaryStatusActive},
+			{Type: AncillaryTypePriorityBoarding, Name: "Priority boarding", Status: AncillaryStatusCancelled},
+		},
+	}
+	flight := Flight{FlightID: 3, FlightNumber: "QA101"}
+	family := FareFamily{CheckedBaggageKg: 20}
+
+	pass := NewBoardingPass(ticket, flight, family, LoyaltyTierSilver, testTierPolicy.Benefits(LoyaltyTierSilver))
+	assert.Equal(t, int32(30), pass.CheckedBaggageKg)
+	assert.False(t, pass.PriorityBoarding)
+	assert.False(t, pass.LoungeAccess)
+	assert.Equal(t, []string{"Extra baggage 20kg"}, pass.Ancillaries)
+
+	pass = NewBoardingPass(ticket, flight, family, LoyaltyTierPlatinum, testTierPolicy.Benefits(LoyaltyTierPlatinum))
+	assert.Equal(t, int32(40), pass.CheckedBaggageKg)
+	assert.True(t, pass.PriorityBoarding)
+	assert.True(t, pass.LoungeAccess)
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+)
+
+// ManifestPassenger is one passenger of a flight manifest with the special services
+// the ground staff and crew have to provide.
+type ManifestPassenger struct {
+	TicketID        int64         `json:"ticket_id"`
+	TicketNumber    string        `json:"ticket_number"`
+	BookingPNR      string        `json:"booking_pnr"`
+	FirstName       string        `json:"first_name"`
+	LastName        string        `json:"last_name"`
+	PassengerType   PassengerType `json:"passenger_type"`
+	SeatCode        string        `json:"seat_code"`
+	FlightClass     FlightClass   `json:"flight_class"`
+	SpecialServices []string      `json:"special_services,omitempty"`
+}
+
+// FlightManifest lists the passengers holding an active ticket on a flight.
+type FlightManifest struct {
+	Flight     Flight              `json:"flight"`
+	Passengers []ManifestPassenger `json:"passengers"`
+	// SpecialServiceCounts là số yêu cầu của từng mã SSR trên chuyến bay, dùng để chuẩn bị suất ăn và hỗ trợ
+	SpecialServiceCounts map[string]int `json:"special_service_counts"`
+}
+
+// NewFlightManifest builds the manifest of flight from its tickets and the special
+// services requested on it. Cancelled tickets are left out; passengers are sorted by name.
+func NewFlightManifest(flight Flight, tickets []Ticket, services []TicketSpecialService) FlightManifest {
+	servicesByTicket := make(map[int64][]string)
+	for _, service := range services {
+		servicesByTicket[service.TicketID] = append(servicesByTicket[service.TicketID], service.Code)
+	}
+
+	manifest := FlightManifest{
+		Flight:               flight,
+		Passengers:           []ManifestPassenger{},
+		SpecialServiceCounts: make(map[string]int),
+	}
+	for _, ticket := range tickets {
+		if ticket.Status != TicketStatusActive {
+			continue
+		}
+		codes := servicesByTicket[ticket.TicketID]
+		for _, code := range codes {
+			manifest.SpecialServiceCounts[code]++
+		}
+		manifest.Passengers = append(manifest.Passengers, ManifestPassenger{
+			TicketID:        ticket.TicketID,
+			TicketNumber:    ticket.TicketNumber,
+			BookingPNR:      ticket.BookingPNR,
+			FirstName:       ticket.Owner.FirstName,
+			LastName:        ticket.Owner.LastName,
+			PassengerType:   ticket.PassengerType,
+			SeatCode:        ticket.Seat.SeatCode,
+			FlightClass:     ticket.FlightClass,
+			SpecialServices: codes,
+		})
+	}
+	sort.SliceStable(manifest.Passengers, func(i, j int) bool {
+		a, b := manifest.Passengers[i], manifest.Passengers[j]
+		if !strings.EqualFold(a.LastName, b.LastName) {
+			return strings.ToUpper(a.LastName) < strings.ToUpper(b.LastName)
+		}
+		return strings.ToUpper(a.FirstName) < strings.ToUpper(b.FirstName)
+	})
+	return manifest
+}
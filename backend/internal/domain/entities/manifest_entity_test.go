@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFlightManifest(t *testing.T) {
+	flight := Flight{FlightID: 3, FlightNumber: "VN101"}
+	tickets := []Ticket{
+		{TicketID: 1, Status: TicketStatusActive, Owner: TicketOwner{FirstName: "Binh", LastName: "Tran"}, Seat: Seat{SeatCode: "2A"}},
+		{TicketID: 2, Status: TicketStatusActive, Owner: TicketOwner{FirstName: "An", LastName: "Nguyen"}, Seat: Seat{SeatCode: "2B"}},
+		{TicketID: 3, Status: TicketStatusCancelled, Owner: TicketOwner{FirstName: "Cuong", LastName: "Le"}},
+	}
+	services := []TicketSpecialService{
+		{TicketID: 1, Code: "WCHR"},
+		{TicketID: 1, Code: "VGML"},
+		{TicketID: 2, Code: "VGML"},
+		{TicketID: 3, Code: "PETC"},
+	}
+
+	manifest := NewFlightManifest(flight, tickets, services)
+	require.Len(t, manifest.Passengers, 2)
+	assert.Equal(t, "Nguyen", manifest.Passengers[0].LastName)
+	assert.Equal(t, []string{"VGML"}, manifest.Passengers[0].SpecialServices)
+	assert.Equal(t, []string{"WCHR", "VGML"}, manifest.Passengers[1].SpecialServices)
+	assert.Equal(t, map[string]int{"WCHR": 1, "VGML": 2}, manifest.SpecialServiceCounts)
+}
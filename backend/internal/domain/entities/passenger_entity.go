@@ -0,0 +1,127 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+type PassengerType string
+
+const (
+	PassengerTypeAdult  PassengerType = "adult"
+	PassengerTypeChild  PassengerType = "child"
+	PassengerTypeInfant PassengerType = "infant"
+)
+
+// Tuổi (tính tại ngày bay) mà hành khách không còn là em bé / trẻ em
+const (
+	infantAgeLimit = 2
+	childAgeLimit  = 12
+)
+
+// PassengerTypeAt classifies a passenger by their age on travelDate.
+func PassengerTypeAt(dateOfBirth time.Time, travelDate time.Time) PassengerType {
+	switch {
+	case dateOfBirth.AddDate(infantAgeLimit, 0, 0).After(travelDate):
+		return PassengerTypeInfant
+	case dateOfBirth.AddDate(childAgeLimit, 0, 0).After(travelDate):
+		return PassengerTypeChild
+	default:
+		return PassengerTypeAdult
+	}
+}
+
+// PassengerPolicy holds the fare ratios of children and infants, as a percentage
+// of the adult fare, and how many lap infants one adult may travel with.
+type PassengerPolicy struct {
+	ChildFarePercent   int64
+	InfantFarePercent  int64
+	MaxInfantsPerAdult int
+}
+
+// Fare returns what a passenger of type t pays when the adult fare is adultFare.
+func (p PassengerPolicy) Fare(adultFare int64, t PassengerType) int64 {
+	switch t {
+	case PassengerTypeChild:
+		return adultFare * p.ChildFarePercent / 100
+	case PassengerTypeInfant:
+		return adultFare * p.InfantFarePercent / 100
+	default:
+		return adultFare
+	}
+}
+
+// PassengerError explains why a passenger cannot be booked on a segment.
+// Segment and Passenger are 1-based; Passenger is 0 when the whole segment is invalid.
+type PassengerError struct {
+	Segment   int
+	Passenger int
+	Reason    string
+}
+
+func (e *PassengerError) Error() string {
+	if e.Passenger == 0 {
+		return fmt.Sprintf("invalid passengers on segment %d: %s", e.Segment, e.Reason)
+	}
+	return fmt.Sprintf("invalid passenger %d on segment %d: %s", e.Passenger, e.Segment, e.Reason)
+}
+
+// ApplyToSegment sets the passenger type of every ticket of one segment. Every infant
+// is linked to an adult on the same segment: the one named by AccompanyingPassenger,
+// or else the first adult who still has room on their lap.
+func (p PassengerPolicy) ApplyToSegment(segment int, tickets []Ticket, travelDate time.Time) error {
+	var adults []int
+	for i := range tickets {
+		dateOfBirth := tickets[i].Owner.DateOfBirth
+		if dateOfBirth.IsZero() {
+			return &PassengerError{Segment: segment, Passenger: i + 1, Reason: "date of birth is required"}
+		}
+		if dateOfBirth.After(travelDate) {
+			return &PassengerError{Segment: segment, Passenger: i + 1, Reason: "date of birth is after the travel date"}
+		}
+		tickets[i].PassengerType = PassengerTypeAt(dateOfBirth, travelDate)
+		if tickets[i].PassengerType == PassengerTypeAdult {
+			adults = append(adults, i)
+		}
+	}
+	if len(adults) == 0 {
+		return &PassengerError{Segment: segment, Reason: "at least one adult must travel on every segment"}
+	}
+
+	infantsPerAdult := make(map[int]int, len(adults))
+	// Các em bé đã chỉ định người lớn đi kèm được xếp trước
+	for i := range tickets {
+		if tickets[i].PassengerType != PassengerTypeInfant {
+			tickets[i].AccompanyingPassenger = nil
+			continue
+		}
+		if tickets[i].AccompanyingPassenger == nil {
+			continue
+		}
+		adult := *tickets[i].AccompanyingPassenger
+		if adult < 0 || adult >= len(tickets) || tickets[adult].PassengerType != PassengerTypeAdult {
+			return &PassengerError{Segment: segment, Passenger: i + 1, Reason: "an infant must be accompanied by an adult on the same segment"}
+		}
+		if infantsPerAdult[adult] >= p.MaxInfantsPerAdult {
+			return &PassengerError{Segment: segment, Passenger: i + 1, Reason: fmt.Sprintf("an adult may travel with at most %d infant(s)", p.MaxInfantsPerAdult)}
+		}
+		infantsPerAdult[adult]++
+	}
+	for i := range tickets {
+		if tickets[i].PassengerType != PassengerTypeInfant || tickets[i].AccompanyingPassenger != nil {
+			continue
+		}
+		for _, adult := range adults {
+			if infantsPerAdult[adult] < p.MaxInfantsPerAdult {
+				infantsPerAdult[adult]++
+				accompanying := adult
+				tickets[i].AccompanyingPassenger = &accompanying
+				break
+			}
+		}
+		if tickets[i].AccompanyingPassenger == nil {
+			return &PassengerError{Segment: segment, Passenger: i + 1, Reason: fmt.Sprintf("an adult may travel with at most %d infant(s)", p.MaxInfantsPerAdult)}
+		}
+	}
+	return nil
+}
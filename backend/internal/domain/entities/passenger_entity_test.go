@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassengerTypeAt(t *testing.T) {
+	travelDate := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, PassengerTypeInfant, PassengerTypeAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), travelDate))
+	assert.Equal(t, PassengerTypeChild, PassengerTypeAt(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC), travelDate))
+	assert.Equal(t, PassengerTypeChild, PassengerTypeAt(time.Date(2013, 6, 2, 0, 0, 0, 0, time.UTC), travelDate))
+	assert.Equal(t, PassengerTypeAdult, PassengerTypeAt(time.Date(2013, 6, 1, 0, 0, 0, 0, time.UTC), travelDate))
+}
+
+func TestPassengerPolicyApplyToSegment(t *testing.T) {
+	travelDate := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	policy := PassengerPolicy{ChildFarePercent: 75, InfantFarePercent: 10, MaxInfantsPerAdult: 1}
+	passenger := func(dateOfBirth time.Time) Ticket {
+		return Ticket{Owner: TicketOwner{DateOfBirth: dateOfBirth}}
+	}
+	adult := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	child := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	infant := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("classifies and links passengers", func(t *testing.T) {
+		tickets := []Ticket{passenger(infant), passenger(adult), passenger(child)}
+		require.NoError(t, policy.ApplyToSegment(1, tickets, travelDate))
+
+		assert.Equal(t, PassengerTypeInfant, tickets[0].PassengerType)
+		require.NotNil(t, tickets[0].AccompanyingPassenger)
+		assert.Equal(t, 1, *tickets[0].AccompanyingPassenger)
+		assert.Equal(t, PassengerTypeAdult, tickets[1].PassengerType)
+		assert.Equal(t, PassengerTypeChild, tickets[2].PassengerType)
+		assert.Nil(t, tickets[2].AccompanyingPassenger)
+	})
+
+	tests := []struct {
+		name      string
+		tickets   func() []Ticket
+		passenger int
+	}{
+		{"missing date of birth", func() []Ticket { return []Ticket{passenger(adult), passenger(time.Time{})} }, 2},
+		{"born after travel", func() []Ticket { return []Ticket{passenger(adult), passenger(travelDate.AddDate(0, 0, 1))} }, 2},
+		{"no adult", func() []Ticket { return []Ticket{passenger(child)} }, 0},
+		{"too many infants", func() []Ticket { return []Ticket{passenger(adult), passenger(infant), passenger(infant)} }, 3},
+		{"infant with child", func() []Ticket {
+			tickets := []Ticket{passenger(adult), passenger(child), passenger(infant)}
+			accompanying := 1
+			tickets[2].AccompanyingPassenger = &accompanying
+			return tickets
+		}, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := policy.ApplyToSegment(2, test.tickets(), travelDate)
+			var passengerErr *PassengerError
+			require.True(t, errors.As(err, &passengerErr), "expected PassengerError, got %v", err)
+			assert.Equal(t, 2, passengerErr.Segment)
+			assert.Equal(t, test.passenger, passengerErr.Passenger)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package entities
+
+import "time"
+
+// PaymentPurpose tells what a payment intent pays for; ReferenceID of the payment
+// identifies the item of that purpose.
+type PaymentPurpose string
+
+const (
+	// PaymentPurposeBooking trả tiền vé của booking
+	PaymentPurposeBooking PaymentPurpose = "booking"
+	// PaymentPurposeFlightChange trả tiền chênh lệch của một yêu cầu đổi chuyến
+	PaymentPurposeFlightChange PaymentPurpose = "flight_change"
+	// PaymentPurposeSeatSelection trả phí chọn ghế của booking đã thanh toán
+	PaymentPurposeSeatSelection PaymentPurpose = "seat_selection"
+	// PaymentPurposeAncillary trả tiền một dịch vụ bổ trợ mua sau khi booking đã thanh toán
+	PaymentPurposeAncillary PaymentPurpose = "ancillary"
+	// PaymentPurposeGroupDeposit trả tiền cọc của một đoàn đã được báo giá
+	PaymentPurposeGroupDeposit PaymentPurpose = "group_deposit"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending   PaymentStatus = "pending"
+	PaymentStatusSucceeded PaymentStatus = "succeeded"
+	PaymentStatusFailed    PaymentStatus = "failed"
+)
+
+// Payment is one payment intent created through the payment gateway. It only counts
+// as paid once the gateway confirms it through its webhook.
+type Payment struct {
+	PaymentID      int64          `json:"payment_id"`
+	BookingID      int64          `json:"booking_id"`
+	Purpose        PaymentPurpose `json:"purpose"`
+	ReferenceID    int64          `json:"reference_id"`
+	IntentID       string         `json:"intent_id"`
+	Amount         int64          `json:"amount"`
+	AmountReceived int64          `json:"amount_received"`
+	AmountRefunded int64          `json:"amount_refunded"`
+	Currency       string         `json:"currency"`
+	Status         PaymentStatus  `json:"status"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// Refundable returns what can still be paid back against the payment.
+func (p Payment) Refundable() int64 {
+	if p.Status != PaymentStatusSucceeded || p.AmountReceived <= p.AmountRefunded {
+		return 0
+	}
+	return p.AmountReceived - p.AmountRefunded
+}
+
+// PaymentRefund is the part of a refund paid back against one captured payment.
+type PaymentRefund struct {
+	Payment Payment
+	Amount  int64
+}
+
+// AllocateRefund spreads amount over the captured payments, oldest first, and returns
+// the parts together with what could not be allocated because too little was captured.
+func AllocateRefund(payments []Payment, amount int64) ([]PaymentRefund, int64) {
+	var parts []PaymentRefund
+	for _, payment := range payments {
+		if amount <= 0 {
+			break
+		}
+		part := min(payment.Refundable(), amount)
+		if part <= 0 {
+			continue
+		}
+		parts = append(parts, PaymentRefund{Payment: payment, Amount: part})
+		amount -= part
+	}
+	return parts, amount
+}
+
+// PaymentIntent is what the payment gateway returns for a new charge: the client
+// completes it with ClientSecret, the webhook reports it by ID.
+type PaymentIntent struct {
+	ID           string
+	ClientSecret string
+}
+
+type PaymentEventType string
+
+const (
+	PaymentEventSucceeded PaymentEventType = "succeeded"
+	PaymentEventFailed    PaymentEventType = "failed"
+	// PaymentEventIgnored là các sự kiện webhook không ảnh hưởng tới thanh toán
+	PaymentEventIgnored PaymentEventType = "ignored"
+)
+
+// PaymentEvent is a verified notification from the payment gateway about one intent.
+type PaymentEvent struct {
+	Type           PaymentEventType
+	IntentID       string
+	AmountReceived int64
+}
+
+// BookingPaymentResult is the outcome of capturing a booking payment. Confirmed tells
+// whether this payment confirmed the booking; Refund is set when the booking had been
+// cancelled, or paid through another payment, before the money arrived.
+type BookingPaymentResult struct {
+	Payment   Payment
+	Booking   Booking
+	Confirmed bool
+	Refund    *Refund
+}
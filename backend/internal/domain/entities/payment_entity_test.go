@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentRefundable(t *testing.T) {
+	payment := Payment{Status: PaymentStatusSucceeded, Amount: 500, AmountReceived: 500, AmountRefunded: 200}
+	assert.Equal(t, int64(300), payment.Refundable())
+
+	pending := payment
+	pending.Status = PaymentStatusPending
+	assert.Zero(t, pending.Refundable())
+}
+
+func TestAllocateRefund(t *testing.T) {
+	payments := []Payment{
+		{PaymentID: 1, Status: PaymentStatusSucceeded, AmountReceived: 300, AmountRefunded: 300},
+		{PaymentID: 2, Status: PaymentStatusSucceeded, AmountReceived: 400},
+		{PaymentID: 3, Status: PaymentStatusSucceeded, AmountReceived: 500, AmountRefunded: 100},
+	}
+
+	parts, unallocated := AllocateRefund(payments, 600)
+	assert.Zero(t, unallocated)
+	assert.Len(t, parts, 2)
+	assert.Equal(t, int64(2), parts[0].Payment.PaymentID)
+	assert.Equal(t, int64(400), parts[0].Amount)
+	assert.Equal(t, int64(200), parts[1].Amount)
+
+	// Không hoàn được nhiều hơn số tiền đã thu
+	_, unallocated = AllocateRefund(payments, 1000)
+	assert.Equal(t, int64(200), unallocated)
+}
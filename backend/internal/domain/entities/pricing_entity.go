@@ -0,0 +1,81 @@
+package entities
+
+type FareItemType string
+
+const (
+	FareItemBaseFare FareItemType = "fare"
+	FareItemTax      FareItemType = "tax"
+	FareItemFee      FareItemType = "fee"
+	// FareItemDiscount là dòng giảm giá, có số tiền âm
+	FareItemDiscount FareItemType = "discount"
+)
+
+// Mã của từng dòng trong chi tiết giá vé
+const (
+	FareCodeBase     = "BASE"
+	FareCodeVAT      = "VAT"
+	FareCodeAirport  = "AIRPORT"
+	FareCodeSecurity = "SECURITY"
+	FareCodePromo    = "PROMO"
+	FareCodeGroup    = "GROUP"
+)
+
+// FareItem is one line of a ticket's price breakdown.
+type FareItem struct {
+	Type        FareItemType `json:"type"`
+	Code        string       `json:"code"`
+	Description string       `json:"description"`
+	Amount      int64        `json:"amount"`
+}
+
+// FareBreakdown is the itemized price of one ticket.
+type FareBreakdown struct {
+	Items []FareItem `json:"items"`
+	Total int64      `json:"total"`
+}
+
+func (b *FareBreakdown) add(item FareItem) {
+	if item.Amount <= 0 {
+		return
+	}
+	b.Items = append(b.Items, item)
+	b.Total += item.Amount
+}
+
+// CabinFares is the fare of every cabin as a percentage of the flight base price.
+type CabinFares map[FlightClass]int64
+
+// Fare returns the fare of one seat in class on flight. A cabin without a configured
+// percentage sells at the base price.
+func (c CabinFares) Fare(flight Flight, class FlightClass) int64 {
+	percent, ok := c[class]
+	if !ok {
+		percent = 100
+	}
+	return int64(flight.BasePrice) * percent / 100
+}
+
+// PricingRules turns a cabin fare into what each passenger pays: the passenger-type
+// ratio gives the base fare, VAT is charged on it, and every passenger with a seat
+// pays the airport and security fees.
+type PricingRules struct {
+	Cabins      CabinFares
+	Passengers  PassengerPolicy
+	VATPercent  int64
+	AirportFee  int64
+	SecurityFee int64
+}
+
+// PriceTicket computes the breakdown of one ticket whose cabin currently sells for
+// cabinFare per adult seat.
+func (r PricingRules) PriceTicket(cabinFare int64, passengerType PassengerType) FareBreakdown {
+	var breakdown FareBreakdown
+	baseFare := r.Passengers.Fare(cabinFare, passengerType)
+	breakdown.add(FareItem{Type: FareItemBaseFare, Code: FareCodeBase, Description: "Base fare", Amount: baseFare})
+	breakdown.add(FareItem{Type: FareItemTax, Code: FareCodeVAT, Description: "Value added tax", Amount: baseFare * r.VATPercent / 100})
+	if passengerType != PassengerTypeInfant {
+		breakdown.add(FareItem{Type: FareItemFee, Code: FareCodeAirport, Description: "Passenger service charge", Amount: r.AirportFee})
+		breakdown.add(FareItem{Type: FareItemFee, Code: FareCodeSecurity, Description: "Security screening fee", Amount: r.SecurityFee})
+	}
+	return breakdown
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingRulesPriceTicket(t *testing.T) {
+	rules := PricingRules{
+		Passengers:  PassengerPolicy{ChildFarePercent: 75, InfantFarePercent: 10, MaxInfantsPerAdult: 1},
+		VATPercent:  10,
+		AirportFee:  100000,
+		SecurityFee: 20000,
+	}
+	flight := Flight{FlightID: 1, BasePrice: 1000000}
+
+	adult := rules.PriceTicket(testCabinFares.Fare(flight, FlightClassBusiness), PassengerTypeAdult)
+	assert.Equal(t, []FareItem{
+		{Type: FareItemBaseFare, Code: FareCodeBase, Description: "Base fare", Amount: 1500000},
+		{Type: FareItemTax, Code: FareCodeVAT, Description: "Value added tax", Amount: 150000},
+		{Type: FareItemFee, Code: FareCodeAirport, Description: "Passenger service charge", Amount: 100000},
+		{Type: FareItemFee, Code: FareCodeSecurity, Description: "Security screening fee", Amount: 20000},
+	}, adult.Items)
+	assert.Equal(t, int64(1770000), adult.Total)
+
+	child := rules.PriceTicket(testCabinFares.Fare(flight, FlightClassEconomy), PassengerTypeChild)
+	assert.Equal(t, int64(750000+75000+100000+20000), child.Total)
+
+	infant := rules.PriceTicket(testCabinFares.Fare(flight, FlightClassEconomy), PassengerTypeInfant)
+	assert.Len(t, infant.Items, 2)
+	assert.Equal(t, int64(100000+10000), infant.Total)
+}
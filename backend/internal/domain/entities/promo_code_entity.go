@@ -0,0 +1,206 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type PromoDiscountType string
+
+const (
+	// PromoDiscountPercent giảm theo % giá cơ bản, PromoDiscountFixed giảm một số tiền cố định cho cả booking
+	PromoDiscountPercent PromoDiscountType = "percent"
+	PromoDiscountFixed   PromoDiscountType = "fixed"
+)
+
+// ErrInvalidPromoCode is returned when a promo code definition is malformed.
+var ErrInvalidPromoCode = errors.New("invalid promo code")
+
+// PromoCode is a discount on the base fare of the tickets of a booking. An empty
+// DepartureCity, ArrivalCity or FlightClasses applies the code to every route or cabin.
+type PromoCode struct {
+	PromoCodeID   int64             `json:"promo_code_id"`
+	Code          string            `json:"code"`
+	Description   string            `json:"description"`
+	DiscountType  PromoDiscountType `json:"discount_type"`
+	DiscountValue int64             `json:"discount_value"`
+	// MaxDiscount giới hạn số tiền giảm của mã giảm theo %, 0 là không giới hạn
+	MaxDiscount   int64         `json:"max_discount"`
+	ValidFrom     time.Time     `json:"valid_from"`
+	ValidUntil    time.Time     `json:"valid_until"`
+	DepartureCity string        `json:"departure_city"`
+	ArrivalCity   string        `json:"arrival_city"`
+	FlightClasses []FlightClass `json:"flight_classes"`
+	// MaxUses và MaxUsesPerCustomer bằng 0 là không giới hạn số lượt dùng
+	MaxUses            int32 `json:"max_uses"`
+	MaxUsesPerCustomer int32 `json:"max_uses_per_customer"`
+	// Stackable cho phép dùng chung với các mã khác cũng cho phép dùng chung
+	Stackable bool      `json:"stackable"`
+	Active    bool      `json:"active"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NormalizePromoCode returns the canonical, upper-case form of a code typed by a customer.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate checks that the code can be offered.
+func (p PromoCode) Validate() error {
+	switch {
+	case p.Code == "":
+		return fmt.Errorf("%w: code is required", ErrInvalidPromoCode)
+	case p.DiscountType != PromoDiscountPercent && p.DiscountType != PromoDiscountFixed:
+		return fmt.Errorf("%w: unknown discount type %q", ErrInvalidPromoCode, p.DiscountType)
+	case p.DiscountValue <= 0:
+		return fmt.Errorf("%w: discount must be positive", ErrInvalidPromoCode)
+	case p.DiscountType == PromoDiscountPercent && p.DiscountValue > 100:
+		return fmt.Errorf("%w: a percentage discount is at most 100", ErrInvalidPromoCode)
+	case p.MaxDiscount < 0 || p.MaxUses < 0 || p.MaxUsesPerCustomer < 0:
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidPromoCode)
+	case !p.ValidUntil.After(p.ValidFrom):
+		return fmt.Errorf("%w: the validity window is empty", ErrInvalidPromoCode)
+	}
+	for _, class := range p.FlightClasses {
+		if !class.Valid() {
+			return fmt.Errorf("%w: unknown cabin class %q", ErrInvalidPromoCode, class)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the code applies to a ticket in class on the route.
+func (p PromoCode) Matches(departureCity, arrivalCity string, class FlightClass) bool {
+	if (p.DepartureCity != "" && p.DepartureCity != departureCity) || (p.ArrivalCity != "" && p.ArrivalCity != arrivalCity) {
+		return false
+	}
+	if len(p.FlightClasses) == 0 {
+		return true
+	}
+	for _, allowed := range p.FlightClasses {
+		if allowed == class {
+			return true
+		}
+	}
+	return false
+}
+
+// PromoTarget is a ticket of the booking being created with the route it flies.
+type PromoTarget struct {
+	Ticket        *Ticket
+	DepartureCity string
+	ArrivalCity   string
+}
+
+// PromoRedemption is the discount a promo code gave on a booking.
+type PromoRedemption struct {
+	PromoCodeID int64  `json:"promo_code_id"`
+	Code        string `json:"code"`
+	Amount      int64  `json:"amount"`
+}
+
+// ApplyPromoCodes discounts the tickets of targets with promos and returns what each
+// code took off. A code that is not stackable must be used on its own. Each code is
+// applied to the base fare left after the codes before it, split across the tickets it
+// matches in proportion to their base fare, and recorded on every discounted ticket as
+// a negative fare line, so the ticket price stays the sum of its lines.
+// Usage caps are not checked here: they are enforced when the redemption is recorded.
+func ApplyPromoCodes(promos []PromoCode, targets []PromoTarget, now time.Time) ([]PromoRedemption, error) {
+	seen := make(map[string]bool)
+	for _, promo := range promos {
+		if seen[promo.Code] {
+			return nil, &PromoCodeError{Code: promo.Code, Reason: "the code is used twice"}
+		}
+		seen[promo.Code] = true
+		if len(promos) > 1 && !promo.Stackable {
+			return nil, &PromoCodeError{Code: promo.Code, Reason: "the code cannot be combined with other codes"}
+		}
+		switch {
+		case !promo.Active:
+			return nil, &PromoCodeError{Code: promo.Code, Reason: "the code is no longer active"}
+		case now.Before(promo.ValidFrom):
+			return nil, &PromoCodeError{Code: promo.Code, Reason: "the code is not valid yet"}
+		case !now.Before(promo.ValidUntil):
+			return nil, &PromoCodeError{Code: promo.Code, Reason: "the code has expired"}
+		}
+	}
+
+	// Giá cơ bản còn lại của từng vé sau các mã đã áp dụng trước
+	remaining := make([]int64, len(targets))
+	for i, target := range targets {
+		remaining[i] = target.Ticket.baseFare()
+	}
+
+	redemptions := make([]PromoRedemption, 0, len(promos))
+	for _, promo := range promos {
+		var eligible []int
+		var base int64
+		for i, target := range targets {
+			if remaining[i] > 0 && promo.Matches(target.DepartureCity, target.ArrivalCity, target.Ticket.FlightClass) {
+				eligible = append(eligible, i)
+				base += remaining[i]
+			}
+		}
+		if base == 0 {
+			return nil, &PromoCodeError{Code: promo.Code, Reason: "the code does not apply to any ticket of this booking"}
+		}
+
+		discount := promo.DiscountValue
+		if promo.DiscountType == PromoDiscountPercent {
+			discount = base * promo.DiscountValue / 100
+			if promo.MaxDiscount > 0 && discount > promo.MaxDiscount {
+				discount = promo.MaxDiscount
+			}
+		}
+		if discount > base {
+			discount = base
+		}
+
+		// Chia tiền giảm theo tỷ lệ giá cơ bản, vé cuối nhận phần lẻ
+		left := discount
+		for k, i := range eligible {
+			share := discount * remaining[i] / base
+			if k == len(eligible)-1 {
+				share = left
+			}
+			left -= share
+			if share == 0 {
+				continue
+			}
+			remaining[i] -= share
+			ticket := targets[i].Ticket
+			ticket.FareItems = append(ticket.FareItems, FareItem{
+				Type:        FareItemDiscount,
+				Code:        FareCodePromo,
+				Description: "Promo code " + promo.Code,
+				Amount:      -share,
+			})
+			ticket.Price -= int32(share)
+		}
+		redemptions = append(redemptions, PromoRedemption{PromoCodeID: promo.PromoCodeID, Code: promo.Code, Amount: discount})
+	}
+	return redemptions, nil
+}
+
+// baseFare is the base fare of the ticket less the discounts already on it.
+func (t Ticket) baseFare() int64 {
+	var amount int64
+	for _, item := range t.FareItems {
+		if item.Type == FareItemBaseFare || item.Type == FareItemDiscount {
+			amount += item.Amount
+		}
+	}
+	return amount
+}
+
+// PromoCodeError is returned when a promo code cannot be used on a booking.
+type PromoCodeError struct {
+	Code   string
+	Reason string
+}
+
+func (e *PromoCodeError) Error() string {
+	return fmt.Sprintf("promo code %s: %s", e.Code, e.Reason)
+}
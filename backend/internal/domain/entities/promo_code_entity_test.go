@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPromoTicket(class FlightClass, baseFare int64) *Ticket {
+	return &Ticket{
+		FlightClass: class,
+		Price:       int32(baseFare + 100000),
+		FareItems: []FareItem{
+			{Type: FareItemBaseFare, Code: FareCodeBase, Amount: baseFare},
+			{Type: FareItemFee, Code: FareCodeAirport, Amount: 100000},
+		},
+	}
+}
+
+func testPromoCode(code string, discountType PromoDiscountType, value int64, now time.Time) PromoCode {
+	return PromoCode{
+		PromoCodeID:   1,
+		Code:          code,
+		DiscountType:  discountType,
+		DiscountValue: value,
+		ValidFrom:     now.Add(-time.Hour),
+		ValidUntil:    now.Add(time.Hour),
+		Active:        true,
+	}
+}
+
+func TestApplyPromoCodesPercent(t *testing.T) {
+	now := time.Now()
+	targets := []PromoTarget{
+		{Ticket: testPromoTicket(FlightClassEconomy, 1000000), DepartureCity: "Hà Nội", ArrivalCity: "Đà Nẵng"},
+		{Ticket: testPromoTicket(FlightClassBusiness, 3000000), DepartureCity: "Hà Nội", ArrivalCity: "Đà Nẵng"},
+	}
+	promo := testPromoCode("SUMMER10", PromoDiscountPercent, 10, now)
+	promo.FlightClasses = []FlightClass{FlightClassEconomy}
+
+	redemptions, err := ApplyPromoCodes([]PromoCode{promo}, targets, now)
+	require.NoError(t, err)
+	require.Len(t, redemptions, 1)
+	assert.Equal(t, int64(100000), redemptions[0].Amount)
+	assert.Equal(t, int32(1000000), targets[0].Ticket.Price)
+	assert.Equal(t, int64(-100000), targets[0].Ticket.FareItems[2].Amount)
+
+	// Vé hạng thương gia không thuộc phạm vi của mã
+	assert.Equal(t, int32(3100000), targets[1].Ticket.Price)
+	assert.Len(t, targets[1].Ticket.FareItems, 2)
+}
+
+func TestApplyPromoCodesFixedSplitsAcrossTickets(t *testing.T) {
+	now := time.Now()
+	targets := []PromoTarget{
+		{Ticket: testPromoTicket(FlightClassEconomy, 1000000)},
+		{Ticket: testPromoTicket(FlightClassEconomy, 2000000)},
+	}
+	redemptions, err := ApplyPromoCodes([]PromoCode{testPromoCode("FLAT", PromoDiscountFixed, 300001, now)}, targets, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(300001), redemptions[0].Amount)
+	assert.Equal(t, int32(1000000), targets[0].Ticket.Price)
+	assert.Equal(t, int32(1899999), targets[1].Ticket.Price)
+
+	// Mã giảm cố định không vượt quá giá cơ bản
+	small := []PromoTarget{{Ticket: testPromoTicket(FlightClassEconomy, 50000)}}
+	redemptions, err = ApplyPromoCodes([]PromoCode{testPromoCode("FLAT", PromoDiscountFixed, 300000, now)}, small, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50000), redemptions[0].Amount)
+	assert.Equal(t, int32(100000), small[0].Ticket.Price)
+}
+
+func TestApplyPromoCodesStacking(t *testing.T) {
+	now := time.Now()
+	first := testPromoCode("A", PromoDiscountPercent, 50, now)
+	second := testPromoCode("B", PromoDiscountPercent, 50, now)
+	second.PromoCodeID = 2
+
+	var promoErr *PromoCodeError
+	_, err := ApplyPromoCodes([]PromoCode{first, second}, []PromoTarget{{Ticket: testPromoTicket(FlightClassEconomy, 1000000)}}, now)
+	require.ErrorAs(t, err, &promoErr)
+
+	first.Stackable, second.Stackable = true, true
+	targets := []PromoTarget{{Ticket: testPromoTicket(FlightClassEconomy, 1000000)}}
+	redemptions, err := ApplyPromoCodes([]PromoCode{first, second}, targets, now)
+	require.NoError(t, err)
+	// Mã thứ hai áp dụng trên phần giá còn lại sau mã thứ nhất
+	assert.Equal(t, int64(500000), redemptions[0].Amount)
+	assert.Equal(t, int64(250000), redemptions[1].Amount)
+	assert.Equal(t, int32(350000), targets[0].Ticket.Price)
+}
+
+func TestApplyPromoCodesRejectsUnusableCodes(t *testing.T) {
+	now := time.Now()
+	target := func() []PromoTarget {
+		return []PromoTarget{{Ticket: testPromoTicket(FlightClassEconomy, 1000000), DepartureCity: "Hà Nội", ArrivalCity: "Huế"}}
+	}
+	expired := testPromoCode("OLD", PromoDiscountFixed, 1000, now)
+	expired.ValidUntil = now
+	inactive := testPromoCode("OFF", PromoDiscountFixed, 1000, now)
+	inactive.Active = false
+	otherRoute := testPromoCode("SGN", PromoDiscountFixed, 1000, now)
+	otherRoute.ArrivalCity = "Hồ Chí Minh"
+
+	for _, promo := range []PromoCode{expired, inactive, otherRoute} {
+		var promoErr *PromoCodeError
+		_, err := ApplyPromoCodes([]PromoCode{promo}, target(), now)
+		assert.ErrorAs(t, err, &promoErr, promo.Code)
+	}
+}
+
+func TestPromoCodeValidate(t *testing.T) {
+	now := time.Now()
+	assert.NoError(t, testPromoCode("OK", PromoDiscountPercent, 20, now).Validate())
+
+	tooMuch := testPromoCode("BIG", PromoDiscountPercent, 120, now)
+	assert.ErrorIs(t, tooMuch.Validate(), ErrInvalidPromoCode)
+
+	badClass := testPromoCode("CLS", PromoDiscountFixed, 1000, now)
+	badClass.FlightClasses = []FlightClass{"premium"}
+	assert.ErrorIs(t, badClass.Validate(), ErrInvalidPromoCode)
+}
@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"math/bits"
+	"time"
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusPending   RefundStatus = "pending"
+	RefundStatusCompleted RefundStatus = "completed"
+	// RefundStatusFailed là khoản hoàn cổng thanh toán từ chối, cần xử lý lại thủ công
+	RefundStatusFailed RefundStatus = "failed"
+)
+
+type RefundMethod string
+
+const (
+	// RefundMethodOriginal hoàn về phương thức thanh toán ban đầu
+	RefundMethodOriginal RefundMethod = "original"
+	// RefundMethodWallet hoàn vào ví tín dụng của khách hàng, ghi có ngay khi huỷ
+	RefundMethodWallet RefundMethod = "wallet"
+)
+
+type Refund struct {
+	RefundID  int64        `json:"refund_id"`
+	BookingID int64        `json:"booking_id"`
+	Amount    int64        `json:"amount"`
+	Status    RefundStatus `json:"status"`
+	Reason    string       `json:"reason"`
+	Method    RefundMethod `json:"method"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// RefundPolicy holds the fare rules used when a ticket is cancelled.
+// Cancelling at least FullRefundBefore ahead of departure returns the whole fare,
+// at least PartialRefundBefore ahead returns PartialRefundPercent of it for the
+// ticket's cabin class, and anything later is not refundable.
+type RefundPolicy struct {
+	FullRefundBefore     time.Duration
+	PartialRefundBefore  time.Duration
+	PartialRefundPercent map[FlightClass]int
+}
+
+// RefundAmount returns how much of price is refunded when the ticket is cancelled at now.
+func (p RefundPolicy) RefundAmount(class FlightClass, price int64, departureTime, now time.Time) int64 {
+	timeLeft := departureTime.Sub(now)
+	switch {
+	case timeLeft >= p.FullRefundBefore:
+		return price
+	case timeLeft >= p.PartialRefundBefore:
+		percent := p.PartialRefundPercent[class]
+		if percent < 0 {
+			percent = 0
+		}
+		if percent > 100 {
+			percent = 100
+		}
+		return price * int64(percent) / 100
+	default:
+		return 0
+	}
+}
+
+// ProRata returns the part of amount that part out of whole stands for. It splits a
+// refund, or the fare that earns points, between the ways a booking was paid: points and
+// travel credit go back in the same proportion as the money.
+func ProRata(amount, part, whole int64) int64 {
+	if amount <= 0 || part <= 0 {
+		return 0
+	}
+	if whole <= 0 || part >= whole {
+		return amount
+	}
+	// part < whole nên thương nhỏ hơn amount và phép chia 128 bit không tràn
+	hi, lo := bits.Mul64(uint64(amount), uint64(part))
+	quotient, _ := bits.Div64(hi, lo, uint64(whole))
+	return int64(quotient)
+}
+
+type CancelBookingResult struct {
+	Booking Booking
+	Refund  *Refund
+	// CancelledTickets là các vé bị huỷ cùng booking; ghế của chúng được mời cho danh sách chờ
+	CancelledTickets []Ticket
+	// WalletCredit là khoản tín dụng ghi vào ví khi hoàn tiền vào ví
+	WalletCredit *WalletTransaction
+}
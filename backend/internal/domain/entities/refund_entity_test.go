@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefundPolicyRefundAmount(t *testing.T) {
+	policy := RefundPolicy{
+		FullRefundBefore:    7 * 24 * time.Hour,
+		PartialRefundBefore: 24 * time.Hour,
+		PartialRefundPercent: map[FlightClass]int{
+			FlightClassEconomy:    50,
+			FlightClassBusiness:   75,
+			FlightClassFirstClass: 90,
+		},
+	}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		class     FlightClass
+		departure time.Time
+		expected  int64
+	}{
+		{"full refund window", FlightClassEconomy, now.Add(8 * 24 * time.Hour), 1000},
+		{"full refund boundary", FlightClassEconomy, now.Add(7 * 24 * time.Hour), 1000},
+		{"partial economy", FlightClassEconomy, now.Add(48 * time.Hour), 500},
+		{"partial business", FlightClassBusiness, now.Add(48 * time.Hour), 750},
+		{"partial first class", FlightClassFirstClass, now.Add(24 * time.Hour), 900},
+		{"unknown class", FlightClass("premium"), now.Add(48 * time.Hour), 0},
+		{"too close to departure", FlightClassFirstClass, now.Add(time.Hour), 0},
+		{"already departed", FlightClassEconomy, now.Add(-time.Hour), 0},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, policy.RefundAmount(test.class, 1000, test.departure, now), test.name)
+	}
+}
+
+func TestProRata(t *testing.T) {
+	assert.Equal(t, int64(300), ProRata(600, 500, 1000))
+	assert.Equal(t, int64(600), ProRata(600, 1000, 1000))
+	assert.Equal(t, int64(600), ProRata(600, 1200, 1000))
+	assert.Equal(t, int64(0), ProRata(600, 0, 1000))
+	assert.Equal(t, int64(0), ProRata(0, 500, 1000))
+	// Số lớn không tràn int64 khi nhân
+	assert.Equal(t, int64(3_000_000_000_000), ProRata(6_000_000_000_000, 5_000_000_000_000, 10_000_000_000_000))
+}
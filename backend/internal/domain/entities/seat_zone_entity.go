@@ -0,0 +1,221 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type SeatZoneType string
+
+const (
+	SeatZoneFront        SeatZoneType = "front"
+	SeatZoneExitRow      SeatZoneType = "exit_row"
+	SeatZoneExtraLegroom SeatZoneType = "extra_legroom"
+)
+
+// Valid reports whether t is one of the priced seat zones.
+func (t SeatZoneType) Valid() bool {
+	switch t {
+	case SeatZoneFront, SeatZoneExitRow, SeatZoneExtraLegroom:
+		return true
+	}
+	return false
+}
+
+// ErrInvalidSeatZone is returned when a seat zone is malformed.
+var ErrInvalidSeatZone = errors.New("invalid seat zone")
+
+// SeatZone prices a range of rows, either on one flight (FlightID set) or on every
+// flight of an aircraft type. Seats outside every zone are free to select.
+type SeatZone struct {
+	SeatZoneID   int64        `json:"seat_zone_id"`
+	FlightID     int64        `json:"flight_id,omitempty"`
+	AircraftType string       `json:"aircraft_type,omitempty"`
+	Type         SeatZoneType `json:"type"`
+	Name         string       `json:"name"`
+	RowFrom      int32        `json:"row_from"`
+	RowTo        int32        `json:"row_to"`
+	Price        int64        `json:"price"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+// Validate checks that the zone can be priced.
+func (z SeatZone) Validate() error {
+	switch {
+	case z.FlightID == 0 && z.AircraftType == "":
+		return fmt.Errorf("%w: a flight or an aircraft type is required", ErrInvalidSeatZone)
+	case z.Name == "":
+		return fmt.Errorf("%w: name is required", ErrInvalidSeatZone)
+	case !z.Type.Valid():
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidSeatZone, z.Type)
+	case z.RowFrom <= 0 || z.RowTo < z.RowFrom:
+		return fmt.Errorf("%w: rows must be a positive range", ErrInvalidSeatZone)
+	case z.Price < 0:
+		return fmt.Errorf("%w: price must not be negative", ErrInvalidSeatZone)
+	}
+	return nil
+}
+
+// Contains reports whether row lies in the zone.
+func (z SeatZone) Contains(row int) bool {
+	return row >= int(z.RowFrom) && row <= int(z.RowTo)
+}
+
+// SeatRow returns the row number of a seat code such as "12A".
+func SeatRow(seatCode string) (int, bool) {
+	digits := 0
+	for digits < len(seatCode) && seatCode[digits] >= '0' && seatCode[digits] <= '9' {
+		digits++
+	}
+	if digits == 0 || digits == len(seatCode) {
+		return 0, false
+	}
+	row, err := strconv.Atoi(seatCode[:digits])
+	if err != nil || row <= 0 {
+		return 0, false
+	}
+	return row, true
+}
+
+// SeatMap is the set of zones priced on one flight.
+type SeatMap []SeatZone
+
+// SeatMapForFlight keeps the zones that apply to flight: the ones defined for the
+// flight itself, or, when it has none, the ones of its aircraft type.
+func SeatMapForFlight(zones []SeatZone, flight Flight) SeatMap {
+	var own, aircraft SeatMap
+	for _, zone := range zones {
+		switch {
+		case zone.FlightID == flight.FlightID:
+			own = append(own, zone)
+		case zone.FlightID == 0 && zone.AircraftType != "" && zone.AircraftType == flight.AircraftType:
+			aircraft = append(aircraft, zone)
+		}
+	}
+	if len(own) > 0 {
+		return own
+	}
+	return aircraft
+}
+
+// ZoneOf returns the zone of seatCode; the dearest one wins when zones overlap.
+func (m SeatMap) ZoneOf(seatCode string) (SeatZone, bool) {
+	row, ok := SeatRow(seatCode)
+	if !ok {
+		return SeatZone{}, false
+	}
+	var found SeatZone
+	matched := false
+	for _, zone := range m {
+		if zone.Contains(row) && (!matched || zone.Price > found.Price) {
+			found, matched = zone, true
+		}
+	}
+	return found, matched
+}
+
+// SeatUpdate asks for the passenger of a ticket to sit in SeatCode.
+type SeatUpdate struct {
+	TicketID int64
+	SeatCode string
+}
+
+// SeatSelection is a seat chosen for a ticket and what is still owed for it.
+type SeatSelection struct {
+	TicketID int64     `json:"ticket_id"`
+	SeatCode string    `json:"seat_code"`
+	Zone     *SeatZone `json:"zone,omitempty"`
+	// Fee là số tiền còn phải trả sau khi trừ phí chọn ghế đã trả trước đó
+	Fee int64 `json:"fee"`
+}
+
+// Select checks that the passenger of ticket may sit in seatCode and prices the seat.
+// holdsInfant tells whether an infant travels on the passenger's lap. It returns a
+// *SeatRuleError when the seat cannot be given to the passenger.
+func (m SeatMap) Select(ticket Ticket, seatCode string, holdsInfant bool) (SeatSelection, error) {
+	if _, ok := SeatRow(seatCode); !ok {
+		return SeatSelection{}, &SeatRuleError{SeatCode: seatCode, Reason: "invalid seat code"}
+	}
+	if ticket.PassengerType == PassengerTypeInfant {
+		return SeatSelection{}, &SeatRuleError{SeatCode: seatCode, Reason: "infants travel on an adult's lap"}
+	}
+
+	selection := SeatSelection{TicketID: ticket.TicketID, SeatCode: seatCode}
+	zone, ok := m.ZoneOf(seatCode)
+	if !ok {
+		return selection, nil
+	}
+	if zone.Type == SeatZoneExitRow {
+		// Hàng ghế thoát hiểm chỉ dành cho người lớn không bế em bé
+		if ticket.PassengerType == PassengerTypeChild {
+			return SeatSelection{}, &SeatRuleError{SeatCode: seatCode, Reason: "minors may not sit in an exit row"}
+		}
+		if holdsInfant {
+			return SeatSelection{}, &SeatRuleError{SeatCode: seatCode, Reason: "passengers travelling with an infant may not sit in an exit row"}
+		}
+	}
+	selection.Zone = &zone
+	if fee := zone.Price - ticket.PaidSeatFees(); fee > 0 {
+		selection.Fee = fee
+	}
+	return selection, nil
+}
+
+// FeeItem returns the add-on line that records the seat fee on the ticket.
+func (s SeatSelection) FeeItem() TicketAncillary {
+	name := "Seat " + s.SeatCode
+	if s.Zone != nil {
+		name = fmt.Sprintf("Seat %s (%s)", s.SeatCode, s.Zone.Name)
+	}
+	return TicketAncillary{
+		TicketID:  s.TicketID,
+		Code:      "SEAT",
+		Type:      AncillaryTypeSeat,
+		Name:      name,
+		Quantity:  1,
+		UnitPrice: s.Fee,
+		Amount:    s.Fee,
+		Status:    AncillaryStatusActive,
+	}
+}
+
+// SeatRuleError is returned when a passenger may not take a seat.
+type SeatRuleError struct {
+	SeatCode string
+	Reason   string
+}
+
+func (e *SeatRuleError) Error() string {
+	return fmt.Sprintf("seat %s: %s", e.SeatCode, e.Reason)
+}
+
+// SeatSelectionStatus is where a seat selection stands: on a confirmed booking with seat
+// fees to pay, the seats only change once the fees are captured.
+type SeatSelectionStatus string
+
+const (
+	SeatSelectionStatusPending   SeatSelectionStatus = "pending"
+	SeatSelectionStatusCompleted SeatSelectionStatus = "completed"
+	// SeatSelectionStatusFailed là yêu cầu không thực hiện được: thanh toán thất bại hoặc ghế đã bị giữ
+	SeatSelectionStatusFailed SeatSelectionStatus = "failed"
+)
+
+// SeatSelectionResult lists the seats given. When the booking had already been paid and
+// the seat fees are charged on their own, Status is pending, PaymentClientSecret is set
+// and Tickets is empty until the payment webhook confirms the payment.
+type SeatSelectionResult struct {
+	Status              SeatSelectionStatus
+	Tickets             []Ticket
+	Selections          []SeatSelection
+	AmountDue           int64
+	PaymentClientSecret string
+}
+
+// SeatSelectionPaymentResult is the outcome of capturing the seat fees of a pending
+// selection; Refund is set when the seats could not be given any more.
+type SeatSelectionPaymentResult struct {
+	Status SeatSelectionStatus
+	Refund *Refund
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSeatZones = []SeatZone{
+	{SeatZoneID: 1, AircraftType: "A321", Type: SeatZoneFront, Name: "Front rows", RowFrom: 1, RowTo: 3, Price: 150000},
+	{SeatZoneID: 2, AircraftType: "A321", Type: SeatZoneExitRow, Name: "Exit row", RowFrom: 12, RowTo: 12, Price: 200000},
+	{SeatZoneID: 3, FlightID: 7, Type: SeatZoneExtraLegroom, Name: "Extra legroom", RowFrom: 1, RowTo: 5, Price: 300000},
+	{SeatZoneID: 4, AircraftType: "B787", Type: SeatZoneFront, Name: "Front rows", RowFrom: 1, RowTo: 4, Price: 250000},
+}
+
+func TestSeatRow(t *testing.T) {
+	row, ok := SeatRow("12A")
+	require.True(t, ok)
+	assert.Equal(t, 12, row)
+
+	for _, code := range []string{"", "A12", "12", "0C"} {
+		_, ok := SeatRow(code)
+		assert.False(t, ok, code)
+	}
+}
+
+func TestSeatMapForFlight(t *testing.T) {
+	seatMap := SeatMapForFlight(testSeatZones, Flight{FlightID: 1, AircraftType: "A321"})
+	require.Len(t, seatMap, 2)
+
+	// Vùng ghế riêng của chuyến bay thay thế vùng ghế của loại máy bay
+	seatMap = SeatMapForFlight(testSeatZones, Flight{FlightID: 7, AircraftType: "A321"})
+	require.Len(t, seatMap, 1)
+	assert.Equal(t, int64(3), seatMap[0].SeatZoneID)
+
+	assert.Empty(t, SeatMapForFlight(testSeatZones, Flight{FlightID: 2, AircraftType: "ATR72"}))
+}
+
+func TestSeatMapSelect(t *testing.T) {
+	seatMap := SeatMapForFlight(testSeatZones, Flight{FlightID: 1, AircraftType: "A321"})
+	adult := Ticket{TicketID: 10, PassengerType: PassengerTypeAdult}
+
+	selection, err := seatMap.Select(adult, "2C", false)
+	require.NoError(t, err)
+	require.NotNil(t, selection.Zone)
+	assert.Equal(t, int64(150000), selection.Fee)
+
+	selection, err = seatMap.Select(adult, "20C", false)
+	require.NoError(t, err)
+	assert.Nil(t, selection.Zone)
+	assert.Zero(t, selection.Fee)
+
+	// Phí đã trả được trừ khi đổi sang ghế đắt hơn
+	adult.Ancillaries = []TicketAncillary{{Type: AncillaryTypeSeat, Amount: 150000, Status: AncillaryStatusActive}}
+	selection, err = seatMap.Select(adult, "12A", false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(50000), selection.Fee)
+
+	selection, err = seatMap.Select(adult, "1A", false)
+	require.NoError(t, err)
+	assert.Zero(t, selection.Fee)
+}
+
+func TestSeatMapSelectExitRowRules(t *testing.T) {
+	seatMap := SeatMapForFlight(testSeatZones, Flight{FlightID: 1, AircraftType: "A321"})
+	var ruleErr *SeatRuleError
+
+	_, err := seatMap.Select(Ticket{PassengerType: PassengerTypeChild}, "12A", false)
+	require.ErrorAs(t, err, &ruleErr)
+
+	_, err = seatMap.Select(Ticket{PassengerType: PassengerTypeAdult}, "12A", true)
+	require.ErrorAs(t, err, &ruleErr)
+
+	_, err = seatMap.Select(Ticket{PassengerType: PassengerTypeInfant}, "20A", false)
+	require.ErrorAs(t, err, &ruleErr)
+
+	// Trẻ em vẫn được ngồi ở các vùng ghế khác
+	_, err = seatMap.Select(Ticket{PassengerType: PassengerTypeChild}, "2A", false)
+	assert.NoError(t, err)
+}
+
+func TestSeatZoneValidate(t *testing.T) {
+	assert.NoError(t, testSeatZones[0].Validate())
+
+	invalid := []SeatZone{
+		{Type: SeatZoneFront, Name: "No scope", RowFrom: 1, RowTo: 2},
+		{AircraftType: "A321", Type: "window", Name: "Window", RowFrom: 1, RowTo: 2},
+		{AircraftType: "A321", Type: SeatZoneFront, Name: "Reversed", RowFrom: 5, RowTo: 2},
+		{AircraftType: "A321", Type: SeatZoneFront, Name: "Negative", RowFrom: 1, RowTo: 2, Price: -1},
+	}
+	for _, zone := range invalid {
+		assert.ErrorIs(t, zone.Validate(), ErrInvalidSeatZone, zone.Name)
+	}
+}
@@ -0,0 +1,137 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SpecialServiceCategory groups SSR codes; a passenger holds at most one code per category.
+type SpecialServiceCategory string
+
+const (
+	SpecialServiceWheelchair         SpecialServiceCategory = "wheelchair"
+	SpecialServiceUnaccompaniedMinor SpecialServiceCategory = "unaccompanied_minor"
+	SpecialServicePet                SpecialServiceCategory = "pet"
+	SpecialServiceMeal               SpecialServiceCategory = "meal"
+)
+
+// maxSpecialServiceNoteLength là độ dài tối đa của ghi chú kèm yêu cầu (ví dụ giống loài thú cưng)
+const maxSpecialServiceNoteLength = 255
+
+// SpecialService is an IATA special service request (SSR) code the airline handles, such
+// as WCHR for wheelchair assistance or PETC for a pet in cabin.
+type SpecialService struct {
+	Code     string                 `json:"code"`
+	Category SpecialServiceCategory `json:"category"`
+	Name     string                 `json:"name"`
+	// FlightLimit là số yêu cầu tối đa của mã trên một chuyến bay, 0 là không giới hạn
+	FlightLimit int32 `json:"flight_limit"`
+	Active      bool  `json:"active"`
+}
+
+// SpecialServiceCatalog is the list of SSR codes passengers can request.
+type SpecialServiceCatalog []SpecialService
+
+// Request returns the special service code requested for ticket, travelling on a
+// segment together with the segment tickets. It returns a *SpecialServiceError when the
+// code is unknown or does not suit the passenger. The flight limit is checked when the
+// request is stored.
+func (c SpecialServiceCatalog) Request(code string, note string, ticket Ticket, segment []Ticket) (TicketSpecialService, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	var service SpecialService
+	for _, s := range c {
+		if s.Code == code && s.Active {
+			service = s
+		}
+	}
+	if service.Code == "" {
+		return TicketSpecialService{}, &SpecialServiceError{Code: code, Reason: "unknown special service code"}
+	}
+	if len([]rune(note)) > maxSpecialServiceNoteLength {
+		return TicketSpecialService{}, &SpecialServiceError{Code: code, Reason: fmt.Sprintf("note must be at most %d characters", maxSpecialServiceNoteLength)}
+	}
+
+	for _, held := range ticket.SpecialServices {
+		if held.Code == service.Code {
+			return TicketSpecialService{}, &SpecialServiceError{Code: code, Reason: "already requested for this passenger"}
+		}
+		if held.Category == service.Category {
+			return TicketSpecialService{}, &SpecialServiceError{Code: code, Reason: fmt.Sprintf("passenger already has %s; remove it first", held.Code)}
+		}
+	}
+
+	switch service.Category {
+	case SpecialServiceUnaccompaniedMinor:
+		// Trẻ em đi một mình: hành khách phải là trẻ em và không có người lớn trên cùng chặng
+		if ticket.PassengerType != PassengerTypeChild {
+			return TicketSpecialService{}, &SpecialServiceError{Code: code, Reason: "only children can travel as unaccompanied minors"}
+		}
+		for _, other := range segment {
+			if other.Status == TicketStatusActive && other.PassengerType == PassengerTypeAdult {
+				return TicketSpecialService{}, &SpecialServiceError{Code: code, Reason: "the child travels with an adult of the booking"}
+			}
+		}
+	case SpecialServicePet:
+		if ticket.PassengerType == PassengerTypeInfant {
+			return TicketSpecialService{}, &SpecialServiceError{Code: code, Reason: "infants without a seat cannot carry a pet"}
+		}
+	}
+
+	return TicketSpecialService{
+		TicketID:  ticket.TicketID,
+		BookingID: ticket.BookingID,
+		FlightID:  ticket.FlightID,
+		Code:      service.Code,
+		Category:  service.Category,
+		Name:      service.Name,
+		Note:      strings.TrimSpace(note),
+	}, nil
+}
+
+// Limit returns the number of requests for code a flight can carry, 0 meaning unlimited.
+func (c SpecialServiceCatalog) Limit(code string) int32 {
+	for _, s := range c {
+		if s.Code == code {
+			return s.FlightLimit
+		}
+	}
+	return 0
+}
+
+// TicketSpecialService is a special service requested for one ticket.
+type TicketSpecialService struct {
+	TicketSpecialServiceID int64                  `json:"ticket_special_service_id"`
+	TicketID               int64                  `json:"ticket_id"`
+	BookingID              int64                  `json:"booking_id"`
+	FlightID               int64                  `json:"flight_id"`
+	Code                   string                 `json:"code"`
+	Category               SpecialServiceCategory `json:"category"`
+	Name                   string                 `json:"name"`
+	Note                   string                 `json:"note"`
+	CreatedAt              time.Time              `json:"created_at"`
+}
+
+// SpecialServiceError is returned when a special service cannot be requested or removed.
+type SpecialServiceError struct {
+	Code   string
+	Reason string
+}
+
+func (e *SpecialServiceError) Error() string {
+	return fmt.Sprintf("special service %s: %s", e.Code, e.Reason)
+}
+
+// AddSpecialServiceParams identifies the special service a passenger asks for after booking.
+type AddSpecialServiceParams struct {
+	BookingID int64
+	TicketID  int64
+	Code      string
+	Note      string
+}
+
+// RemoveSpecialServiceParams identifies the special service request to withdraw.
+type RemoveSpecialServiceParams struct {
+	BookingID              int64
+	TicketSpecialServiceID int64
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSpecialServices = SpecialServiceCatalog{
+	{Code: "WCHR", Category: SpecialServiceWheelchair, Name: "Wheelchair to the aircraft door", Active: true},
+	{Code: "UMNR", Category: SpecialServiceUnaccompaniedMinor, Name: "Unaccompanied minor", FlightLimit: 4, Active: true},
+	{Code: "PETC", Category: SpecialServicePet, Name: "Pet in cabin", FlightLimit: 2, Active: true},
+	{Code: "VGML", Category: SpecialServiceMeal, Name: "Vegetarian meal", Active: true},
+	{Code: "KSML", Category: SpecialServiceMeal, Name: "Kosher meal", Active: true},
+	{Code: "DBML", Category: SpecialServiceMeal, Name: "Diabetic meal", Active: false},
+}
+
+func TestSpecialServiceCatalogRequest(t *testing.T) {
+	adult := Ticket{TicketID: 1, BookingID: 7, FlightID: 3, Status: TicketStatusActive, PassengerType: PassengerTypeAdult}
+
+	item, err := testSpecialServices.Request(" vgml ", "no eggs", adult, []Ticket{adult})
+	require.NoError(t, err)
+	assert.Equal(t, "VGML", item.Code)
+	assert.Equal(t, SpecialServiceMeal, item.Category)
+	assert.Equal(t, int64(3), item.FlightID)
+	assert.Equal(t, "no eggs", item.Note)
+
+	var specialServiceErr *SpecialServiceError
+	_, err = testSpecialServices.Request("XXXX", "", adult, nil)
+	assert.ErrorAs(t, err, &specialServiceErr)
+	_, err = testSpecialServices.Request("DBML", "", adult, nil)
+	assert.ErrorAs(t, err, &specialServiceErr)
+
+	// Mỗi nhóm chỉ một mã cho một hành khách
+	adult.SpecialServices = []TicketSpecialService{item}
+	_, err = testSpecialServices.Request("VGML", "", adult, nil)
+	assert.ErrorAs(t, err, &specialServiceErr)
+	_, err = testSpecialServices.Request("KSML", "", adult, nil)
+	assert.ErrorAs(t, err, &specialServiceErr)
+	_, err = testSpecialServices.Request("WCHR", "", adult, nil)
+	assert.NoError(t, err)
+}
+
+func TestSpecialServiceCatalogRequestPassengerRules(t *testing.T) {
+	adult := Ticket{TicketID: 1, Status: TicketStatusActive, PassengerType: PassengerTypeAdult}
+	child := Ticket{TicketID: 2, Status: TicketStatusActive, PassengerType: PassengerTypeChild}
+	infant := Ticket{TicketID: 3, Status: TicketStatusActive, PassengerType: PassengerTypeInfant}
+
+	var specialServiceErr *SpecialServiceError
+	_, err := testSpecialServices.Request("UMNR", "", child, []Ticket{child})
+	assert.NoError(t, err)
+	_, err = testSpecialServices.Request("UMNR", "", child, []Ticket{adult, child})
+	assert.ErrorAs(t, err, &specialServiceErr)
+	_, err = testSpecialServices.Request("UMNR", "", adult, []Ticket{adult})
+	assert.ErrorAs(t, err, &specialServiceErr)
+
+	// Người lớn đã huỷ vé không còn đi cùng trẻ
+	cancelled := adult
+	cancelled.Status = TicketStatusCancelled
+	_, err = testSpecialServices.Request("UMNR", "", child, []Ticket{cancelled, child})
+	assert.NoError(t, err)
+
+	_, err = testSpecialServices.Request("PETC", "cat, 5kg", adult, nil)
+	assert.NoError(t, err)
+	_, err = testSpecialServices.Request("PETC", "", infant, nil)
+	assert.ErrorAs(t, err, &specialServiceErr)
+
+	assert.Equal(t, int32(2), testSpecialServices.Limit("PETC"))
+	assert.Equal(t, int32(0), testSpecialServices.Limit("VGML"))
+}
@@ -0,0 +1,15 @@
+package entities
+
+import "fmt"
+
+// StatusTransitionError is returned when a booking or a ticket is asked to move
+// to a status that cannot be reached from its current one.
+type StatusTransitionError struct {
+	Entity string
+	From   string
+	To     string
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("%s cannot transition from %q to %q", e.Entity, e.From, e.To)
+}
@@ -7,10 +7,34 @@ import (
 type TicketStatus string
 
 const (
-	TicketStatusActive    TicketStatus = "Booked"
+	TicketStatusActive    TicketStatus = "Active"
 	TicketStatusCancelled TicketStatus = "Cancelled"
 )
 
+// ticketTransitions lists, for every ticket status, the statuses it may move to.
+var ticketTransitions = map[TicketStatus][]TicketStatus{
+	TicketStatusActive:    {TicketStatusCancelled},
+	TicketStatusCancelled: {},
+}
+
+// CanTransitionTo reports whether a ticket in status s may move to next.
+func (s TicketStatus) CanTransitionTo(next TicketStatus) bool {
+	for _, allowed := range ticketTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns a *StatusTransitionError when s cannot move to next.
+func (s TicketStatus) ValidateTransition(next TicketStatus) error {
+	if !s.CanTransitionTo(next) {
+		return &StatusTransitionError{Entity: "ticket", From: string(s), To: string(next)}
+	}
+	return nil
+}
+
 type FlightClass string
 
 const (
@@ -19,16 +43,89 @@ const (
 	FlightClassFirstClass FlightClass = "firstClass"
 )
 
+// FlightClasses lists the cabins the airline sells.
+var FlightClasses = []FlightClass{FlightClassEconomy, FlightClassBusiness, FlightClassFirstClass}
+
+// Valid reports whether c is one of the cabins the airline sells.
+func (c FlightClass) Valid() bool {
+	for _, class := range FlightClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
 type Ticket struct {
-	TicketID    int64        `json:"ticket_id"`
-	SeatID      int64        `json:"seat_id"`
-	FlightClass FlightClass  `json:"flight_class"`
-	Price       int32        `json:"price"`
-	Status      TicketStatus `json:"status"`
-	BookingID   int64        `json:"booking_id"`
-	FlightID    int64        `json:"flight_id"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
-	Seat        Seat         `json:"seat"`
-	Owner       TicketOwner  `json:"owner"`
+	TicketID     int64        `json:"ticket_id"`
+	TicketNumber string       `json:"ticket_number"`
+	SeatID       int64        `json:"seat_id"`
+	FlightClass  FlightClass  `json:"flight_class"`
+	Price        int32        `json:"price"`
+	Status       TicketStatus `json:"status"`
+	BookingID    int64        `json:"booking_id"`
+	BookingPNR   string       `json:"booking_pnr"`
+	FlightID     int64        `json:"flight_id"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+	Seat         Seat         `json:"seat"`
+	Owner        TicketOwner  `json:"owner"`
+	// Em bé không có ghế riêng; AccompanyingTicketID là vé của người lớn đi kèm
+	PassengerType        PassengerType `json:"passenger_type"`
+	AccompanyingTicketID int64         `json:"accompanying_ticket_id,omitempty"`
+	// AccompanyingPassenger là vị trí (từ 0) của người lớn đi kèm trong cùng chặng, chỉ dùng khi tạo booking
+	AccompanyingPassenger *int `json:"-"`
+	// FareItems là chi tiết giá vé; Price là tổng của các dòng này
+	FareItems []FareItem `json:"fare_items,omitempty"`
+	// FareFamily là gói giá (Lite/Classic/Flex) của vé trong hạng ghế
+	FareFamily FareFamilyCode `json:"fare_family"`
+	// Ancillaries là các dịch vụ bổ trợ đã mua cho vé, tính riêng với Price
+	Ancillaries []TicketAncillary `json:"ancillaries,omitempty"`
+	// SpecialServices là các yêu cầu dịch vụ đặc biệt (SSR) của hành khách, ví dụ xe lăn hoặc suất ăn chay
+	SpecialServices []TicketSpecialService `json:"special_services,omitempty"`
+	// CheckedInAt là thời điểm hành khách làm thủ tục trực tuyến, nil khi chưa làm thủ tục
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
+}
+
+// AmountDue returns what the passenger pays for the ticket: its fare plus every add-on
+// that has not been cancelled. A cancelled ticket costs nothing.
+func (t Ticket) AmountDue() int64 {
+	if t.Status == TicketStatusCancelled {
+		return 0
+	}
+	amount := int64(t.Price)
+	for _, ancillary := range t.Ancillaries {
+		if ancillary.Status == AncillaryStatusActive {
+			amount += ancillary.Amount
+		}
+	}
+	return amount
+}
+
+// PaidSeatFees returns the seat fees already charged on the ticket, so that moving to
+// a dearer seat only costs the difference.
+func (t Ticket) PaidSeatFees() int64 {
+	var paid int64
+	for _, ancillary := range t.Ancillaries {
+		if ancillary.Type == AncillaryTypeSeat && ancillary.Status == AncillaryStatusActive {
+			paid += ancillary.Amount
+		}
+	}
+	return paid
+}
+
+// CancelTicketParams holds what is needed to cancel one ticket. The refund rules decide
+// how much of the ticket and its add-ons is refunded.
+type CancelTicketParams struct {
+	TicketID     int64
+	RefundPolicy RefundPolicy
+	FareFamilies FareFamilyCatalog
+	CancelledAt  time.Time
+}
+
+// CancelTicketResult is the cancelled ticket and the refund recorded for it, nil when its
+// booking had not been paid.
+type CancelTicketResult struct {
+	Ticket *Ticket
+	Refund *Refund
 }
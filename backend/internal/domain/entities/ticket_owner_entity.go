@@ -20,4 +20,10 @@ type TicketOwner struct {
 	PassportNumber       string     `json:"passport_number"`
 	IdentificationNumber string     `json:"identification_number"`
 	Address              string     `json:"address"`
+	// DocumentExpiry là hạn giấy tờ lúc đặt, rỗng nếu không có thông tin
+	DocumentExpiry time.Time `json:"document_expiry"`
+	// DocumentCountry là nước cấp hộ chiếu (ISO 3166-1 alpha-2)
+	DocumentCountry string `json:"document_country"`
+	// CompanionID là hồ sơ người đi cùng đã dùng để đặt vé, 0 nếu khách nhập tay
+	CompanionID int64 `json:"companion_id"`
 }
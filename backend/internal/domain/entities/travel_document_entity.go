@@ -0,0 +1,158 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// passportFormats are the passport number formats of the issuing countries we know.
+// Other countries are checked against the ICAO 9303 document number rules only.
+var passportFormats = map[string]*regexp.Regexp{
+	"VN": regexp.MustCompile(`^[A-Z][0-9]{7}$`),
+	"US": regexp.MustCompile(`^([0-9]{9}|[A-Z][0-9]{8})$`),
+	"GB": regexp.MustCompile(`^[0-9]{9}$`),
+	"JP": regexp.MustCompile(`^[A-Z]{2}[0-9]{7}$`),
+	"KR": regexp.MustCompile(`^[A-Z][0-9]{8}$|^[A-Z][0-9]{3}[A-Z][0-9]{4}$`),
+	"CN": regexp.MustCompile(`^[EG][0-9]{8}$|^E[A-Z][0-9]{7}$`),
+	"FR": regexp.MustCompile(`^[0-9]{2}[A-Z]{2}[0-9]{5}$`),
+	"DE": regexp.MustCompile(`^[CFGHJKLMNPRTVWXYZ0-9]{9}$`),
+	"AU": regexp.MustCompile(`^[A-Z]{1,2}[0-9]{7}$`),
+	"SG": regexp.MustCompile(`^[A-Z][0-9]{7}[A-Z]$`),
+	"TH": regexp.MustCompile(`^[A-Z]{1,2}[0-9]{6,7}$`),
+}
+
+var (
+	icaoDocumentNumber = regexp.MustCompile(`^[A-Z0-9]{6,9}$`)
+	countryCode        = regexp.MustCompile(`^[A-Z]{2}$`)
+	identityCardNumber = regexp.MustCompile(`^[A-Z0-9]{6,20}$`)
+)
+
+// maxNameLength là độ dài tối đa của họ hoặc tên, theo giới hạn của hệ thống đặt chỗ
+const maxNameLength = 50
+
+// CheckPassportNumber validates a passport number against the format of the country
+// (ISO 3166-1 alpha-2) that issued it.
+func CheckPassportNumber(country string, number string) error {
+	if !countryCode.MatchString(country) {
+		return fmt.Errorf("issuing country must be a two-letter ISO country code")
+	}
+	format, ok := passportFormats[country]
+	if !ok {
+		format = icaoDocumentNumber
+	}
+	if !format.MatchString(number) {
+		return fmt.Errorf("%q is not a valid %s passport number", number, country)
+	}
+	return nil
+}
+
+// CheckPassengerName validates a first or last name as it must be printed on the ticket.
+// On international trips the name has to match the machine readable zone of the passport,
+// so only unaccented Latin letters are accepted there.
+func CheckPassengerName(name string, international bool) error {
+	trimmed := strings.TrimSpace(name)
+	switch {
+	case trimmed == "":
+		return fmt.Errorf("is required")
+	case len([]rune(trimmed)) > maxNameLength:
+		return fmt.Errorf("must be at most %d characters", maxNameLength)
+	}
+	for _, r := range trimmed {
+		switch {
+		case r == ' ' || r == '-' || r == '\'':
+		case international && (r < 'A' || r > 'Z') && (r < 'a' || r > 'z'):
+			return fmt.Errorf("must be written in Latin letters without accents, as printed in the passport")
+		case !unicode.IsLetter(r):
+			return fmt.Errorf("may only contain letters, spaces, hyphens and apostrophes")
+		}
+	}
+	return nil
+}
+
+// FieldError is a problem with one field of a request. Field is the JSON path of the field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// DocumentError lists every passenger detail of a booking that failed validation.
+type DocumentError struct {
+	Fields []FieldError
+}
+
+func (e *DocumentError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Field+": "+field.Message)
+	}
+	return "invalid passenger documents: " + strings.Join(messages, "; ")
+}
+
+// DocumentPolicy holds the travel document rules checked when booking.
+type DocumentPolicy struct {
+	// HomeCountry là mã quốc gia (ISO 3166-1 alpha-2) của mạng bay nội địa; chuyến có sân bay ở nước khác là quốc tế
+	HomeCountry string
+	// PassportValidityMonths là số tháng hộ chiếu phải còn hạn sau ngày bay chặng cuối (ngày về) của hành trình quốc tế
+	PassportValidityMonths int
+}
+
+// International reports whether any flight of the itinerary leaves or reaches an airport
+// outside the home country.
+func (p DocumentPolicy) International(flights []Flight) bool {
+	for _, flight := range flights {
+		if !strings.EqualFold(flight.DepartureCountry, p.HomeCountry) || !strings.EqualFold(flight.ArrivalCountry, p.HomeCountry) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckPassenger returns the problems with the name and documents of owner on a trip
+// that starts on departureDate and whose last flight leaves on returnDate. Fields are
+// named as in the ownerData of a booking request.
+func (p DocumentPolicy) CheckPassenger(owner TicketOwner, international bool, departureDate, returnDate time.Time) []FieldError {
+	var fields []FieldError
+	if err := CheckPassengerName(owner.FirstName, international); err != nil {
+		fields = append(fields, FieldError{Field: "firstName", Message: "first name " + err.Error()})
+	}
+	if err := CheckPassengerName(owner.LastName, international); err != nil {
+		fields = append(fields, FieldError{Field: "lastName", Message: "last name " + err.Error()})
+	}
+	if owner.DateOfBirth.IsZero() || owner.DateOfBirth.After(departureDate) {
+		fields = append(fields, FieldError{Field: "dateOfBirth", Message: "date of birth is required and must be before the trip"})
+	}
+
+	// Chuyến quốc tế bắt buộc hộ chiếu; chuyến nội địa chấp nhận hộ chiếu hoặc giấy tờ tuỳ thân
+	if owner.PassportNumber == "" {
+		if international {
+			fields = append(fields, FieldError{Field: "passportNumber", Message: "a passport is required on international trips"})
+		}
+		if owner.IdentificationNumber != "" && !identityCardNumber.MatchString(strings.ToUpper(owner.IdentificationNumber)) {
+			fields = append(fields, FieldError{Field: "identityCardNumber", Message: "identity card number may only contain 6 to 20 letters and digits"})
+		}
+		return fields
+	}
+	if err := CheckPassportNumber(owner.DocumentCountry, owner.PassportNumber); err != nil {
+		field := "passportNumber"
+		if !countryCode.MatchString(owner.DocumentCountry) {
+			field = "passportCountry"
+		}
+		fields = append(fields, FieldError{Field: field, Message: err.Error()})
+	}
+
+	// Hộ chiếu phải còn hạn đến ngày về, và thêm PassportValidityMonths tháng với chuyến quốc tế
+	validUntil := returnDate
+	if international {
+		validUntil = returnDate.AddDate(0, p.PassportValidityMonths, 0)
+	}
+	switch {
+	case owner.DocumentExpiry.IsZero():
+		fields = append(fields, FieldError{Field: "passportExpiry", Message: "passport expiry date is required"})
+	case owner.DocumentExpiry.Before(validUntil):
+		fields = append(fields, FieldError{Field: "passportExpiry", Message: fmt.Sprintf("passport must be valid until at least %s", validUntil.Format("2006-01-02"))})
+	}
+	return fields
+}
@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPassportNumber(t *testing.T) {
+	assert.NoError(t, CheckPassportNumber("VN", "C1234567"))
+	assert.NoError(t, CheckPassportNumber("US", "123456789"))
+	// Nước không có định dạng riêng chỉ kiểm tra theo ICAO
+	assert.NoError(t, CheckPassportNumber("NZ", "LA123456"))
+
+	assert.Error(t, CheckPassportNumber("VN", "123456789"))
+	assert.Error(t, CheckPassportNumber("NZ", "LA-12345"))
+	assert.Error(t, CheckPassportNumber("VNM", "C1234567"))
+	assert.Error(t, CheckPassportNumber("", "C1234567"))
+}
+
+func TestCheckPassengerName(t *testing.T) {
+	assert.NoError(t, CheckPassengerName("Nguyễn Văn", false))
+	assert.NoError(t, CheckPassengerName("O'Brien-Smith", true))
+
+	assert.Error(t, CheckPassengerName("Nguyễn", true))
+	assert.Error(t, CheckPassengerName("  ", false))
+	assert.Error(t, CheckPassengerName("An2", false))
+}
+
+func TestDocumentPolicyInternational(t *testing.T) {
+	policy := DocumentPolicy{HomeCountry: "VN"}
+
+	assert.False(t, policy.International([]Flight{{DepartureCountry: "VN", ArrivalCountry: "vn"}}))
+	assert.True(t, policy.International([]Flight{
+		{DepartureCountry: "VN", ArrivalCountry: "VN"},
+		{DepartureCountry: "VN", ArrivalCountry: "TH"},
+	}))
+}
+
+func TestDocumentPolicyCheckPassenger(t *testing.T) {
+	policy := DocumentPolicy{PassportValidityMonths: 6}
+	departureDate := time.Date(2025, 8, 10, 12, 0, 0, 0, time.UTC)
+	owner := TicketOwner{
+		FirstName:       "An",
+		LastName:        "Nguyen",
+		DateOfBirth:     time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC),
+		PassportNumber:  "C1234567",
+		DocumentCountry: "VN",
+		DocumentExpiry:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.Empty(t, policy.CheckPassenger(owner, true, departureDate, departureDate))
+
+	// Hộ chiếu hết hạn trong vòng sáu tháng sau ngày khởi hành chỉ bị từ chối với chuyến quốc tế
+	expiring := owner
+	expiring.DocumentExpiry = time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	assert.Empty(t, policy.CheckPassenger(expiring, false, departureDate, departureDate))
+	fields := policy.CheckPassenger(expiring, true, departureDate, departureDate)
+	require.Len(t, fields, 1)
+	assert.Equal(t, "passportExpiry", fields[0].Field)
+
+	// Chuyến nội địa chấp nhận giấy tờ tuỳ thân, chuyến quốc tế bắt buộc hộ chiếu
+	domestic := TicketOwner{FirstName: "Bình", LastName: "Trần", DateOfBirth: owner.DateOfBirth, IdentificationNumber: "001090012345"}
+	assert.Empty(t, policy.CheckPassenger(domestic, false, departureDate, departureDate))
+	fields = policy.CheckPassenger(domestic, true, departureDate, departureDate)
+	require.Len(t, fields, 3)
+	assert.Equal(t, "firstName", fields[0].Field)
+	assert.Equal(t, "lastName", fields[1].Field)
+	assert.Equal(t, "passportNumber", fields[2].Field)
+
+	missingCountry := owner
+	missingCountry.DocumentCountry = ""
+	fields = policy.CheckPassenger(missingCountry, true, departureDate, departureDate)
+	require.Len(t, fields, 1)
+	assert.Equal(t, "passportCountry", fields[0].Field)
+
+	noBirthDate := owner
+	noBirthDate.DateOfBirth = time.Time{}
+	fields = policy.CheckPassenger(noBirthDate, true, departureDate, departureDate)
+	require.Len(t, fields, 1)
+	assert.Equal(t, "dateOfBirth", fields[0].Field)
+
+	// Chuyến khứ hồi: hộ chiếu còn hạn sáu tháng sau ngày đi nhưng không đủ sáu tháng sau ngày về
+	returnDate := time.Date(2025, 10, 20, 12, 0, 0, 0, time.UTC)
+	roundTrip := owner
+	roundTrip.DocumentExpiry = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	fields = policy.CheckPassenger(roundTrip, true, departureDate, returnDate)
+	require.Len(t, fields, 1)
+	assert.Equal(t, "passportExpiry", fields[0].Field)
+	assert.Contains(t, fields[0].Message, "2026-04-20")
+	assert.Empty(t, policy.CheckPassenger(roundTrip, false, departureDate, returnDate))
+}
@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// TripFilter selects the bookings listed in a customer's trips. A trip is upcoming while
+// any of its flights has yet to depart, and past once the last one has left.
+type TripFilter struct {
+	Upcoming bool
+	// Status lọc theo trạng thái booking; để trống để lấy mọi trạng thái
+	Status BookingStatus
+	// DepartsFrom và DepartsTo giới hạn ngày khởi hành của chặng đầu; giá trị zero là không giới hạn
+	DepartsFrom time.Time
+	DepartsTo   time.Time
+	Now         time.Time
+	Page        int
+	Limit       int
+}
+
+// Validate checks the status and the date range of the filter.
+func (f TripFilter) Validate() error {
+	switch f.Status {
+	case "", BookingStatusConfirmed, BookingStatusPending, BookingStatusCancelled:
+	default:
+		return fmt.Errorf("unknown booking status %q", f.Status)
+	}
+	if !f.DepartsFrom.IsZero() && !f.DepartsTo.IsZero() && !f.DepartsTo.After(f.DepartsFrom) {
+		return fmt.Errorf("the end of the date range must be after its start")
+	}
+	return nil
+}
+
+// Offset returns how many trips come before the requested page.
+func (f TripFilter) Offset() int {
+	return (f.Page - 1) * f.Limit
+}
+
+// Trip is a booking as the customer sees it in their trips: every segment with its
+// flight and the passengers, seats and ticket statuses on it.
+type Trip struct {
+	Booking            Booking
+	Segments           []TripSegment
+	FirstDepartureTime time.Time
+	LastDepartureTime  time.Time
+}
+
+// Upcoming reports whether a flight of the trip has yet to depart at now.
+func (t Trip) Upcoming(now time.Time) bool {
+	return !t.LastDepartureTime.Before(now)
+}
+
+type TripSegment struct {
+	SegmentOrder int
+	Flight       Flight
+	Tickets      []Ticket
+}
+
+// TripPage is one page of a customer's upcoming or past trips. UpcomingCount and PastCount
+// count every trip matching the status and date filters on both sides.
+type TripPage struct {
+	Trips         []Trip
+	Total         int64
+	UpcomingCount int64
+	PastCount     int64
+}
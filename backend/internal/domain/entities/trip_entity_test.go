@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTripFilterValidate(t *testing.T) {
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, TripFilter{}.Validate())
+	require.NoError(t, TripFilter{Status: BookingStatusConfirmed, DepartsFrom: from, DepartsTo: from.AddDate(0, 1, 0)}.Validate())
+
+	assert.Error(t, TripFilter{Status: "boarded"}.Validate())
+	assert.Error(t, TripFilter{DepartsFrom: from, DepartsTo: from}.Validate())
+}
+
+func TestTripUpcoming(t *testing.T) {
+	now := time.Date(2026, 7, 10, 12, 0, 0, 0, time.UTC)
+	trip := Trip{FirstDepartureTime: now.Add(-72 * time.Hour), LastDepartureTime: now.Add(2 * time.Hour)}
+	assert.True(t, trip.Upcoming(now))
+
+	trip.LastDepartureTime = now.Add(-time.Hour)
+	assert.False(t, trip.Upcoming(now))
+	assert.Equal(t, 20, TripFilter{Page: 3, Limit: 10}.Offset())
+}
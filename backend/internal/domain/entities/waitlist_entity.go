@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LoyaltyTier is the membership level of a customer.
+type LoyaltyTier string
+
+const (
+	LoyaltyTierMember   LoyaltyTier = "member"
+	LoyaltyTierSilver   LoyaltyTier = "silver"
+	LoyaltyTierGold     LoyaltyTier = "gold"
+	LoyaltyTierPlatinum LoyaltyTier = "platinum"
+)
+
+// Rank orders tiers from member (0) to platinum (3); unknown tiers rank as member.
+func (t LoyaltyTier) Rank() int {
+	switch t {
+	case LoyaltyTierSilver:
+		return 1
+	case LoyaltyTierGold:
+		return 2
+	case LoyaltyTierPlatinum:
+		return 3
+	}
+	return 0
+}
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "waiting"
+	WaitlistStatusOffered   WaitlistStatus = "offered"
+	WaitlistStatusClaimed   WaitlistStatus = "claimed"
+	WaitlistStatusBooked    WaitlistStatus = "booked"
+	WaitlistStatusExpired   WaitlistStatus = "expired"
+	WaitlistStatusCancelled WaitlistStatus = "cancelled"
+)
+
+// WaitlistEntry is a customer waiting for a seat in a cabin of a sold-out flight.
+// When a seat is freed the next entry is offered it, and the seat is held for them
+// until the offer expires or they book it.
+type WaitlistEntry struct {
+	WaitlistEntryID int64          `json:"waitlist_entry_id"`
+	FlightID        int64          `json:"flight_id"`
+	FlightClass     FlightClass    `json:"flight_class"`
+	UserEmail       string         `json:"user_email"`
+	Status          WaitlistStatus `json:"status"`
+	// ClaimToken là mã trong đường dẫn nhận ghế gửi qua email, chỉ có khi đã được mời
+	ClaimToken     string     `json:"-"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	JoinedAt       time.Time  `json:"joined_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// CheckClaimable returns a *WaitlistError unless the entry holds an offer for email
+// that is still open at now.
+func (e WaitlistEntry) CheckClaimable(email string, now time.Time) error {
+	switch {
+	case !strings.EqualFold(e.UserEmail, email):
+		return &WaitlistError{Reason: "the offer was sent to another customer"}
+	case e.Status == WaitlistStatusClaimed:
+		return &WaitlistError{Reason: "the offer has already been claimed"}
+	case e.Status != WaitlistStatusOffered:
+		return &WaitlistError{Reason: fmt.Sprintf("the entry is %s", e.Status)}
+	case e.OfferExpiresAt == nil || !e.OfferExpiresAt.After(now):
+		return &WaitlistError{Reason: "the offer has expired"}
+	}
+	return nil
+}
+
+// WaitlistError is returned when a waitlist offer cannot be claimed.
+type WaitlistError struct {
+	Reason string
+}
+
+func (e *WaitlistError) Error() string {
+	return "waitlist: " + e.Reason
+}
+
+// ClaimWaitlistResult is the claimed entry and the fares locked for the customer to book the seat.
+type ClaimWaitlistResult struct {
+	Entry WaitlistEntry
+	Quote FareQuote
+}
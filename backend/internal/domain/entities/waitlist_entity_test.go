@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoyaltyTierRank(t *testing.T) {
+	assert.Greater(t, LoyaltyTierPlatinum.Rank(), LoyaltyTierGold.Rank())
+	assert.Greater(t, LoyaltyTierGold.Rank(), LoyaltyTierSilver.Rank())
+	assert.Greater(t, LoyaltyTierSilver.Rank(), LoyaltyTierMember.Rank())
+	assert.Equal(t, LoyaltyTierMember.Rank(), LoyaltyTier("").Rank())
+}
+
+func TestWaitlistEntryCheckClaimable(t *testing.T) {
+	now := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(time.Hour)
+	entry := WaitlistEntry{UserEmail: "an@example.com", Status: WaitlistStatusOffered, OfferExpiresAt: &expiresAt}
+
+	require.NoError(t, entry.CheckClaimable("AN@example.com", now))
+
+	var waitlistErr *WaitlistError
+	assert.ErrorAs(t, entry.CheckClaimable("binh@example.com", now), &waitlistErr)
+	assert.ErrorAs(t, entry.CheckClaimable("an@example.com", expiresAt), &waitlistErr)
+
+	entry.Status = WaitlistStatusClaimed
+	assert.ErrorAs(t, entry.CheckClaimable("an@example.com", now), &waitlistErr)
+
+	entry.Status = WaitlistStatusWaiting
+	entry.OfferExpiresAt = nil
+	assert.ErrorAs(t, entry.CheckClaimable("an@example.com", now), &waitlistErr)
+}
@@ -0,0 +1,116 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+type WalletTransactionKind string
+
+const (
+	// WalletRefund là tiền hoàn vào ví khi khách chọn nhận tín dụng thay cho hoàn tiền
+	WalletRefund WalletTransactionKind = "refund"
+	// WalletGoodwill là tín dụng admin tặng, ví dụ sau khi chuyến bay bị gián đoạn
+	WalletGoodwill WalletTransactionKind = "goodwill"
+	// WalletPayment là tiền trong ví dùng để thanh toán booking
+	WalletPayment WalletTransactionKind = "payment"
+	// WalletRestore là tiền đã thanh toán bằng ví được trả lại khi booking bị huỷ
+	WalletRestore WalletTransactionKind = "restore"
+	// WalletExpiry là tín dụng hết hạn chưa dùng
+	WalletExpiry WalletTransactionKind = "expiry"
+)
+
+// WalletTransaction is one line of a customer's travel credit statement. Amount is
+// positive for credits and negative for debits.
+type WalletTransaction struct {
+	TransactionID int64                 `json:"transaction_id"`
+	Kind          WalletTransactionKind `json:"kind"`
+	Amount        int64                 `json:"amount"`
+	BookingID     int64                 `json:"booking_id"`
+	RefundID      int64                 `json:"refund_id"`
+	// IssuedBy là người đã cấp tín dụng thiện chí, để trống với tín dụng tự động
+	IssuedBy    string     `json:"issued_by"`
+	Description string     `json:"description"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// CheckWalletPayment validates paying amount from a wallet holding balance towards a
+// booking that still has amountDue to pay.
+func CheckWalletPayment(amount int64, balance int64, amountDue int64) error {
+	switch {
+	case amount <= 0:
+		return &WalletError{Reason: "the amount to pay must be positive"}
+	case amount > balance:
+		return &WalletError{Reason: fmt.Sprintf("only %d is available in the wallet", balance)}
+	case amount > amountDue:
+		return &WalletError{Reason: fmt.Sprintf("only %d is due on the booking", amountDue)}
+	}
+	return nil
+}
+
+// WalletStatement is a page of a customer's wallet transactions, newest first.
+type WalletStatement struct {
+	Transactions []WalletTransaction `json:"transactions"`
+	Total        int64               `json:"total"`
+}
+
+// PayWithWalletParams asks to pay part or all of a booking from the wallet.
+type PayWithWalletParams struct {
+	UserID    int64
+	BookingID int64
+	Amount    int64
+	// AmountDue là số tiền booking phải trả trước khi dùng ví, đã trừ phần trả bằng điểm và
+	// tiền đã thu trước
+	AmountDue int64
+}
+
+// PayWithWalletResult is the wallet payment and what is left to pay.
+type PayWithWalletResult struct {
+	Transaction WalletTransaction `json:"transaction"`
+	Balance     int64             `json:"balance"`
+	AmountDue   int64             `json:"amount_due"`
+	// Booking là booking sau khi thanh toán; được xác nhận khi ví trả hết số tiền còn lại
+	Booking Booking `json:"booking"`
+	// Confirmed là true nếu chính lần trả này xác nhận booking
+	Confirmed bool `json:"confirmed"`
+}
+
+// IssueWalletCreditParams credits a customer's wallet with goodwill from an admin.
+type IssueWalletCreditParams struct {
+	UserID int64
+	// Email tìm tài khoản khách hàng khi chưa biết UserID
+	Email       string
+	Amount      int64
+	IssuedBy    string
+	Description string
+	BookingID   int64
+	ExpiresAt   time.Time
+}
+
+// Validate checks the credit before it is issued.
+func (p IssueWalletCreditParams) Validate() error {
+	switch {
+	case p.Amount <= 0:
+		return &WalletError{Reason: "the credit amount must be positive"}
+	case p.Description == "":
+		return &WalletError{Reason: "a reason is required"}
+	}
+	return nil
+}
+
+// FlightWalletCredit is the goodwill credited to one customer for a disrupted flight.
+type FlightWalletCredit struct {
+	UserID      int64             `json:"user_id"`
+	Tickets     int64             `json:"tickets"`
+	Transaction WalletTransaction `json:"transaction"`
+}
+
+// WalletError is returned when travel credit cannot be used or issued.
+type WalletError struct {
+	Reason string
+}
+
+func (e *WalletError) Error() string {
+	return "wallet: " + e.Reason
+}
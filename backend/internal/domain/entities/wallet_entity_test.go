@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckWalletPayment(t *testing.T) {
+	require.NoError(t, CheckWalletPayment(500000, 800000, 500000))
+
+	var walletErr *WalletError
+	assert.ErrorAs(t, CheckWalletPayment(0, 800000, 500000), &walletErr)
+	assert.ErrorAs(t, CheckWalletPayment(900000, 800000, 1000000), &walletErr)
+	assert.EqualError(t, CheckWalletPayment(600000, 800000, 500000), "wallet: only 500000 is due on the booking")
+}
+
+func TestIssueWalletCreditParamsValidate(t *testing.T) {
+	params := IssueWalletCreditParams{UserID: 1, Amount: 200000, Description: "Flight QA101 delayed 5 hours"}
+	require.NoError(t, params.Validate())
+
+	params.Amount = -1
+	assert.Error(t, params.Validate())
+
+	params.Amount = 200000
+	params.Description = ""
+	assert.Error(t, params.Validate())
+}
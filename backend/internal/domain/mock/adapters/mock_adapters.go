@@ -0,0 +1,827 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/spaghetti-lover/qairlines/internal/domain/adapters (interfaces: IBookingRepository,IFlightRepository,ILoyaltyRepository,ILoyaltyScheduler,IPaymentRepository,IWalletRepository)
+//
+// Generated by this command:
+//
+//	mockgen -package=mockadapters -destination=internal/domain/mock/adapters/mock_adapters.go github.com/spaghetti-lover/qairlines/internal/domain/adapters IBookingRepository,IFlightRepository,ILoyaltyRepository,ILoyaltyScheduler,IPaymentRepository,IWalletRepository
+//
+
+// Package mockadapters is a generated GoMock package.
+package mockadapters
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entities "github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIBookingRepository is a mock of IBookingRepository interface.
+type MockIBookingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBookingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIBookingRepositoryMockRecorder is the mock recorder for MockIBookingRepository.
+type MockIBookingRepositoryMockRecorder struct {
+	mock *MockIBookingRepository
+}
+
+// NewMockIBookingRepository creates a new mock instance.
+func NewMockIBookingRepository(ctrl *gomock.Controller) *MockIBookingRepository {
+	mock := &MockIBookingRepository{ctrl: ctrl}
+	mock.recorder = &MockIBookingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBookingRepository) EXPECT() *MockIBookingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelBooking mocks base method.
+func (m *MockIBookingRepository) CancelBooking(ctx context.Context, arg entities.CancelBookingParams) (entities.CancelBookingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBooking", ctx, arg)
+	ret0, _ := ret[0].(entities.CancelBookingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBooking indicates an expected call of CancelBooking.
+func (mr *MockIBookingRepositoryMockRecorder) CancelBooking(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockIBookingRepository)(nil).CancelBooking), ctx, arg)
+}
+
+// ChangeFlight mocks base method.
+func (m *MockIBookingRepository) ChangeFlight(ctx context.Context, arg entities.ChangeFlightParams) (entities.ChangeFlightResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeFlight", ctx, arg)
+	ret0, _ := ret[0].(entities.ChangeFlightResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeFlight indicates an expected call of ChangeFlight.
+func (mr *MockIBookingRepositoryMockRecorder) ChangeFlight(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeFlight", reflect.TypeOf((*MockIBookingRepository)(nil).ChangeFlight), ctx, arg)
+}
+
+// CompleteFlightChange mocks base method.
+func (m *MockIBookingRepository) CompleteFlightChange(ctx context.Context, event entities.PaymentEvent) (entities.ChangeFlightResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteFlightChange", ctx, event)
+	ret0, _ := ret[0].(entities.ChangeFlightResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteFlightChange indicates an expected call of CompleteFlightChange.
+func (mr *MockIBookingRepositoryMockRecorder) CompleteFlightChange(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteFlightChange", reflect.TypeOf((*MockIBookingRepository)(nil).CompleteFlightChange), ctx, event)
+}
+
+// CountCustomerTrips mocks base method.
+func (m *MockIBookingRepository) CountCustomerTrips(ctx context.Context, email string, filter entities.TripFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomerTrips", ctx, email, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomerTrips indicates an expected call of CountCustomerTrips.
+func (mr *MockIBookingRepositoryMockRecorder) CountCustomerTrips(ctx, email, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomerTrips", reflect.TypeOf((*MockIBookingRepository)(nil).CountCustomerTrips), ctx, email, filter)
+}
+
+// CreateBookingTx mocks base method.
+func (m *MockIBookingRepository) CreateBookingTx(ctx context.Context, booking entities.CreateBookingParams) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBookingTx", ctx, booking)
+	ret0, _ := ret[0].(entities.Booking)
+	ret1, _ := ret[1].([]entities.Ticket)
+	ret2, _ := ret[2].([]entities.Ticket)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// CreateBookingTx indicates an expected call of CreateBookingTx.
+func (mr *MockIBookingRepositoryMockRecorder) CreateBookingTx(ctx, booking any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingTx", reflect.TypeOf((*MockIBookingRepository)(nil).CreateBookingTx), ctx, booking)
+}
+
+// GetBookingByID mocks base method.
+func (m *MockIBookingRepository) GetBookingByID(ctx context.Context, bookingID int64) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByID", ctx, bookingID)
+	ret0, _ := ret[0].(entities.Booking)
+	ret1, _ := ret[1].([]entities.Ticket)
+	ret2, _ := ret[2].([]entities.Ticket)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetBookingByID indicates an expected call of GetBookingByID.
+func (mr *MockIBookingRepositoryMockRecorder) GetBookingByID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByID", reflect.TypeOf((*MockIBookingRepository)(nil).GetBookingByID), ctx, bookingID)
+}
+
+// GetBookingByPNR mocks base method.
+func (m *MockIBookingRepository) GetBookingByPNR(ctx context.Context, pnr string) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByPNR", ctx, pnr)
+	ret0, _ := ret[0].(entities.Booking)
+	ret1, _ := ret[1].([]entities.Ticket)
+	ret2, _ := ret[2].([]entities.Ticket)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetBookingByPNR indicates an expected call of GetBookingByPNR.
+func (mr *MockIBookingRepositoryMockRecorder) GetBookingByPNR(ctx, pnr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByPNR", reflect.TypeOf((*MockIBookingRepository)(nil).GetBookingByPNR), ctx, pnr)
+}
+
+// GetBookingByPNRAndLastName mocks base method.
+func (m *MockIBookingRepository) GetBookingByPNRAndLastName(ctx context.Context, pnr, lastName string) (entities.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByPNRAndLastName", ctx, pnr, lastName)
+	ret0, _ := ret[0].(entities.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingByPNRAndLastName indicates an expected call of GetBookingByPNRAndLastName.
+func (mr *MockIBookingRepositoryMockRecorder) GetBookingByPNRAndLastName(ctx, pnr, lastName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByPNRAndLastName", reflect.TypeOf((*MockIBookingRepository)(nil).GetBookingByPNRAndLastName), ctx, pnr, lastName)
+}
+
+// GetBookingIDByPNR mocks base method.
+func (m *MockIBookingRepository) GetBookingIDByPNR(ctx context.Context, pnr string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingIDByPNR", ctx, pnr)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingIDByPNR indicates an expected call of GetBookingIDByPNR.
+func (mr *MockIBookingRepositoryMockRecorder) GetBookingIDByPNR(ctx, pnr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingIDByPNR", reflect.TypeOf((*MockIBookingRepository)(nil).GetBookingIDByPNR), ctx, pnr)
+}
+
+// ListCustomerTrips mocks base method.
+func (m *MockIBookingRepository) ListCustomerTrips(ctx context.Context, email string, filter entities.TripFilter) ([]entities.Trip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomerTrips", ctx, email, filter)
+	ret0, _ := ret[0].([]entities.Trip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomerTrips indicates an expected call of ListCustomerTrips.
+func (mr *MockIBookingRepositoryMockRecorder) ListCustomerTrips(ctx, email, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomerTrips", reflect.TypeOf((*MockIBookingRepository)(nil).ListCustomerTrips), ctx, email, filter)
+}
+
+// RequestFlightChange mocks base method.
+func (m *MockIBookingRepository) RequestFlightChange(ctx context.Context, arg entities.ChangeFlightParams, payment entities.Payment) (entities.ChangeFlightResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestFlightChange", ctx, arg, payment)
+	ret0, _ := ret[0].(entities.ChangeFlightResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestFlightChange indicates an expected call of RequestFlightChange.
+func (mr *MockIBookingRepositoryMockRecorder) RequestFlightChange(ctx, arg, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestFlightChange", reflect.TypeOf((*MockIBookingRepository)(nil).RequestFlightChange), ctx, arg, payment)
+}
+
+// UpdateBookingStatus mocks base method.
+func (m *MockIBookingRepository) UpdateBookingStatus(ctx context.Context, arg entities.UpdateBookingStatusParams) (entities.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBookingStatus", ctx, arg)
+	ret0, _ := ret[0].(entities.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBookingStatus indicates an expected call of UpdateBookingStatus.
+func (mr *MockIBookingRepositoryMockRecorder) UpdateBookingStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingStatus", reflect.TypeOf((*MockIBookingRepository)(nil).UpdateBookingStatus), ctx, arg)
+}
+
+// UpdateRefundStatus mocks base method.
+func (m *MockIBookingRepository) UpdateRefundStatus(ctx context.Context, refundID int64, status entities.RefundStatus) (entities.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefundStatus", ctx, refundID, status)
+	ret0, _ := ret[0].(entities.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRefundStatus indicates an expected call of UpdateRefundStatus.
+func (mr *MockIBookingRepositoryMockRecorder) UpdateRefundStatus(ctx, refundID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefundStatus", reflect.TypeOf((*MockIBookingRepository)(nil).UpdateRefundStatus), ctx, refundID, status)
+}
+
+// MockIFlightRepository is a mock of IFlightRepository interface.
+type MockIFlightRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFlightRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIFlightRepositoryMockRecorder is the mock recorder for MockIFlightRepository.
+type MockIFlightRepositoryMockRecorder struct {
+	mock *MockIFlightRepository
+}
+
+// NewMockIFlightRepository creates a new mock instance.
+func NewMockIFlightRepository(ctrl *gomock.Controller) *MockIFlightRepository {
+	mock := &MockIFlightRepository{ctrl: ctrl}
+	mock.recorder = &MockIFlightRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFlightRepository) EXPECT() *MockIFlightRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountSoldSeats mocks base method.
+func (m *MockIFlightRepository) CountSoldSeats(ctx context.Context, flightID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSoldSeats", ctx, flightID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSoldSeats indicates an expected call of CountSoldSeats.
+func (mr *MockIFlightRepositoryMockRecorder) CountSoldSeats(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSoldSeats", reflect.TypeOf((*MockIFlightRepository)(nil).CountSoldSeats), ctx, flightID)
+}
+
+// CountSoldSeatsByClass mocks base method.
+func (m *MockIFlightRepository) CountSoldSeatsByClass(ctx context.Context, flightIDs []int64) (map[int64]map[entities.FlightClass]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSoldSeatsByClass", ctx, flightIDs)
+	ret0, _ := ret[0].(map[int64]map[entities.FlightClass]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSoldSeatsByClass indicates an expected call of CountSoldSeatsByClass.
+func (mr *MockIFlightRepositoryMockRecorder) CountSoldSeatsByClass(ctx, flightIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSoldSeatsByClass", reflect.TypeOf((*MockIFlightRepository)(nil).CountSoldSeatsByClass), ctx, flightIDs)
+}
+
+// CreateFlight mocks base method.
+func (m *MockIFlightRepository) CreateFlight(ctx context.Context, flight entities.Flight) (entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFlight", ctx, flight)
+	ret0, _ := ret[0].(entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFlight indicates an expected call of CreateFlight.
+func (mr *MockIFlightRepositoryMockRecorder) CreateFlight(ctx, flight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlight", reflect.TypeOf((*MockIFlightRepository)(nil).CreateFlight), ctx, flight)
+}
+
+// DeleteFlightByID mocks base method.
+func (m *MockIFlightRepository) DeleteFlightByID(ctx context.Context, flightID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlightByID", ctx, flightID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlightByID indicates an expected call of DeleteFlightByID.
+func (mr *MockIFlightRepositoryMockRecorder) DeleteFlightByID(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlightByID", reflect.TypeOf((*MockIFlightRepository)(nil).DeleteFlightByID), ctx, flightID)
+}
+
+// GetAllFlights mocks base method.
+func (m *MockIFlightRepository) GetAllFlights(ctx context.Context) ([]entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFlights", ctx)
+	ret0, _ := ret[0].([]entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFlights indicates an expected call of GetAllFlights.
+func (mr *MockIFlightRepositoryMockRecorder) GetAllFlights(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFlights", reflect.TypeOf((*MockIFlightRepository)(nil).GetAllFlights), ctx)
+}
+
+// GetFlightByID mocks base method.
+func (m *MockIFlightRepository) GetFlightByID(ctx context.Context, flightID int64) (*entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlightByID", ctx, flightID)
+	ret0, _ := ret[0].(*entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlightByID indicates an expected call of GetFlightByID.
+func (mr *MockIFlightRepositoryMockRecorder) GetFlightByID(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlightByID", reflect.TypeOf((*MockIFlightRepository)(nil).GetFlightByID), ctx, flightID)
+}
+
+// ListAlternativeFlights mocks base method.
+func (m *MockIFlightRepository) ListAlternativeFlights(ctx context.Context, flight entities.Flight, after time.Time) ([]entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlternativeFlights", ctx, flight, after)
+	ret0, _ := ret[0].([]entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlternativeFlights indicates an expected call of ListAlternativeFlights.
+func (mr *MockIFlightRepositoryMockRecorder) ListAlternativeFlights(ctx, flight, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlternativeFlights", reflect.TypeOf((*MockIFlightRepository)(nil).ListAlternativeFlights), ctx, flight, after)
+}
+
+// ListFlights mocks base method.
+func (m *MockIFlightRepository) ListFlights(ctx context.Context, page, limit int) ([]entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlights", ctx, page, limit)
+	ret0, _ := ret[0].([]entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlights indicates an expected call of ListFlights.
+func (mr *MockIFlightRepositoryMockRecorder) ListFlights(ctx, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlights", reflect.TypeOf((*MockIFlightRepository)(nil).ListFlights), ctx, page, limit)
+}
+
+// ListFlightsByIDs mocks base method.
+func (m *MockIFlightRepository) ListFlightsByIDs(ctx context.Context, flightIDs []int64) (map[int64]entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlightsByIDs", ctx, flightIDs)
+	ret0, _ := ret[0].(map[int64]entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlightsByIDs indicates an expected call of ListFlightsByIDs.
+func (mr *MockIFlightRepositoryMockRecorder) ListFlightsByIDs(ctx, flightIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlightsByIDs", reflect.TypeOf((*MockIFlightRepository)(nil).ListFlightsByIDs), ctx, flightIDs)
+}
+
+// SearchFlights mocks base method.
+func (m *MockIFlightRepository) SearchFlights(ctx context.Context, departureCity, arrivalCity string, flightDate time.Time) ([]entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFlights", ctx, departureCity, arrivalCity, flightDate)
+	ret0, _ := ret[0].([]entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFlights indicates an expected call of SearchFlights.
+func (mr *MockIFlightRepositoryMockRecorder) SearchFlights(ctx, departureCity, arrivalCity, flightDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFlights", reflect.TypeOf((*MockIFlightRepository)(nil).SearchFlights), ctx, departureCity, arrivalCity, flightDate)
+}
+
+// UpdateFlightTimes mocks base method.
+func (m *MockIFlightRepository) UpdateFlightTimes(ctx context.Context, flightID int64, departureTime, arrivalTime time.Time) (*entities.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFlightTimes", ctx, flightID, departureTime, arrivalTime)
+	ret0, _ := ret[0].(*entities.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFlightTimes indicates an expected call of UpdateFlightTimes.
+func (mr *MockIFlightRepositoryMockRecorder) UpdateFlightTimes(ctx, flightID, departureTime, arrivalTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlightTimes", reflect.TypeOf((*MockIFlightRepository)(nil).UpdateFlightTimes), ctx, flightID, departureTime, arrivalTime)
+}
+
+// MockILoyaltyRepository is a mock of ILoyaltyRepository interface.
+type MockILoyaltyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockILoyaltyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockILoyaltyRepositoryMockRecorder is the mock recorder for MockILoyaltyRepository.
+type MockILoyaltyRepositoryMockRecorder struct {
+	mock *MockILoyaltyRepository
+}
+
+// NewMockILoyaltyRepository creates a new mock instance.
+func NewMockILoyaltyRepository(ctrl *gomock.Controller) *MockILoyaltyRepository {
+	mock := &MockILoyaltyRepository{ctrl: ctrl}
+	mock.recorder = &MockILoyaltyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILoyaltyRepository) EXPECT() *MockILoyaltyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockILoyaltyRepository) GetBalance(ctx context.Context, userID int64, now time.Time) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, userID, now)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockILoyaltyRepositoryMockRecorder) GetBalance(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockILoyaltyRepository)(nil).GetBalance), ctx, userID, now)
+}
+
+// GetQualifyingPoints mocks base method.
+func (m *MockILoyaltyRepository) GetQualifyingPoints(ctx context.Context, userID int64, since time.Time) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQualifyingPoints", ctx, userID, since)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQualifyingPoints indicates an expected call of GetQualifyingPoints.
+func (mr *MockILoyaltyRepositoryMockRecorder) GetQualifyingPoints(ctx, userID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQualifyingPoints", reflect.TypeOf((*MockILoyaltyRepository)(nil).GetQualifyingPoints), ctx, userID, since)
+}
+
+// GetRedeemedAmount mocks base method.
+func (m *MockILoyaltyRepository) GetRedeemedAmount(ctx context.Context, bookingID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedeemedAmount", ctx, bookingID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedeemedAmount indicates an expected call of GetRedeemedAmount.
+func (mr *MockILoyaltyRepositoryMockRecorder) GetRedeemedAmount(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedeemedAmount", reflect.TypeOf((*MockILoyaltyRepository)(nil).GetRedeemedAmount), ctx, bookingID)
+}
+
+// GetTier mocks base method.
+func (m *MockILoyaltyRepository) GetTier(ctx context.Context, userID int64) (entities.LoyaltyTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTier", ctx, userID)
+	ret0, _ := ret[0].(entities.LoyaltyTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTier indicates an expected call of GetTier.
+func (mr *MockILoyaltyRepositoryMockRecorder) GetTier(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTier", reflect.TypeOf((*MockILoyaltyRepository)(nil).GetTier), ctx, userID)
+}
+
+// GetTierByEmail mocks base method.
+func (m *MockILoyaltyRepository) GetTierByEmail(ctx context.Context, email string) (entities.LoyaltyTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTierByEmail", ctx, email)
+	ret0, _ := ret[0].(entities.LoyaltyTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTierByEmail indicates an expected call of GetTierByEmail.
+func (mr *MockILoyaltyRepositoryMockRecorder) GetTierByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTierByEmail", reflect.TypeOf((*MockILoyaltyRepository)(nil).GetTierByEmail), ctx, email)
+}
+
+// ListTransactions mocks base method.
+func (m *MockILoyaltyRepository) ListTransactions(ctx context.Context, userID int64, limit, offset int32) (entities.LoyaltyStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, userID, limit, offset)
+	ret0, _ := ret[0].(entities.LoyaltyStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockILoyaltyRepositoryMockRecorder) ListTransactions(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockILoyaltyRepository)(nil).ListTransactions), ctx, userID, limit, offset)
+}
+
+// RedeemPoints mocks base method.
+func (m *MockILoyaltyRepository) RedeemPoints(ctx context.Context, arg entities.RedeemLoyaltyParams) (entities.RedeemLoyaltyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemPoints", ctx, arg)
+	ret0, _ := ret[0].(entities.RedeemLoyaltyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemPoints indicates an expected call of RedeemPoints.
+func (mr *MockILoyaltyRepositoryMockRecorder) RedeemPoints(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemPoints", reflect.TypeOf((*MockILoyaltyRepository)(nil).RedeemPoints), ctx, arg)
+}
+
+// MockILoyaltyScheduler is a mock of ILoyaltyScheduler interface.
+type MockILoyaltyScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockILoyaltySchedulerMockRecorder
+	isgomock struct{}
+}
+
+// MockILoyaltySchedulerMockRecorder is the mock recorder for MockILoyaltyScheduler.
+type MockILoyaltySchedulerMockRecorder struct {
+	mock *MockILoyaltyScheduler
+}
+
+// NewMockILoyaltyScheduler creates a new mock instance.
+func NewMockILoyaltyScheduler(ctrl *gomock.Controller) *MockILoyaltyScheduler {
+	mock := &MockILoyaltyScheduler{ctrl: ctrl}
+	mock.recorder = &MockILoyaltySchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILoyaltyScheduler) EXPECT() *MockILoyaltySchedulerMockRecorder {
+	return m.recorder
+}
+
+// ScheduleAccrual mocks base method.
+func (m *MockILoyaltyScheduler) ScheduleAccrual(ctx context.Context, flight entities.Flight) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleAccrual", ctx, flight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleAccrual indicates an expected call of ScheduleAccrual.
+func (mr *MockILoyaltySchedulerMockRecorder) ScheduleAccrual(ctx, flight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleAccrual", reflect.TypeOf((*MockILoyaltyScheduler)(nil).ScheduleAccrual), ctx, flight)
+}
+
+// MockIPaymentRepository is a mock of IPaymentRepository interface.
+type MockIPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPaymentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIPaymentRepositoryMockRecorder is the mock recorder for MockIPaymentRepository.
+type MockIPaymentRepositoryMockRecorder struct {
+	mock *MockIPaymentRepository
+}
+
+// NewMockIPaymentRepository creates a new mock instance.
+func NewMockIPaymentRepository(ctrl *gomock.Controller) *MockIPaymentRepository {
+	mock := &MockIPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockIPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPaymentRepository) EXPECT() *MockIPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConfirmBookingPayment mocks base method.
+func (m *MockIPaymentRepository) ConfirmBookingPayment(ctx context.Context, event entities.PaymentEvent) (entities.BookingPaymentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmBookingPayment", ctx, event)
+	ret0, _ := ret[0].(entities.BookingPaymentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmBookingPayment indicates an expected call of ConfirmBookingPayment.
+func (mr *MockIPaymentRepositoryMockRecorder) ConfirmBookingPayment(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmBookingPayment", reflect.TypeOf((*MockIPaymentRepository)(nil).ConfirmBookingPayment), ctx, event)
+}
+
+// CreatePayment mocks base method.
+func (m *MockIPaymentRepository) CreatePayment(ctx context.Context, payment entities.Payment) (entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, payment)
+	ret0, _ := ret[0].(entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockIPaymentRepositoryMockRecorder) CreatePayment(ctx, payment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockIPaymentRepository)(nil).CreatePayment), ctx, payment)
+}
+
+// FailPayment mocks base method.
+func (m *MockIPaymentRepository) FailPayment(ctx context.Context, intentID string) (entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailPayment", ctx, intentID)
+	ret0, _ := ret[0].(entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailPayment indicates an expected call of FailPayment.
+func (mr *MockIPaymentRepositoryMockRecorder) FailPayment(ctx, intentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailPayment", reflect.TypeOf((*MockIPaymentRepository)(nil).FailPayment), ctx, intentID)
+}
+
+// GetPaymentByIntentID mocks base method.
+func (m *MockIPaymentRepository) GetPaymentByIntentID(ctx context.Context, intentID string) (entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByIntentID", ctx, intentID)
+	ret0, _ := ret[0].(entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByIntentID indicates an expected call of GetPaymentByIntentID.
+func (mr *MockIPaymentRepositoryMockRecorder) GetPaymentByIntentID(ctx, intentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByIntentID", reflect.TypeOf((*MockIPaymentRepository)(nil).GetPaymentByIntentID), ctx, intentID)
+}
+
+// ListCapturedPayments mocks base method.
+func (m *MockIPaymentRepository) ListCapturedPayments(ctx context.Context, bookingID int64) ([]entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapturedPayments", ctx, bookingID)
+	ret0, _ := ret[0].([]entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapturedPayments indicates an expected call of ListCapturedPayments.
+func (mr *MockIPaymentRepositoryMockRecorder) ListCapturedPayments(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapturedPayments", reflect.TypeOf((*MockIPaymentRepository)(nil).ListCapturedPayments), ctx, bookingID)
+}
+
+// RecordRefund mocks base method.
+func (m *MockIPaymentRepository) RecordRefund(ctx context.Context, paymentID, amount int64) (entities.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordRefund", ctx, paymentID, amount)
+	ret0, _ := ret[0].(entities.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordRefund indicates an expected call of RecordRefund.
+func (mr *MockIPaymentRepositoryMockRecorder) RecordRefund(ctx, paymentID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordRefund", reflect.TypeOf((*MockIPaymentRepository)(nil).RecordRefund), ctx, paymentID, amount)
+}
+
+// MockIWalletRepository is a mock of IWalletRepository interface.
+type MockIWalletRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWalletRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIWalletRepositoryMockRecorder is the mock recorder for MockIWalletRepository.
+type MockIWalletRepositoryMockRecorder struct {
+	mock *MockIWalletRepository
+}
+
+// NewMockIWalletRepository creates a new mock instance.
+func NewMockIWalletRepository(ctrl *gomock.Controller) *MockIWalletRepository {
+	mock := &MockIWalletRepository{ctrl: ctrl}
+	mock.recorder = &MockIWalletRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWalletRepository) EXPECT() *MockIWalletRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockIWalletRepository) GetBalance(ctx context.Context, userID int64, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, userID, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockIWalletRepositoryMockRecorder) GetBalance(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockIWalletRepository)(nil).GetBalance), ctx, userID, now)
+}
+
+// GetPaidAmount mocks base method.
+func (m *MockIWalletRepository) GetPaidAmount(ctx context.Context, bookingID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaidAmount", ctx, bookingID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaidAmount indicates an expected call of GetPaidAmount.
+func (mr *MockIWalletRepositoryMockRecorder) GetPaidAmount(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaidAmount", reflect.TypeOf((*MockIWalletRepository)(nil).GetPaidAmount), ctx, bookingID)
+}
+
+// IssueCredit mocks base method.
+func (m *MockIWalletRepository) IssueCredit(ctx context.Context, arg entities.IssueWalletCreditParams) (entities.WalletTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueCredit", ctx, arg)
+	ret0, _ := ret[0].(entities.WalletTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueCredit indicates an expected call of IssueCredit.
+func (mr *MockIWalletRepositoryMockRecorder) IssueCredit(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueCredit", reflect.TypeOf((*MockIWalletRepository)(nil).IssueCredit), ctx, arg)
+}
+
+// ListFlightCustomers mocks base method.
+func (m *MockIWalletRepository) ListFlightCustomers(ctx context.Context, flightID int64) ([]entities.FlightWalletCredit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlightCustomers", ctx, flightID)
+	ret0, _ := ret[0].([]entities.FlightWalletCredit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlightCustomers indicates an expected call of ListFlightCustomers.
+func (mr *MockIWalletRepositoryMockRecorder) ListFlightCustomers(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlightCustomers", reflect.TypeOf((*MockIWalletRepository)(nil).ListFlightCustomers), ctx, flightID)
+}
+
+// ListTransactions mocks base method.
+func (m *MockIWalletRepository) ListTransactions(ctx context.Context, userID int64, limit, offset int32) (entities.WalletStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, userID, limit, offset)
+	ret0, _ := ret[0].(entities.WalletStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockIWalletRepositoryMockRecorder) ListTransactions(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockIWalletRepository)(nil).ListTransactions), ctx, userID, limit, offset)
+}
+
+// PayBooking mocks base method.
+func (m *MockIWalletRepository) PayBooking(ctx context.Context, arg entities.PayWithWalletParams) (entities.PayWithWalletResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayBooking", ctx, arg)
+	ret0, _ := ret[0].(entities.PayWithWalletResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayBooking indicates an expected call of PayBooking.
+func (mr *MockIWalletRepositoryMockRecorder) PayBooking(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayBooking", reflect.TypeOf((*MockIWalletRepository)(nil).PayBooking), ctx, arg)
+}
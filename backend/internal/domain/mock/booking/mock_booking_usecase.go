@@ -0,0 +1,335 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking (interfaces: IManageBookingLookupUseCase,IGetManagedBookingUseCase,IUpdateManagedSeatsUseCase,ICancelBookingUseCase,IQuoteFlightChangeUseCase,IChangeFlightUseCase,IGetBookingIDUseCase,IGetBookingUseCase)
+//
+// Generated by this command:
+//
+//	mockgen -package=mockbooking -destination=internal/domain/mock/booking/mock_booking_usecase.go github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking IManageBookingLookupUseCase,IGetManagedBookingUseCase,IUpdateManagedSeatsUseCase,ICancelBookingUseCase,IQuoteFlightChangeUseCase,IChangeFlightUseCase,IGetBookingIDUseCase,IGetBookingUseCase
+//
+
+// Package mockbooking is a generated GoMock package.
+package mockbooking
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entities "github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	booking "github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIManageBookingLookupUseCase is a mock of IManageBookingLookupUseCase interface.
+type MockIManageBookingLookupUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIManageBookingLookupUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIManageBookingLookupUseCaseMockRecorder is the mock recorder for MockIManageBookingLookupUseCase.
+type MockIManageBookingLookupUseCaseMockRecorder struct {
+	mock *MockIManageBookingLookupUseCase
+}
+
+// NewMockIManageBookingLookupUseCase creates a new mock instance.
+func NewMockIManageBookingLookupUseCase(ctrl *gomock.Controller) *MockIManageBookingLookupUseCase {
+	mock := &MockIManageBookingLookupUseCase{ctrl: ctrl}
+	mock.recorder = &MockIManageBookingLookupUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIManageBookingLookupUseCase) EXPECT() *MockIManageBookingLookupUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIManageBookingLookupUseCase) Execute(ctx context.Context, input booking.ManageBookingLookupInput) (*booking.ManageBookingLookupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, input)
+	ret0, _ := ret[0].(*booking.ManageBookingLookupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIManageBookingLookupUseCaseMockRecorder) Execute(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIManageBookingLookupUseCase)(nil).Execute), ctx, input)
+}
+
+// MockIGetManagedBookingUseCase is a mock of IGetManagedBookingUseCase interface.
+type MockIGetManagedBookingUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGetManagedBookingUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIGetManagedBookingUseCaseMockRecorder is the mock recorder for MockIGetManagedBookingUseCase.
+type MockIGetManagedBookingUseCaseMockRecorder struct {
+	mock *MockIGetManagedBookingUseCase
+}
+
+// NewMockIGetManagedBookingUseCase creates a new mock instance.
+func NewMockIGetManagedBookingUseCase(ctrl *gomock.Controller) *MockIGetManagedBookingUseCase {
+	mock := &MockIGetManagedBookingUseCase{ctrl: ctrl}
+	mock.recorder = &MockIGetManagedBookingUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGetManagedBookingUseCase) EXPECT() *MockIGetManagedBookingUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIGetManagedBookingUseCase) Execute(ctx context.Context, bookingID int64) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, bookingID)
+	ret0, _ := ret[0].(entities.Booking)
+	ret1, _ := ret[1].([]entities.Ticket)
+	ret2, _ := ret[2].([]entities.Ticket)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIGetManagedBookingUseCaseMockRecorder) Execute(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIGetManagedBookingUseCase)(nil).Execute), ctx, bookingID)
+}
+
+// MockIUpdateManagedSeatsUseCase is a mock of IUpdateManagedSeatsUseCase interface.
+type MockIUpdateManagedSeatsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUpdateManagedSeatsUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIUpdateManagedSeatsUseCaseMockRecorder is the mock recorder for MockIUpdateManagedSeatsUseCase.
+type MockIUpdateManagedSeatsUseCaseMockRecorder struct {
+	mock *MockIUpdateManagedSeatsUseCase
+}
+
+// NewMockIUpdateManagedSeatsUseCase creates a new mock instance.
+func NewMockIUpdateManagedSeatsUseCase(ctrl *gomock.Controller) *MockIUpdateManagedSeatsUseCase {
+	mock := &MockIUpdateManagedSeatsUseCase{ctrl: ctrl}
+	mock.recorder = &MockIUpdateManagedSeatsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUpdateManagedSeatsUseCase) EXPECT() *MockIUpdateManagedSeatsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIUpdateManagedSeatsUseCase) Execute(ctx context.Context, bookingID int64, updates []entities.SeatUpdate) (entities.SeatSelectionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, bookingID, updates)
+	ret0, _ := ret[0].(entities.SeatSelectionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIUpdateManagedSeatsUseCaseMockRecorder) Execute(ctx, bookingID, updates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIUpdateManagedSeatsUseCase)(nil).Execute), ctx, bookingID, updates)
+}
+
+// MockICancelBookingUseCase is a mock of ICancelBookingUseCase interface.
+type MockICancelBookingUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICancelBookingUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockICancelBookingUseCaseMockRecorder is the mock recorder for MockICancelBookingUseCase.
+type MockICancelBookingUseCaseMockRecorder struct {
+	mock *MockICancelBookingUseCase
+}
+
+// NewMockICancelBookingUseCase creates a new mock instance.
+func NewMockICancelBookingUseCase(ctrl *gomock.Controller) *MockICancelBookingUseCase {
+	mock := &MockICancelBookingUseCase{ctrl: ctrl}
+	mock.recorder = &MockICancelBookingUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICancelBookingUseCase) EXPECT() *MockICancelBookingUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockICancelBookingUseCase) Execute(ctx context.Context, params entities.CancelBookingParams) (entities.CancelBookingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(entities.CancelBookingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockICancelBookingUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockICancelBookingUseCase)(nil).Execute), ctx, params)
+}
+
+// MockIQuoteFlightChangeUseCase is a mock of IQuoteFlightChangeUseCase interface.
+type MockIQuoteFlightChangeUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIQuoteFlightChangeUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIQuoteFlightChangeUseCaseMockRecorder is the mock recorder for MockIQuoteFlightChangeUseCase.
+type MockIQuoteFlightChangeUseCaseMockRecorder struct {
+	mock *MockIQuoteFlightChangeUseCase
+}
+
+// NewMockIQuoteFlightChangeUseCase creates a new mock instance.
+func NewMockIQuoteFlightChangeUseCase(ctrl *gomock.Controller) *MockIQuoteFlightChangeUseCase {
+	mock := &MockIQuoteFlightChangeUseCase{ctrl: ctrl}
+	mock.recorder = &MockIQuoteFlightChangeUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIQuoteFlightChangeUseCase) EXPECT() *MockIQuoteFlightChangeUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIQuoteFlightChangeUseCase) Execute(ctx context.Context, bookingID, flightID int64, requesterEmail string) ([]entities.FlightChangeQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, bookingID, flightID, requesterEmail)
+	ret0, _ := ret[0].([]entities.FlightChangeQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIQuoteFlightChangeUseCaseMockRecorder) Execute(ctx, bookingID, flightID, requesterEmail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIQuoteFlightChangeUseCase)(nil).Execute), ctx, bookingID, flightID, requesterEmail)
+}
+
+// MockIChangeFlightUseCase is a mock of IChangeFlightUseCase interface.
+type MockIChangeFlightUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIChangeFlightUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIChangeFlightUseCaseMockRecorder is the mock recorder for MockIChangeFlightUseCase.
+type MockIChangeFlightUseCaseMockRecorder struct {
+	mock *MockIChangeFlightUseCase
+}
+
+// NewMockIChangeFlightUseCase creates a new mock instance.
+func NewMockIChangeFlightUseCase(ctrl *gomock.Controller) *MockIChangeFlightUseCase {
+	mock := &MockIChangeFlightUseCase{ctrl: ctrl}
+	mock.recorder = &MockIChangeFlightUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIChangeFlightUseCase) EXPECT() *MockIChangeFlightUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIChangeFlightUseCase) Execute(ctx context.Context, params entities.ChangeFlightParams) (entities.ChangeFlightResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(entities.ChangeFlightResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIChangeFlightUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIChangeFlightUseCase)(nil).Execute), ctx, params)
+}
+
+// MockIGetBookingIDUseCase is a mock of IGetBookingIDUseCase interface.
+type MockIGetBookingIDUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGetBookingIDUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIGetBookingIDUseCaseMockRecorder is the mock recorder for MockIGetBookingIDUseCase.
+type MockIGetBookingIDUseCaseMockRecorder struct {
+	mock *MockIGetBookingIDUseCase
+}
+
+// NewMockIGetBookingIDUseCase creates a new mock instance.
+func NewMockIGetBookingIDUseCase(ctrl *gomock.Controller) *MockIGetBookingIDUseCase {
+	mock := &MockIGetBookingIDUseCase{ctrl: ctrl}
+	mock.recorder = &MockIGetBookingIDUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGetBookingIDUseCase) EXPECT() *MockIGetBookingIDUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIGetBookingIDUseCase) Execute(ctx context.Context, reference string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, reference)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIGetBookingIDUseCaseMockRecorder) Execute(ctx, reference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIGetBookingIDUseCase)(nil).Execute), ctx, reference)
+}
+
+// MockIGetBookingUseCase is a mock of IGetBookingUseCase interface.
+type MockIGetBookingUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGetBookingUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIGetBookingUseCaseMockRecorder is the mock recorder for MockIGetBookingUseCase.
+type MockIGetBookingUseCaseMockRecorder struct {
+	mock *MockIGetBookingUseCase
+}
+
+// NewMockIGetBookingUseCase creates a new mock instance.
+func NewMockIGetBookingUseCase(ctrl *gomock.Controller) *MockIGetBookingUseCase {
+	mock := &MockIGetBookingUseCase{ctrl: ctrl}
+	mock.recorder = &MockIGetBookingUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGetBookingUseCase) EXPECT() *MockIGetBookingUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIGetBookingUseCase) Execute(ctx context.Context, reference, lastName string) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, reference, lastName)
+	ret0, _ := ret[0].(entities.Booking)
+	ret1, _ := ret[1].([]entities.Ticket)
+	ret2, _ := ret[2].([]entities.Ticket)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIGetBookingUseCaseMockRecorder) Execute(ctx, reference, lastName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIGetBookingUseCase)(nil).Execute), ctx, reference, lastName)
+}
@@ -12,7 +12,9 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	db "github.com/spaghetti-lover/qairlines/db/sqlc"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,926 +43,3358 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
-// CancelTicket mocks base method.
-func (m *MockStore) CancelTicket(ctx context.Context, ticketID int64) (db.CancelTicketRow, error) {
+// AccrueFlightLoyaltyTx mocks base method.
+func (m *MockStore) AccrueFlightLoyaltyTx(ctx context.Context, arg db.AccrueFlightLoyaltyTxParams) (db.AccrueFlightLoyaltyTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelTicket", ctx, ticketID)
-	ret0, _ := ret[0].(db.CancelTicketRow)
+	ret := m.ctrl.Call(m, "AccrueFlightLoyaltyTx", ctx, arg)
+	ret0, _ := ret[0].(db.AccrueFlightLoyaltyTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CancelTicket indicates an expected call of CancelTicket.
-func (mr *MockStoreMockRecorder) CancelTicket(ctx, ticketID any) *gomock.Call {
+// AccrueFlightLoyaltyTx indicates an expected call of AccrueFlightLoyaltyTx.
+func (mr *MockStoreMockRecorder) AccrueFlightLoyaltyTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicket", reflect.TypeOf((*MockStore)(nil).CancelTicket), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccrueFlightLoyaltyTx", reflect.TypeOf((*MockStore)(nil).AccrueFlightLoyaltyTx), ctx, arg)
 }
 
-// CancelTicketTx mocks base method.
-func (m *MockStore) CancelTicketTx(ctx context.Context, arg db.CancelTicketTxParams) (db.CancelTicketTxResult, error) {
+// ActivateTicketAncillary mocks base method.
+func (m *MockStore) ActivateTicketAncillary(ctx context.Context, id int64) (db.TicketAncillary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelTicketTx", ctx, arg)
-	ret0, _ := ret[0].(db.CancelTicketTxResult)
+	ret := m.ctrl.Call(m, "ActivateTicketAncillary", ctx, id)
+	ret0, _ := ret[0].(db.TicketAncillary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CancelTicketTx indicates an expected call of CancelTicketTx.
-func (mr *MockStoreMockRecorder) CancelTicketTx(ctx, arg any) *gomock.Call {
+// ActivateTicketAncillary indicates an expected call of ActivateTicketAncillary.
+func (mr *MockStoreMockRecorder) ActivateTicketAncillary(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicketTx", reflect.TypeOf((*MockStore)(nil).CancelTicketTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTicketAncillary", reflect.TypeOf((*MockStore)(nil).ActivateTicketAncillary), ctx, id)
 }
 
-// CheckSeatAvailability mocks base method.
-func (m *MockStore) CheckSeatAvailability(ctx context.Context, arg db.CheckSeatAvailabilityParams) (bool, error) {
+// ActivateTicketAncillaryTx mocks base method.
+func (m *MockStore) ActivateTicketAncillaryTx(ctx context.Context, arg db.SettlePaymentParams) (db.ActivateTicketAncillaryTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckSeatAvailability", ctx, arg)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ActivateTicketAncillaryTx", ctx, arg)
+	ret0, _ := ret[0].(db.ActivateTicketAncillaryTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CheckSeatAvailability indicates an expected call of CheckSeatAvailability.
-func (mr *MockStoreMockRecorder) CheckSeatAvailability(ctx, arg any) *gomock.Call {
+// ActivateTicketAncillaryTx indicates an expected call of ActivateTicketAncillaryTx.
+func (mr *MockStoreMockRecorder) ActivateTicketAncillaryTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSeatAvailability", reflect.TypeOf((*MockStore)(nil).CheckSeatAvailability), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTicketAncillaryTx", reflect.TypeOf((*MockStore)(nil).ActivateTicketAncillaryTx), ctx, arg)
 }
 
-// CountOccupiedSeats mocks base method.
-func (m *MockStore) CountOccupiedSeats(ctx context.Context, flightID *int64) (int64, error) {
+// AddCustomerLoyaltyPoints mocks base method.
+func (m *MockStore) AddCustomerLoyaltyPoints(ctx context.Context, arg db.AddCustomerLoyaltyPointsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountOccupiedSeats", ctx, flightID)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AddCustomerLoyaltyPoints", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CountOccupiedSeats indicates an expected call of CountOccupiedSeats.
-func (mr *MockStoreMockRecorder) CountOccupiedSeats(ctx, flightID any) *gomock.Call {
+// AddCustomerLoyaltyPoints indicates an expected call of AddCustomerLoyaltyPoints.
+func (mr *MockStoreMockRecorder) AddCustomerLoyaltyPoints(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOccupiedSeats", reflect.TypeOf((*MockStore)(nil).CountOccupiedSeats), ctx, flightID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomerLoyaltyPoints", reflect.TypeOf((*MockStore)(nil).AddCustomerLoyaltyPoints), ctx, arg)
 }
 
-// CreateAdmin mocks base method.
-func (m *MockStore) CreateAdmin(ctx context.Context, userID int64) (int64, error) {
+// AddCustomerWalletBalance mocks base method.
+func (m *MockStore) AddCustomerWalletBalance(ctx context.Context, arg db.AddCustomerWalletBalanceParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAdmin", ctx, userID)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AddCustomerWalletBalance", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateAdmin indicates an expected call of CreateAdmin.
-func (mr *MockStoreMockRecorder) CreateAdmin(ctx, userID any) *gomock.Call {
+// AddCustomerWalletBalance indicates an expected call of AddCustomerWalletBalance.
+func (mr *MockStoreMockRecorder) AddCustomerWalletBalance(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockStore)(nil).CreateAdmin), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomerWalletBalance", reflect.TypeOf((*MockStore)(nil).AddCustomerWalletBalance), ctx, arg)
 }
 
-// CreateAdminTx mocks base method.
-func (m *MockStore) CreateAdminTx(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// AddPaymentRefund mocks base method.
+func (m *MockStore) AddPaymentRefund(ctx context.Context, arg db.AddPaymentRefundParams) (db.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAdminTx", ctx, arg)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "AddPaymentRefund", ctx, arg)
+	ret0, _ := ret[0].(db.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAdminTx indicates an expected call of CreateAdminTx.
-func (mr *MockStoreMockRecorder) CreateAdminTx(ctx, arg any) *gomock.Call {
+// AddPaymentRefund indicates an expected call of AddPaymentRefund.
+func (mr *MockStoreMockRecorder) AddPaymentRefund(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminTx", reflect.TypeOf((*MockStore)(nil).CreateAdminTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaymentRefund", reflect.TypeOf((*MockStore)(nil).AddPaymentRefund), ctx, arg)
 }
 
-// CreateBooking mocks base method.
-func (m *MockStore) CreateBooking(ctx context.Context, arg db.CreateBookingParams) (db.Booking, error) {
+// AddTicketSpecialServiceTx mocks base method.
+func (m *MockStore) AddTicketSpecialServiceTx(ctx context.Context, arg db.AddTicketSpecialServiceTxParams) (db.TicketSpecialService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBooking", ctx, arg)
-	ret0, _ := ret[0].(db.Booking)
+	ret := m.ctrl.Call(m, "AddTicketSpecialServiceTx", ctx, arg)
+	ret0, _ := ret[0].(db.TicketSpecialService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateBooking indicates an expected call of CreateBooking.
-func (mr *MockStoreMockRecorder) CreateBooking(ctx, arg any) *gomock.Call {
+// AddTicketSpecialServiceTx indicates an expected call of AddTicketSpecialServiceTx.
+func (mr *MockStoreMockRecorder) AddTicketSpecialServiceTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBooking", reflect.TypeOf((*MockStore)(nil).CreateBooking), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTicketSpecialServiceTx", reflect.TypeOf((*MockStore)(nil).AddTicketSpecialServiceTx), ctx, arg)
 }
 
-// CreateBookingTx mocks base method.
-func (m *MockStore) CreateBookingTx(ctx context.Context, arg db.CreateBookingTxParams) (db.CreateBookingTxResult, error) {
+// AssignTicketNumbersTx mocks base method.
+func (m *MockStore) AssignTicketNumbersTx(ctx context.Context, prefix string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBookingTx", ctx, arg)
-	ret0, _ := ret[0].(db.CreateBookingTxResult)
+	ret := m.ctrl.Call(m, "AssignTicketNumbersTx", ctx, prefix)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateBookingTx indicates an expected call of CreateBookingTx.
-func (mr *MockStoreMockRecorder) CreateBookingTx(ctx, arg any) *gomock.Call {
+// AssignTicketNumbersTx indicates an expected call of AssignTicketNumbersTx.
+func (mr *MockStoreMockRecorder) AssignTicketNumbersTx(ctx, prefix any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingTx", reflect.TypeOf((*MockStore)(nil).CreateBookingTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTicketNumbersTx", reflect.TypeOf((*MockStore)(nil).AssignTicketNumbersTx), ctx, prefix)
 }
 
-// CreateCustomer mocks base method.
-func (m *MockStore) CreateCustomer(ctx context.Context, arg db.CreateCustomerParams) (db.Customer, error) {
+// AttachGroupDepositPayments mocks base method.
+func (m *MockStore) AttachGroupDepositPayments(ctx context.Context, arg db.AttachGroupDepositPaymentsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCustomer", ctx, arg)
-	ret0, _ := ret[0].(db.Customer)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "AttachGroupDepositPayments", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateCustomer indicates an expected call of CreateCustomer.
-func (mr *MockStoreMockRecorder) CreateCustomer(ctx, arg any) *gomock.Call {
+// AttachGroupDepositPayments indicates an expected call of AttachGroupDepositPayments.
+func (mr *MockStoreMockRecorder) AttachGroupDepositPayments(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockStore)(nil).CreateCustomer), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachGroupDepositPayments", reflect.TypeOf((*MockStore)(nil).AttachGroupDepositPayments), ctx, arg)
 }
 
-// CreateCustomerTx mocks base method.
-func (m *MockStore) CreateCustomerTx(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// BookWaitlistHolds mocks base method.
+func (m *MockStore) BookWaitlistHolds(ctx context.Context, arg db.BookWaitlistHoldsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCustomerTx", ctx, arg)
-	ret0, _ := ret[0].(db.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "BookWaitlistHolds", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateCustomerTx indicates an expected call of CreateCustomerTx.
-func (mr *MockStoreMockRecorder) CreateCustomerTx(ctx, arg any) *gomock.Call {
+// BookWaitlistHolds indicates an expected call of BookWaitlistHolds.
+func (mr *MockStoreMockRecorder) BookWaitlistHolds(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerTx", reflect.TypeOf((*MockStore)(nil).CreateCustomerTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BookWaitlistHolds", reflect.TypeOf((*MockStore)(nil).BookWaitlistHolds), ctx, arg)
 }
 
-// CreateFlight mocks base method.
-func (m *MockStore) CreateFlight(ctx context.Context, arg db.CreateFlightParams) (db.Flight, error) {
+// CancelBookingTx mocks base method.
+func (m *MockStore) CancelBookingTx(ctx context.Context, arg db.CancelBookingTxParams) (db.CancelBookingTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFlight", ctx, arg)
-	ret0, _ := ret[0].(db.Flight)
+	ret := m.ctrl.Call(m, "CancelBookingTx", ctx, arg)
+	ret0, _ := ret[0].(db.CancelBookingTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateFlight indicates an expected call of CreateFlight.
-func (mr *MockStoreMockRecorder) CreateFlight(ctx, arg any) *gomock.Call {
+// CancelBookingTx indicates an expected call of CancelBookingTx.
+func (mr *MockStoreMockRecorder) CancelBookingTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlight", reflect.TypeOf((*MockStore)(nil).CreateFlight), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBookingTx", reflect.TypeOf((*MockStore)(nil).CancelBookingTx), ctx, arg)
 }
 
-// CreateNews mocks base method.
-func (m *MockStore) CreateNews(ctx context.Context, arg db.CreateNewsParams) (db.News, error) {
+// CancelTicket mocks base method.
+func (m *MockStore) CancelTicket(ctx context.Context, ticketID int64) (db.CancelTicketRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNews", ctx, arg)
-	ret0, _ := ret[0].(db.News)
+	ret := m.ctrl.Call(m, "CancelTicket", ctx, ticketID)
+	ret0, _ := ret[0].(db.CancelTicketRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateNews indicates an expected call of CreateNews.
-func (mr *MockStoreMockRecorder) CreateNews(ctx, arg any) *gomock.Call {
+// CancelTicket indicates an expected call of CancelTicket.
+func (mr *MockStoreMockRecorder) CancelTicket(ctx, ticketID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNews", reflect.TypeOf((*MockStore)(nil).CreateNews), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicket", reflect.TypeOf((*MockStore)(nil).CancelTicket), ctx, ticketID)
 }
 
-// CreateSeat mocks base method.
-func (m *MockStore) CreateSeat(ctx context.Context, arg db.CreateSeatParams) (db.Seat, error) {
+// CancelTicketAncillary mocks base method.
+func (m *MockStore) CancelTicketAncillary(ctx context.Context, arg db.CancelTicketAncillaryParams) (db.TicketAncillary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSeat", ctx, arg)
-	ret0, _ := ret[0].(db.Seat)
+	ret := m.ctrl.Call(m, "CancelTicketAncillary", ctx, arg)
+	ret0, _ := ret[0].(db.TicketAncillary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSeat indicates an expected call of CreateSeat.
-func (mr *MockStoreMockRecorder) CreateSeat(ctx, arg any) *gomock.Call {
+// CancelTicketAncillary indicates an expected call of CancelTicketAncillary.
+func (mr *MockStoreMockRecorder) CancelTicketAncillary(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeat", reflect.TypeOf((*MockStore)(nil).CreateSeat), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicketAncillary", reflect.TypeOf((*MockStore)(nil).CancelTicketAncillary), ctx, arg)
 }
 
-// CreateTicket mocks base method.
-func (m *MockStore) CreateTicket(ctx context.Context, arg db.CreateTicketParams) (db.Ticket, error) {
+// CancelTicketAncillaryTx mocks base method.
+func (m *MockStore) CancelTicketAncillaryTx(ctx context.Context, arg db.CancelTicketAncillaryTxParams) (db.CancelTicketAncillaryTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicket", ctx, arg)
-	ret0, _ := ret[0].(db.Ticket)
+	ret := m.ctrl.Call(m, "CancelTicketAncillaryTx", ctx, arg)
+	ret0, _ := ret[0].(db.CancelTicketAncillaryTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicket indicates an expected call of CreateTicket.
-func (mr *MockStoreMockRecorder) CreateTicket(ctx, arg any) *gomock.Call {
+// CancelTicketAncillaryTx indicates an expected call of CancelTicketAncillaryTx.
+func (mr *MockStoreMockRecorder) CancelTicketAncillaryTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockStore)(nil).CreateTicket), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicketAncillaryTx", reflect.TypeOf((*MockStore)(nil).CancelTicketAncillaryTx), ctx, arg)
 }
 
-// CreateTicketOwnerSnapshot mocks base method.
-func (m *MockStore) CreateTicketOwnerSnapshot(ctx context.Context, arg db.CreateTicketOwnerSnapshotParams) (db.Ticketownersnapshot, error) {
+// CancelTicketTx mocks base method.
+func (m *MockStore) CancelTicketTx(ctx context.Context, arg db.CancelTicketTxParams) (db.CancelTicketTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicketOwnerSnapshot", ctx, arg)
-	ret0, _ := ret[0].(db.Ticketownersnapshot)
+	ret := m.ctrl.Call(m, "CancelTicketTx", ctx, arg)
+	ret0, _ := ret[0].(db.CancelTicketTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicketOwnerSnapshot indicates an expected call of CreateTicketOwnerSnapshot.
-func (mr *MockStoreMockRecorder) CreateTicketOwnerSnapshot(ctx, arg any) *gomock.Call {
+// CancelTicketTx indicates an expected call of CancelTicketTx.
+func (mr *MockStoreMockRecorder) CancelTicketTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketOwnerSnapshot", reflect.TypeOf((*MockStore)(nil).CreateTicketOwnerSnapshot), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTicketTx", reflect.TypeOf((*MockStore)(nil).CancelTicketTx), ctx, arg)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// CancelWaitlistEntry mocks base method.
+func (m *MockStore) CancelWaitlistEntry(ctx context.Context, arg db.CancelWaitlistEntryParams) (db.WaitlistEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "CancelWaitlistEntry", ctx, arg)
+	ret0, _ := ret[0].(db.WaitlistEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+// CancelWaitlistEntry indicates an expected call of CancelWaitlistEntry.
+func (mr *MockStoreMockRecorder) CancelWaitlistEntry(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelWaitlistEntry", reflect.TypeOf((*MockStore)(nil).CancelWaitlistEntry), ctx, arg)
 }
 
-// DeactivateUser mocks base method.
-func (m *MockStore) DeactivateUser(ctx context.Context, userID int64) error {
+// ChangeFlightTx mocks base method.
+func (m *MockStore) ChangeFlightTx(ctx context.Context, arg db.ChangeFlightTxParams) (db.ChangeFlightTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ChangeFlightTx", ctx, arg)
+	ret0, _ := ret[0].(db.ChangeFlightTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeactivateUser indicates an expected call of DeactivateUser.
-func (mr *MockStoreMockRecorder) DeactivateUser(ctx, userID any) *gomock.Call {
+// ChangeFlightTx indicates an expected call of ChangeFlightTx.
+func (mr *MockStoreMockRecorder) ChangeFlightTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockStore)(nil).DeactivateUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeFlightTx", reflect.TypeOf((*MockStore)(nil).ChangeFlightTx), ctx, arg)
 }
 
-// DeleteAdmin mocks base method.
-func (m *MockStore) DeleteAdmin(ctx context.Context, userID int64) error {
+// CheckInTicketsTx mocks base method.
+func (m *MockStore) CheckInTicketsTx(ctx context.Context, arg db.CheckInTicketsTxParams) ([]db.TicketCheckIn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAdmin", ctx, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CheckInTicketsTx", ctx, arg)
+	ret0, _ := ret[0].([]db.TicketCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAdmin indicates an expected call of DeleteAdmin.
-func (mr *MockStoreMockRecorder) DeleteAdmin(ctx, userID any) *gomock.Call {
+// CheckInTicketsTx indicates an expected call of CheckInTicketsTx.
+func (mr *MockStoreMockRecorder) CheckInTicketsTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdmin", reflect.TypeOf((*MockStore)(nil).DeleteAdmin), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInTicketsTx", reflect.TypeOf((*MockStore)(nil).CheckInTicketsTx), ctx, arg)
 }
 
-// DeleteAdminTx mocks base method.
-func (m *MockStore) DeleteAdminTx(ctx context.Context, arg db.DeleteAdminTxParams) (db.DeleteAdminTxResult, error) {
+// CheckSeatAvailability mocks base method.
+func (m *MockStore) CheckSeatAvailability(ctx context.Context, arg db.CheckSeatAvailabilityParams) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAdminTx", ctx, arg)
-	ret0, _ := ret[0].(db.DeleteAdminTxResult)
+	ret := m.ctrl.Call(m, "CheckSeatAvailability", ctx, arg)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteAdminTx indicates an expected call of DeleteAdminTx.
-func (mr *MockStoreMockRecorder) DeleteAdminTx(ctx, arg any) *gomock.Call {
+// CheckSeatAvailability indicates an expected call of CheckSeatAvailability.
+func (mr *MockStoreMockRecorder) CheckSeatAvailability(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdminTx", reflect.TypeOf((*MockStore)(nil).DeleteAdminTx), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSeatAvailability", reflect.TypeOf((*MockStore)(nil).CheckSeatAvailability), ctx, arg)
 }
 
-// DeleteBookings mocks base method.
-func (m *MockStore) DeleteBookings(ctx context.Context, bookingID int64) error {
+// ClaimWaitlistOffer mocks base method.
+func (m *MockStore) ClaimWaitlistOffer(ctx context.Context, id int64) (db.WaitlistEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBookings", ctx, bookingID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ClaimWaitlistOffer", ctx, id)
+	ret0, _ := ret[0].(db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteBookings indicates an expected call of DeleteBookings.
-func (mr *MockStoreMockRecorder) DeleteBookings(ctx, bookingID any) *gomock.Call {
+// ClaimWaitlistOffer indicates an expected call of ClaimWaitlistOffer.
+func (mr *MockStoreMockRecorder) ClaimWaitlistOffer(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookings", reflect.TypeOf((*MockStore)(nil).DeleteBookings), ctx, bookingID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWaitlistOffer", reflect.TypeOf((*MockStore)(nil).ClaimWaitlistOffer), ctx, id)
 }
 
-// DeleteCustomerByID mocks base method.
-func (m *MockStore) DeleteCustomerByID(ctx context.Context, userID int64) (int64, error) {
+// CompleteFlightChangeTx mocks base method.
+func (m *MockStore) CompleteFlightChangeTx(ctx context.Context, arg db.SettlePaymentParams) (db.CompleteFlightChangeTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCustomerByID", ctx, userID)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "CompleteFlightChangeTx", ctx, arg)
+	ret0, _ := ret[0].(db.CompleteFlightChangeTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteCustomerByID indicates an expected call of DeleteCustomerByID.
-func (mr *MockStoreMockRecorder) DeleteCustomerByID(ctx, userID any) *gomock.Call {
+// CompleteFlightChangeTx indicates an expected call of CompleteFlightChangeTx.
+func (mr *MockStoreMockRecorder) CompleteFlightChangeTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomerByID", reflect.TypeOf((*MockStore)(nil).DeleteCustomerByID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteFlightChangeTx", reflect.TypeOf((*MockStore)(nil).CompleteFlightChangeTx), ctx, arg)
 }
 
-// DeleteFlight mocks base method.
-func (m *MockStore) DeleteFlight(ctx context.Context, flightID int64) (int64, error) {
+// CompleteGroupDepositTx mocks base method.
+func (m *MockStore) CompleteGroupDepositTx(ctx context.Context, arg db.SettlePaymentParams) (db.CompleteGroupDepositTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFlight", ctx, flightID)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "CompleteGroupDepositTx", ctx, arg)
+	ret0, _ := ret[0].(db.CompleteGroupDepositTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteFlight indicates an expected call of DeleteFlight.
-func (mr *MockStoreMockRecorder) DeleteFlight(ctx, flightID any) *gomock.Call {
+// CompleteGroupDepositTx indicates an expected call of CompleteGroupDepositTx.
+func (mr *MockStoreMockRecorder) CompleteGroupDepositTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlight", reflect.TypeOf((*MockStore)(nil).DeleteFlight), ctx, flightID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteGroupDepositTx", reflect.TypeOf((*MockStore)(nil).CompleteGroupDepositTx), ctx, arg)
 }
 
-// DeleteNews mocks base method.
-func (m *MockStore) DeleteNews(ctx context.Context, id int64) (int64, error) {
+// CompleteSeatSelectionTx mocks base method.
+func (m *MockStore) CompleteSeatSelectionTx(ctx context.Context, arg db.SettlePaymentParams) (db.CompleteSeatSelectionTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNews", ctx, id)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "CompleteSeatSelectionTx", ctx, arg)
+	ret0, _ := ret[0].(db.CompleteSeatSelectionTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteNews indicates an expected call of DeleteNews.
-func (mr *MockStoreMockRecorder) DeleteNews(ctx, id any) *gomock.Call {
+// CompleteSeatSelectionTx indicates an expected call of CompleteSeatSelectionTx.
+func (mr *MockStoreMockRecorder) CompleteSeatSelectionTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNews", reflect.TypeOf((*MockStore)(nil).DeleteNews), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSeatSelectionTx", reflect.TypeOf((*MockStore)(nil).CompleteSeatSelectionTx), ctx, arg)
 }
 
-// DeleteTicket mocks base method.
-func (m *MockStore) DeleteTicket(ctx context.Context, ticketID int64) error {
+// ConfirmBookingPaymentTx mocks base method.
+func (m *MockStore) ConfirmBookingPaymentTx(ctx context.Context, arg db.SettlePaymentParams) (db.ConfirmBookingPaymentTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ConfirmBookingPaymentTx", ctx, arg)
+	ret0, _ := ret[0].(db.ConfirmBookingPaymentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTicket indicates an expected call of DeleteTicket.
-func (mr *MockStoreMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+// ConfirmBookingPaymentTx indicates an expected call of ConfirmBookingPaymentTx.
+func (mr *MockStoreMockRecorder) ConfirmBookingPaymentTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*MockStore)(nil).DeleteTicket), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmBookingPaymentTx", reflect.TypeOf((*MockStore)(nil).ConfirmBookingPaymentTx), ctx, arg)
 }
 
-// DeleteUser mocks base method.
-func (m *MockStore) DeleteUser(ctx context.Context, userID int64) error {
+// CountCompanionsByUser mocks base method.
+func (m *MockStore) CountCompanionsByUser(ctx context.Context, userID int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CountCompanionsByUser", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockStoreMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+// CountCompanionsByUser indicates an expected call of CountCompanionsByUser.
+func (mr *MockStoreMockRecorder) CountCompanionsByUser(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCompanionsByUser", reflect.TypeOf((*MockStore)(nil).CountCompanionsByUser), ctx, userID)
 }
 
-// GetAdmin mocks base method.
-func (m *MockStore) GetAdmin(ctx context.Context, userID int64) (int64, error) {
+// CountCustomerTrips mocks base method.
+func (m *MockStore) CountCustomerTrips(ctx context.Context, arg db.CountCustomerTripsParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAdmin", ctx, userID)
+	ret := m.ctrl.Call(m, "CountCustomerTrips", ctx, arg)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAdmin indicates an expected call of GetAdmin.
-func (mr *MockStoreMockRecorder) GetAdmin(ctx, userID any) *gomock.Call {
+// CountCustomerTrips indicates an expected call of CountCustomerTrips.
+func (mr *MockStoreMockRecorder) CountCustomerTrips(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdmin", reflect.TypeOf((*MockStore)(nil).GetAdmin), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomerTrips", reflect.TypeOf((*MockStore)(nil).CountCustomerTrips), ctx, arg)
 }
 
-// GetAdminByEmail mocks base method.
-func (m *MockStore) GetAdminByEmail(ctx context.Context, email string) (db.GetAdminByEmailRow, error) {
+// CountFlightSpecialServices mocks base method.
+func (m *MockStore) CountFlightSpecialServices(ctx context.Context, arg db.CountFlightSpecialServicesParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAdminByEmail", ctx, email)
-	ret0, _ := ret[0].(db.GetAdminByEmailRow)
+	ret := m.ctrl.Call(m, "CountFlightSpecialServices", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAdminByEmail indicates an expected call of GetAdminByEmail.
-func (mr *MockStoreMockRecorder) GetAdminByEmail(ctx, email any) *gomock.Call {
+// CountFlightSpecialServices indicates an expected call of CountFlightSpecialServices.
+func (mr *MockStoreMockRecorder) CountFlightSpecialServices(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminByEmail", reflect.TypeOf((*MockStore)(nil).GetAdminByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFlightSpecialServices", reflect.TypeOf((*MockStore)(nil).CountFlightSpecialServices), ctx, arg)
 }
 
-// GetAllFlights mocks base method.
-func (m *MockStore) GetAllFlights(ctx context.Context) ([]db.GetAllFlightsRow, error) {
+// CountGroupBlockedSeats mocks base method.
+func (m *MockStore) CountGroupBlockedSeats(ctx context.Context, outboundFlightID pgtype.Int8) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllFlights", ctx)
-	ret0, _ := ret[0].([]db.GetAllFlightsRow)
+	ret := m.ctrl.Call(m, "CountGroupBlockedSeats", ctx, outboundFlightID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllFlights indicates an expected call of GetAllFlights.
-func (mr *MockStoreMockRecorder) GetAllFlights(ctx any) *gomock.Call {
+// CountGroupBlockedSeats indicates an expected call of CountGroupBlockedSeats.
+func (mr *MockStoreMockRecorder) CountGroupBlockedSeats(ctx, outboundFlightID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFlights", reflect.TypeOf((*MockStore)(nil).GetAllFlights), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountGroupBlockedSeats", reflect.TypeOf((*MockStore)(nil).CountGroupBlockedSeats), ctx, outboundFlightID)
 }
 
-// GetAllSeats mocks base method.
-func (m *MockStore) GetAllSeats(ctx context.Context) ([]db.Seat, error) {
+// CountLoyaltyTransactions mocks base method.
+func (m *MockStore) CountLoyaltyTransactions(ctx context.Context, userID int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllSeats", ctx)
-	ret0, _ := ret[0].([]db.Seat)
+	ret := m.ctrl.Call(m, "CountLoyaltyTransactions", ctx, userID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllSeats indicates an expected call of GetAllSeats.
-func (mr *MockStoreMockRecorder) GetAllSeats(ctx any) *gomock.Call {
+// CountLoyaltyTransactions indicates an expected call of CountLoyaltyTransactions.
+func (mr *MockStoreMockRecorder) CountLoyaltyTransactions(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSeats", reflect.TypeOf((*MockStore)(nil).GetAllSeats), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLoyaltyTransactions", reflect.TypeOf((*MockStore)(nil).CountLoyaltyTransactions), ctx, userID)
 }
 
-// GetAllTicketOwnerSnapshots mocks base method.
-func (m *MockStore) GetAllTicketOwnerSnapshots(ctx context.Context) ([]db.Ticketownersnapshot, error) {
+// CountOccupiedSeats mocks base method.
+func (m *MockStore) CountOccupiedSeats(ctx context.Context, flightID pgtype.Int8) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTicketOwnerSnapshots", ctx)
-	ret0, _ := ret[0].([]db.Ticketownersnapshot)
+	ret := m.ctrl.Call(m, "CountOccupiedSeats", ctx, flightID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllTicketOwnerSnapshots indicates an expected call of GetAllTicketOwnerSnapshots.
-func (mr *MockStoreMockRecorder) GetAllTicketOwnerSnapshots(ctx any) *gomock.Call {
+// CountOccupiedSeats indicates an expected call of CountOccupiedSeats.
+func (mr *MockStoreMockRecorder) CountOccupiedSeats(ctx, flightID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketOwnerSnapshots", reflect.TypeOf((*MockStore)(nil).GetAllTicketOwnerSnapshots), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOccupiedSeats", reflect.TypeOf((*MockStore)(nil).CountOccupiedSeats), ctx, flightID)
 }
 
-// GetAllUser mocks base method.
-func (m *MockStore) GetAllUser(ctx context.Context) ([]db.User, error) {
+// CountPromoRedemptions mocks base method.
+func (m *MockStore) CountPromoRedemptions(ctx context.Context, promoCodeID int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllUser", ctx)
-	ret0, _ := ret[0].([]db.User)
+	ret := m.ctrl.Call(m, "CountPromoRedemptions", ctx, promoCodeID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllUser indicates an expected call of GetAllUser.
-func (mr *MockStoreMockRecorder) GetAllUser(ctx any) *gomock.Call {
+// CountPromoRedemptions indicates an expected call of CountPromoRedemptions.
+func (mr *MockStoreMockRecorder) CountPromoRedemptions(ctx, promoCodeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUser", reflect.TypeOf((*MockStore)(nil).GetAllUser), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPromoRedemptions", reflect.TypeOf((*MockStore)(nil).CountPromoRedemptions), ctx, promoCodeID)
 }
 
-// GetBooking mocks base method.
-func (m *MockStore) GetBooking(ctx context.Context, bookingID int64) (db.Booking, error) {
+// CountPromoRedemptionsByEmail mocks base method.
+func (m *MockStore) CountPromoRedemptionsByEmail(ctx context.Context, arg db.CountPromoRedemptionsByEmailParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBooking", ctx, bookingID)
+	ret := m.ctrl.Call(m, "CountPromoRedemptionsByEmail", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPromoRedemptionsByEmail indicates an expected call of CountPromoRedemptionsByEmail.
+func (mr *MockStoreMockRecorder) CountPromoRedemptionsByEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPromoRedemptionsByEmail", reflect.TypeOf((*MockStore)(nil).CountPromoRedemptionsByEmail), ctx, arg)
+}
+
+// CountSoldSeats mocks base method.
+func (m *MockStore) CountSoldSeats(ctx context.Context, flightID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSoldSeats", ctx, flightID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSoldSeats indicates an expected call of CountSoldSeats.
+func (mr *MockStoreMockRecorder) CountSoldSeats(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSoldSeats", reflect.TypeOf((*MockStore)(nil).CountSoldSeats), ctx, flightID)
+}
+
+// CountSoldSeatsByClass mocks base method.
+func (m *MockStore) CountSoldSeatsByClass(ctx context.Context, flightIds []int64) ([]db.CountSoldSeatsByClassRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSoldSeatsByClass", ctx, flightIds)
+	ret0, _ := ret[0].([]db.CountSoldSeatsByClassRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSoldSeatsByClass indicates an expected call of CountSoldSeatsByClass.
+func (mr *MockStoreMockRecorder) CountSoldSeatsByClass(ctx, flightIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSoldSeatsByClass", reflect.TypeOf((*MockStore)(nil).CountSoldSeatsByClass), ctx, flightIds)
+}
+
+// CountWalletTransactions mocks base method.
+func (m *MockStore) CountWalletTransactions(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWalletTransactions", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWalletTransactions indicates an expected call of CountWalletTransactions.
+func (mr *MockStoreMockRecorder) CountWalletTransactions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWalletTransactions", reflect.TypeOf((*MockStore)(nil).CountWalletTransactions), ctx, userID)
+}
+
+// CreateAdmin mocks base method.
+func (m *MockStore) CreateAdmin(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdmin", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdmin indicates an expected call of CreateAdmin.
+func (mr *MockStoreMockRecorder) CreateAdmin(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockStore)(nil).CreateAdmin), ctx, userID)
+}
+
+// CreateAdminTx mocks base method.
+func (m *MockStore) CreateAdminTx(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminTx", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdminTx indicates an expected call of CreateAdminTx.
+func (mr *MockStoreMockRecorder) CreateAdminTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminTx", reflect.TypeOf((*MockStore)(nil).CreateAdminTx), ctx, arg)
+}
+
+// CreateBooking mocks base method.
+func (m *MockStore) CreateBooking(ctx context.Context, arg db.CreateBookingParams) (db.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBooking", ctx, arg)
 	ret0, _ := ret[0].(db.Booking)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBooking indicates an expected call of GetBooking.
-func (mr *MockStoreMockRecorder) GetBooking(ctx, bookingID any) *gomock.Call {
+// CreateBooking indicates an expected call of CreateBooking.
+func (mr *MockStoreMockRecorder) CreateBooking(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBooking", reflect.TypeOf((*MockStore)(nil).GetBooking), ctx, bookingID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBooking", reflect.TypeOf((*MockStore)(nil).CreateBooking), ctx, arg)
 }
 
-// GetBookingHistoryByUID mocks base method.
-func (m *MockStore) GetBookingHistoryByUID(ctx context.Context, userID int64) ([]int64, error) {
+// CreateBookingSegment mocks base method.
+func (m *MockStore) CreateBookingSegment(ctx context.Context, arg db.CreateBookingSegmentParams) (db.BookingSegment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBookingHistoryByUID", ctx, userID)
-	ret0, _ := ret[0].([]int64)
+	ret := m.ctrl.Call(m, "CreateBookingSegment", ctx, arg)
+	ret0, _ := ret[0].(db.BookingSegment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBookingHistoryByUID indicates an expected call of GetBookingHistoryByUID.
-func (mr *MockStoreMockRecorder) GetBookingHistoryByUID(ctx, userID any) *gomock.Call {
+// CreateBookingSegment indicates an expected call of CreateBookingSegment.
+func (mr *MockStoreMockRecorder) CreateBookingSegment(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingHistoryByUID", reflect.TypeOf((*MockStore)(nil).GetBookingHistoryByUID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingSegment", reflect.TypeOf((*MockStore)(nil).CreateBookingSegment), ctx, arg)
 }
 
-// GetCustomer mocks base method.
-func (m *MockStore) GetCustomer(ctx context.Context, userID int64) (db.Customer, error) {
+// CreateBookingStatusHistory mocks base method.
+func (m *MockStore) CreateBookingStatusHistory(ctx context.Context, arg db.CreateBookingStatusHistoryParams) (db.BookingStatusHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomer", ctx, userID)
-	ret0, _ := ret[0].(db.Customer)
+	ret := m.ctrl.Call(m, "CreateBookingStatusHistory", ctx, arg)
+	ret0, _ := ret[0].(db.BookingStatusHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCustomer indicates an expected call of GetCustomer.
-func (mr *MockStoreMockRecorder) GetCustomer(ctx, userID any) *gomock.Call {
+// CreateBookingStatusHistory indicates an expected call of CreateBookingStatusHistory.
+func (mr *MockStoreMockRecorder) CreateBookingStatusHistory(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomer", reflect.TypeOf((*MockStore)(nil).GetCustomer), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingStatusHistory", reflect.TypeOf((*MockStore)(nil).CreateBookingStatusHistory), ctx, arg)
 }
 
-// GetCustomerByEmail mocks base method.
-func (m *MockStore) GetCustomerByEmail(ctx context.Context, email string) (db.Customer, error) {
+// CreateBookingTx mocks base method.
+func (m *MockStore) CreateBookingTx(ctx context.Context, arg db.CreateBookingTxParams) (db.CreateBookingTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerByEmail", ctx, email)
+	ret := m.ctrl.Call(m, "CreateBookingTx", ctx, arg)
+	ret0, _ := ret[0].(db.CreateBookingTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBookingTx indicates an expected call of CreateBookingTx.
+func (mr *MockStoreMockRecorder) CreateBookingTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingTx", reflect.TypeOf((*MockStore)(nil).CreateBookingTx), ctx, arg)
+}
+
+// CreateCompanion mocks base method.
+func (m *MockStore) CreateCompanion(ctx context.Context, arg db.CreateCompanionParams) (db.Companion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanion", ctx, arg)
+	ret0, _ := ret[0].(db.Companion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompanion indicates an expected call of CreateCompanion.
+func (mr *MockStoreMockRecorder) CreateCompanion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanion", reflect.TypeOf((*MockStore)(nil).CreateCompanion), ctx, arg)
+}
+
+// CreateCompanionTx mocks base method.
+func (m *MockStore) CreateCompanionTx(ctx context.Context, arg db.CreateCompanionTxParams) (db.Companion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompanionTx", ctx, arg)
+	ret0, _ := ret[0].(db.Companion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompanionTx indicates an expected call of CreateCompanionTx.
+func (mr *MockStoreMockRecorder) CreateCompanionTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompanionTx", reflect.TypeOf((*MockStore)(nil).CreateCompanionTx), ctx, arg)
+}
+
+// CreateCustomer mocks base method.
+func (m *MockStore) CreateCustomer(ctx context.Context, arg db.CreateCustomerParams) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, arg)
 	ret0, _ := ret[0].(db.Customer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCustomerByEmail indicates an expected call of GetCustomerByEmail.
-func (mr *MockStoreMockRecorder) GetCustomerByEmail(ctx, email any) *gomock.Call {
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockStoreMockRecorder) CreateCustomer(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByEmail", reflect.TypeOf((*MockStore)(nil).GetCustomerByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockStore)(nil).CreateCustomer), ctx, arg)
 }
 
-// GetCustomerByID mocks base method.
-func (m *MockStore) GetCustomerByID(ctx context.Context, userID int64) (db.GetCustomerByIDRow, error) {
+// CreateCustomerTx mocks base method.
+func (m *MockStore) CreateCustomerTx(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, userID)
-	ret0, _ := ret[0].(db.GetCustomerByIDRow)
+	ret := m.ctrl.Call(m, "CreateCustomerTx", ctx, arg)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCustomerByID indicates an expected call of GetCustomerByID.
-func (mr *MockStoreMockRecorder) GetCustomerByID(ctx, userID any) *gomock.Call {
+// CreateCustomerTx indicates an expected call of CreateCustomerTx.
+func (mr *MockStoreMockRecorder) CreateCustomerTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockStore)(nil).GetCustomerByID), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerTx", reflect.TypeOf((*MockStore)(nil).CreateCustomerTx), ctx, arg)
 }
 
-// GetFlight mocks base method.
-func (m *MockStore) GetFlight(ctx context.Context, flightID int64) (db.Flight, error) {
+// CreateFlight mocks base method.
+func (m *MockStore) CreateFlight(ctx context.Context, arg db.CreateFlightParams) (db.Flight, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFlight", ctx, flightID)
+	ret := m.ctrl.Call(m, "CreateFlight", ctx, arg)
 	ret0, _ := ret[0].(db.Flight)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFlight indicates an expected call of GetFlight.
-func (mr *MockStoreMockRecorder) GetFlight(ctx, flightID any) *gomock.Call {
+// CreateFlight indicates an expected call of CreateFlight.
+func (mr *MockStoreMockRecorder) CreateFlight(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlight", reflect.TypeOf((*MockStore)(nil).GetFlight), ctx, flightID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlight", reflect.TypeOf((*MockStore)(nil).CreateFlight), ctx, arg)
 }
 
-// GetFlightsByStatus mocks base method.
-func (m *MockStore) GetFlightsByStatus(ctx context.Context, flightID int64) (db.FlightStatus, error) {
+// CreateFlightChange mocks base method.
+func (m *MockStore) CreateFlightChange(ctx context.Context, arg db.CreateFlightChangeParams) (db.FlightChange, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFlightsByStatus", ctx, flightID)
-	ret0, _ := ret[0].(db.FlightStatus)
+	ret := m.ctrl.Call(m, "CreateFlightChange", ctx, arg)
+	ret0, _ := ret[0].(db.FlightChange)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFlightsByStatus indicates an expected call of GetFlightsByStatus.
-func (mr *MockStoreMockRecorder) GetFlightsByStatus(ctx, flightID any) *gomock.Call {
+// CreateFlightChange indicates an expected call of CreateFlightChange.
+func (mr *MockStoreMockRecorder) CreateFlightChange(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlightsByStatus", reflect.TypeOf((*MockStore)(nil).GetFlightsByStatus), ctx, flightID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlightChange", reflect.TypeOf((*MockStore)(nil).CreateFlightChange), ctx, arg)
 }
 
-// GetNews mocks base method.
-func (m *MockStore) GetNews(ctx context.Context, id int64) (db.News, error) {
+// CreateGroupBooking mocks base method.
+func (m *MockStore) CreateGroupBooking(ctx context.Context, arg db.CreateGroupBookingParams) (db.GroupBooking, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNews", ctx, id)
+	ret := m.ctrl.Call(m, "CreateGroupBooking", ctx, arg)
+	ret0, _ := ret[0].(db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupBooking indicates an expected call of CreateGroupBooking.
+func (mr *MockStoreMockRecorder) CreateGroupBooking(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupBooking", reflect.TypeOf((*MockStore)(nil).CreateGroupBooking), ctx, arg)
+}
+
+// CreateGroupBookingPassenger mocks base method.
+func (m *MockStore) CreateGroupBookingPassenger(ctx context.Context, arg db.CreateGroupBookingPassengerParams) (db.GroupBookingPassenger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupBookingPassenger", ctx, arg)
+	ret0, _ := ret[0].(db.GroupBookingPassenger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupBookingPassenger indicates an expected call of CreateGroupBookingPassenger.
+func (mr *MockStoreMockRecorder) CreateGroupBookingPassenger(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupBookingPassenger", reflect.TypeOf((*MockStore)(nil).CreateGroupBookingPassenger), ctx, arg)
+}
+
+// CreateLoyaltyTransaction mocks base method.
+func (m *MockStore) CreateLoyaltyTransaction(ctx context.Context, arg db.CreateLoyaltyTransactionParams) (db.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoyaltyTransaction", ctx, arg)
+	ret0, _ := ret[0].(db.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoyaltyTransaction indicates an expected call of CreateLoyaltyTransaction.
+func (mr *MockStoreMockRecorder) CreateLoyaltyTransaction(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoyaltyTransaction", reflect.TypeOf((*MockStore)(nil).CreateLoyaltyTransaction), ctx, arg)
+}
+
+// CreateNews mocks base method.
+func (m *MockStore) CreateNews(ctx context.Context, arg db.CreateNewsParams) (db.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNews", ctx, arg)
 	ret0, _ := ret[0].(db.News)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNews indicates an expected call of GetNews.
-func (mr *MockStoreMockRecorder) GetNews(ctx, id any) *gomock.Call {
+// CreateNews indicates an expected call of CreateNews.
+func (mr *MockStoreMockRecorder) CreateNews(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockStore)(nil).GetNews), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNews", reflect.TypeOf((*MockStore)(nil).CreateNews), ctx, arg)
 }
 
-// GetSeat mocks base method.
-func (m *MockStore) GetSeat(ctx context.Context, seatID int64) (db.Seat, error) {
+// CreatePayment mocks base method.
+func (m *MockStore) CreatePayment(ctx context.Context, arg db.CreatePaymentParams) (db.Payment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSeat", ctx, seatID)
-	ret0, _ := ret[0].(db.Seat)
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, arg)
+	ret0, _ := ret[0].(db.Payment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSeat indicates an expected call of GetSeat.
-func (mr *MockStoreMockRecorder) GetSeat(ctx, seatID any) *gomock.Call {
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockStoreMockRecorder) CreatePayment(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeat", reflect.TypeOf((*MockStore)(nil).GetSeat), ctx, seatID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockStore)(nil).CreatePayment), ctx, arg)
 }
 
-// GetSeatByTicketID mocks base method.
-func (m *MockStore) GetSeatByTicketID(ctx context.Context, ticketID int64) (db.GetSeatByTicketIDRow, error) {
+// CreatePromoCode mocks base method.
+func (m *MockStore) CreatePromoCode(ctx context.Context, arg db.CreatePromoCodeParams) (db.PromoCode, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSeatByTicketID", ctx, ticketID)
-	ret0, _ := ret[0].(db.GetSeatByTicketIDRow)
+	ret := m.ctrl.Call(m, "CreatePromoCode", ctx, arg)
+	ret0, _ := ret[0].(db.PromoCode)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSeatByTicketID indicates an expected call of GetSeatByTicketID.
-func (mr *MockStoreMockRecorder) GetSeatByTicketID(ctx, ticketID any) *gomock.Call {
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockStoreMockRecorder) CreatePromoCode(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeatByTicketID", reflect.TypeOf((*MockStore)(nil).GetSeatByTicketID), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockStore)(nil).CreatePromoCode), ctx, arg)
 }
 
-// GetTicketByFlightId mocks base method.
-func (m *MockStore) GetTicketByFlightId(ctx context.Context, flightID int64) ([]db.Ticket, error) {
+// CreatePromoRedemption mocks base method.
+func (m *MockStore) CreatePromoRedemption(ctx context.Context, arg db.CreatePromoRedemptionParams) (db.PromoRedemption, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketByFlightId", ctx, flightID)
-	ret0, _ := ret[0].([]db.Ticket)
+	ret := m.ctrl.Call(m, "CreatePromoRedemption", ctx, arg)
+	ret0, _ := ret[0].(db.PromoRedemption)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketByFlightId indicates an expected call of GetTicketByFlightId.
-func (mr *MockStoreMockRecorder) GetTicketByFlightId(ctx, flightID any) *gomock.Call {
+// CreatePromoRedemption indicates an expected call of CreatePromoRedemption.
+func (mr *MockStoreMockRecorder) CreatePromoRedemption(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketByFlightId", reflect.TypeOf((*MockStore)(nil).GetTicketByFlightId), ctx, flightID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoRedemption", reflect.TypeOf((*MockStore)(nil).CreatePromoRedemption), ctx, arg)
 }
 
-// GetTicketByID mocks base method.
-func (m *MockStore) GetTicketByID(ctx context.Context, ticketID int64) (db.GetTicketByIDRow, error) {
+// CreateRefund mocks base method.
+func (m *MockStore) CreateRefund(ctx context.Context, arg db.CreateRefundParams) (db.Refund, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketByID", ctx, ticketID)
-	ret0, _ := ret[0].(db.GetTicketByIDRow)
+	ret := m.ctrl.Call(m, "CreateRefund", ctx, arg)
+	ret0, _ := ret[0].(db.Refund)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketByID indicates an expected call of GetTicketByID.
-func (mr *MockStoreMockRecorder) GetTicketByID(ctx, ticketID any) *gomock.Call {
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockStoreMockRecorder) CreateRefund(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockStore)(nil).CreateRefund), ctx, arg)
+}
+
+// CreateSeat mocks base method.
+func (m *MockStore) CreateSeat(ctx context.Context, arg db.CreateSeatParams) (db.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeat", ctx, arg)
+	ret0, _ := ret[0].(db.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeat indicates an expected call of CreateSeat.
+func (mr *MockStoreMockRecorder) CreateSeat(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeat", reflect.TypeOf((*MockStore)(nil).CreateSeat), ctx, arg)
+}
+
+// CreateSeatSelection mocks base method.
+func (m *MockStore) CreateSeatSelection(ctx context.Context, arg db.CreateSeatSelectionParams) (db.SeatSelection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeatSelection", ctx, arg)
+	ret0, _ := ret[0].(db.SeatSelection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeatSelection indicates an expected call of CreateSeatSelection.
+func (mr *MockStoreMockRecorder) CreateSeatSelection(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeatSelection", reflect.TypeOf((*MockStore)(nil).CreateSeatSelection), ctx, arg)
+}
+
+// CreateSeatZone mocks base method.
+func (m *MockStore) CreateSeatZone(ctx context.Context, arg db.CreateSeatZoneParams) (db.SeatZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeatZone", ctx, arg)
+	ret0, _ := ret[0].(db.SeatZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeatZone indicates an expected call of CreateSeatZone.
+func (mr *MockStoreMockRecorder) CreateSeatZone(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeatZone", reflect.TypeOf((*MockStore)(nil).CreateSeatZone), ctx, arg)
+}
+
+// CreateTicket mocks base method.
+func (m *MockStore) CreateTicket(ctx context.Context, arg db.CreateTicketParams) (db.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, arg)
+	ret0, _ := ret[0].(db.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockStoreMockRecorder) CreateTicket(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockStore)(nil).CreateTicket), ctx, arg)
+}
+
+// CreateTicketAncillary mocks base method.
+func (m *MockStore) CreateTicketAncillary(ctx context.Context, arg db.CreateTicketAncillaryParams) (db.TicketAncillary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketAncillary", ctx, arg)
+	ret0, _ := ret[0].(db.TicketAncillary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketAncillary indicates an expected call of CreateTicketAncillary.
+func (mr *MockStoreMockRecorder) CreateTicketAncillary(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketAncillary", reflect.TypeOf((*MockStore)(nil).CreateTicketAncillary), ctx, arg)
+}
+
+// CreateTicketCheckIn mocks base method.
+func (m *MockStore) CreateTicketCheckIn(ctx context.Context, arg db.CreateTicketCheckInParams) (db.TicketCheckIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketCheckIn", ctx, arg)
+	ret0, _ := ret[0].(db.TicketCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketCheckIn indicates an expected call of CreateTicketCheckIn.
+func (mr *MockStoreMockRecorder) CreateTicketCheckIn(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketCheckIn", reflect.TypeOf((*MockStore)(nil).CreateTicketCheckIn), ctx, arg)
+}
+
+// CreateTicketFareItem mocks base method.
+func (m *MockStore) CreateTicketFareItem(ctx context.Context, arg db.CreateTicketFareItemParams) (db.TicketFareItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketFareItem", ctx, arg)
+	ret0, _ := ret[0].(db.TicketFareItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketFareItem indicates an expected call of CreateTicketFareItem.
+func (mr *MockStoreMockRecorder) CreateTicketFareItem(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketFareItem", reflect.TypeOf((*MockStore)(nil).CreateTicketFareItem), ctx, arg)
+}
+
+// CreateTicketOwnerSnapshot mocks base method.
+func (m *MockStore) CreateTicketOwnerSnapshot(ctx context.Context, arg db.CreateTicketOwnerSnapshotParams) (db.Ticketownersnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketOwnerSnapshot", ctx, arg)
+	ret0, _ := ret[0].(db.Ticketownersnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketOwnerSnapshot indicates an expected call of CreateTicketOwnerSnapshot.
+func (mr *MockStoreMockRecorder) CreateTicketOwnerSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketOwnerSnapshot", reflect.TypeOf((*MockStore)(nil).CreateTicketOwnerSnapshot), ctx, arg)
+}
+
+// CreateTicketSpecialService mocks base method.
+func (m *MockStore) CreateTicketSpecialService(ctx context.Context, arg db.CreateTicketSpecialServiceParams) (db.TicketSpecialService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketSpecialService", ctx, arg)
+	ret0, _ := ret[0].(db.TicketSpecialService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketSpecialService indicates an expected call of CreateTicketSpecialService.
+func (mr *MockStoreMockRecorder) CreateTicketSpecialService(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketSpecialService", reflect.TypeOf((*MockStore)(nil).CreateTicketSpecialService), ctx, arg)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+}
+
+// CreateWaitlistEntry mocks base method.
+func (m *MockStore) CreateWaitlistEntry(ctx context.Context, arg db.CreateWaitlistEntryParams) (db.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWaitlistEntry", ctx, arg)
+	ret0, _ := ret[0].(db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWaitlistEntry indicates an expected call of CreateWaitlistEntry.
+func (mr *MockStoreMockRecorder) CreateWaitlistEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWaitlistEntry", reflect.TypeOf((*MockStore)(nil).CreateWaitlistEntry), ctx, arg)
+}
+
+// CreateWalletTransaction mocks base method.
+func (m *MockStore) CreateWalletTransaction(ctx context.Context, arg db.CreateWalletTransactionParams) (db.WalletTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWalletTransaction", ctx, arg)
+	ret0, _ := ret[0].(db.WalletTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWalletTransaction indicates an expected call of CreateWalletTransaction.
+func (mr *MockStoreMockRecorder) CreateWalletTransaction(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWalletTransaction", reflect.TypeOf((*MockStore)(nil).CreateWalletTransaction), ctx, arg)
+}
+
+// DeactivatePromoCode mocks base method.
+func (m *MockStore) DeactivatePromoCode(ctx context.Context, id int64) (db.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivatePromoCode", ctx, id)
+	ret0, _ := ret[0].(db.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivatePromoCode indicates an expected call of DeactivatePromoCode.
+func (mr *MockStoreMockRecorder) DeactivatePromoCode(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivatePromoCode", reflect.TypeOf((*MockStore)(nil).DeactivatePromoCode), ctx, id)
+}
+
+// DeactivateUser mocks base method.
+func (m *MockStore) DeactivateUser(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockStoreMockRecorder) DeactivateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockStore)(nil).DeactivateUser), ctx, userID)
+}
+
+// DeleteAdmin mocks base method.
+func (m *MockStore) DeleteAdmin(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdmin", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdmin indicates an expected call of DeleteAdmin.
+func (mr *MockStoreMockRecorder) DeleteAdmin(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdmin", reflect.TypeOf((*MockStore)(nil).DeleteAdmin), ctx, userID)
+}
+
+// DeleteAdminTx mocks base method.
+func (m *MockStore) DeleteAdminTx(ctx context.Context, arg db.DeleteAdminTxParams) (db.DeleteAdminTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdminTx", ctx, arg)
+	ret0, _ := ret[0].(db.DeleteAdminTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAdminTx indicates an expected call of DeleteAdminTx.
+func (mr *MockStoreMockRecorder) DeleteAdminTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdminTx", reflect.TypeOf((*MockStore)(nil).DeleteAdminTx), ctx, arg)
+}
+
+// DeleteAncillary mocks base method.
+func (m *MockStore) DeleteAncillary(ctx context.Context, id int64) (db.Ancillary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAncillary", ctx, id)
+	ret0, _ := ret[0].(db.Ancillary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAncillary indicates an expected call of DeleteAncillary.
+func (mr *MockStoreMockRecorder) DeleteAncillary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAncillary", reflect.TypeOf((*MockStore)(nil).DeleteAncillary), ctx, id)
+}
+
+// DeleteBookings mocks base method.
+func (m *MockStore) DeleteBookings(ctx context.Context, bookingID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBookings", ctx, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBookings indicates an expected call of DeleteBookings.
+func (mr *MockStoreMockRecorder) DeleteBookings(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookings", reflect.TypeOf((*MockStore)(nil).DeleteBookings), ctx, bookingID)
+}
+
+// DeleteCompanion mocks base method.
+func (m *MockStore) DeleteCompanion(ctx context.Context, arg db.DeleteCompanionParams) (db.Companion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompanion", ctx, arg)
+	ret0, _ := ret[0].(db.Companion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCompanion indicates an expected call of DeleteCompanion.
+func (mr *MockStoreMockRecorder) DeleteCompanion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompanion", reflect.TypeOf((*MockStore)(nil).DeleteCompanion), ctx, arg)
+}
+
+// DeleteCustomerByID mocks base method.
+func (m *MockStore) DeleteCustomerByID(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomerByID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomerByID indicates an expected call of DeleteCustomerByID.
+func (mr *MockStoreMockRecorder) DeleteCustomerByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomerByID", reflect.TypeOf((*MockStore)(nil).DeleteCustomerByID), ctx, userID)
+}
+
+// DeleteFlight mocks base method.
+func (m *MockStore) DeleteFlight(ctx context.Context, flightID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlight", ctx, flightID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFlight indicates an expected call of DeleteFlight.
+func (mr *MockStoreMockRecorder) DeleteFlight(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlight", reflect.TypeOf((*MockStore)(nil).DeleteFlight), ctx, flightID)
+}
+
+// DeleteGroupBookingPassengers mocks base method.
+func (m *MockStore) DeleteGroupBookingPassengers(ctx context.Context, groupBookingID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupBookingPassengers", ctx, groupBookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupBookingPassengers indicates an expected call of DeleteGroupBookingPassengers.
+func (mr *MockStoreMockRecorder) DeleteGroupBookingPassengers(ctx, groupBookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupBookingPassengers", reflect.TypeOf((*MockStore)(nil).DeleteGroupBookingPassengers), ctx, groupBookingID)
+}
+
+// DeleteNews mocks base method.
+func (m *MockStore) DeleteNews(ctx context.Context, id int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNews", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNews indicates an expected call of DeleteNews.
+func (mr *MockStoreMockRecorder) DeleteNews(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNews", reflect.TypeOf((*MockStore)(nil).DeleteNews), ctx, id)
+}
+
+// DeletePricingCurve mocks base method.
+func (m *MockStore) DeletePricingCurve(ctx context.Context, id int64) (db.PricingCurve, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePricingCurve", ctx, id)
+	ret0, _ := ret[0].(db.PricingCurve)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePricingCurve indicates an expected call of DeletePricingCurve.
+func (mr *MockStoreMockRecorder) DeletePricingCurve(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePricingCurve", reflect.TypeOf((*MockStore)(nil).DeletePricingCurve), ctx, id)
+}
+
+// DeleteSeatZone mocks base method.
+func (m *MockStore) DeleteSeatZone(ctx context.Context, id int64) (db.SeatZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeatZone", ctx, id)
+	ret0, _ := ret[0].(db.SeatZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeatZone indicates an expected call of DeleteSeatZone.
+func (mr *MockStoreMockRecorder) DeleteSeatZone(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeatZone", reflect.TypeOf((*MockStore)(nil).DeleteSeatZone), ctx, id)
+}
+
+// DeleteTicket mocks base method.
+func (m *MockStore) DeleteTicket(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicket indicates an expected call of DeleteTicket.
+func (mr *MockStoreMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*MockStore)(nil).DeleteTicket), ctx, ticketID)
+}
+
+// DeleteTicketCheckIn mocks base method.
+func (m *MockStore) DeleteTicketCheckIn(ctx context.Context, arg db.DeleteTicketCheckInParams) (db.TicketCheckIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketCheckIn", ctx, arg)
+	ret0, _ := ret[0].(db.TicketCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTicketCheckIn indicates an expected call of DeleteTicketCheckIn.
+func (mr *MockStoreMockRecorder) DeleteTicketCheckIn(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketCheckIn", reflect.TypeOf((*MockStore)(nil).DeleteTicketCheckIn), ctx, arg)
+}
+
+// DeleteTicketCheckInsByBookingID mocks base method.
+func (m *MockStore) DeleteTicketCheckInsByBookingID(ctx context.Context, bookingID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketCheckInsByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketCheckInsByBookingID indicates an expected call of DeleteTicketCheckInsByBookingID.
+func (mr *MockStoreMockRecorder) DeleteTicketCheckInsByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketCheckInsByBookingID", reflect.TypeOf((*MockStore)(nil).DeleteTicketCheckInsByBookingID), ctx, bookingID)
+}
+
+// DeleteTicketCheckInsByTicketID mocks base method.
+func (m *MockStore) DeleteTicketCheckInsByTicketID(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketCheckInsByTicketID", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketCheckInsByTicketID indicates an expected call of DeleteTicketCheckInsByTicketID.
+func (mr *MockStoreMockRecorder) DeleteTicketCheckInsByTicketID(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketCheckInsByTicketID", reflect.TypeOf((*MockStore)(nil).DeleteTicketCheckInsByTicketID), ctx, ticketID)
+}
+
+// DeleteTicketFareItems mocks base method.
+func (m *MockStore) DeleteTicketFareItems(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketFareItems", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketFareItems indicates an expected call of DeleteTicketFareItems.
+func (mr *MockStoreMockRecorder) DeleteTicketFareItems(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketFareItems", reflect.TypeOf((*MockStore)(nil).DeleteTicketFareItems), ctx, ticketID)
+}
+
+// DeleteTicketSpecialService mocks base method.
+func (m *MockStore) DeleteTicketSpecialService(ctx context.Context, arg db.DeleteTicketSpecialServiceParams) (db.TicketSpecialService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketSpecialService", ctx, arg)
+	ret0, _ := ret[0].(db.TicketSpecialService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTicketSpecialService indicates an expected call of DeleteTicketSpecialService.
+func (mr *MockStoreMockRecorder) DeleteTicketSpecialService(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketSpecialService", reflect.TypeOf((*MockStore)(nil).DeleteTicketSpecialService), ctx, arg)
+}
+
+// DeleteUser mocks base method.
+func (m *MockStore) DeleteUser(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStoreMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), ctx, userID)
+}
+
+// ExpireLoyaltyPointsTx mocks base method.
+func (m *MockStore) ExpireLoyaltyPointsTx(ctx context.Context, userID int64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireLoyaltyPointsTx", ctx, userID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireLoyaltyPointsTx indicates an expected call of ExpireLoyaltyPointsTx.
+func (mr *MockStoreMockRecorder) ExpireLoyaltyPointsTx(ctx, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireLoyaltyPointsTx", reflect.TypeOf((*MockStore)(nil).ExpireLoyaltyPointsTx), ctx, userID, now)
+}
+
+// ExpireWaitlistOffer mocks base method.
+func (m *MockStore) ExpireWaitlistOffer(ctx context.Context, id int64) (db.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireWaitlistOffer", ctx, id)
+	ret0, _ := ret[0].(db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireWaitlistOffer indicates an expected call of ExpireWaitlistOffer.
+func (mr *MockStoreMockRecorder) ExpireWaitlistOffer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireWaitlistOffer", reflect.TypeOf((*MockStore)(nil).ExpireWaitlistOffer), ctx, id)
+}
+
+// FailPaymentTx mocks base method.
+func (m *MockStore) FailPaymentTx(ctx context.Context, intentID string) (db.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailPaymentTx", ctx, intentID)
+	ret0, _ := ret[0].(db.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailPaymentTx indicates an expected call of FailPaymentTx.
+func (mr *MockStoreMockRecorder) FailPaymentTx(ctx, intentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailPaymentTx", reflect.TypeOf((*MockStore)(nil).FailPaymentTx), ctx, intentID)
+}
+
+// GetAdmin mocks base method.
+func (m *MockStore) GetAdmin(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdmin", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdmin indicates an expected call of GetAdmin.
+func (mr *MockStoreMockRecorder) GetAdmin(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdmin", reflect.TypeOf((*MockStore)(nil).GetAdmin), ctx, userID)
+}
+
+// GetAdminByEmail mocks base method.
+func (m *MockStore) GetAdminByEmail(ctx context.Context, email string) (db.GetAdminByEmailRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminByEmail", ctx, email)
+	ret0, _ := ret[0].(db.GetAdminByEmailRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminByEmail indicates an expected call of GetAdminByEmail.
+func (mr *MockStoreMockRecorder) GetAdminByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminByEmail", reflect.TypeOf((*MockStore)(nil).GetAdminByEmail), ctx, email)
+}
+
+// GetAllFlights mocks base method.
+func (m *MockStore) GetAllFlights(ctx context.Context) ([]db.GetAllFlightsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFlights", ctx)
+	ret0, _ := ret[0].([]db.GetAllFlightsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFlights indicates an expected call of GetAllFlights.
+func (mr *MockStoreMockRecorder) GetAllFlights(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFlights", reflect.TypeOf((*MockStore)(nil).GetAllFlights), ctx)
+}
+
+// GetAllSeats mocks base method.
+func (m *MockStore) GetAllSeats(ctx context.Context) ([]db.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSeats", ctx)
+	ret0, _ := ret[0].([]db.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSeats indicates an expected call of GetAllSeats.
+func (mr *MockStoreMockRecorder) GetAllSeats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSeats", reflect.TypeOf((*MockStore)(nil).GetAllSeats), ctx)
+}
+
+// GetAllTicketOwnerSnapshots mocks base method.
+func (m *MockStore) GetAllTicketOwnerSnapshots(ctx context.Context) ([]db.Ticketownersnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTicketOwnerSnapshots", ctx)
+	ret0, _ := ret[0].([]db.Ticketownersnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTicketOwnerSnapshots indicates an expected call of GetAllTicketOwnerSnapshots.
+func (mr *MockStoreMockRecorder) GetAllTicketOwnerSnapshots(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketOwnerSnapshots", reflect.TypeOf((*MockStore)(nil).GetAllTicketOwnerSnapshots), ctx)
+}
+
+// GetAllUser mocks base method.
+func (m *MockStore) GetAllUser(ctx context.Context) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUser", ctx)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUser indicates an expected call of GetAllUser.
+func (mr *MockStoreMockRecorder) GetAllUser(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUser", reflect.TypeOf((*MockStore)(nil).GetAllUser), ctx)
+}
+
+// GetBooking mocks base method.
+func (m *MockStore) GetBooking(ctx context.Context, bookingID int64) (db.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBooking", ctx, bookingID)
+	ret0, _ := ret[0].(db.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBooking indicates an expected call of GetBooking.
+func (mr *MockStoreMockRecorder) GetBooking(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBooking", reflect.TypeOf((*MockStore)(nil).GetBooking), ctx, bookingID)
+}
+
+// GetBookingByPNR mocks base method.
+func (m *MockStore) GetBookingByPNR(ctx context.Context, pnr string) (db.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByPNR", ctx, pnr)
+	ret0, _ := ret[0].(db.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingByPNR indicates an expected call of GetBookingByPNR.
+func (mr *MockStoreMockRecorder) GetBookingByPNR(ctx, pnr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByPNR", reflect.TypeOf((*MockStore)(nil).GetBookingByPNR), ctx, pnr)
+}
+
+// GetBookingByPNRAndLastName mocks base method.
+func (m *MockStore) GetBookingByPNRAndLastName(ctx context.Context, arg db.GetBookingByPNRAndLastNameParams) (db.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByPNRAndLastName", ctx, arg)
+	ret0, _ := ret[0].(db.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingByPNRAndLastName indicates an expected call of GetBookingByPNRAndLastName.
+func (mr *MockStoreMockRecorder) GetBookingByPNRAndLastName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByPNRAndLastName", reflect.TypeOf((*MockStore)(nil).GetBookingByPNRAndLastName), ctx, arg)
+}
+
+// GetBookingForUpdate mocks base method.
+func (m *MockStore) GetBookingForUpdate(ctx context.Context, bookingID int64) (db.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingForUpdate", ctx, bookingID)
+	ret0, _ := ret[0].(db.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingForUpdate indicates an expected call of GetBookingForUpdate.
+func (mr *MockStoreMockRecorder) GetBookingForUpdate(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingForUpdate", reflect.TypeOf((*MockStore)(nil).GetBookingForUpdate), ctx, bookingID)
+}
+
+// GetBookingHistoryByUID mocks base method.
+func (m *MockStore) GetBookingHistoryByUID(ctx context.Context, userID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingHistoryByUID", ctx, userID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingHistoryByUID indicates an expected call of GetBookingHistoryByUID.
+func (mr *MockStoreMockRecorder) GetBookingHistoryByUID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingHistoryByUID", reflect.TypeOf((*MockStore)(nil).GetBookingHistoryByUID), ctx, userID)
+}
+
+// GetCompanionByEmail mocks base method.
+func (m *MockStore) GetCompanionByEmail(ctx context.Context, arg db.GetCompanionByEmailParams) (db.Companion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanionByEmail", ctx, arg)
+	ret0, _ := ret[0].(db.Companion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanionByEmail indicates an expected call of GetCompanionByEmail.
+func (mr *MockStoreMockRecorder) GetCompanionByEmail(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanionByEmail", reflect.TypeOf((*MockStore)(nil).GetCompanionByEmail), ctx, arg)
+}
+
+// GetCustomer mocks base method.
+func (m *MockStore) GetCustomer(ctx context.Context, userID int64) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomer", ctx, userID)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomer indicates an expected call of GetCustomer.
+func (mr *MockStoreMockRecorder) GetCustomer(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomer", reflect.TypeOf((*MockStore)(nil).GetCustomer), ctx, userID)
+}
+
+// GetCustomerByEmail mocks base method.
+func (m *MockStore) GetCustomerByEmail(ctx context.Context, email string) (db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByEmail", ctx, email)
+	ret0, _ := ret[0].(db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByEmail indicates an expected call of GetCustomerByEmail.
+func (mr *MockStoreMockRecorder) GetCustomerByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByEmail", reflect.TypeOf((*MockStore)(nil).GetCustomerByEmail), ctx, email)
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockStore) GetCustomerByID(ctx context.Context, userID int64) (db.GetCustomerByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, userID)
+	ret0, _ := ret[0].(db.GetCustomerByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockStoreMockRecorder) GetCustomerByID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockStore)(nil).GetCustomerByID), ctx, userID)
+}
+
+// GetCustomerLoyaltyPointsForUpdate mocks base method.
+func (m *MockStore) GetCustomerLoyaltyPointsForUpdate(ctx context.Context, userID int64) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerLoyaltyPointsForUpdate", ctx, userID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerLoyaltyPointsForUpdate indicates an expected call of GetCustomerLoyaltyPointsForUpdate.
+func (mr *MockStoreMockRecorder) GetCustomerLoyaltyPointsForUpdate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerLoyaltyPointsForUpdate", reflect.TypeOf((*MockStore)(nil).GetCustomerLoyaltyPointsForUpdate), ctx, userID)
+}
+
+// GetCustomerLoyaltyTier mocks base method.
+func (m *MockStore) GetCustomerLoyaltyTier(ctx context.Context, userID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerLoyaltyTier", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerLoyaltyTier indicates an expected call of GetCustomerLoyaltyTier.
+func (mr *MockStoreMockRecorder) GetCustomerLoyaltyTier(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerLoyaltyTier", reflect.TypeOf((*MockStore)(nil).GetCustomerLoyaltyTier), ctx, userID)
+}
+
+// GetCustomerLoyaltyTierByEmail mocks base method.
+func (m *MockStore) GetCustomerLoyaltyTierByEmail(ctx context.Context, email string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerLoyaltyTierByEmail", ctx, email)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerLoyaltyTierByEmail indicates an expected call of GetCustomerLoyaltyTierByEmail.
+func (mr *MockStoreMockRecorder) GetCustomerLoyaltyTierByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerLoyaltyTierByEmail", reflect.TypeOf((*MockStore)(nil).GetCustomerLoyaltyTierByEmail), ctx, email)
+}
+
+// GetCustomerQualifyingPoints mocks base method.
+func (m *MockStore) GetCustomerQualifyingPoints(ctx context.Context, arg db.GetCustomerQualifyingPointsParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerQualifyingPoints", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerQualifyingPoints indicates an expected call of GetCustomerQualifyingPoints.
+func (mr *MockStoreMockRecorder) GetCustomerQualifyingPoints(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerQualifyingPoints", reflect.TypeOf((*MockStore)(nil).GetCustomerQualifyingPoints), ctx, arg)
+}
+
+// GetCustomerWalletBalanceForUpdate mocks base method.
+func (m *MockStore) GetCustomerWalletBalanceForUpdate(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerWalletBalanceForUpdate", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerWalletBalanceForUpdate indicates an expected call of GetCustomerWalletBalanceForUpdate.
+func (mr *MockStoreMockRecorder) GetCustomerWalletBalanceForUpdate(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerWalletBalanceForUpdate", reflect.TypeOf((*MockStore)(nil).GetCustomerWalletBalanceForUpdate), ctx, userID)
+}
+
+// GetFareFamily mocks base method.
+func (m *MockStore) GetFareFamily(ctx context.Context, arg db.GetFareFamilyParams) (db.FareFamily, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFareFamily", ctx, arg)
+	ret0, _ := ret[0].(db.FareFamily)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFareFamily indicates an expected call of GetFareFamily.
+func (mr *MockStoreMockRecorder) GetFareFamily(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFareFamily", reflect.TypeOf((*MockStore)(nil).GetFareFamily), ctx, arg)
+}
+
+// GetFlight mocks base method.
+func (m *MockStore) GetFlight(ctx context.Context, flightID int64) (db.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlight", ctx, flightID)
+	ret0, _ := ret[0].(db.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlight indicates an expected call of GetFlight.
+func (mr *MockStoreMockRecorder) GetFlight(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlight", reflect.TypeOf((*MockStore)(nil).GetFlight), ctx, flightID)
+}
+
+// GetFlightChangeForUpdate mocks base method.
+func (m *MockStore) GetFlightChangeForUpdate(ctx context.Context, id int64) (db.FlightChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlightChangeForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.FlightChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlightChangeForUpdate indicates an expected call of GetFlightChangeForUpdate.
+func (mr *MockStoreMockRecorder) GetFlightChangeForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlightChangeForUpdate", reflect.TypeOf((*MockStore)(nil).GetFlightChangeForUpdate), ctx, id)
+}
+
+// GetFlightsByStatus mocks base method.
+func (m *MockStore) GetFlightsByStatus(ctx context.Context, flightID int64) (db.FlightStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlightsByStatus", ctx, flightID)
+	ret0, _ := ret[0].(db.FlightStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlightsByStatus indicates an expected call of GetFlightsByStatus.
+func (mr *MockStoreMockRecorder) GetFlightsByStatus(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlightsByStatus", reflect.TypeOf((*MockStore)(nil).GetFlightsByStatus), ctx, flightID)
+}
+
+// GetGroupBooking mocks base method.
+func (m *MockStore) GetGroupBooking(ctx context.Context, id int64) (db.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupBooking", ctx, id)
+	ret0, _ := ret[0].(db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupBooking indicates an expected call of GetGroupBooking.
+func (mr *MockStoreMockRecorder) GetGroupBooking(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupBooking", reflect.TypeOf((*MockStore)(nil).GetGroupBooking), ctx, id)
+}
+
+// GetGroupBookingForUpdate mocks base method.
+func (m *MockStore) GetGroupBookingForUpdate(ctx context.Context, id int64) (db.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupBookingForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupBookingForUpdate indicates an expected call of GetGroupBookingForUpdate.
+func (mr *MockStoreMockRecorder) GetGroupBookingForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupBookingForUpdate", reflect.TypeOf((*MockStore)(nil).GetGroupBookingForUpdate), ctx, id)
+}
+
+// GetLoyaltyAccrualByTicket mocks base method.
+func (m *MockStore) GetLoyaltyAccrualByTicket(ctx context.Context, ticketID pgtype.Int8) (db.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoyaltyAccrualByTicket", ctx, ticketID)
+	ret0, _ := ret[0].(db.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoyaltyAccrualByTicket indicates an expected call of GetLoyaltyAccrualByTicket.
+func (mr *MockStoreMockRecorder) GetLoyaltyAccrualByTicket(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoyaltyAccrualByTicket", reflect.TypeOf((*MockStore)(nil).GetLoyaltyAccrualByTicket), ctx, ticketID)
+}
+
+// GetNews mocks base method.
+func (m *MockStore) GetNews(ctx context.Context, id int64) (db.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNews", ctx, id)
+	ret0, _ := ret[0].(db.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNews indicates an expected call of GetNews.
+func (mr *MockStoreMockRecorder) GetNews(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNews", reflect.TypeOf((*MockStore)(nil).GetNews), ctx, id)
+}
+
+// GetNextWaitlistEntry mocks base method.
+func (m *MockStore) GetNextWaitlistEntry(ctx context.Context, arg db.GetNextWaitlistEntryParams) (db.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextWaitlistEntry", ctx, arg)
+	ret0, _ := ret[0].(db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextWaitlistEntry indicates an expected call of GetNextWaitlistEntry.
+func (mr *MockStoreMockRecorder) GetNextWaitlistEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextWaitlistEntry", reflect.TypeOf((*MockStore)(nil).GetNextWaitlistEntry), ctx, arg)
+}
+
+// GetPaymentByIntentID mocks base method.
+func (m *MockStore) GetPaymentByIntentID(ctx context.Context, intentID string) (db.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByIntentID", ctx, intentID)
+	ret0, _ := ret[0].(db.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByIntentID indicates an expected call of GetPaymentByIntentID.
+func (mr *MockStoreMockRecorder) GetPaymentByIntentID(ctx, intentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByIntentID", reflect.TypeOf((*MockStore)(nil).GetPaymentByIntentID), ctx, intentID)
+}
+
+// GetPaymentByIntentIDForUpdate mocks base method.
+func (m *MockStore) GetPaymentByIntentIDForUpdate(ctx context.Context, intentID string) (db.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByIntentIDForUpdate", ctx, intentID)
+	ret0, _ := ret[0].(db.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByIntentIDForUpdate indicates an expected call of GetPaymentByIntentIDForUpdate.
+func (mr *MockStoreMockRecorder) GetPaymentByIntentIDForUpdate(ctx, intentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByIntentIDForUpdate", reflect.TypeOf((*MockStore)(nil).GetPaymentByIntentIDForUpdate), ctx, intentID)
+}
+
+// GetPromoCodeByCode mocks base method.
+func (m *MockStore) GetPromoCodeByCode(ctx context.Context, code string) (db.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeByCode", ctx, code)
+	ret0, _ := ret[0].(db.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeByCode indicates an expected call of GetPromoCodeByCode.
+func (mr *MockStoreMockRecorder) GetPromoCodeByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeByCode", reflect.TypeOf((*MockStore)(nil).GetPromoCodeByCode), ctx, code)
+}
+
+// GetPromoCodeForUpdate mocks base method.
+func (m *MockStore) GetPromoCodeForUpdate(ctx context.Context, id int64) (db.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeForUpdate indicates an expected call of GetPromoCodeForUpdate.
+func (mr *MockStoreMockRecorder) GetPromoCodeForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeForUpdate", reflect.TypeOf((*MockStore)(nil).GetPromoCodeForUpdate), ctx, id)
+}
+
+// GetSeat mocks base method.
+func (m *MockStore) GetSeat(ctx context.Context, seatID int64) (db.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeat", ctx, seatID)
+	ret0, _ := ret[0].(db.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeat indicates an expected call of GetSeat.
+func (mr *MockStoreMockRecorder) GetSeat(ctx, seatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeat", reflect.TypeOf((*MockStore)(nil).GetSeat), ctx, seatID)
+}
+
+// GetSeatByTicketID mocks base method.
+func (m *MockStore) GetSeatByTicketID(ctx context.Context, ticketID int64) (db.GetSeatByTicketIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeatByTicketID", ctx, ticketID)
+	ret0, _ := ret[0].(db.GetSeatByTicketIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeatByTicketID indicates an expected call of GetSeatByTicketID.
+func (mr *MockStoreMockRecorder) GetSeatByTicketID(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeatByTicketID", reflect.TypeOf((*MockStore)(nil).GetSeatByTicketID), ctx, ticketID)
+}
+
+// GetSeatSelectionForUpdate mocks base method.
+func (m *MockStore) GetSeatSelectionForUpdate(ctx context.Context, id int64) (db.SeatSelection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeatSelectionForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.SeatSelection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeatSelectionForUpdate indicates an expected call of GetSeatSelectionForUpdate.
+func (mr *MockStoreMockRecorder) GetSeatSelectionForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeatSelectionForUpdate", reflect.TypeOf((*MockStore)(nil).GetSeatSelectionForUpdate), ctx, id)
+}
+
+// GetTicketAncillaryForUpdate mocks base method.
+func (m *MockStore) GetTicketAncillaryForUpdate(ctx context.Context, id int64) (db.TicketAncillary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketAncillaryForUpdate", ctx, id)
+	ret0, _ := ret[0].(db.TicketAncillary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketAncillaryForUpdate indicates an expected call of GetTicketAncillaryForUpdate.
+func (mr *MockStoreMockRecorder) GetTicketAncillaryForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAncillaryForUpdate", reflect.TypeOf((*MockStore)(nil).GetTicketAncillaryForUpdate), ctx, id)
+}
+
+// GetTicketByFlightId mocks base method.
+func (m *MockStore) GetTicketByFlightId(ctx context.Context, flightID int64) ([]db.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketByFlightId", ctx, flightID)
+	ret0, _ := ret[0].([]db.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketByFlightId indicates an expected call of GetTicketByFlightId.
+func (mr *MockStoreMockRecorder) GetTicketByFlightId(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketByFlightId", reflect.TypeOf((*MockStore)(nil).GetTicketByFlightId), ctx, flightID)
+}
+
+// GetTicketByID mocks base method.
+func (m *MockStore) GetTicketByID(ctx context.Context, ticketID int64) (db.GetTicketByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketByID", ctx, ticketID)
+	ret0, _ := ret[0].(db.GetTicketByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketByID indicates an expected call of GetTicketByID.
+func (mr *MockStoreMockRecorder) GetTicketByID(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketByID", reflect.TypeOf((*MockStore)(nil).GetTicketByID), ctx, ticketID)
+}
+
+// GetTicketByNumber mocks base method.
+func (m *MockStore) GetTicketByNumber(ctx context.Context, ticketNumber pgtype.Text) (db.GetTicketByNumberRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketByNumber", ctx, ticketNumber)
+	ret0, _ := ret[0].(db.GetTicketByNumberRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketByNumber indicates an expected call of GetTicketByNumber.
+func (mr *MockStoreMockRecorder) GetTicketByNumber(ctx, ticketNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketByNumber", reflect.TypeOf((*MockStore)(nil).GetTicketByNumber), ctx, ticketNumber)
+}
+
+// GetTicketOwnerSnapshot mocks base method.
+func (m *MockStore) GetTicketOwnerSnapshot(ctx context.Context, ticketID int64) (db.Ticketownersnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketOwnerSnapshot", ctx, ticketID)
+	ret0, _ := ret[0].(db.Ticketownersnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketOwnerSnapshot indicates an expected call of GetTicketOwnerSnapshot.
+func (mr *MockStoreMockRecorder) GetTicketOwnerSnapshot(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketOwnerSnapshot", reflect.TypeOf((*MockStore)(nil).GetTicketOwnerSnapshot), ctx, ticketID)
+}
+
+// GetTicketsByBookingIDAndType mocks base method.
+func (m *MockStore) GetTicketsByBookingIDAndType(ctx context.Context, arg db.GetTicketsByBookingIDAndTypeParams) ([]db.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsByBookingIDAndType", ctx, arg)
+	ret0, _ := ret[0].([]db.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketsByBookingIDAndType indicates an expected call of GetTicketsByBookingIDAndType.
+func (mr *MockStoreMockRecorder) GetTicketsByBookingIDAndType(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByBookingIDAndType", reflect.TypeOf((*MockStore)(nil).GetTicketsByBookingIDAndType), ctx, arg)
+}
+
+// GetTicketsByFlightID mocks base method.
+func (m *MockStore) GetTicketsByFlightID(ctx context.Context, flightID int64) ([]db.GetTicketsByFlightIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsByFlightID", ctx, flightID)
+	ret0, _ := ret[0].([]db.GetTicketsByFlightIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketsByFlightID indicates an expected call of GetTicketsByFlightID.
+func (mr *MockStoreMockRecorder) GetTicketsByFlightID(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByFlightID", reflect.TypeOf((*MockStore)(nil).GetTicketsByFlightID), ctx, flightID)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(ctx context.Context, userID int64) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, userID)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockStoreMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetWaitlistEntryByClaimToken mocks base method.
+func (m *MockStore) GetWaitlistEntryByClaimToken(ctx context.Context, claimToken pgtype.Text) (db.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistEntryByClaimToken", ctx, claimToken)
+	ret0, _ := ret[0].(db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlistEntryByClaimToken indicates an expected call of GetWaitlistEntryByClaimToken.
+func (mr *MockStoreMockRecorder) GetWaitlistEntryByClaimToken(ctx, claimToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistEntryByClaimToken", reflect.TypeOf((*MockStore)(nil).GetWaitlistEntryByClaimToken), ctx, claimToken)
+}
+
+// GetWaitlistEntryByOfferKey mocks base method.
+func (m *MockStore) GetWaitlistEntryByOfferKey(ctx context.Context, offerKey pgtype.Text) (db.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistEntryByOfferKey", ctx, offerKey)
+	ret0, _ := ret[0].(db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlistEntryByOfferKey indicates an expected call of GetWaitlistEntryByOfferKey.
+func (mr *MockStoreMockRecorder) GetWaitlistEntryByOfferKey(ctx, offerKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistEntryByOfferKey", reflect.TypeOf((*MockStore)(nil).GetWaitlistEntryByOfferKey), ctx, offerKey)
+}
+
+// IsAdmin mocks base method.
+func (m *MockStore) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockStoreMockRecorder) IsAdmin(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockStore)(nil).IsAdmin), ctx, userID)
+}
+
+// IsSeatCodeTaken mocks base method.
+func (m *MockStore) IsSeatCodeTaken(ctx context.Context, arg db.IsSeatCodeTakenParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSeatCodeTaken", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSeatCodeTaken indicates an expected call of IsSeatCodeTaken.
+func (mr *MockStoreMockRecorder) IsSeatCodeTaken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSeatCodeTaken", reflect.TypeOf((*MockStore)(nil).IsSeatCodeTaken), ctx, arg)
+}
+
+// IssueGroupBookingTx mocks base method.
+func (m *MockStore) IssueGroupBookingTx(ctx context.Context, arg db.IssueGroupBookingTxParams) (db.IssueGroupBookingTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueGroupBookingTx", ctx, arg)
+	ret0, _ := ret[0].(db.IssueGroupBookingTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueGroupBookingTx indicates an expected call of IssueGroupBookingTx.
+func (mr *MockStoreMockRecorder) IssueGroupBookingTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueGroupBookingTx", reflect.TypeOf((*MockStore)(nil).IssueGroupBookingTx), ctx, arg)
+}
+
+// IssueWalletCreditTx mocks base method.
+func (m *MockStore) IssueWalletCreditTx(ctx context.Context, arg db.IssueWalletCreditTxParams) (db.WalletTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueWalletCreditTx", ctx, arg)
+	ret0, _ := ret[0].(db.WalletTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueWalletCreditTx indicates an expected call of IssueWalletCreditTx.
+func (mr *MockStoreMockRecorder) IssueWalletCreditTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueWalletCreditTx", reflect.TypeOf((*MockStore)(nil).IssueWalletCreditTx), ctx, arg)
+}
+
+// ListAdmins mocks base method.
+func (m *MockStore) ListAdmins(ctx context.Context, arg db.ListAdminsParams) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdmins", ctx, arg)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdmins indicates an expected call of ListAdmins.
+func (mr *MockStoreMockRecorder) ListAdmins(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmins", reflect.TypeOf((*MockStore)(nil).ListAdmins), ctx, arg)
+}
+
+// ListAlternativeFlights mocks base method.
+func (m *MockStore) ListAlternativeFlights(ctx context.Context, arg db.ListAlternativeFlightsParams) ([]db.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlternativeFlights", ctx, arg)
+	ret0, _ := ret[0].([]db.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlternativeFlights indicates an expected call of ListAlternativeFlights.
+func (mr *MockStoreMockRecorder) ListAlternativeFlights(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlternativeFlights", reflect.TypeOf((*MockStore)(nil).ListAlternativeFlights), ctx, arg)
+}
+
+// ListAncillaries mocks base method.
+func (m *MockStore) ListAncillaries(ctx context.Context) ([]db.Ancillary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAncillaries", ctx)
+	ret0, _ := ret[0].([]db.Ancillary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAncillaries indicates an expected call of ListAncillaries.
+func (mr *MockStoreMockRecorder) ListAncillaries(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAncillaries", reflect.TypeOf((*MockStore)(nil).ListAncillaries), ctx)
+}
+
+// ListBookingSegments mocks base method.
+func (m *MockStore) ListBookingSegments(ctx context.Context, bookingID int64) ([]db.BookingSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookingSegments", ctx, bookingID)
+	ret0, _ := ret[0].([]db.BookingSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookingSegments indicates an expected call of ListBookingSegments.
+func (mr *MockStoreMockRecorder) ListBookingSegments(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookingSegments", reflect.TypeOf((*MockStore)(nil).ListBookingSegments), ctx, bookingID)
+}
+
+// ListBookingSegmentsByBookingIDs mocks base method.
+func (m *MockStore) ListBookingSegmentsByBookingIDs(ctx context.Context, bookingIds []int64) ([]db.BookingSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookingSegmentsByBookingIDs", ctx, bookingIds)
+	ret0, _ := ret[0].([]db.BookingSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookingSegmentsByBookingIDs indicates an expected call of ListBookingSegmentsByBookingIDs.
+func (mr *MockStoreMockRecorder) ListBookingSegmentsByBookingIDs(ctx, bookingIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookingSegmentsByBookingIDs", reflect.TypeOf((*MockStore)(nil).ListBookingSegmentsByBookingIDs), ctx, bookingIds)
+}
+
+// ListBookingStatusHistory mocks base method.
+func (m *MockStore) ListBookingStatusHistory(ctx context.Context, bookingID int64) ([]db.BookingStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookingStatusHistory", ctx, bookingID)
+	ret0, _ := ret[0].([]db.BookingStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookingStatusHistory indicates an expected call of ListBookingStatusHistory.
+func (mr *MockStoreMockRecorder) ListBookingStatusHistory(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookingStatusHistory", reflect.TypeOf((*MockStore)(nil).ListBookingStatusHistory), ctx, bookingID)
+}
+
+// ListBookings mocks base method.
+func (m *MockStore) ListBookings(ctx context.Context, arg db.ListBookingsParams) ([]db.Booking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookings", ctx, arg)
+	ret0, _ := ret[0].([]db.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookings indicates an expected call of ListBookings.
+func (mr *MockStoreMockRecorder) ListBookings(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookings", reflect.TypeOf((*MockStore)(nil).ListBookings), ctx, arg)
+}
+
+// ListCapturedPaymentsByBookingID mocks base method.
+func (m *MockStore) ListCapturedPaymentsByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]db.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapturedPaymentsByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapturedPaymentsByBookingID indicates an expected call of ListCapturedPaymentsByBookingID.
+func (mr *MockStoreMockRecorder) ListCapturedPaymentsByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapturedPaymentsByBookingID", reflect.TypeOf((*MockStore)(nil).ListCapturedPaymentsByBookingID), ctx, bookingID)
+}
+
+// ListCompanionsByUser mocks base method.
+func (m *MockStore) ListCompanionsByUser(ctx context.Context, userID int64) ([]db.Companion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompanionsByUser", ctx, userID)
+	ret0, _ := ret[0].([]db.Companion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompanionsByUser indicates an expected call of ListCompanionsByUser.
+func (mr *MockStoreMockRecorder) ListCompanionsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompanionsByUser", reflect.TypeOf((*MockStore)(nil).ListCompanionsByUser), ctx, userID)
+}
+
+// ListCustomerTrips mocks base method.
+func (m *MockStore) ListCustomerTrips(ctx context.Context, arg db.ListCustomerTripsParams) ([]db.ListCustomerTripsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomerTrips", ctx, arg)
+	ret0, _ := ret[0].([]db.ListCustomerTripsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomerTrips indicates an expected call of ListCustomerTrips.
+func (mr *MockStoreMockRecorder) ListCustomerTrips(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomerTrips", reflect.TypeOf((*MockStore)(nil).ListCustomerTrips), ctx, arg)
+}
+
+// ListCustomers mocks base method.
+func (m *MockStore) ListCustomers(ctx context.Context, arg db.ListCustomersParams) ([]db.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomers", ctx, arg)
+	ret0, _ := ret[0].([]db.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomers indicates an expected call of ListCustomers.
+func (mr *MockStoreMockRecorder) ListCustomers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomers", reflect.TypeOf((*MockStore)(nil).ListCustomers), ctx, arg)
+}
+
+// ListExpiredLoyaltyLots mocks base method.
+func (m *MockStore) ListExpiredLoyaltyLots(ctx context.Context, arg db.ListExpiredLoyaltyLotsParams) ([]db.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredLoyaltyLots", ctx, arg)
+	ret0, _ := ret[0].([]db.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredLoyaltyLots indicates an expected call of ListExpiredLoyaltyLots.
+func (mr *MockStoreMockRecorder) ListExpiredLoyaltyLots(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredLoyaltyLots", reflect.TypeOf((*MockStore)(nil).ListExpiredLoyaltyLots), ctx, arg)
+}
+
+// ListExpiredWalletLots mocks base method.
+func (m *MockStore) ListExpiredWalletLots(ctx context.Context, arg db.ListExpiredWalletLotsParams) ([]db.WalletTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredWalletLots", ctx, arg)
+	ret0, _ := ret[0].([]db.WalletTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredWalletLots indicates an expected call of ListExpiredWalletLots.
+func (mr *MockStoreMockRecorder) ListExpiredWalletLots(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredWalletLots", reflect.TypeOf((*MockStore)(nil).ListExpiredWalletLots), ctx, arg)
+}
+
+// ListFareFamilies mocks base method.
+func (m *MockStore) ListFareFamilies(ctx context.Context) ([]db.FareFamily, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFareFamilies", ctx)
+	ret0, _ := ret[0].([]db.FareFamily)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFareFamilies indicates an expected call of ListFareFamilies.
+func (mr *MockStoreMockRecorder) ListFareFamilies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFareFamilies", reflect.TypeOf((*MockStore)(nil).ListFareFamilies), ctx)
+}
+
+// ListFlightCustomerTickets mocks base method.
+func (m *MockStore) ListFlightCustomerTickets(ctx context.Context, flightID int64) ([]db.ListFlightCustomerTicketsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlightCustomerTickets", ctx, flightID)
+	ret0, _ := ret[0].([]db.ListFlightCustomerTicketsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlightCustomerTickets indicates an expected call of ListFlightCustomerTickets.
+func (mr *MockStoreMockRecorder) ListFlightCustomerTickets(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlightCustomerTickets", reflect.TypeOf((*MockStore)(nil).ListFlightCustomerTickets), ctx, flightID)
+}
+
+// ListFlights mocks base method.
+func (m *MockStore) ListFlights(ctx context.Context, arg db.ListFlightsParams) ([]db.ListFlightsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlights", ctx, arg)
+	ret0, _ := ret[0].([]db.ListFlightsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlights indicates an expected call of ListFlights.
+func (mr *MockStoreMockRecorder) ListFlights(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlights", reflect.TypeOf((*MockStore)(nil).ListFlights), ctx, arg)
+}
+
+// ListFlightsByIDs mocks base method.
+func (m *MockStore) ListFlightsByIDs(ctx context.Context, flightIds []int64) ([]db.Flight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlightsByIDs", ctx, flightIds)
+	ret0, _ := ret[0].([]db.Flight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlightsByIDs indicates an expected call of ListFlightsByIDs.
+func (mr *MockStoreMockRecorder) ListFlightsByIDs(ctx, flightIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlightsByIDs", reflect.TypeOf((*MockStore)(nil).ListFlightsByIDs), ctx, flightIds)
+}
+
+// ListGroupBookingPassengers mocks base method.
+func (m *MockStore) ListGroupBookingPassengers(ctx context.Context, groupBookingID int64) ([]db.GroupBookingPassenger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupBookingPassengers", ctx, groupBookingID)
+	ret0, _ := ret[0].([]db.GroupBookingPassenger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupBookingPassengers indicates an expected call of ListGroupBookingPassengers.
+func (mr *MockStoreMockRecorder) ListGroupBookingPassengers(ctx, groupBookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupBookingPassengers", reflect.TypeOf((*MockStore)(nil).ListGroupBookingPassengers), ctx, groupBookingID)
+}
+
+// ListGroupBookings mocks base method.
+func (m *MockStore) ListGroupBookings(ctx context.Context) ([]db.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupBookings", ctx)
+	ret0, _ := ret[0].([]db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupBookings indicates an expected call of ListGroupBookings.
+func (mr *MockStoreMockRecorder) ListGroupBookings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupBookings", reflect.TypeOf((*MockStore)(nil).ListGroupBookings), ctx)
+}
+
+// ListGroupBookingsByEmail mocks base method.
+func (m *MockStore) ListGroupBookingsByEmail(ctx context.Context, userEmail string) ([]db.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupBookingsByEmail", ctx, userEmail)
+	ret0, _ := ret[0].([]db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupBookingsByEmail indicates an expected call of ListGroupBookingsByEmail.
+func (mr *MockStoreMockRecorder) ListGroupBookingsByEmail(ctx, userEmail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupBookingsByEmail", reflect.TypeOf((*MockStore)(nil).ListGroupBookingsByEmail), ctx, userEmail)
+}
+
+// ListInfantTicketsByAccompanyingTicketID mocks base method.
+func (m *MockStore) ListInfantTicketsByAccompanyingTicketID(ctx context.Context, accompanyingTicketID pgtype.Int8) ([]db.ListInfantTicketsByAccompanyingTicketIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInfantTicketsByAccompanyingTicketID", ctx, accompanyingTicketID)
+	ret0, _ := ret[0].([]db.ListInfantTicketsByAccompanyingTicketIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInfantTicketsByAccompanyingTicketID indicates an expected call of ListInfantTicketsByAccompanyingTicketID.
+func (mr *MockStoreMockRecorder) ListInfantTicketsByAccompanyingTicketID(ctx, accompanyingTicketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInfantTicketsByAccompanyingTicketID", reflect.TypeOf((*MockStore)(nil).ListInfantTicketsByAccompanyingTicketID), ctx, accompanyingTicketID)
+}
+
+// ListLoyaltyAccrualCandidates mocks base method.
+func (m *MockStore) ListLoyaltyAccrualCandidates(ctx context.Context, flightID int64) ([]db.ListLoyaltyAccrualCandidatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoyaltyAccrualCandidates", ctx, flightID)
+	ret0, _ := ret[0].([]db.ListLoyaltyAccrualCandidatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoyaltyAccrualCandidates indicates an expected call of ListLoyaltyAccrualCandidates.
+func (mr *MockStoreMockRecorder) ListLoyaltyAccrualCandidates(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoyaltyAccrualCandidates", reflect.TypeOf((*MockStore)(nil).ListLoyaltyAccrualCandidates), ctx, flightID)
+}
+
+// ListLoyaltyRedemptionsByBooking mocks base method.
+func (m *MockStore) ListLoyaltyRedemptionsByBooking(ctx context.Context, bookingID pgtype.Int8) ([]db.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoyaltyRedemptionsByBooking", ctx, bookingID)
+	ret0, _ := ret[0].([]db.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoyaltyRedemptionsByBooking indicates an expected call of ListLoyaltyRedemptionsByBooking.
+func (mr *MockStoreMockRecorder) ListLoyaltyRedemptionsByBooking(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoyaltyRedemptionsByBooking", reflect.TypeOf((*MockStore)(nil).ListLoyaltyRedemptionsByBooking), ctx, bookingID)
+}
+
+// ListLoyaltyTierCandidates mocks base method.
+func (m *MockStore) ListLoyaltyTierCandidates(ctx context.Context, arg db.ListLoyaltyTierCandidatesParams) ([]db.ListLoyaltyTierCandidatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoyaltyTierCandidates", ctx, arg)
+	ret0, _ := ret[0].([]db.ListLoyaltyTierCandidatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoyaltyTierCandidates indicates an expected call of ListLoyaltyTierCandidates.
+func (mr *MockStoreMockRecorder) ListLoyaltyTierCandidates(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoyaltyTierCandidates", reflect.TypeOf((*MockStore)(nil).ListLoyaltyTierCandidates), ctx, arg)
+}
+
+// ListLoyaltyTransactions mocks base method.
+func (m *MockStore) ListLoyaltyTransactions(ctx context.Context, arg db.ListLoyaltyTransactionsParams) ([]db.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoyaltyTransactions", ctx, arg)
+	ret0, _ := ret[0].([]db.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoyaltyTransactions indicates an expected call of ListLoyaltyTransactions.
+func (mr *MockStoreMockRecorder) ListLoyaltyTransactions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoyaltyTransactions", reflect.TypeOf((*MockStore)(nil).ListLoyaltyTransactions), ctx, arg)
+}
+
+// ListNews mocks base method.
+func (m *MockStore) ListNews(ctx context.Context, arg db.ListNewsParams) ([]db.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNews", ctx, arg)
+	ret0, _ := ret[0].([]db.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNews indicates an expected call of ListNews.
+func (mr *MockStoreMockRecorder) ListNews(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNews", reflect.TypeOf((*MockStore)(nil).ListNews), ctx, arg)
+}
+
+// ListOpenLoyaltyLots mocks base method.
+func (m *MockStore) ListOpenLoyaltyLots(ctx context.Context, userID int64) ([]db.LoyaltyTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenLoyaltyLots", ctx, userID)
+	ret0, _ := ret[0].([]db.LoyaltyTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenLoyaltyLots indicates an expected call of ListOpenLoyaltyLots.
+func (mr *MockStoreMockRecorder) ListOpenLoyaltyLots(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenLoyaltyLots", reflect.TypeOf((*MockStore)(nil).ListOpenLoyaltyLots), ctx, userID)
+}
+
+// ListOpenWalletLots mocks base method.
+func (m *MockStore) ListOpenWalletLots(ctx context.Context, userID int64) ([]db.WalletTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenWalletLots", ctx, userID)
+	ret0, _ := ret[0].([]db.WalletTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenWalletLots indicates an expected call of ListOpenWalletLots.
+func (mr *MockStoreMockRecorder) ListOpenWalletLots(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenWalletLots", reflect.TypeOf((*MockStore)(nil).ListOpenWalletLots), ctx, userID)
+}
+
+// ListPricingCurves mocks base method.
+func (m *MockStore) ListPricingCurves(ctx context.Context) ([]db.PricingCurve, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPricingCurves", ctx)
+	ret0, _ := ret[0].([]db.PricingCurve)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPricingCurves indicates an expected call of ListPricingCurves.
+func (mr *MockStoreMockRecorder) ListPricingCurves(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPricingCurves", reflect.TypeOf((*MockStore)(nil).ListPricingCurves), ctx)
+}
+
+// ListPricingCurvesByRoute mocks base method.
+func (m *MockStore) ListPricingCurvesByRoute(ctx context.Context, arg db.ListPricingCurvesByRouteParams) ([]db.PricingCurve, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPricingCurvesByRoute", ctx, arg)
+	ret0, _ := ret[0].([]db.PricingCurve)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPricingCurvesByRoute indicates an expected call of ListPricingCurvesByRoute.
+func (mr *MockStoreMockRecorder) ListPricingCurvesByRoute(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPricingCurvesByRoute", reflect.TypeOf((*MockStore)(nil).ListPricingCurvesByRoute), ctx, arg)
+}
+
+// ListPromoCodes mocks base method.
+func (m *MockStore) ListPromoCodes(ctx context.Context) ([]db.PromoCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromoCodes", ctx)
+	ret0, _ := ret[0].([]db.PromoCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromoCodes indicates an expected call of ListPromoCodes.
+func (mr *MockStoreMockRecorder) ListPromoCodes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromoCodes", reflect.TypeOf((*MockStore)(nil).ListPromoCodes), ctx)
+}
+
+// ListRefundsByBookingID mocks base method.
+func (m *MockStore) ListRefundsByBookingID(ctx context.Context, bookingID int64) ([]db.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRefundsByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRefundsByBookingID indicates an expected call of ListRefundsByBookingID.
+func (mr *MockStoreMockRecorder) ListRefundsByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefundsByBookingID", reflect.TypeOf((*MockStore)(nil).ListRefundsByBookingID), ctx, bookingID)
+}
+
+// ListSeatZones mocks base method.
+func (m *MockStore) ListSeatZones(ctx context.Context) ([]db.SeatZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeatZones", ctx)
+	ret0, _ := ret[0].([]db.SeatZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeatZones indicates an expected call of ListSeatZones.
+func (mr *MockStoreMockRecorder) ListSeatZones(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeatZones", reflect.TypeOf((*MockStore)(nil).ListSeatZones), ctx)
+}
+
+// ListSeatZonesForFlight mocks base method.
+func (m *MockStore) ListSeatZonesForFlight(ctx context.Context, arg db.ListSeatZonesForFlightParams) ([]db.SeatZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeatZonesForFlight", ctx, arg)
+	ret0, _ := ret[0].([]db.SeatZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeatZonesForFlight indicates an expected call of ListSeatZonesForFlight.
+func (mr *MockStoreMockRecorder) ListSeatZonesForFlight(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeatZonesForFlight", reflect.TypeOf((*MockStore)(nil).ListSeatZonesForFlight), ctx, arg)
+}
+
+// ListSeatsWithFlightId mocks base method.
+func (m *MockStore) ListSeatsWithFlightId(ctx context.Context, flightID pgtype.Int8) ([]db.Seat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeatsWithFlightId", ctx, flightID)
+	ret0, _ := ret[0].([]db.Seat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeatsWithFlightId indicates an expected call of ListSeatsWithFlightId.
+func (mr *MockStoreMockRecorder) ListSeatsWithFlightId(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeatsWithFlightId", reflect.TypeOf((*MockStore)(nil).ListSeatsWithFlightId), ctx, flightID)
+}
+
+// ListSpecialServices mocks base method.
+func (m *MockStore) ListSpecialServices(ctx context.Context) ([]db.SpecialService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSpecialServices", ctx)
+	ret0, _ := ret[0].([]db.SpecialService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSpecialServices indicates an expected call of ListSpecialServices.
+func (mr *MockStoreMockRecorder) ListSpecialServices(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSpecialServices", reflect.TypeOf((*MockStore)(nil).ListSpecialServices), ctx)
+}
+
+// ListTicketAncillariesByBookingID mocks base method.
+func (m *MockStore) ListTicketAncillariesByBookingID(ctx context.Context, bookingID int64) ([]db.TicketAncillary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketAncillariesByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.TicketAncillary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketAncillariesByBookingID indicates an expected call of ListTicketAncillariesByBookingID.
+func (mr *MockStoreMockRecorder) ListTicketAncillariesByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketAncillariesByBookingID", reflect.TypeOf((*MockStore)(nil).ListTicketAncillariesByBookingID), ctx, bookingID)
+}
+
+// ListTicketCheckInsByBookingID mocks base method.
+func (m *MockStore) ListTicketCheckInsByBookingID(ctx context.Context, bookingID int64) ([]db.TicketCheckIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketCheckInsByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.TicketCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketCheckInsByBookingID indicates an expected call of ListTicketCheckInsByBookingID.
+func (mr *MockStoreMockRecorder) ListTicketCheckInsByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketCheckInsByBookingID", reflect.TypeOf((*MockStore)(nil).ListTicketCheckInsByBookingID), ctx, bookingID)
+}
+
+// ListTicketCheckInsByFlightID mocks base method.
+func (m *MockStore) ListTicketCheckInsByFlightID(ctx context.Context, flightID int64) ([]db.TicketCheckIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketCheckInsByFlightID", ctx, flightID)
+	ret0, _ := ret[0].([]db.TicketCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketCheckInsByFlightID indicates an expected call of ListTicketCheckInsByFlightID.
+func (mr *MockStoreMockRecorder) ListTicketCheckInsByFlightID(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketCheckInsByFlightID", reflect.TypeOf((*MockStore)(nil).ListTicketCheckInsByFlightID), ctx, flightID)
+}
+
+// ListTicketFareItemsByBookingID mocks base method.
+func (m *MockStore) ListTicketFareItemsByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]db.TicketFareItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketFareItemsByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.TicketFareItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketFareItemsByBookingID indicates an expected call of ListTicketFareItemsByBookingID.
+func (mr *MockStoreMockRecorder) ListTicketFareItemsByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketFareItemsByBookingID", reflect.TypeOf((*MockStore)(nil).ListTicketFareItemsByBookingID), ctx, bookingID)
+}
+
+// ListTicketOwnerSnapshots mocks base method.
+func (m *MockStore) ListTicketOwnerSnapshots(ctx context.Context, arg db.ListTicketOwnerSnapshotsParams) ([]db.Ticketownersnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketOwnerSnapshots", ctx, arg)
+	ret0, _ := ret[0].([]db.Ticketownersnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketOwnerSnapshots indicates an expected call of ListTicketOwnerSnapshots.
+func (mr *MockStoreMockRecorder) ListTicketOwnerSnapshots(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketOwnerSnapshots", reflect.TypeOf((*MockStore)(nil).ListTicketOwnerSnapshots), ctx, arg)
+}
+
+// ListTicketOwnersByBookingID mocks base method.
+func (m *MockStore) ListTicketOwnersByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]db.ListTicketOwnersByBookingIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketOwnersByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.ListTicketOwnersByBookingIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketOwnersByBookingID indicates an expected call of ListTicketOwnersByBookingID.
+func (mr *MockStoreMockRecorder) ListTicketOwnersByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketOwnersByBookingID", reflect.TypeOf((*MockStore)(nil).ListTicketOwnersByBookingID), ctx, bookingID)
+}
+
+// ListTicketSpecialServicesByBookingID mocks base method.
+func (m *MockStore) ListTicketSpecialServicesByBookingID(ctx context.Context, bookingID int64) ([]db.TicketSpecialService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketSpecialServicesByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.TicketSpecialService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketSpecialServicesByBookingID indicates an expected call of ListTicketSpecialServicesByBookingID.
+func (mr *MockStoreMockRecorder) ListTicketSpecialServicesByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketSpecialServicesByBookingID", reflect.TypeOf((*MockStore)(nil).ListTicketSpecialServicesByBookingID), ctx, bookingID)
+}
+
+// ListTicketSpecialServicesByFlightID mocks base method.
+func (m *MockStore) ListTicketSpecialServicesByFlightID(ctx context.Context, flightID int64) ([]db.TicketSpecialService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketSpecialServicesByFlightID", ctx, flightID)
+	ret0, _ := ret[0].([]db.TicketSpecialService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketSpecialServicesByFlightID indicates an expected call of ListTicketSpecialServicesByFlightID.
+func (mr *MockStoreMockRecorder) ListTicketSpecialServicesByFlightID(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketSpecialServicesByFlightID", reflect.TypeOf((*MockStore)(nil).ListTicketSpecialServicesByFlightID), ctx, flightID)
+}
+
+// ListTickets mocks base method.
+func (m *MockStore) ListTickets(ctx context.Context, arg db.ListTicketsParams) ([]db.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTickets", ctx, arg)
+	ret0, _ := ret[0].([]db.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTickets indicates an expected call of ListTickets.
+func (mr *MockStoreMockRecorder) ListTickets(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTickets", reflect.TypeOf((*MockStore)(nil).ListTickets), ctx, arg)
+}
+
+// ListTicketsByBookingID mocks base method.
+func (m *MockStore) ListTicketsByBookingID(ctx context.Context, bookingID pgtype.Int8) ([]db.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketsByBookingID", ctx, bookingID)
+	ret0, _ := ret[0].([]db.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketsByBookingID indicates an expected call of ListTicketsByBookingID.
+func (mr *MockStoreMockRecorder) ListTicketsByBookingID(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketsByBookingID", reflect.TypeOf((*MockStore)(nil).ListTicketsByBookingID), ctx, bookingID)
+}
+
+// ListTripTicketsByBookingIDs mocks base method.
+func (m *MockStore) ListTripTicketsByBookingIDs(ctx context.Context, bookingIds []int64) ([]db.ListTripTicketsByBookingIDsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTripTicketsByBookingIDs", ctx, bookingIds)
+	ret0, _ := ret[0].([]db.ListTripTicketsByBookingIDsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTripTicketsByBookingIDs indicates an expected call of ListTripTicketsByBookingIDs.
+func (mr *MockStoreMockRecorder) ListTripTicketsByBookingIDs(ctx, bookingIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTripTicketsByBookingIDs", reflect.TypeOf((*MockStore)(nil).ListTripTicketsByBookingIDs), ctx, bookingIds)
+}
+
+// ListUnnumberedTicketIDs mocks base method.
+func (m *MockStore) ListUnnumberedTicketIDs(ctx context.Context) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnnumberedTicketIDs", ctx)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnnumberedTicketIDs indicates an expected call of ListUnnumberedTicketIDs.
+func (mr *MockStoreMockRecorder) ListUnnumberedTicketIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnnumberedTicketIDs", reflect.TypeOf((*MockStore)(nil).ListUnnumberedTicketIDs), ctx)
+}
+
+// ListUsers mocks base method.
+func (m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockStoreMockRecorder) ListUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), ctx, arg)
+}
+
+// ListWaitlistEntriesByEmail mocks base method.
+func (m *MockStore) ListWaitlistEntriesByEmail(ctx context.Context, userEmail string) ([]db.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWaitlistEntriesByEmail", ctx, userEmail)
+	ret0, _ := ret[0].([]db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWaitlistEntriesByEmail indicates an expected call of ListWaitlistEntriesByEmail.
+func (mr *MockStoreMockRecorder) ListWaitlistEntriesByEmail(ctx, userEmail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaitlistEntriesByEmail", reflect.TypeOf((*MockStore)(nil).ListWaitlistEntriesByEmail), ctx, userEmail)
+}
+
+// ListWalletPaymentsByBooking mocks base method.
+func (m *MockStore) ListWalletPaymentsByBooking(ctx context.Context, bookingID pgtype.Int8) ([]db.WalletTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWalletPaymentsByBooking", ctx, bookingID)
+	ret0, _ := ret[0].([]db.WalletTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWalletPaymentsByBooking indicates an expected call of ListWalletPaymentsByBooking.
+func (mr *MockStoreMockRecorder) ListWalletPaymentsByBooking(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWalletPaymentsByBooking", reflect.TypeOf((*MockStore)(nil).ListWalletPaymentsByBooking), ctx, bookingID)
+}
+
+// ListWalletTransactions mocks base method.
+func (m *MockStore) ListWalletTransactions(ctx context.Context, arg db.ListWalletTransactionsParams) ([]db.WalletTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWalletTransactions", ctx, arg)
+	ret0, _ := ret[0].([]db.WalletTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWalletTransactions indicates an expected call of ListWalletTransactions.
+func (mr *MockStoreMockRecorder) ListWalletTransactions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWalletTransactions", reflect.TypeOf((*MockStore)(nil).ListWalletTransactions), ctx, arg)
+}
+
+// LockCustomer mocks base method.
+func (m *MockStore) LockCustomer(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCustomer", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockCustomer indicates an expected call of LockCustomer.
+func (mr *MockStoreMockRecorder) LockCustomer(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCustomer", reflect.TypeOf((*MockStore)(nil).LockCustomer), ctx, userID)
+}
+
+// LockFlight mocks base method.
+func (m *MockStore) LockFlight(ctx context.Context, flightID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockFlight", ctx, flightID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockFlight indicates an expected call of LockFlight.
+func (mr *MockStoreMockRecorder) LockFlight(ctx, flightID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockFlight", reflect.TypeOf((*MockStore)(nil).LockFlight), ctx, flightID)
+}
+
+// MarkSeatUnavailable mocks base method.
+func (m *MockStore) MarkSeatUnavailable(ctx context.Context, arg db.MarkSeatUnavailableParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSeatUnavailable", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSeatUnavailable indicates an expected call of MarkSeatUnavailable.
+func (mr *MockStoreMockRecorder) MarkSeatUnavailable(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSeatUnavailable", reflect.TypeOf((*MockStore)(nil).MarkSeatUnavailable), ctx, arg)
+}
+
+// NextTicketSerial mocks base method.
+func (m *MockStore) NextTicketSerial(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextTicketSerial", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextTicketSerial indicates an expected call of NextTicketSerial.
+func (mr *MockStoreMockRecorder) NextTicketSerial(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextTicketSerial", reflect.TypeOf((*MockStore)(nil).NextTicketSerial), ctx)
+}
+
+// OfferWaitlistEntry mocks base method.
+func (m *MockStore) OfferWaitlistEntry(ctx context.Context, arg db.OfferWaitlistEntryParams) (db.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferWaitlistEntry", ctx, arg)
+	ret0, _ := ret[0].(db.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferWaitlistEntry indicates an expected call of OfferWaitlistEntry.
+func (mr *MockStoreMockRecorder) OfferWaitlistEntry(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferWaitlistEntry", reflect.TypeOf((*MockStore)(nil).OfferWaitlistEntry), ctx, arg)
+}
+
+// OfferWaitlistSeatTx mocks base method.
+func (m *MockStore) OfferWaitlistSeatTx(ctx context.Context, arg db.OfferWaitlistSeatTxParams) (db.OfferWaitlistSeatTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferWaitlistSeatTx", ctx, arg)
+	ret0, _ := ret[0].(db.OfferWaitlistSeatTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferWaitlistSeatTx indicates an expected call of OfferWaitlistSeatTx.
+func (mr *MockStoreMockRecorder) OfferWaitlistSeatTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferWaitlistSeatTx", reflect.TypeOf((*MockStore)(nil).OfferWaitlistSeatTx), ctx, arg)
+}
+
+// PayGroupDeposit mocks base method.
+func (m *MockStore) PayGroupDeposit(ctx context.Context, id int64) (db.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayGroupDeposit", ctx, id)
+	ret0, _ := ret[0].(db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayGroupDeposit indicates an expected call of PayGroupDeposit.
+func (mr *MockStoreMockRecorder) PayGroupDeposit(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayGroupDeposit", reflect.TypeOf((*MockStore)(nil).PayGroupDeposit), ctx, id)
+}
+
+// PayWithWalletTx mocks base method.
+func (m *MockStore) PayWithWalletTx(ctx context.Context, arg db.PayWithWalletTxParams) (db.PayWithWalletTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayWithWalletTx", ctx, arg)
+	ret0, _ := ret[0].(db.PayWithWalletTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayWithWalletTx indicates an expected call of PayWithWalletTx.
+func (mr *MockStoreMockRecorder) PayWithWalletTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayWithWalletTx", reflect.TypeOf((*MockStore)(nil).PayWithWalletTx), ctx, arg)
+}
+
+// QuoteGroupBooking mocks base method.
+func (m *MockStore) QuoteGroupBooking(ctx context.Context, arg db.QuoteGroupBookingParams) (db.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteGroupBooking", ctx, arg)
+	ret0, _ := ret[0].(db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteGroupBooking indicates an expected call of QuoteGroupBooking.
+func (mr *MockStoreMockRecorder) QuoteGroupBooking(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteGroupBooking", reflect.TypeOf((*MockStore)(nil).QuoteGroupBooking), ctx, arg)
+}
+
+// RedeemLoyaltyPointsTx mocks base method.
+func (m *MockStore) RedeemLoyaltyPointsTx(ctx context.Context, arg db.RedeemLoyaltyPointsTxParams) (db.RedeemLoyaltyPointsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemLoyaltyPointsTx", ctx, arg)
+	ret0, _ := ret[0].(db.RedeemLoyaltyPointsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeemLoyaltyPointsTx indicates an expected call of RedeemLoyaltyPointsTx.
+func (mr *MockStoreMockRecorder) RedeemLoyaltyPointsTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemLoyaltyPointsTx", reflect.TypeOf((*MockStore)(nil).RedeemLoyaltyPointsTx), ctx, arg)
+}
+
+// ReleaseGroupBooking mocks base method.
+func (m *MockStore) ReleaseGroupBooking(ctx context.Context, id int64) (db.GroupBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseGroupBooking", ctx, id)
+	ret0, _ := ret[0].(db.GroupBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseGroupBooking indicates an expected call of ReleaseGroupBooking.
+func (mr *MockStoreMockRecorder) ReleaseGroupBooking(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseGroupBooking", reflect.TypeOf((*MockStore)(nil).ReleaseGroupBooking), ctx, id)
+}
+
+// ReleasePromoRedemptions mocks base method.
+func (m *MockStore) ReleasePromoRedemptions(ctx context.Context, bookingID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePromoRedemptions", ctx, bookingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePromoRedemptions indicates an expected call of ReleasePromoRedemptions.
+func (mr *MockStoreMockRecorder) ReleasePromoRedemptions(ctx, bookingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePromoRedemptions", reflect.TypeOf((*MockStore)(nil).ReleasePromoRedemptions), ctx, bookingID)
+}
+
+// RemoveAuthorFromBlogPosts mocks base method.
+func (m *MockStore) RemoveAuthorFromBlogPosts(ctx context.Context, authorID pgtype.Int8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAuthorFromBlogPosts", ctx, authorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAuthorFromBlogPosts indicates an expected call of RemoveAuthorFromBlogPosts.
+func (mr *MockStoreMockRecorder) RemoveAuthorFromBlogPosts(ctx, authorID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketByID", reflect.TypeOf((*MockStore)(nil).GetTicketByID), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAuthorFromBlogPosts", reflect.TypeOf((*MockStore)(nil).RemoveAuthorFromBlogPosts), ctx, authorID)
 }
 
-// GetTicketOwnerSnapshot mocks base method.
-func (m *MockStore) GetTicketOwnerSnapshot(ctx context.Context, ticketID int64) (db.Ticketownersnapshot, error) {
+// RemoveUserFromBookings mocks base method.
+func (m *MockStore) RemoveUserFromBookings(ctx context.Context, userEmail pgtype.Text) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketOwnerSnapshot", ctx, ticketID)
-	ret0, _ := ret[0].(db.Ticketownersnapshot)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RemoveUserFromBookings", ctx, userEmail)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTicketOwnerSnapshot indicates an expected call of GetTicketOwnerSnapshot.
-func (mr *MockStoreMockRecorder) GetTicketOwnerSnapshot(ctx, ticketID any) *gomock.Call {
+// RemoveUserFromBookings indicates an expected call of RemoveUserFromBookings.
+func (mr *MockStoreMockRecorder) RemoveUserFromBookings(ctx, userEmail any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketOwnerSnapshot", reflect.TypeOf((*MockStore)(nil).GetTicketOwnerSnapshot), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromBookings", reflect.TypeOf((*MockStore)(nil).RemoveUserFromBookings), ctx, userEmail)
 }
 
-// GetTicketsByBookingIDAndType mocks base method.
-func (m *MockStore) GetTicketsByBookingIDAndType(ctx context.Context, arg db.GetTicketsByBookingIDAndTypeParams) ([]db.Ticket, error) {
+// ReplaceGroupBookingPassengersTx mocks base method.
+func (m *MockStore) ReplaceGroupBookingPassengersTx(ctx context.Context, arg db.ReplaceGroupBookingPassengersTxParams) (db.ReplaceGroupBookingPassengersTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsByBookingIDAndType", ctx, arg)
-	ret0, _ := ret[0].([]db.Ticket)
+	ret := m.ctrl.Call(m, "ReplaceGroupBookingPassengersTx", ctx, arg)
+	ret0, _ := ret[0].(db.ReplaceGroupBookingPassengersTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketsByBookingIDAndType indicates an expected call of GetTicketsByBookingIDAndType.
-func (mr *MockStoreMockRecorder) GetTicketsByBookingIDAndType(ctx, arg any) *gomock.Call {
+// ReplaceGroupBookingPassengersTx indicates an expected call of ReplaceGroupBookingPassengersTx.
+func (mr *MockStoreMockRecorder) ReplaceGroupBookingPassengersTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByBookingIDAndType", reflect.TypeOf((*MockStore)(nil).GetTicketsByBookingIDAndType), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceGroupBookingPassengersTx", reflect.TypeOf((*MockStore)(nil).ReplaceGroupBookingPassengersTx), ctx, arg)
 }
 
-// GetTicketsByFlightID mocks base method.
-func (m *MockStore) GetTicketsByFlightID(ctx context.Context, flightID int64) ([]db.GetTicketsByFlightIDRow, error) {
+// RequestFlightChangeTx mocks base method.
+func (m *MockStore) RequestFlightChangeTx(ctx context.Context, arg db.RequestFlightChangeTxParams) (db.RequestFlightChangeTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsByFlightID", ctx, flightID)
-	ret0, _ := ret[0].([]db.GetTicketsByFlightIDRow)
+	ret := m.ctrl.Call(m, "RequestFlightChangeTx", ctx, arg)
+	ret0, _ := ret[0].(db.RequestFlightChangeTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketsByFlightID indicates an expected call of GetTicketsByFlightID.
-func (mr *MockStoreMockRecorder) GetTicketsByFlightID(ctx, flightID any) *gomock.Call {
+// RequestFlightChangeTx indicates an expected call of RequestFlightChangeTx.
+func (mr *MockStoreMockRecorder) RequestFlightChangeTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsByFlightID", reflect.TypeOf((*MockStore)(nil).GetTicketsByFlightID), ctx, flightID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestFlightChangeTx", reflect.TypeOf((*MockStore)(nil).RequestFlightChangeTx), ctx, arg)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(ctx context.Context, userID int64) (db.User, error) {
+// RequestGroupDepositTx mocks base method.
+func (m *MockStore) RequestGroupDepositTx(ctx context.Context, arg db.RequestGroupDepositTxParams) (db.RequestGroupDepositTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "RequestGroupDepositTx", ctx, arg)
+	ret0, _ := ret[0].(db.RequestGroupDepositTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+// RequestGroupDepositTx indicates an expected call of RequestGroupDepositTx.
+func (mr *MockStoreMockRecorder) RequestGroupDepositTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestGroupDepositTx", reflect.TypeOf((*MockStore)(nil).RequestGroupDepositTx), ctx, arg)
 }
 
-// GetUserByEmail mocks base method.
-func (m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+// RequestSeatSelectionTx mocks base method.
+func (m *MockStore) RequestSeatSelectionTx(ctx context.Context, arg db.RequestSeatSelectionTxParams) (db.RequestSeatSelectionTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "RequestSeatSelectionTx", ctx, arg)
+	ret0, _ := ret[0].(db.RequestSeatSelectionTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockStoreMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+// RequestSeatSelectionTx indicates an expected call of RequestSeatSelectionTx.
+func (mr *MockStoreMockRecorder) RequestSeatSelectionTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSeatSelectionTx", reflect.TypeOf((*MockStore)(nil).RequestSeatSelectionTx), ctx, arg)
 }
 
-// IsAdmin mocks base method.
-func (m *MockStore) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+// RequestTicketAncillaryTx mocks base method.
+func (m *MockStore) RequestTicketAncillaryTx(ctx context.Context, arg db.RequestTicketAncillaryTxParams) (db.RequestTicketAncillaryTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsAdmin", ctx, userID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "RequestTicketAncillaryTx", ctx, arg)
+	ret0, _ := ret[0].(db.RequestTicketAncillaryTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsAdmin indicates an expected call of IsAdmin.
-func (mr *MockStoreMockRecorder) IsAdmin(ctx, userID any) *gomock.Call {
+// RequestTicketAncillaryTx indicates an expected call of RequestTicketAncillaryTx.
+func (mr *MockStoreMockRecorder) RequestTicketAncillaryTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockStore)(nil).IsAdmin), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestTicketAncillaryTx", reflect.TypeOf((*MockStore)(nil).RequestTicketAncillaryTx), ctx, arg)
 }
 
-// ListAdmins mocks base method.
-func (m *MockStore) ListAdmins(ctx context.Context, arg db.ListAdminsParams) ([]int64, error) {
+// SearchFlights mocks base method.
+func (m *MockStore) SearchFlights(ctx context.Context, arg db.SearchFlightsParams) ([]db.SearchFlightsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAdmins", ctx, arg)
-	ret0, _ := ret[0].([]int64)
+	ret := m.ctrl.Call(m, "SearchFlights", ctx, arg)
+	ret0, _ := ret[0].([]db.SearchFlightsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAdmins indicates an expected call of ListAdmins.
-func (mr *MockStoreMockRecorder) ListAdmins(ctx, arg any) *gomock.Call {
+// SearchFlights indicates an expected call of SearchFlights.
+func (mr *MockStoreMockRecorder) SearchFlights(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmins", reflect.TypeOf((*MockStore)(nil).ListAdmins), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFlights", reflect.TypeOf((*MockStore)(nil).SearchFlights), ctx, arg)
 }
 
-// ListBookings mocks base method.
-func (m *MockStore) ListBookings(ctx context.Context, arg db.ListBookingsParams) ([]db.Booking, error) {
+// SetTicketNumber mocks base method.
+func (m *MockStore) SetTicketNumber(ctx context.Context, arg db.SetTicketNumberParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBookings", ctx, arg)
-	ret0, _ := ret[0].([]db.Booking)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetTicketNumber", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListBookings indicates an expected call of ListBookings.
-func (mr *MockStoreMockRecorder) ListBookings(ctx, arg any) *gomock.Call {
+// SetTicketNumber indicates an expected call of SetTicketNumber.
+func (mr *MockStoreMockRecorder) SetTicketNumber(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookings", reflect.TypeOf((*MockStore)(nil).ListBookings), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTicketNumber", reflect.TypeOf((*MockStore)(nil).SetTicketNumber), ctx, arg)
 }
 
-// ListCustomers mocks base method.
-func (m *MockStore) ListCustomers(ctx context.Context, arg db.ListCustomersParams) ([]db.Customer, error) {
+// SumExpiredWalletCredit mocks base method.
+func (m *MockStore) SumExpiredWalletCredit(ctx context.Context, arg db.SumExpiredWalletCreditParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCustomers", ctx, arg)
-	ret0, _ := ret[0].([]db.Customer)
+	ret := m.ctrl.Call(m, "SumExpiredWalletCredit", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCustomers indicates an expected call of ListCustomers.
-func (mr *MockStoreMockRecorder) ListCustomers(ctx, arg any) *gomock.Call {
+// SumExpiredWalletCredit indicates an expected call of SumExpiredWalletCredit.
+func (mr *MockStoreMockRecorder) SumExpiredWalletCredit(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomers", reflect.TypeOf((*MockStore)(nil).ListCustomers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumExpiredWalletCredit", reflect.TypeOf((*MockStore)(nil).SumExpiredWalletCredit), ctx, arg)
 }
 
-// ListFlights mocks base method.
-func (m *MockStore) ListFlights(ctx context.Context, arg db.ListFlightsParams) ([]db.ListFlightsRow, error) {
+// SumLoyaltyRedeemedByBooking mocks base method.
+func (m *MockStore) SumLoyaltyRedeemedByBooking(ctx context.Context, bookingID pgtype.Int8) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListFlights", ctx, arg)
-	ret0, _ := ret[0].([]db.ListFlightsRow)
+	ret := m.ctrl.Call(m, "SumLoyaltyRedeemedByBooking", ctx, bookingID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFlights indicates an expected call of ListFlights.
-func (mr *MockStoreMockRecorder) ListFlights(ctx, arg any) *gomock.Call {
+// SumLoyaltyRedeemedByBooking indicates an expected call of SumLoyaltyRedeemedByBooking.
+func (mr *MockStoreMockRecorder) SumLoyaltyRedeemedByBooking(ctx, bookingID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlights", reflect.TypeOf((*MockStore)(nil).ListFlights), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumLoyaltyRedeemedByBooking", reflect.TypeOf((*MockStore)(nil).SumLoyaltyRedeemedByBooking), ctx, bookingID)
 }
 
-// ListNews mocks base method.
-func (m *MockStore) ListNews(ctx context.Context, arg db.ListNewsParams) ([]db.News, error) {
+// SumWalletPaidByBooking mocks base method.
+func (m *MockStore) SumWalletPaidByBooking(ctx context.Context, bookingID pgtype.Int8) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNews", ctx, arg)
-	ret0, _ := ret[0].([]db.News)
+	ret := m.ctrl.Call(m, "SumWalletPaidByBooking", ctx, bookingID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNews indicates an expected call of ListNews.
-func (mr *MockStoreMockRecorder) ListNews(ctx, arg any) *gomock.Call {
+// SumWalletPaidByBooking indicates an expected call of SumWalletPaidByBooking.
+func (mr *MockStoreMockRecorder) SumWalletPaidByBooking(ctx, bookingID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNews", reflect.TypeOf((*MockStore)(nil).ListNews), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumWalletPaidByBooking", reflect.TypeOf((*MockStore)(nil).SumWalletPaidByBooking), ctx, bookingID)
 }
 
-// ListSeatsWithFlightId mocks base method.
-func (m *MockStore) ListSeatsWithFlightId(ctx context.Context, flightID *int64) ([]db.Seat, error) {
+// TicketGroupBooking mocks base method.
+func (m *MockStore) TicketGroupBooking(ctx context.Context, arg db.TicketGroupBookingParams) (db.GroupBooking, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSeatsWithFlightId", ctx, flightID)
-	ret0, _ := ret[0].([]db.Seat)
+	ret := m.ctrl.Call(m, "TicketGroupBooking", ctx, arg)
+	ret0, _ := ret[0].(db.GroupBooking)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSeatsWithFlightId indicates an expected call of ListSeatsWithFlightId.
-func (mr *MockStoreMockRecorder) ListSeatsWithFlightId(ctx, flightID any) *gomock.Call {
+// TicketGroupBooking indicates an expected call of TicketGroupBooking.
+func (mr *MockStoreMockRecorder) TicketGroupBooking(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeatsWithFlightId", reflect.TypeOf((*MockStore)(nil).ListSeatsWithFlightId), ctx, flightID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TicketGroupBooking", reflect.TypeOf((*MockStore)(nil).TicketGroupBooking), ctx, arg)
 }
 
-// ListTicketOwnerSnapshots mocks base method.
-func (m *MockStore) ListTicketOwnerSnapshots(ctx context.Context, arg db.ListTicketOwnerSnapshotsParams) ([]db.Ticketownersnapshot, error) {
+// UndoCheckInTx mocks base method.
+func (m *MockStore) UndoCheckInTx(ctx context.Context, arg db.UndoCheckInTxParams) ([]db.TicketCheckIn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTicketOwnerSnapshots", ctx, arg)
-	ret0, _ := ret[0].([]db.Ticketownersnapshot)
+	ret := m.ctrl.Call(m, "UndoCheckInTx", ctx, arg)
+	ret0, _ := ret[0].([]db.TicketCheckIn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTicketOwnerSnapshots indicates an expected call of ListTicketOwnerSnapshots.
-func (mr *MockStoreMockRecorder) ListTicketOwnerSnapshots(ctx, arg any) *gomock.Call {
+// UndoCheckInTx indicates an expected call of UndoCheckInTx.
+func (mr *MockStoreMockRecorder) UndoCheckInTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketOwnerSnapshots", reflect.TypeOf((*MockStore)(nil).ListTicketOwnerSnapshots), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoCheckInTx", reflect.TypeOf((*MockStore)(nil).UndoCheckInTx), ctx, arg)
 }
 
-// ListTickets mocks base method.
-func (m *MockStore) ListTickets(ctx context.Context, arg db.ListTicketsParams) ([]db.Ticket, error) {
+// UpdateBookingDepartureFlight mocks base method.
+func (m *MockStore) UpdateBookingDepartureFlight(ctx context.Context, arg db.UpdateBookingDepartureFlightParams) (db.Booking, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTickets", ctx, arg)
-	ret0, _ := ret[0].([]db.Ticket)
+	ret := m.ctrl.Call(m, "UpdateBookingDepartureFlight", ctx, arg)
+	ret0, _ := ret[0].(db.Booking)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTickets indicates an expected call of ListTickets.
-func (mr *MockStoreMockRecorder) ListTickets(ctx, arg any) *gomock.Call {
+// UpdateBookingDepartureFlight indicates an expected call of UpdateBookingDepartureFlight.
+func (mr *MockStoreMockRecorder) UpdateBookingDepartureFlight(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTickets", reflect.TypeOf((*MockStore)(nil).ListTickets), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingDepartureFlight", reflect.TypeOf((*MockStore)(nil).UpdateBookingDepartureFlight), ctx, arg)
 }
 
-// ListUsers mocks base method.
-func (m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+// UpdateBookingReturnFlight mocks base method.
+func (m *MockStore) UpdateBookingReturnFlight(ctx context.Context, arg db.UpdateBookingReturnFlightParams) (db.Booking, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", ctx, arg)
-	ret0, _ := ret[0].([]db.User)
+	ret := m.ctrl.Call(m, "UpdateBookingReturnFlight", ctx, arg)
+	ret0, _ := ret[0].(db.Booking)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockStoreMockRecorder) ListUsers(ctx, arg any) *gomock.Call {
+// UpdateBookingReturnFlight indicates an expected call of UpdateBookingReturnFlight.
+func (mr *MockStoreMockRecorder) UpdateBookingReturnFlight(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingReturnFlight", reflect.TypeOf((*MockStore)(nil).UpdateBookingReturnFlight), ctx, arg)
 }
 
-// MarkSeatUnavailable mocks base method.
-func (m *MockStore) MarkSeatUnavailable(ctx context.Context, arg db.MarkSeatUnavailableParams) error {
+// UpdateBookingSegmentFlight mocks base method.
+func (m *MockStore) UpdateBookingSegmentFlight(ctx context.Context, arg db.UpdateBookingSegmentFlightParams) (db.BookingSegment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkSeatUnavailable", ctx, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateBookingSegmentFlight", ctx, arg)
+	ret0, _ := ret[0].(db.BookingSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MarkSeatUnavailable indicates an expected call of MarkSeatUnavailable.
-func (mr *MockStoreMockRecorder) MarkSeatUnavailable(ctx, arg any) *gomock.Call {
+// UpdateBookingSegmentFlight indicates an expected call of UpdateBookingSegmentFlight.
+func (mr *MockStoreMockRecorder) UpdateBookingSegmentFlight(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSeatUnavailable", reflect.TypeOf((*MockStore)(nil).MarkSeatUnavailable), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingSegmentFlight", reflect.TypeOf((*MockStore)(nil).UpdateBookingSegmentFlight), ctx, arg)
 }
 
-// RemoveAuthorFromBlogPosts mocks base method.
-func (m *MockStore) RemoveAuthorFromBlogPosts(ctx context.Context, authorID *int64) error {
+// UpdateBookingStatus mocks base method.
+func (m *MockStore) UpdateBookingStatus(ctx context.Context, arg db.UpdateBookingStatusParams) (db.Booking, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveAuthorFromBlogPosts", ctx, authorID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateBookingStatus", ctx, arg)
+	ret0, _ := ret[0].(db.Booking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveAuthorFromBlogPosts indicates an expected call of RemoveAuthorFromBlogPosts.
-func (mr *MockStoreMockRecorder) RemoveAuthorFromBlogPosts(ctx, authorID any) *gomock.Call {
+// UpdateBookingStatus indicates an expected call of UpdateBookingStatus.
+func (mr *MockStoreMockRecorder) UpdateBookingStatus(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAuthorFromBlogPosts", reflect.TypeOf((*MockStore)(nil).RemoveAuthorFromBlogPosts), ctx, authorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingStatus", reflect.TypeOf((*MockStore)(nil).UpdateBookingStatus), ctx, arg)
 }
 
-// RemoveUserFromBookings mocks base method.
-func (m *MockStore) RemoveUserFromBookings(ctx context.Context, userEmail *string) error {
+// UpdateBookingStatusTx mocks base method.
+func (m *MockStore) UpdateBookingStatusTx(ctx context.Context, arg db.UpdateBookingStatusTxParams) (db.UpdateBookingStatusTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveUserFromBookings", ctx, userEmail)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateBookingStatusTx", ctx, arg)
+	ret0, _ := ret[0].(db.UpdateBookingStatusTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveUserFromBookings indicates an expected call of RemoveUserFromBookings.
-func (mr *MockStoreMockRecorder) RemoveUserFromBookings(ctx, userEmail any) *gomock.Call {
+// UpdateBookingStatusTx indicates an expected call of UpdateBookingStatusTx.
+func (mr *MockStoreMockRecorder) UpdateBookingStatusTx(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromBookings", reflect.TypeOf((*MockStore)(nil).RemoveUserFromBookings), ctx, userEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBookingStatusTx", reflect.TypeOf((*MockStore)(nil).UpdateBookingStatusTx), ctx, arg)
 }
 
-// SearchFlights mocks base method.
-func (m *MockStore) SearchFlights(ctx context.Context, arg db.SearchFlightsParams) ([]db.SearchFlightsRow, error) {
+// UpdateCompanion mocks base method.
+func (m *MockStore) UpdateCompanion(ctx context.Context, arg db.UpdateCompanionParams) (db.Companion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchFlights", ctx, arg)
-	ret0, _ := ret[0].([]db.SearchFlightsRow)
+	ret := m.ctrl.Call(m, "UpdateCompanion", ctx, arg)
+	ret0, _ := ret[0].(db.Companion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchFlights indicates an expected call of SearchFlights.
-func (mr *MockStoreMockRecorder) SearchFlights(ctx, arg any) *gomock.Call {
+// UpdateCompanion indicates an expected call of UpdateCompanion.
+func (mr *MockStoreMockRecorder) UpdateCompanion(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFlights", reflect.TypeOf((*MockStore)(nil).SearchFlights), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCompanion", reflect.TypeOf((*MockStore)(nil).UpdateCompanion), ctx, arg)
 }
 
 // UpdateCustomer mocks base method.
@@ -977,6 +3411,20 @@ func (mr *MockStoreMockRecorder) UpdateCustomer(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomer", reflect.TypeOf((*MockStore)(nil).UpdateCustomer), ctx, arg)
 }
 
+// UpdateCustomerLoyaltyTier mocks base method.
+func (m *MockStore) UpdateCustomerLoyaltyTier(ctx context.Context, arg db.UpdateCustomerLoyaltyTierParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomerLoyaltyTier", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCustomerLoyaltyTier indicates an expected call of UpdateCustomerLoyaltyTier.
+func (mr *MockStoreMockRecorder) UpdateCustomerLoyaltyTier(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerLoyaltyTier", reflect.TypeOf((*MockStore)(nil).UpdateCustomerLoyaltyTier), ctx, arg)
+}
+
 // UpdateCustomerTx mocks base method.
 func (m *MockStore) UpdateCustomerTx(ctx context.Context, arg db.UpdateCustomerTxParams) error {
 	m.ctrl.T.Helper()
@@ -991,6 +3439,21 @@ func (mr *MockStoreMockRecorder) UpdateCustomerTx(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomerTx", reflect.TypeOf((*MockStore)(nil).UpdateCustomerTx), ctx, arg)
 }
 
+// UpdateFlightChangeStatus mocks base method.
+func (m *MockStore) UpdateFlightChangeStatus(ctx context.Context, arg db.UpdateFlightChangeStatusParams) (db.FlightChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFlightChangeStatus", ctx, arg)
+	ret0, _ := ret[0].(db.FlightChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFlightChangeStatus indicates an expected call of UpdateFlightChangeStatus.
+func (mr *MockStoreMockRecorder) UpdateFlightChangeStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlightChangeStatus", reflect.TypeOf((*MockStore)(nil).UpdateFlightChangeStatus), ctx, arg)
+}
+
 // UpdateFlightTimes mocks base method.
 func (m *MockStore) UpdateFlightTimes(ctx context.Context, arg db.UpdateFlightTimesParams) (db.UpdateFlightTimesRow, error) {
 	m.ctrl.T.Helper()
@@ -1006,6 +3469,20 @@ func (mr *MockStoreMockRecorder) UpdateFlightTimes(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlightTimes", reflect.TypeOf((*MockStore)(nil).UpdateFlightTimes), ctx, arg)
 }
 
+// UpdateLoyaltyLotRemaining mocks base method.
+func (m *MockStore) UpdateLoyaltyLotRemaining(ctx context.Context, arg db.UpdateLoyaltyLotRemainingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoyaltyLotRemaining", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLoyaltyLotRemaining indicates an expected call of UpdateLoyaltyLotRemaining.
+func (mr *MockStoreMockRecorder) UpdateLoyaltyLotRemaining(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoyaltyLotRemaining", reflect.TypeOf((*MockStore)(nil).UpdateLoyaltyLotRemaining), ctx, arg)
+}
+
 // UpdateNews mocks base method.
 func (m *MockStore) UpdateNews(ctx context.Context, arg db.UpdateNewsParams) (db.News, error) {
 	m.ctrl.T.Helper()
@@ -1021,6 +3498,36 @@ func (mr *MockStoreMockRecorder) UpdateNews(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNews", reflect.TypeOf((*MockStore)(nil).UpdateNews), ctx, arg)
 }
 
+// UpdatePaymentStatus mocks base method.
+func (m *MockStore) UpdatePaymentStatus(ctx context.Context, arg db.UpdatePaymentStatusParams) (db.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", ctx, arg)
+	ret0, _ := ret[0].(db.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
+func (mr *MockStoreMockRecorder) UpdatePaymentStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockStore)(nil).UpdatePaymentStatus), ctx, arg)
+}
+
+// UpdateRefundStatus mocks base method.
+func (m *MockStore) UpdateRefundStatus(ctx context.Context, arg db.UpdateRefundStatusParams) (db.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefundStatus", ctx, arg)
+	ret0, _ := ret[0].(db.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRefundStatus indicates an expected call of UpdateRefundStatus.
+func (mr *MockStoreMockRecorder) UpdateRefundStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefundStatus", reflect.TypeOf((*MockStore)(nil).UpdateRefundStatus), ctx, arg)
+}
+
 // UpdateSeat mocks base method.
 func (m *MockStore) UpdateSeat(ctx context.Context, arg db.UpdateSeatParams) (db.Seat, error) {
 	m.ctrl.T.Helper()
@@ -1050,6 +3557,21 @@ func (mr *MockStoreMockRecorder) UpdateSeatAvailability(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeatAvailability", reflect.TypeOf((*MockStore)(nil).UpdateSeatAvailability), ctx, arg)
 }
 
+// UpdateSeatSelectionStatus mocks base method.
+func (m *MockStore) UpdateSeatSelectionStatus(ctx context.Context, arg db.UpdateSeatSelectionStatusParams) (db.SeatSelection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeatSelectionStatus", ctx, arg)
+	ret0, _ := ret[0].(db.SeatSelection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSeatSelectionStatus indicates an expected call of UpdateSeatSelectionStatus.
+func (mr *MockStoreMockRecorder) UpdateSeatSelectionStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeatSelectionStatus", reflect.TypeOf((*MockStore)(nil).UpdateSeatSelectionStatus), ctx, arg)
+}
+
 // UpdateSeats mocks base method.
 func (m *MockStore) UpdateSeats(ctx context.Context, bookingID int64, seats []db.SeatUpdateParams) error {
 	m.ctrl.T.Helper()
@@ -1078,6 +3600,35 @@ func (mr *MockStoreMockRecorder) UpdateTicket(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicket", reflect.TypeOf((*MockStore)(nil).UpdateTicket), ctx, arg)
 }
 
+// UpdateTicketFlight mocks base method.
+func (m *MockStore) UpdateTicketFlight(ctx context.Context, arg db.UpdateTicketFlightParams) (db.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTicketFlight", ctx, arg)
+	ret0, _ := ret[0].(db.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTicketFlight indicates an expected call of UpdateTicketFlight.
+func (mr *MockStoreMockRecorder) UpdateTicketFlight(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketFlight", reflect.TypeOf((*MockStore)(nil).UpdateTicketFlight), ctx, arg)
+}
+
+// UpdateTicketSpecialServicesFlight mocks base method.
+func (m *MockStore) UpdateTicketSpecialServicesFlight(ctx context.Context, arg db.UpdateTicketSpecialServicesFlightParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTicketSpecialServicesFlight", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTicketSpecialServicesFlight indicates an expected call of UpdateTicketSpecialServicesFlight.
+func (mr *MockStoreMockRecorder) UpdateTicketSpecialServicesFlight(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketSpecialServicesFlight", reflect.TypeOf((*MockStore)(nil).UpdateTicketSpecialServicesFlight), ctx, arg)
+}
+
 // UpdateTicketStatus mocks base method.
 func (m *MockStore) UpdateTicketStatus(ctx context.Context, arg db.UpdateTicketStatusParams) (db.Ticket, error) {
 	m.ctrl.T.Helper()
@@ -1120,3 +3671,47 @@ func (mr *MockStoreMockRecorder) UpdateUserPassword(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockStore)(nil).UpdateUserPassword), ctx, arg)
 }
+
+// UpdateWalletLotRemaining mocks base method.
+func (m *MockStore) UpdateWalletLotRemaining(ctx context.Context, arg db.UpdateWalletLotRemainingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWalletLotRemaining", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWalletLotRemaining indicates an expected call of UpdateWalletLotRemaining.
+func (mr *MockStoreMockRecorder) UpdateWalletLotRemaining(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWalletLotRemaining", reflect.TypeOf((*MockStore)(nil).UpdateWalletLotRemaining), ctx, arg)
+}
+
+// UpsertAncillary mocks base method.
+func (m *MockStore) UpsertAncillary(ctx context.Context, arg db.UpsertAncillaryParams) (db.Ancillary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAncillary", ctx, arg)
+	ret0, _ := ret[0].(db.Ancillary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAncillary indicates an expected call of UpsertAncillary.
+func (mr *MockStoreMockRecorder) UpsertAncillary(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAncillary", reflect.TypeOf((*MockStore)(nil).UpsertAncillary), ctx, arg)
+}
+
+// UpsertPricingCurve mocks base method.
+func (m *MockStore) UpsertPricingCurve(ctx context.Context, arg db.UpsertPricingCurveParams) (db.PricingCurve, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPricingCurve", ctx, arg)
+	ret0, _ := ret[0].(db.PricingCurve)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertPricingCurve indicates an expected call of UpsertPricingCurve.
+func (mr *MockStoreMockRecorder) UpsertPricingCurve(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPricingCurve", reflect.TypeOf((*MockStore)(nil).UpsertPricingCurve), ctx, arg)
+}
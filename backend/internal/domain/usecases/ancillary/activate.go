@@ -0,0 +1,35 @@
+package ancillary
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+)
+
+type ActivateAncillaryUseCase struct {
+	ancillaryRepository adapters.IAncillaryRepository
+	refundPayment       payment.IRefundPaymentUseCase
+}
+
+func NewActivateAncillaryUseCase(ancillaryRepository adapters.IAncillaryRepository, refundPayment payment.IRefundPaymentUseCase) payment.IPaymentSettler {
+	return &ActivateAncillaryUseCase{
+		ancillaryRepository: ancillaryRepository,
+		refundPayment:       refundPayment,
+	}
+}
+
+// Execute activates an add-on bought after booking once its payment is captured. When
+// the add-on or its booking was cancelled meanwhile, the payment is refunded instead.
+func (u *ActivateAncillaryUseCase) Execute(ctx context.Context, event entities.PaymentEvent) error {
+	result, err := u.ancillaryRepository.ActivateTicketAncillary(ctx, event)
+	if err != nil {
+		return err
+	}
+	if result.Refund != nil {
+		_, err := u.refundPayment.Execute(ctx, *result.Refund)
+		return err
+	}
+	return nil
+}
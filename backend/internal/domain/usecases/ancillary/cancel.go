@@ -0,0 +1,86 @@
+package ancillary
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+)
+
+type ICancelAncillaryUseCase interface {
+	Execute(ctx context.Context, params entities.CancelAncillaryParams) (entities.CancelAncillaryResult, error)
+}
+
+type CancelAncillaryUseCase struct {
+	ancillaryRepository adapters.IAncillaryRepository
+	bookingRepository   adapters.IBookingRepository
+	flightRepository    adapters.IFlightRepository
+	refundPayment       payment.IRefundPaymentUseCase
+}
+
+func NewCancelAncillaryUseCase(ancillaryRepository adapters.IAncillaryRepository, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, refundPayment payment.IRefundPaymentUseCase) ICancelAncillaryUseCase {
+	return &CancelAncillaryUseCase{
+		ancillaryRepository: ancillaryRepository,
+		bookingRepository:   bookingRepository,
+		flightRepository:    flightRepository,
+		refundPayment:       refundPayment,
+	}
+}
+
+// Execute cancels an unused add-on. When the add-on had been paid, what was captured for
+// it is recorded as a refund and paid back through the payment gateway; an add-on still
+// waiting for its payment is cancelled without refund.
+func (u *CancelAncillaryUseCase) Execute(ctx context.Context, params entities.CancelAncillaryParams) (entities.CancelAncillaryResult, error) {
+	booking, err := loadRequesterBooking(ctx, u.bookingRepository, params.BookingID, params.RequesterEmail)
+	if err != nil {
+		return entities.CancelAncillaryResult{}, err
+	}
+
+	item, ticket, ok := findTicketAncillary(booking, params.TicketAncillaryID)
+	if !ok {
+		return entities.CancelAncillaryResult{}, adapters.ErrTicketAncillaryNotFound
+	}
+	flight, err := u.flightRepository.GetFlightByID(ctx, ticket.FlightID)
+	if err != nil {
+		return entities.CancelAncillaryResult{}, err
+	}
+	if err := item.CheckCancellable(flight.DepartureTime, time.Now()); err != nil {
+		return entities.CancelAncillaryResult{}, err
+	}
+
+	// 1. Huỷ dịch vụ; chỉ dịch vụ đã thu tiền mới được ghi nhận khoản hoàn
+	if params.Reason == "" {
+		params.Reason = "ancillary " + item.Code + " cancelled"
+	}
+	result, err := u.ancillaryRepository.CancelTicketAncillary(ctx, params)
+	if err != nil {
+		return entities.CancelAncillaryResult{}, err
+	}
+
+	// 2. Hoàn tiền qua cổng thanh toán; khoản hoàn bị từ chối được ghi trạng thái failed
+	if result.Refund != nil {
+		refund, err := u.refundPayment.Execute(ctx, *result.Refund)
+		if err != nil {
+			return result, err
+		}
+		result.Refund = &refund
+	}
+
+	return result, nil
+}
+
+// findTicketAncillary returns the add-on with ticketAncillaryID and the ticket it was bought for.
+func findTicketAncillary(booking entities.Booking, ticketAncillaryID int64) (entities.TicketAncillary, entities.Ticket, bool) {
+	for _, segment := range booking.Segments {
+		for _, ticket := range segment.Tickets {
+			for _, item := range ticket.Ancillaries {
+				if item.TicketAncillaryID == ticketAncillaryID {
+					return item, ticket, true
+				}
+			}
+		}
+	}
+	return entities.TicketAncillary{}, entities.Ticket{}, false
+}
@@ -0,0 +1,26 @@
+package ancillary
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+)
+
+type IDeleteAncillaryUseCase interface {
+	Execute(ctx context.Context, ancillaryID int64) error
+}
+
+type DeleteAncillaryUseCase struct {
+	ancillaryRepository adapters.IAncillaryRepository
+}
+
+func NewDeleteAncillaryUseCase(ancillaryRepository adapters.IAncillaryRepository) IDeleteAncillaryUseCase {
+	return &DeleteAncillaryUseCase{
+		ancillaryRepository: ancillaryRepository,
+	}
+}
+
+// Execute removes a catalog entry; add-ons already sold keep their name and price.
+func (u *DeleteAncillaryUseCase) Execute(ctx context.Context, ancillaryID int64) error {
+	return u.ancillaryRepository.DeleteAncillary(ctx, ancillaryID)
+}
@@ -0,0 +1,26 @@
+package ancillary
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListAncillariesUseCase interface {
+	Execute(ctx context.Context) (entities.AncillaryCatalog, error)
+}
+
+type ListAncillariesUseCase struct {
+	ancillaryRepository adapters.IAncillaryRepository
+}
+
+func NewListAncillariesUseCase(ancillaryRepository adapters.IAncillaryRepository) IListAncillariesUseCase {
+	return &ListAncillariesUseCase{
+		ancillaryRepository: ancillaryRepository,
+	}
+}
+
+func (u *ListAncillariesUseCase) Execute(ctx context.Context) (entities.AncillaryCatalog, error) {
+	return u.ancillaryRepository.ListAncillaries(ctx)
+}
@@ -0,0 +1,37 @@
+package ancillary
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListAncillaryOffersUseCase interface {
+	Execute(ctx context.Context, flightID int64, class entities.FlightClass) ([]entities.Ancillary, error)
+}
+
+type ListAncillaryOffersUseCase struct {
+	ancillaryRepository adapters.IAncillaryRepository
+	flightRepository    adapters.IFlightRepository
+}
+
+func NewListAncillaryOffersUseCase(ancillaryRepository adapters.IAncillaryRepository, flightRepository adapters.IFlightRepository) IListAncillaryOffersUseCase {
+	return &ListAncillaryOffersUseCase{
+		ancillaryRepository: ancillaryRepository,
+		flightRepository:    flightRepository,
+	}
+}
+
+// Execute lists the add-ons on sale for a cabin of a flight, priced for its route.
+func (u *ListAncillaryOffersUseCase) Execute(ctx context.Context, flightID int64, class entities.FlightClass) ([]entities.Ancillary, error) {
+	flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := u.ancillaryRepository.ListAncillaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return catalog.Offers(flight.DepartureCity, flight.ArrivalCity, class), nil
+}
@@ -0,0 +1,130 @@
+package ancillary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IPurchaseAncillaryUseCase interface {
+	Execute(ctx context.Context, params entities.PurchaseAncillaryParams) (entities.PurchaseAncillaryResult, error)
+}
+
+type PurchaseAncillaryUseCase struct {
+	ancillaryRepository adapters.IAncillaryRepository
+	bookingRepository   adapters.IBookingRepository
+	flightRepository    adapters.IFlightRepository
+	paymentGateway      adapters.PaymentGateway
+	currency            string
+}
+
+func NewPurchaseAncillaryUseCase(ancillaryRepository adapters.IAncillaryRepository, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, paymentGateway adapters.PaymentGateway, currency string) IPurchaseAncillaryUseCase {
+	return &PurchaseAncillaryUseCase{
+		ancillaryRepository: ancillaryRepository,
+		bookingRepository:   bookingRepository,
+		flightRepository:    flightRepository,
+		paymentGateway:      paymentGateway,
+		currency:            currency,
+	}
+}
+
+// Execute adds an add-on to a ticket of a pending or confirmed booking. On a pending
+// booking it becomes part of the amount paid for the booking; a confirmed booking has
+// already been paid, so the add-on is charged through its own payment intent and only
+// becomes active once the payment webhook confirms it.
+func (u *PurchaseAncillaryUseCase) Execute(ctx context.Context, params entities.PurchaseAncillaryParams) (entities.PurchaseAncillaryResult, error) {
+	booking, err := loadRequesterBooking(ctx, u.bookingRepository, params.BookingID, params.RequesterEmail)
+	if err != nil {
+		return entities.PurchaseAncillaryResult{}, err
+	}
+	if booking.Status != entities.BookingStatusPending && booking.Status != entities.BookingStatusConfirmed {
+		return entities.PurchaseAncillaryResult{}, adapters.ErrBookingNotChangeable
+	}
+
+	ticket, ok := findActiveTicket(booking, params.TicketID)
+	if !ok {
+		return entities.PurchaseAncillaryResult{}, adapters.ErrTicketNotFound
+	}
+	flight, err := u.flightRepository.GetFlightByID(ctx, ticket.FlightID)
+	if err != nil {
+		return entities.PurchaseAncillaryResult{}, err
+	}
+	if !flight.DepartureTime.After(time.Now()) {
+		return entities.PurchaseAncillaryResult{}, &entities.AncillaryError{Code: params.Code, Reason: "flight has already departed"}
+	}
+
+	// Định giá theo tuyến và hạng ghế của vé
+	catalog, err := u.ancillaryRepository.ListAncillaries(ctx)
+	if err != nil {
+		return entities.PurchaseAncillaryResult{}, err
+	}
+	item, err := catalog.Sell(params.Code, params.Quantity, flight.DepartureCity, flight.ArrivalCity, ticket.FlightClass)
+	if err != nil {
+		return entities.PurchaseAncillaryResult{}, err
+	}
+	item.TicketID = ticket.TicketID
+	item.BookingID = booking.BookingID
+
+	// 1. Booking chưa thanh toán thì dịch vụ được trả cùng tiền vé
+	var result entities.PurchaseAncillaryResult
+	if booking.Status == entities.BookingStatusPending || item.Amount == 0 {
+		result.Ancillary, err = u.ancillaryRepository.AddTicketAncillary(ctx, item)
+		if err != nil {
+			return entities.PurchaseAncillaryResult{}, err
+		}
+		return result, nil
+	}
+
+	// 2. Booking đã thanh toán thì thu tiền dịch vụ riêng; dịch vụ chờ webhook xác nhận mới có hiệu lực
+	metadata := map[string]string{
+		"booking_id": strconv.FormatInt(booking.BookingID, 10),
+		"purpose":    string(entities.PaymentPurposeAncillary),
+	}
+	intent, err := u.paymentGateway.CreatePaymentIntent(item.Amount, u.currency, metadata)
+	if err != nil {
+		return entities.PurchaseAncillaryResult{}, fmt.Errorf("failed to create payment intent: %w", err)
+	}
+	result.Ancillary, err = u.ancillaryRepository.RequestTicketAncillary(ctx, item, entities.Payment{
+		IntentID: intent.ID,
+		Amount:   item.Amount,
+		Currency: u.currency,
+	})
+	if err != nil {
+		return entities.PurchaseAncillaryResult{}, err
+	}
+	result.PaymentClientSecret = intent.ClientSecret
+	return result, nil
+}
+
+// loadRequesterBooking returns the booking, hiding it from customers who do not own it.
+func loadRequesterBooking(ctx context.Context, bookingRepository adapters.IBookingRepository, bookingID int64, requesterEmail string) (entities.Booking, error) {
+	booking, _, _, err := bookingRepository.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.Booking{}, adapters.ErrBookingNotFound
+		}
+		return entities.Booking{}, err
+	}
+	if requesterEmail != "" && !strings.EqualFold(booking.UserEmail, requesterEmail) {
+		return entities.Booking{}, adapters.ErrBookingNotFound
+	}
+	return booking, nil
+}
+
+// findActiveTicket returns the active ticket of the booking with ticketID.
+func findActiveTicket(booking entities.Booking, ticketID int64) (entities.Ticket, bool) {
+	for _, segment := range booking.Segments {
+		for _, ticket := range segment.Tickets {
+			if ticket.TicketID == ticketID && ticket.Status == entities.TicketStatusActive {
+				return ticket, true
+			}
+		}
+	}
+	return entities.Ticket{}, false
+}
@@ -0,0 +1,30 @@
+package ancillary
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IUpsertAncillaryUseCase interface {
+	Execute(ctx context.Context, ancillary entities.Ancillary) (entities.Ancillary, error)
+}
+
+type UpsertAncillaryUseCase struct {
+	ancillaryRepository adapters.IAncillaryRepository
+}
+
+func NewUpsertAncillaryUseCase(ancillaryRepository adapters.IAncillaryRepository) IUpsertAncillaryUseCase {
+	return &UpsertAncillaryUseCase{
+		ancillaryRepository: ancillaryRepository,
+	}
+}
+
+// Execute creates the catalog entry of a code on a route and cabin, or replaces it when it already exists.
+func (u *UpsertAncillaryUseCase) Execute(ctx context.Context, ancillary entities.Ancillary) (entities.Ancillary, error) {
+	if err := ancillary.Validate(); err != nil {
+		return entities.Ancillary{}, err
+	}
+	return u.ancillaryRepository.UpsertAncillary(ctx, ancillary)
+}
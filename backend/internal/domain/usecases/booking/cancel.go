@@ -0,0 +1,131 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/worker"
+)
+
+type ICancelBookingUseCase interface {
+	Execute(ctx context.Context, params entities.CancelBookingParams) (entities.CancelBookingResult, error)
+}
+
+type CancelBookingUseCase struct {
+	bookingRepository    adapters.IBookingRepository
+	taskDistributor      worker.TaskDistributor
+	waitlistScheduler    adapters.IWaitlistScheduler
+	refundPolicy         entities.RefundPolicy
+	fareFamilyRepository adapters.IFareFamilyRepository
+	walletCreditTTL      time.Duration
+}
+
+func NewCancelBookingUseCase(bookingRepository adapters.IBookingRepository, taskDistributor worker.TaskDistributor, waitlistScheduler adapters.IWaitlistScheduler, refundPolicy entities.RefundPolicy, fareFamilyRepository adapters.IFareFamilyRepository, walletCreditTTL time.Duration) ICancelBookingUseCase {
+	return &CancelBookingUseCase{
+		bookingRepository:    bookingRepository,
+		taskDistributor:      taskDistributor,
+		waitlistScheduler:    waitlistScheduler,
+		refundPolicy:         refundPolicy,
+		fareFamilyRepository: fareFamilyRepository,
+		walletCreditTTL:      walletCreditTTL,
+	}
+}
+
+// Execute cancels the booking and all of its active tickets in one transaction,
+// records the refund computed from the fare family of every ticket, emails the customer
+// and offers the freed seats to the customers waitlisted in their cabins.
+// The refund goes to the original payment method, or to the customer's wallet as travel
+// credit when params.RefundToWallet is set.
+func (u *CancelBookingUseCase) Execute(ctx context.Context, params entities.CancelBookingParams) (entities.CancelBookingResult, error) {
+	// Khách hàng chỉ được huỷ booking của chính mình
+	if params.RequesterEmail != "" {
+		booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, params.BookingID)
+		if err != nil {
+			if errors.Is(err, adapters.ErrBookingNotFound) {
+				return entities.CancelBookingResult{}, adapters.ErrBookingNotFound
+			}
+			return entities.CancelBookingResult{}, err
+		}
+		if !strings.EqualFold(booking.UserEmail, params.RequesterEmail) {
+			return entities.CancelBookingResult{}, adapters.ErrBookingNotFound
+		}
+	}
+
+	fareFamilies, err := u.fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return entities.CancelBookingResult{}, err
+	}
+	params.RefundPolicy = u.refundPolicy
+	params.FareFamilies = fareFamilies
+	params.CancelledAt = time.Now()
+	params.WalletCreditTTL = u.walletCreditTTL
+	result, err := u.bookingRepository.CancelBooking(ctx, params)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.CancelBookingResult{}, adapters.ErrBookingNotFound
+		}
+		return entities.CancelBookingResult{}, err
+	}
+
+	// Booking đã huỷ thành công, lỗi gửi email hay mời danh sách chờ không làm hỏng kết quả huỷ
+	if err := u.sendCancellationEmail(ctx, result); err != nil {
+		log.Error().Err(err).Int64("booking_id", result.Booking.BookingID).Msg("failed to enqueue cancellation email")
+	}
+	for _, ticket := range result.CancelledTickets {
+		if err := u.waitlistScheduler.OfferTicketSeat(ctx, ticket); err != nil {
+			log.Error().Err(err).Int64("ticket_id", ticket.TicketID).Msg("failed to enqueue waitlist offer")
+		}
+	}
+
+	return result, nil
+}
+
+func (u *CancelBookingUseCase) sendCancellationEmail(ctx context.Context, result entities.CancelBookingResult) error {
+	if result.Booking.UserEmail == "" {
+		return nil
+	}
+
+	refundLine := "<p>Đặt chỗ này chưa được thanh toán nên không phát sinh khoản hoàn tiền.</p>"
+	if result.Refund != nil {
+		refundLine = fmt.Sprintf("<p><strong>Số tiền được hoàn:</strong> %d</p>", result.Refund.Amount)
+		if result.Refund.Method == entities.RefundMethodWallet {
+			refundLine += "<p>Khoản hoàn tiền đã được cộng vào ví Qairlines của bạn dưới dạng tín dụng bay.</p>"
+		} else {
+			refundLine += "<p>Khoản hoàn tiền sẽ được xử lý về phương thức thanh toán ban đầu.</p>"
+		}
+	}
+
+	taskPayload := &worker.PayloadSendVerifyEmail{
+		To:      result.Booking.UserEmail,
+		Subject: "Xác nhận huỷ đặt chỗ",
+		Body: fmt.Sprintf(
+			`<html>
+				<body>
+					<h2>Xin chào,</h2>
+					<p>Đặt chỗ của bạn đã được <b>huỷ thành công</b>.</p>
+					<p><strong>Mã đặt chỗ (PNR):</strong> %s</p>
+					<p><strong>Số vé đã huỷ:</strong> %d</p>
+					%s
+					<br>
+					<p>Trân trọng,<br>
+					<b>Đội ngũ Qairlines</b></p>
+				</body>
+				</html>`,
+			result.Booking.PNR,
+			len(result.CancelledTickets),
+			refundLine,
+		),
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+	return u.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+}
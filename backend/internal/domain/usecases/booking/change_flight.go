@@ -0,0 +1,271 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
+)
+
+type IChangeFlightUseCase interface {
+	Execute(ctx context.Context, params entities.ChangeFlightParams) (entities.ChangeFlightResult, error)
+}
+
+type ChangeFlightUseCase struct {
+	bookingRepository    adapters.IBookingRepository
+	flightRepository     adapters.IFlightRepository
+	paymentGateway       adapters.PaymentGateway
+	changeFee            int64
+	currency             string
+	pricingRules         entities.PricingRules
+	currentFares         pricing.IGetCurrentFaresUseCase
+	fareFamilyRepository adapters.IFareFamilyRepository
+	loyaltyScheduler     adapters.ILoyaltyScheduler
+	refundPayment        payment.IRefundPaymentUseCase
+}
+
+func NewChangeFlightUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, paymentGateway adapters.PaymentGateway, changeFee int64, currency string, pricingRules entities.PricingRules, currentFares pricing.IGetCurrentFaresUseCase, fareFamilyRepository adapters.IFareFamilyRepository, loyaltyScheduler adapters.ILoyaltyScheduler, refundPayment payment.IRefundPaymentUseCase) IChangeFlightUseCase {
+	return &ChangeFlightUseCase{
+		bookingRepository:    bookingRepository,
+		flightRepository:     flightRepository,
+		paymentGateway:       paymentGateway,
+		changeFee:            changeFee,
+		currency:             currency,
+		pricingRules:         pricingRules,
+		currentFares:         currentFares,
+		fareFamilyRepository: fareFamilyRepository,
+		loyaltyScheduler:     loyaltyScheduler,
+		refundPayment:        refundPayment,
+	}
+}
+
+// Execute moves the tickets of one booking segment to another flight on the same route.
+// A change with nothing to pay is applied straight away and a fare decrease is paid back
+// through the payment gateway. A change with an amount due is only recorded, with a
+// payment intent for that amount; the tickets move once the payment webhook confirms it.
+func (u *ChangeFlightUseCase) Execute(ctx context.Context, params entities.ChangeFlightParams) (entities.ChangeFlightResult, error) {
+	booking, currentFlight, tickets, err := loadChangeableSegment(ctx, u.bookingRepository, u.flightRepository, params.BookingID, params.FromFlightID, params.RequesterEmail)
+	if err != nil {
+		return entities.ChangeFlightResult{}, err
+	}
+
+	// Gói giá của từng vé quyết định có được đổi chuyến và có phải trả phí đổi hay không
+	fareFamilies, changeFee, err := fareFamilyChangeFee(ctx, u.fareFamilyRepository, tickets, u.changeFee)
+	if err != nil {
+		return entities.ChangeFlightResult{}, err
+	}
+
+	newFlight, err := u.flightRepository.GetFlightByID(ctx, params.ToFlightID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			return entities.ChangeFlightResult{}, adapters.ErrFlightNotFound
+		}
+		return entities.ChangeFlightResult{}, err
+	}
+	if !isValidAlternative(*currentFlight, *newFlight, time.Now()) {
+		return entities.ChangeFlightResult{}, adapters.ErrInvalidFlightChange
+	}
+	if err := checkSegmentChronology(ctx, u.flightRepository, booking, params.FromFlightID, *newFlight); err != nil {
+		return entities.ChangeFlightResult{}, err
+	}
+
+	fares, err := u.currentFares.Execute(ctx, *newFlight)
+	if err != nil {
+		return entities.ChangeFlightResult{}, err
+	}
+	params.Quote = entities.QuoteFlightChange(tickets, *newFlight, fares, fareFamilies, changeFee, u.pricingRules)
+
+	if params.Quote.AmountDue > 0 {
+		return u.requestPaidChange(ctx, booking, params)
+	}
+
+	// 1. Không phải trả thêm nên đổi vé sang chuyến bay mới ngay trong một transaction
+	result, err := u.bookingRepository.ChangeFlight(ctx, params)
+	if err != nil {
+		return entities.ChangeFlightResult{}, err
+	}
+	scheduleLoyaltyAccrual(ctx, u.loyaltyScheduler, *newFlight)
+
+	// 2. Hoàn phần chênh lệch; khoản hoàn bị cổng thanh toán từ chối được ghi trạng thái failed
+	if result.Refund != nil {
+		refund, err := u.refundPayment.Execute(ctx, *result.Refund)
+		if err != nil {
+			return result, err
+		}
+		result.Refund = &refund
+	}
+	return result, nil
+}
+
+// requestPaidChange creates the payment intent collecting the amount due and records the
+// change as pending until the payment is captured.
+func (u *ChangeFlightUseCase) requestPaidChange(ctx context.Context, booking entities.Booking, params entities.ChangeFlightParams) (entities.ChangeFlightResult, error) {
+	metadata := map[string]string{
+		"booking_id": strconv.FormatInt(booking.BookingID, 10),
+		"purpose":    string(entities.PaymentPurposeFlightChange),
+	}
+	intent, err := u.paymentGateway.CreatePaymentIntent(params.Quote.AmountDue, u.currency, metadata)
+	if err != nil {
+		return entities.ChangeFlightResult{}, fmt.Errorf("failed to create payment intent: %w", err)
+	}
+
+	result, err := u.bookingRepository.RequestFlightChange(ctx, params, entities.Payment{
+		IntentID: intent.ID,
+		Amount:   params.Quote.AmountDue,
+		Currency: u.currency,
+	})
+	if err != nil {
+		return entities.ChangeFlightResult{}, err
+	}
+	result.Booking = booking
+	result.PaymentClientSecret = intent.ClientSecret
+	return result, nil
+}
+
+// loadChangeableSegment returns the booking, the flight being changed and its active
+// tickets, after checking that the requester owns a confirmed booking flying it and that
+// none of its passengers is checked in.
+func loadChangeableSegment(ctx context.Context, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, bookingID int64, flightID int64, requesterEmail string) (entities.Booking, *entities.Flight, []entities.Ticket, error) {
+	booking, _, _, err := bookingRepository.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
+		}
+		return entities.Booking{}, nil, nil, err
+	}
+	if requesterEmail != "" && !strings.EqualFold(booking.UserEmail, requesterEmail) {
+		return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
+	}
+	if booking.Status != entities.BookingStatusConfirmed {
+		return entities.Booking{}, nil, nil, adapters.ErrBookingNotChangeable
+	}
+
+	segment, ok := findSegment(booking.Segments, flightID)
+	if !ok {
+		return entities.Booking{}, nil, nil, adapters.ErrInvalidFlightChange
+	}
+
+	var tickets []entities.Ticket
+	for _, ticket := range segment.Tickets {
+		if ticket.Status != entities.TicketStatusActive {
+			continue
+		}
+		// Hành khách đã làm thủ tục phải huỷ thủ tục trước khi đổi chuyến
+		if ticket.CheckedInAt != nil {
+			return entities.Booking{}, nil, nil, adapters.ErrTicketAlreadyCheckedIn
+		}
+		tickets = append(tickets, ticket)
+	}
+	if len(tickets) == 0 {
+		return entities.Booking{}, nil, nil, adapters.ErrBookingNotChangeable
+	}
+
+	flight, err := flightRepository.GetFlightByID(ctx, flightID)
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+	if !flight.DepartureTime.After(time.Now()) {
+		return entities.Booking{}, nil, nil, adapters.ErrBookingNotChangeable
+	}
+
+	return booking, flight, tickets, nil
+}
+
+// fareFamilyChangeFee checks that the fare family of every ticket allows changing flights
+// and returns the change fee, which is waived when every family waives it.
+func fareFamilyChangeFee(ctx context.Context, fareFamilyRepository adapters.IFareFamilyRepository, tickets []entities.Ticket, changeFee int64) (entities.FareFamilyCatalog, int64, error) {
+	fareFamilies, err := fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	feeWaived, err := fareFamilies.CheckChange(tickets)
+	if err != nil {
+		return nil, 0, err
+	}
+	if feeWaived {
+		changeFee = 0
+	}
+	return fareFamilies, changeFee, nil
+}
+
+// isValidAlternative reports whether candidate can replace current: same route, not yet departed and not cancelled.
+func isValidAlternative(current entities.Flight, candidate entities.Flight, now time.Time) bool {
+	return candidate.FlightID != current.FlightID &&
+		candidate.DepartureCity == current.DepartureCity &&
+		candidate.ArrivalCity == current.ArrivalCity &&
+		candidate.DepartureTime.After(now) &&
+		candidate.Status != entities.FlightCanceledStatus
+}
+
+// findSegment returns the booking segment flown on flightID.
+func findSegment(segments []entities.BookingSegment, flightID int64) (entities.BookingSegment, bool) {
+	for _, segment := range segments {
+		if flightID != 0 && segment.FlightID == flightID {
+			return segment, true
+		}
+	}
+	return entities.BookingSegment{}, false
+}
+
+// checkSegmentChronology keeps the itinerary in order: the candidate must depart after
+// the previous segment lands and land before the next segment departs, and must not
+// already be part of the booking.
+func checkSegmentChronology(ctx context.Context, flightRepository adapters.IFlightRepository, booking entities.Booking, fromFlightID int64, candidate entities.Flight) error {
+	previous, next, err := adjacentSegmentFlights(ctx, flightRepository, booking, fromFlightID)
+	if err != nil {
+		return err
+	}
+	if !fitsItinerary(booking, previous, next, candidate) {
+		return adapters.ErrInvalidFlightChange
+	}
+	return nil
+}
+
+// adjacentSegmentFlights returns the flights of the segments before and after the one
+// flown on fromFlightID, nil when there is none.
+func adjacentSegmentFlights(ctx context.Context, flightRepository adapters.IFlightRepository, booking entities.Booking, fromFlightID int64) (*entities.Flight, *entities.Flight, error) {
+	var previous, next *entities.Flight
+	for i, segment := range booking.Segments {
+		if segment.FlightID != fromFlightID {
+			continue
+		}
+		var err error
+		if i > 0 {
+			previous, err = flightRepository.GetFlightByID(ctx, booking.Segments[i-1].FlightID)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if i < len(booking.Segments)-1 {
+			next, err = flightRepository.GetFlightByID(ctx, booking.Segments[i+1].FlightID)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return previous, next, nil
+}
+
+// fitsItinerary reports whether candidate fits between the previous and next segment
+// flights and is not already part of the booking.
+func fitsItinerary(booking entities.Booking, previous *entities.Flight, next *entities.Flight, candidate entities.Flight) bool {
+	for _, segment := range booking.Segments {
+		if segment.FlightID == candidate.FlightID {
+			return false
+		}
+	}
+	if previous != nil && !previous.ArrivalTime.Before(candidate.DepartureTime) {
+		return false
+	}
+	if next != nil && !candidate.ArrivalTime.Before(next.DepartureTime) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package booking
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
+)
+
+type IQuoteFlightChangeUseCase interface {
+	Execute(ctx context.Context, bookingID int64, flightID int64, requesterEmail string) ([]entities.FlightChangeQuote, error)
+}
+
+type QuoteFlightChangeUseCase struct {
+	bookingRepository    adapters.IBookingRepository
+	flightRepository     adapters.IFlightRepository
+	changeFee            int64
+	pricingRules         entities.PricingRules
+	currentFares         pricing.IGetCurrentFaresUseCase
+	fareFamilyRepository adapters.IFareFamilyRepository
+}
+
+func NewQuoteFlightChangeUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, changeFee int64, pricingRules entities.PricingRules, currentFares pricing.IGetCurrentFaresUseCase, fareFamilyRepository adapters.IFareFamilyRepository) IQuoteFlightChangeUseCase {
+	return &QuoteFlightChangeUseCase{
+		bookingRepository:    bookingRepository,
+		flightRepository:     flightRepository,
+		changeFee:            changeFee,
+		pricingRules:         pricingRules,
+		currentFares:         currentFares,
+		fareFamilyRepository: fareFamilyRepository,
+	}
+}
+
+// Execute prices moving the booking segment flown on flightID to every upcoming flight on the same route.
+func (u *QuoteFlightChangeUseCase) Execute(ctx context.Context, bookingID int64, flightID int64, requesterEmail string) ([]entities.FlightChangeQuote, error) {
+	booking, currentFlight, tickets, err := loadChangeableSegment(ctx, u.bookingRepository, u.flightRepository, bookingID, flightID, requesterEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	fareFamilies, changeFee, err := fareFamilyChangeFee(ctx, u.fareFamilyRepository, tickets, u.changeFee)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	alternatives, err := u.flightRepository.ListAlternativeFlights(ctx, *currentFlight, now)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, next, err := adjacentSegmentFlights(ctx, u.flightRepository, booking, flightID)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []entities.Flight
+	for _, flight := range alternatives {
+		if isValidAlternative(*currentFlight, flight, now) && fitsItinerary(booking, previous, next, flight) {
+			candidates = append(candidates, flight)
+		}
+	}
+
+	// Định giá mọi chuyến thay thế trong một lần truy vấn
+	fares, err := u.currentFares.ExecuteMany(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	quotes := make([]entities.FlightChangeQuote, 0, len(candidates))
+	for i, flight := range candidates {
+		quotes = append(quotes, entities.QuoteFlightChange(tickets, flight, fares[i], fareFamilies, changeFee, u.pricingRules))
+	}
+	return quotes, nil
+}
@@ -0,0 +1,49 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+)
+
+type CompleteFlightChangeUseCase struct {
+	bookingRepository adapters.IBookingRepository
+	flightRepository  adapters.IFlightRepository
+	refundPayment     payment.IRefundPaymentUseCase
+	loyaltyScheduler  adapters.ILoyaltyScheduler
+}
+
+func NewCompleteFlightChangeUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, refundPayment payment.IRefundPaymentUseCase, loyaltyScheduler adapters.ILoyaltyScheduler) payment.IPaymentSettler {
+	return &CompleteFlightChangeUseCase{
+		bookingRepository: bookingRepository,
+		flightRepository:  flightRepository,
+		refundPayment:     refundPayment,
+		loyaltyScheduler:  loyaltyScheduler,
+	}
+}
+
+// Execute moves the tickets of a flight change once its amount due is captured. When the
+// booking changed since the change was requested, what was captured is refunded instead.
+func (u *CompleteFlightChangeUseCase) Execute(ctx context.Context, event entities.PaymentEvent) error {
+	result, err := u.bookingRepository.CompleteFlightChange(ctx, event)
+	if err != nil {
+		return err
+	}
+	if result.Refund != nil {
+		if _, err := u.refundPayment.Execute(ctx, *result.Refund); err != nil {
+			return err
+		}
+	}
+	if result.Status != entities.FlightChangeStatusCompleted || len(result.Tickets) == 0 {
+		return nil
+	}
+
+	flight, err := u.flightRepository.GetFlightByID(ctx, result.Tickets[0].FlightID)
+	if err != nil {
+		return err
+	}
+	scheduleLoyaltyAccrual(ctx, u.loyaltyScheduler, *flight)
+	return nil
+}
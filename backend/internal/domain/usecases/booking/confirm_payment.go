@@ -0,0 +1,44 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+)
+
+type ConfirmBookingPaymentUseCase struct {
+	paymentRepository adapters.IPaymentRepository
+	bookingRepository adapters.IBookingRepository
+	flightRepository  adapters.IFlightRepository
+	refundPayment     payment.IRefundPaymentUseCase
+	loyaltyScheduler  adapters.ILoyaltyScheduler
+}
+
+func NewConfirmBookingPaymentUseCase(paymentRepository adapters.IPaymentRepository, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, refundPayment payment.IRefundPaymentUseCase, loyaltyScheduler adapters.ILoyaltyScheduler) payment.IPaymentSettler {
+	return &ConfirmBookingPaymentUseCase{
+		paymentRepository: paymentRepository,
+		bookingRepository: bookingRepository,
+		flightRepository:  flightRepository,
+		refundPayment:     refundPayment,
+		loyaltyScheduler:  loyaltyScheduler,
+	}
+}
+
+// Execute captures a booking payment and confirms the booking it paid for. A booking
+// cancelled, or already paid, before the money arrived gets the payment refunded instead.
+func (u *ConfirmBookingPaymentUseCase) Execute(ctx context.Context, event entities.PaymentEvent) error {
+	result, err := u.paymentRepository.ConfirmBookingPayment(ctx, event)
+	if err != nil {
+		return err
+	}
+	if result.Refund != nil {
+		_, err := u.refundPayment.Execute(ctx, *result.Refund)
+		return err
+	}
+	if result.Confirmed {
+		scheduleBookingLoyaltyAccrual(ctx, u.bookingRepository, u.flightRepository, u.loyaltyScheduler, result.Booking.BookingID)
+	}
+	return nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
 	"github.com/spaghetti-lover/qairlines/internal/infra/worker"
@@ -20,93 +24,400 @@ type ICreateBookingUseCase interface {
 }
 
 type CreateBookingUseCase struct {
-	bookingRepository adapters.IBookingRepository
-	flightRepository  adapters.IFlightRepository
-	taskDistributor   worker.TaskDistributor
+	bookingRepository    adapters.IBookingRepository
+	flightRepository     adapters.IFlightRepository
+	taskDistributor      worker.TaskDistributor
+	ticketNumberPrefix   string
+	minConnectionTime    time.Duration
+	pricingRules         entities.PricingRules
+	currentFares         pricing.IGetCurrentFaresUseCase
+	fareQuoteRepository  adapters.IFareQuoteRepository
+	fareFamilyRepository adapters.IFareFamilyRepository
+	ancillaryRepository  adapters.IAncillaryRepository
+	seatZoneRepository   adapters.ISeatZoneRepository
+	promoCodeRepository  adapters.IPromoCodeRepository
+	loyaltyRepository    adapters.ILoyaltyRepository
+	companionRepository  adapters.ICompanionRepository
+	documentPolicy       entities.DocumentPolicy
+	cabinLayout          entities.CabinLayout
+	tierPolicy           entities.TierPolicy
 }
 
-func NewCreateBookingUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, taskDistributor worker.TaskDistributor) ICreateBookingUseCase {
+func NewCreateBookingUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, taskDistributor worker.TaskDistributor, ticketNumberPrefix string, minConnectionTime time.Duration, pricingRules entities.PricingRules, currentFares pricing.IGetCurrentFaresUseCase, fareQuoteRepository adapters.IFareQuoteRepository, fareFamilyRepository adapters.IFareFamilyRepository, ancillaryRepository adapters.IAncillaryRepository, seatZoneRepository adapters.ISeatZoneRepository, promoCodeRepository adapters.IPromoCodeRepository, loyaltyRepository adapters.ILoyaltyRepository, companionRepository adapters.ICompanionRepository, documentPolicy entities.DocumentPolicy, cabinLayout entities.CabinLayout, tierPolicy entities.TierPolicy) ICreateBookingUseCase {
 	return &CreateBookingUseCase{
-		bookingRepository: bookingRepository,
-		flightRepository:  flightRepository,
-		taskDistributor:   taskDistributor,
+		bookingRepository:    bookingRepository,
+		flightRepository:     flightRepository,
+		taskDistributor:      taskDistributor,
+		ticketNumberPrefix:   ticketNumberPrefix,
+		minConnectionTime:    minConnectionTime,
+		pricingRules:         pricingRules,
+		currentFares:         currentFares,
+		fareQuoteRepository:  fareQuoteRepository,
+		fareFamilyRepository: fareFamilyRepository,
+		ancillaryRepository:  ancillaryRepository,
+		seatZoneRepository:   seatZoneRepository,
+		promoCodeRepository:  promoCodeRepository,
+		loyaltyRepository:    loyaltyRepository,
+		companionRepository:  companionRepository,
+		documentPolicy:       documentPolicy,
+		cabinLayout:          cabinLayout,
+		tierPolicy:           tierPolicy,
 	}
 }
 
 func (u *CreateBookingUseCase) Execute(ctx context.Context, booking dto.CreateBookingRequest, email string) (dto.CreateBookingResponse, error) {
-	// Kiểm tra sự tồn tại của chuyến bay đi
-	departureFlightID, err := strconv.ParseInt(booking.DepartureFlightID, 10, 64)
-	if err != nil {
-		return dto.CreateBookingResponse{}, adapters.ErrFlightNotFound
-	}
-	departureFlight, err := u.flightRepository.GetFlightByID(ctx, departureFlightID)
-	if err != nil {
-		if errors.Is(err, adapters.ErrFlightNotFound) {
+	// Kiểm tra sự tồn tại của từng chuyến bay trong hành trình
+	segments := mappers.ToBookingSegmentRequests(booking)
+	flights := make([]entities.Flight, 0, len(segments))
+	for i, segment := range segments {
+		flightID, err := strconv.ParseInt(segment.FlightID, 10, 64)
+		if err != nil {
 			return dto.CreateBookingResponse{}, adapters.ErrFlightNotFound
 		}
+		flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+		if err != nil {
+			if errors.Is(err, adapters.ErrFlightNotFound) {
+				return dto.CreateBookingResponse{}, adapters.ErrFlightNotFound
+			}
+			return dto.CreateBookingResponse{}, err
+		}
+		if len(segment.TicketDataList) == 0 {
+			return dto.CreateBookingResponse{}, &entities.ItineraryError{Segment: i + 1, Reason: "segment has no tickets"}
+		}
+		flights = append(flights, *flight)
+	}
+
+	// Kiểm tra thứ tự thời gian và thời gian nối chuyến giữa các chặng
+	if err := entities.ValidateItinerary(entities.TripType(booking.TripType), flights, u.minConnectionTime, time.Now()); err != nil {
 		return dto.CreateBookingResponse{}, err
 	}
 
-	// Kiểm tra sự tồn tại của chuyến bay về (nếu là roundTrip)
-	var returnFlight *entities.Flight
-	if booking.TripType == "roundTrip" {
-		returnFlightID, err := strconv.ParseInt(booking.ReturnFlightID, 10, 64)
+	// Tạo booking trong repository
+	arg := mappers.ToCreateBookingParams(booking, segments, flights, email)
+
+	// Hành khách chọn từ hồ sơ người đi cùng lấy tên, ngày sinh và giấy tờ từ hồ sơ đã lưu
+	for i := range arg.Segments {
+		for j, requested := range segments[i].TicketDataList {
+			if requested.CompanionID == "" {
+				continue
+			}
+			owner, err := u.companionOwner(ctx, requested.CompanionID, email)
+			var invalidID *strconv.NumError
+			if errors.As(err, &invalidID) {
+				return dto.CreateBookingResponse{}, &entities.PassengerError{Segment: i + 1, Passenger: j + 1, Reason: fmt.Sprintf("invalid companion ID %q", requested.CompanionID)}
+			}
+			if err != nil {
+				return dto.CreateBookingResponse{}, err
+			}
+			owner.PhoneNumber = arg.Segments[i].Tickets[j].Owner.PhoneNumber
+			owner.Address = arg.Segments[i].Tickets[j].Owner.Address
+			arg.Segments[i].Tickets[j].Owner = owner
+		}
+	}
+
+	// Kiểm tra ngày sinh, họ tên và giấy tờ của mọi hành khách, trả về tất cả các trường sai cùng lúc
+	if fields := u.checkDocuments(booking, segments, arg, flights); len(fields) > 0 {
+		return dto.CreateBookingResponse{}, &entities.DocumentError{Fields: fields}
+	}
+
+	// Xác định loại hành khách theo tuổi tại ngày bay của từng chặng và gắn em bé với người lớn
+	for i := range arg.Segments {
+		if err := u.pricingRules.Passengers.ApplyToSegment(i+1, arg.Segments[i].Tickets, flights[i].DepartureTime); err != nil {
+			return dto.CreateBookingResponse{}, err
+		}
+	}
+
+	// Tính giá từng vé phía server theo giá hiện tại hoặc giá đã khóa và gói giá đã chọn; giá client gửi lên chỉ dùng để đối chiếu tổng tiền
+	fareFamilies, err := u.fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return dto.CreateBookingResponse{}, err
+	}
+	ancillaries, err := u.ancillaryRepository.ListAncillaries(ctx)
+	if err != nil {
+		return dto.CreateBookingResponse{}, err
+	}
+	// Quyền lợi theo hạng thành viên của tài khoản đặt chỗ áp dụng cho mọi hành khách trong booking
+	tier, err := u.loyaltyRepository.GetTierByEmail(ctx, email)
+	if err != nil {
+		return dto.CreateBookingResponse{}, err
+	}
+	benefits := u.tierPolicy.Benefits(tier)
+	var total int64
+	for i, segment := range arg.Segments {
+		fares, err := pricing.LockedOrCurrentFares(ctx, u.fareQuoteRepository, u.currentFares, flights[i], segments[i].QuoteID)
 		if err != nil {
 			return dto.CreateBookingResponse{}, err
 		}
-		returnFlight, err = u.flightRepository.GetFlightByID(ctx, returnFlightID)
+		seatZones, err := u.seatZoneRepository.ListSeatZonesForFlight(ctx, flights[i])
 		if err != nil {
-			if errors.Is(err, adapters.ErrFlightNotFound) {
-				return dto.CreateBookingResponse{}, adapters.ErrFlightNotFound
-			}
 			return dto.CreateBookingResponse{}, err
 		}
+		seatMap := entities.SeatMapForFlight(seatZones, flights[i])
+		chosenSeats := make(map[string]bool)
+		for j := range segment.Tickets {
+			ticket := &segment.Tickets[j]
+			if !ticket.FlightClass.Valid() {
+				return dto.CreateBookingResponse{}, &entities.PassengerError{Segment: i + 1, Passenger: j + 1, Reason: fmt.Sprintf("unknown cabin class %q", ticket.FlightClass)}
+			}
+			if ticket.FareFamily == "" {
+				ticket.FareFamily = entities.FareFamilyClassic
+			}
+			family, ok := fareFamilies.Find(ticket.FlightClass, ticket.FareFamily)
+			if !ok {
+				return dto.CreateBookingResponse{}, &entities.PassengerError{Segment: i + 1, Passenger: j + 1, Reason: fmt.Sprintf("unknown fare family %q", ticket.FareFamily)}
+			}
+			breakdown := u.pricingRules.PriceTicket(family.Fare(fares[ticket.FlightClass]), ticket.PassengerType)
+			ticket.Price = int32(breakdown.Total)
+			ticket.FareItems = breakdown.Items
+			total += breakdown.Total
+
+			// Dịch vụ bổ trợ được định giá theo tuyến và hạng ghế của chặng
+			for k, requested := range ticket.Ancillaries {
+				item, err := ancillaries.Sell(requested.Code, requested.Quantity, flights[i].DepartureCity, flights[i].ArrivalCity, ticket.FlightClass)
+				if err != nil {
+					return dto.CreateBookingResponse{}, &entities.PassengerError{Segment: i + 1, Passenger: j + 1, Reason: err.Error()}
+				}
+				ticket.Ancillaries[k] = item
+				total += item.Amount
+			}
+
+			// Ghế chọn trước thuộc vùng thu phí được tính như một dịch vụ bổ trợ của vé
+			if seatCode := ticket.Seat.SeatCode; seatCode != "" {
+				if chosenSeats[seatCode] {
+					return dto.CreateBookingResponse{}, &entities.PassengerError{Segment: i + 1, Passenger: j + 1, Reason: fmt.Sprintf("seat %s is chosen twice", seatCode)}
+				}
+				chosenSeats[seatCode] = true
+				if err := fareFamilies.CheckSeatSelection(*ticket); err != nil {
+					return dto.CreateBookingResponse{}, &entities.PassengerError{Segment: i + 1, Passenger: j + 1, Reason: err.Error()}
+				}
+				selection, err := seatMap.Select(*ticket, seatCode, holdsInfant(segment.Tickets, j))
+				if err != nil {
+					return dto.CreateBookingResponse{}, &entities.PassengerError{Segment: i + 1, Passenger: j + 1, Reason: err.Error()}
+				}
+				selection = benefits.ApplyToSeat(selection)
+				if selection.Fee > 0 {
+					ticket.Ancillaries = append(ticket.Ancillaries, selection.FeeItem())
+					total += selection.Fee
+				}
+			}
+		}
 	}
-	// Tạo booking trong repository
-	params := mappers.ToCreateBookingParams(booking, *departureFlight, returnFlight, email)
-	arg := entities.CreateBookingParams{
-		Email:                   params.Email,
-		DepartureCity:           params.DepartureCity,
-		ArrivalCity:             params.ArrivalCity,
-		DepartureFlightID:       params.DepartureFlightID,
-		ReturnFlightID:          params.ReturnFlightID,
-		TripType:                params.TripType,
-		DepartureTicketDataList: params.DepartureTicketDataList,
-		ReturnTicketDataList:    params.ReturnTicketDataList,
-		AfterCreate: func(booking entities.Booking) error {
-			taskPayload := &worker.PayloadSendVerifyEmail{
-				To:      booking.UserEmail,
-				Subject: "Xác nhận ghế máy bay",
-				Body: fmt.Sprintf(
-					`<html>
-						<body>
-							<h2>Xin chào,</h2>
-							<p>Chúng tôi xin thông báo rằng ghế của bạn đã được <b>cập nhật thành công</b> cho chuyến bay.</p>
-							<p><strong>Mã chuyến bay:</strong> %d</p>
-							<p>Vui lòng kiểm tra lại thông tin trong ứng dụng để đảm bảo mọi thứ chính xác.</p>
-							<p>Chúc bạn có một chuyến bay an toàn và thoải mái!</p>
-							<br>
-							<p>Trân trọng,<br>
-							<b>Đội ngũ Qairlines</b></p>
-						</body>
-						</html>`,
-					booking.BookingID,
-				),
+
+	// Áp dụng mã khuyến mãi lên giá cơ bản; lượt dùng được ghi và kiểm tra giới hạn trong transaction tạo booking
+	if len(booking.PromoCodes) > 0 {
+		promos := make([]entities.PromoCode, 0, len(booking.PromoCodes))
+		for _, code := range booking.PromoCodes {
+			promo, err := u.promoCodeRepository.GetPromoCodeByCode(ctx, entities.NormalizePromoCode(code))
+			if err != nil {
+				if errors.Is(err, adapters.ErrPromoCodeNotFound) {
+					return dto.CreateBookingResponse{}, &entities.PromoCodeError{Code: code, Reason: "the code does not exist"}
+				}
+				return dto.CreateBookingResponse{}, err
 			}
-			opts := []asynq.Option{
-				asynq.MaxRetry(10),
-				asynq.ProcessIn(10 * time.Second),
-				asynq.Queue(worker.QueueCritical),
+			promos = append(promos, promo)
+		}
+		var targets []entities.PromoTarget
+		for i := range arg.Segments {
+			for j := range arg.Segments[i].Tickets {
+				targets = append(targets, entities.PromoTarget{
+					Ticket:        &arg.Segments[i].Tickets[j],
+					DepartureCity: flights[i].DepartureCity,
+					ArrivalCity:   flights[i].ArrivalCity,
+				})
 			}
-			return u.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
-		},
+		}
+		arg.Promotions, err = entities.ApplyPromoCodes(promos, targets, time.Now())
+		if err != nil {
+			return dto.CreateBookingResponse{}, err
+		}
+		for _, promotion := range arg.Promotions {
+			total -= promotion.Amount
+		}
+	}
+
+	// Tổng tiền client gửi chỉ để đối chiếu, booking luôn được tính theo giá server
+	if booking.TotalPrice != nil && *booking.TotalPrice != total {
+		return dto.CreateBookingResponse{}, fmt.Errorf("%w: expected %d, got %d", adapters.ErrPriceMismatch, total, *booking.TotalPrice)
+	}
+	arg.TicketNumberPrefix = u.ticketNumberPrefix
+	// Số ghế từng hạng để transaction kiểm tra chỗ trống, tính cả ghế giữ cho đoàn
+	arg.Capacity = make([]map[entities.FlightClass]int64, len(flights))
+	for i, flight := range flights {
+		arg.Capacity[i] = make(map[entities.FlightClass]int64, len(entities.FlightClasses))
+		for _, class := range entities.FlightClasses {
+			arg.Capacity[i][class] = u.cabinLayout.Capacity(flight, class)
+		}
+	}
+	// Giá đã khóa chỉ dùng được một lần: báo giá được giữ cho booking này trước khi ghi,
+	// trả lại nếu booking không được ghi và chỉ bị xoá sau khi transaction commit
+	var quoteIDs []string
+	for _, segment := range segments {
+		if segment.QuoteID == "" {
+			continue
+		}
+		if err := u.fareQuoteRepository.ReserveFareQuote(ctx, segment.QuoteID); err != nil {
+			u.releaseFareQuotes(ctx, quoteIDs)
+			return dto.CreateBookingResponse{}, err
+		}
+		quoteIDs = append(quoteIDs, segment.QuoteID)
+	}
+	arg.AfterCreate = func(booking entities.Booking, tickets []entities.Ticket) error {
+		taskPayload := &worker.PayloadSendVerifyEmail{
+			To:      booking.UserEmail,
+			Subject: "Xác nhận ghế máy bay",
+			Body: fmt.Sprintf(
+				`<html>
+					<body>
+						<h2>Xin chào,</h2>
+						<p>Chúng tôi xin thông báo rằng ghế của bạn đã được <b>cập nhật thành công</b> cho chuyến bay.</p>
+						<p><strong>Mã đặt chỗ (PNR):</strong> %s</p>
+						<p><strong>Số vé điện tử:</strong></p>
+						<ul>%s</ul>
+						<p><strong>Tổng tiền:</strong> %d</p>
+						<p>Vui lòng kiểm tra lại thông tin trong ứng dụng để đảm bảo mọi thứ chính xác.</p>
+						<p>Chúc bạn có một chuyến bay an toàn và thoải mái!</p>
+						<br>
+						<p>Trân trọng,<br>
+						<b>Đội ngũ Qairlines</b></p>
+					</body>
+					</html>`,
+				booking.PNR,
+				formatETicketList(tickets),
+				bookingAmountDue(tickets),
+			),
+		}
+		opts := []asynq.Option{
+			asynq.MaxRetry(10),
+			asynq.ProcessIn(10 * time.Second),
+			asynq.Queue(worker.QueueCritical),
+		}
+		return u.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
 	}
 	createdBooking, departureTickets, returnTickets, err := u.bookingRepository.CreateBookingTx(ctx, arg)
 
 	if err != nil {
+		u.releaseFareQuotes(ctx, quoteIDs)
 		return dto.CreateBookingResponse{}, err
 	}
+	for _, quoteID := range quoteIDs {
+		if err := u.fareQuoteRepository.ConsumeFareQuote(ctx, quoteID); err != nil {
+			log.Error().Err(err).Str("quote_id", quoteID).Msg("failed to consume fare quote")
+		}
+	}
 
 	// Map kết quả sang DTO
-	return mappers.ToCreateBookingResponse(createdBooking, departureTickets, returnTickets), nil
+	response := mappers.ToCreateBookingResponse(createdBooking, departureTickets, returnTickets)
+	response.TotalPrice = total
+	return response, nil
+}
+
+// releaseFareQuotes makes the quotes reserved for a booking that was not written usable again.
+func (u *CreateBookingUseCase) releaseFareQuotes(ctx context.Context, quoteIDs []string) {
+	for _, quoteID := range quoteIDs {
+		if err := u.fareQuoteRepository.ReleaseFareQuote(ctx, quoteID); err != nil {
+			log.Error().Err(err).Str("quote_id", quoteID).Msg("failed to release fare quote")
+		}
+	}
+}
+
+// companionOwner returns the passenger details of the companion profile companionID saved
+// by the customer with email. A profile the customer does not own is adapters.ErrCompanionNotFound.
+func (u *CreateBookingUseCase) companionOwner(ctx context.Context, companionID string, email string) (entities.TicketOwner, error) {
+	id, err := strconv.ParseInt(companionID, 10, 64)
+	if err != nil {
+		return entities.TicketOwner{}, err
+	}
+	companion, err := u.companionRepository.GetCompanionByEmail(ctx, id, email)
+	if err != nil {
+		return entities.TicketOwner{}, err
+	}
+	return companion.Owner(), nil
+}
+
+// checkDocuments returns the field-level problems with the passengers of the booking, named
+// by their JSON path in the request. Passengers taken from a companion profile are reported
+// on their companionId since their details cannot be edited in the request.
+func (u *CreateBookingUseCase) checkDocuments(booking dto.CreateBookingRequest, segments []dto.BookingSegmentRequest, arg entities.CreateBookingParams, flights []entities.Flight) []entities.FieldError {
+	international := u.documentPolicy.International(flights)
+	departureDate := flights[0].DepartureTime
+	returnDate := flights[len(flights)-1].DepartureTime
+	var fields []entities.FieldError
+	for i, segment := range arg.Segments {
+		passengers := make(map[string]int)
+		for j, ticket := range segment.Tickets {
+			requested := segments[i].TicketDataList[j]
+			path := mappers.TicketDataPath(booking, i, j) + ".ownerData."
+			flagged := make(map[string]bool)
+			if requested.CompanionID != "" {
+				path = mappers.TicketDataPath(booking, i, j) + ".companionId"
+			} else {
+				// Ngày không đúng định dạng được báo riêng thay vì báo thiếu
+				if _, err := time.Parse("2006-01-02", requested.OwnerData.DateOfBirth); err != nil {
+					fields = append(fields, entities.FieldError{Field: path + "dateOfBirth", Message: "date of birth must be a valid date in YYYY-MM-DD format"})
+					flagged["dateOfBirth"] = true
+				}
+				if requested.OwnerData.PassportExpiry != "" {
+					if _, err := time.Parse("2006-01-02", requested.OwnerData.PassportExpiry); err != nil {
+						fields = append(fields, entities.FieldError{Field: path + "passportExpiry", Message: "passport expiry must be a valid date in YYYY-MM-DD format"})
+						flagged["passportExpiry"] = true
+					}
+				}
+			}
+			for _, field := range u.documentPolicy.CheckPassenger(ticket.Owner, international, departureDate, returnDate) {
+				if flagged[field.Field] {
+					continue
+				}
+				if requested.CompanionID != "" {
+					fields = append(fields, entities.FieldError{Field: path, Message: "companion profile: " + field.Message})
+					continue
+				}
+				fields = append(fields, entities.FieldError{Field: path + field.Field, Message: field.Message})
+			}
+
+			// Một hành khách không được xuất hiện hai lần trên cùng một chặng
+			key := strings.ToUpper(strings.TrimSpace(ticket.Owner.LastName)) + "/" + strings.ToUpper(strings.TrimSpace(ticket.Owner.FirstName)) + "/" + ticket.Owner.DateOfBirth.Format("2006-01-02")
+			if first, ok := passengers[key]; ok {
+				fields = append(fields, entities.FieldError{Field: mappers.TicketDataPath(booking, i, j), Message: fmt.Sprintf("same passenger as %s", mappers.TicketDataPath(booking, i, first))})
+				continue
+			}
+			passengers[key] = j
+		}
+	}
+	return fields
+}
+
+// holdsInfant reports whether an infant of the segment travels on the lap of passenger adult.
+func holdsInfant(tickets []entities.Ticket, adult int) bool {
+	for _, ticket := range tickets {
+		if ticket.PassengerType == entities.PassengerTypeInfant && ticket.AccompanyingPassenger != nil && *ticket.AccompanyingPassenger == adult {
+			return true
+		}
+	}
+	return false
+}
+
+// formatETicketList renders one <li> per passenger with the e-ticket number and the add-ons bought for it.
+func formatETicketList(tickets []entities.Ticket) string {
+	var sb strings.Builder
+	for _, ticket := range tickets {
+		fmt.Fprintf(&sb, "<li>%s %s: %s", html.EscapeString(ticket.Owner.LastName), html.EscapeString(ticket.Owner.FirstName), ticket.TicketNumber)
+		if len(ticket.Ancillaries) > 0 {
+			sb.WriteString("<ul>")
+			for _, ancillary := range ticket.Ancillaries {
+				fmt.Fprintf(&sb, "<li>%s x%d: %d</li>", html.EscapeString(ancillary.Name), ancillary.Quantity, ancillary.Amount)
+			}
+			sb.WriteString("</ul>")
+		}
+		sb.WriteString("</li>")
+	}
+	return sb.String()
+}
+
+// bookingAmountDue sums what the customer pays for tickets, add-ons included.
+func bookingAmountDue(tickets []entities.Ticket) int64 {
+	var total int64
+	for _, ticket := range tickets {
+		total += ticket.AmountDue()
+	}
+	return total
 }
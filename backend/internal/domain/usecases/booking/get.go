@@ -3,13 +3,15 @@ package booking
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/pkg/utils"
 )
 
 type IGetBookingUseCase interface {
-	Execute(ctx context.Context, bookingID int64) (entities.Booking, []entities.Ticket, []entities.Ticket, error)
+	Execute(ctx context.Context, reference string, lastName string) (entities.Booking, []entities.Ticket, []entities.Ticket, error)
 }
 
 type GetBookingUseCase struct {
@@ -22,8 +24,25 @@ func NewGetBookingUseCase(bookingRepository adapters.IBookingRepository) *GetBoo
 	}
 }
 
-func (u *GetBookingUseCase) Execute(ctx context.Context, bookingID int64) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
-	booking, departureTickets, returnTickets, err := u.bookingRepository.GetBookingByID(ctx, bookingID)
+// Execute looks a booking up by its PNR and the last name of one of its passengers, the same
+// pair the manage-booking lookup asks for. A PNR alone is printed on boarding passes and
+// luggage tags, so it is not enough to read the passenger data. Any mismatch is reported as
+// ErrBookingNotFound so callers cannot tell which part was wrong.
+func (u *GetBookingUseCase) Execute(ctx context.Context, reference string, lastName string) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	pnr := utils.NormalizePNR(strings.TrimSpace(reference))
+	lastName = strings.TrimSpace(lastName)
+	if !utils.IsValidPNR(pnr) || lastName == "" {
+		return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
+	}
+
+	if _, err := u.bookingRepository.GetBookingByPNRAndLastName(ctx, pnr, lastName); err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
+		}
+		return entities.Booking{}, nil, nil, err
+	}
+
+	booking, departureTickets, returnTickets, err := u.bookingRepository.GetBookingByPNR(ctx, pnr)
 	if err != nil {
 		if errors.Is(err, adapters.ErrBookingNotFound) {
 			return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
@@ -0,0 +1,33 @@
+package booking
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/pkg/utils"
+)
+
+type IGetBookingIDUseCase interface {
+	Execute(ctx context.Context, reference string) (int64, error)
+}
+
+type GetBookingIDUseCase struct {
+	bookingRepository adapters.IBookingRepository
+}
+
+func NewGetBookingIDUseCase(bookingRepository adapters.IBookingRepository) IGetBookingIDUseCase {
+	return &GetBookingIDUseCase{
+		bookingRepository: bookingRepository,
+	}
+}
+
+// Execute resolves the PNR customers know their booking by to its internal ID. Sequential
+// booking IDs are never accepted, so booking routes cannot be walked through by ID.
+func (u *GetBookingIDUseCase) Execute(ctx context.Context, reference string) (int64, error) {
+	pnr := utils.NormalizePNR(strings.TrimSpace(reference))
+	if !utils.IsValidPNR(pnr) {
+		return 0, adapters.ErrBookingNotFound
+	}
+	return u.bookingRepository.GetBookingIDByPNR(ctx, pnr)
+}
@@ -0,0 +1,76 @@
+package booking_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	mockadapters "github.com/spaghetti-lover/qairlines/internal/domain/mock/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetBookingUseCase(t *testing.T) {
+	found := entities.Booking{BookingID: 42, PNR: "ABC234"}
+
+	testCases := []struct {
+		name       string
+		reference  string
+		lastName   string
+		buildStubs func(bookingRepo *mockadapters.MockIBookingRepository)
+		check      func(t *testing.T, result entities.Booking, err error)
+	}{
+		{
+			name:      "OK",
+			reference: " abc234 ",
+			lastName:  "Nguyen",
+			buildStubs: func(bookingRepo *mockadapters.MockIBookingRepository) {
+				bookingRepo.EXPECT().GetBookingByPNRAndLastName(gomock.Any(), "ABC234", "Nguyen").Times(1).Return(found, nil)
+				bookingRepo.EXPECT().GetBookingByPNR(gomock.Any(), "ABC234").Times(1).Return(found, nil, nil, nil)
+			},
+			check: func(t *testing.T, result entities.Booking, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "ABC234", result.PNR)
+			},
+		},
+		{
+			// Sai họ: không được đọc vé và thông tin hành khách
+			name:      "LastNameMismatch",
+			reference: "ABC234",
+			lastName:  "Tran",
+			buildStubs: func(bookingRepo *mockadapters.MockIBookingRepository) {
+				bookingRepo.EXPECT().GetBookingByPNRAndLastName(gomock.Any(), "ABC234", "Tran").Times(1).Return(entities.Booking{}, adapters.ErrBookingNotFound)
+				bookingRepo.EXPECT().GetBookingByPNR(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result entities.Booking, err error) {
+				require.ErrorIs(t, err, adapters.ErrBookingNotFound)
+			},
+		},
+		{
+			name:      "MissingLastName",
+			reference: "ABC234",
+			lastName:  "  ",
+			buildStubs: func(bookingRepo *mockadapters.MockIBookingRepository) {
+				bookingRepo.EXPECT().GetBookingByPNRAndLastName(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				bookingRepo.EXPECT().GetBookingByPNR(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result entities.Booking, err error) {
+				require.ErrorIs(t, err, adapters.ErrBookingNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bookingRepo := mockadapters.NewMockIBookingRepository(ctrl)
+			tc.buildStubs(bookingRepo)
+
+			useCase := booking.NewGetBookingUseCase(bookingRepo)
+			result, _, _, err := useCase.Execute(context.Background(), tc.reference, tc.lastName)
+			tc.check(t, result, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListTripsUseCase interface {
+	Execute(ctx context.Context, email string, filter entities.TripFilter) (entities.TripPage, error)
+}
+
+type ListTripsUseCase struct {
+	bookingRepository adapters.IBookingRepository
+	flightRepository  adapters.IFlightRepository
+}
+
+func NewListTripsUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository) IListTripsUseCase {
+	return &ListTripsUseCase{
+		bookingRepository: bookingRepository,
+		flightRepository:  flightRepository,
+	}
+}
+
+// Execute returns a page of the customer's upcoming or past trips with the flights of every
+// segment, and how many trips match the filters on each side so both tabs can be labelled.
+// Upcoming trips come soonest first, past trips most recent first.
+func (u *ListTripsUseCase) Execute(ctx context.Context, email string, filter entities.TripFilter) (entities.TripPage, error) {
+	filter.Now = time.Now()
+
+	trips, err := u.bookingRepository.ListCustomerTrips(ctx, email, filter)
+	if err != nil {
+		return entities.TripPage{}, err
+	}
+	page := entities.TripPage{Trips: trips}
+
+	// Đếm số chuyến ở cả hai phía với cùng bộ lọc
+	counts := make(map[bool]int64, 2)
+	for _, upcoming := range []bool{true, false} {
+		side := filter
+		side.Upcoming = upcoming
+		counts[upcoming], err = u.bookingRepository.CountCustomerTrips(ctx, email, side)
+		if err != nil {
+			return entities.TripPage{}, err
+		}
+	}
+	page.UpcomingCount = counts[true]
+	page.PastCount = counts[false]
+	page.Total = counts[filter.Upcoming]
+
+	// Tải mọi chuyến bay của trang trong một truy vấn; các booking thường dùng chung chuyến bay
+	seen := make(map[int64]bool)
+	var flightIDs []int64
+	for _, trip := range page.Trips {
+		for _, segment := range trip.Booking.Segments {
+			if !seen[segment.FlightID] {
+				seen[segment.FlightID] = true
+				flightIDs = append(flightIDs, segment.FlightID)
+			}
+		}
+	}
+	flights, err := u.flightRepository.ListFlightsByIDs(ctx, flightIDs)
+	if err != nil {
+		return entities.TripPage{}, fmt.Errorf("failed to load flights: %w", err)
+	}
+	for i, trip := range page.Trips {
+		for _, segment := range trip.Booking.Segments {
+			flight, ok := flights[segment.FlightID]
+			if !ok {
+				return entities.TripPage{}, fmt.Errorf("failed to load flight %d of booking %d: %w", segment.FlightID, trip.Booking.BookingID, adapters.ErrFlightNotFound)
+			}
+			page.Trips[i].Segments = append(page.Trips[i].Segments, entities.TripSegment{
+				SegmentOrder: segment.SegmentOrder,
+				Flight:       flight,
+				Tickets:      segment.Tickets,
+			})
+		}
+	}
+	return page, nil
+}
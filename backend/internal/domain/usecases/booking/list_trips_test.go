@@ -0,0 +1,79 @@
+package booking_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	mockadapters "github.com/spaghetti-lover/qairlines/internal/domain/mock/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListTripsUseCase(t *testing.T) {
+	// Hai booking đi cùng chuyến 3, booking thứ hai có thêm chiều về trên chuyến 4
+	trips := []entities.Trip{
+		{Booking: entities.Booking{BookingID: 1, Segments: []entities.BookingSegment{{SegmentOrder: 1, FlightID: 3}}}},
+		{Booking: entities.Booking{BookingID: 2, Segments: []entities.BookingSegment{{SegmentOrder: 1, FlightID: 3}, {SegmentOrder: 2, FlightID: 4}}}},
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(flightRepo *mockadapters.MockIFlightRepository)
+		check      func(t *testing.T, page entities.TripPage, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(flightRepo *mockadapters.MockIFlightRepository) {
+				flightRepo.EXPECT().ListFlightsByIDs(gomock.Any(), []int64{3, 4}).Times(1).Return(map[int64]entities.Flight{
+					3: {FlightID: 3, FlightNumber: "QA101"},
+					4: {FlightID: 4, FlightNumber: "QA102"},
+				}, nil)
+				flightRepo.EXPECT().GetFlightByID(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, page entities.TripPage, err error) {
+				require.NoError(t, err)
+				require.Len(t, page.Trips, 2)
+				require.Equal(t, "QA101", page.Trips[0].Segments[0].Flight.FlightNumber)
+				require.Equal(t, "QA102", page.Trips[1].Segments[1].Flight.FlightNumber)
+				require.Equal(t, int64(2), page.Total)
+				require.Equal(t, int64(5), page.PastCount)
+			},
+		},
+		{
+			name: "FlightMissing",
+			buildStubs: func(flightRepo *mockadapters.MockIFlightRepository) {
+				flightRepo.EXPECT().ListFlightsByIDs(gomock.Any(), []int64{3, 4}).Times(1).Return(map[int64]entities.Flight{
+					3: {FlightID: 3},
+				}, nil)
+			},
+			check: func(t *testing.T, page entities.TripPage, err error) {
+				require.ErrorIs(t, err, adapters.ErrFlightNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bookingRepo := mockadapters.NewMockIBookingRepository(ctrl)
+			flightRepo := mockadapters.NewMockIFlightRepository(ctrl)
+
+			bookingRepo.EXPECT().ListCustomerTrips(gomock.Any(), "an@example.com", gomock.Any()).Times(1).Return(trips, nil)
+			bookingRepo.EXPECT().CountCustomerTrips(gomock.Any(), "an@example.com", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, filter entities.TripFilter) (int64, error) {
+					if filter.Upcoming {
+						return 2, nil
+					}
+					return 5, nil
+				}).Times(2)
+			tc.buildStubs(flightRepo)
+
+			useCase := booking.NewListTripsUseCase(bookingRepo, flightRepo)
+			page, err := useCase.Execute(context.Background(), "an@example.com", entities.TripFilter{Upcoming: true, Page: 1, Limit: 10})
+			tc.check(t, page, err)
+		})
+	}
+}
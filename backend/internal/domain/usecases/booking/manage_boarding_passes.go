@@ -0,0 +1,76 @@
+package booking
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetBoardingPassesUseCase interface {
+	Execute(ctx context.Context, bookingID int64) ([]entities.BoardingPass, error)
+}
+
+type GetBoardingPassesUseCase struct {
+	bookingRepository    adapters.IBookingRepository
+	flightRepository     adapters.IFlightRepository
+	fareFamilyRepository adapters.IFareFamilyRepository
+	loyaltyRepository    adapters.ILoyaltyRepository
+	tierPolicy           entities.TierPolicy
+}
+
+func NewGetBoardingPassesUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, fareFamilyRepository adapters.IFareFamilyRepository, loyaltyRepository adapters.ILoyaltyRepository, tierPolicy entities.TierPolicy) IGetBoardingPassesUseCase {
+	return &GetBoardingPassesUseCase{
+		bookingRepository:    bookingRepository,
+		flightRepository:     flightRepository,
+		fareFamilyRepository: fareFamilyRepository,
+		loyaltyRepository:    loyaltyRepository,
+		tierPolicy:           tierPolicy,
+	}
+}
+
+// Execute returns a boarding pass for every active ticket of a confirmed booking, segment
+// by segment. The baggage allowance, priority boarding and lounge access follow the fare
+// family of each ticket and the loyalty tier of the account the booking was made from.
+func (u *GetBoardingPassesUseCase) Execute(ctx context.Context, bookingID int64) ([]entities.BoardingPass, error) {
+	booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return nil, adapters.ErrBookingNotFound
+		}
+		return nil, err
+	}
+	if booking.Status != entities.BookingStatusConfirmed {
+		return nil, adapters.ErrBookingNotConfirmed
+	}
+
+	fareFamilies, err := u.fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tier, err := u.loyaltyRepository.GetTierByEmail(ctx, booking.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	benefits := u.tierPolicy.Benefits(tier)
+
+	passes := []entities.BoardingPass{}
+	for _, segment := range booking.Segments {
+		flight, err := u.flightRepository.GetFlightByID(ctx, segment.FlightID)
+		if err != nil {
+			return nil, err
+		}
+		for _, ticket := range segment.Tickets {
+			if ticket.Status != entities.TicketStatusActive {
+				continue
+			}
+			family, _ := fareFamilies.Find(ticket.FlightClass, ticket.FareFamily)
+			pass := entities.NewBoardingPass(ticket, *flight, family, tier, benefits)
+			pass.BookingPNR = booking.PNR
+			passes = append(passes, pass)
+		}
+	}
+	return passes, nil
+}
@@ -0,0 +1,36 @@
+package booking
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetManagedBookingUseCase interface {
+	Execute(ctx context.Context, bookingID int64) (entities.Booking, []entities.Ticket, []entities.Ticket, error)
+}
+
+type GetManagedBookingUseCase struct {
+	bookingRepository adapters.IBookingRepository
+}
+
+func NewGetManagedBookingUseCase(bookingRepository adapters.IBookingRepository) IGetManagedBookingUseCase {
+	return &GetManagedBookingUseCase{
+		bookingRepository: bookingRepository,
+	}
+}
+
+// Execute loads the booking a manage-booking token was issued for. The ID comes from the
+// token, never from the request, so it is looked up directly instead of as a PNR.
+func (u *GetManagedBookingUseCase) Execute(ctx context.Context, bookingID int64) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	booking, departureTickets, returnTickets, err := u.bookingRepository.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
+		}
+		return entities.Booking{}, nil, nil, err
+	}
+	return booking, departureTickets, returnTickets, nil
+}
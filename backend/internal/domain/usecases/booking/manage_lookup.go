@@ -0,0 +1,74 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/pkg/token"
+	"github.com/spaghetti-lover/qairlines/pkg/utils"
+)
+
+// ManageBookingRole is stored in the role claim of manage-booking tokens
+const ManageBookingRole = "manage_booking"
+
+type ManageBookingLookupInput struct {
+	PNR      string
+	LastName string
+}
+
+type ManageBookingLookupOutput struct {
+	Token     string
+	ExpiresAt time.Time
+	Booking   entities.Booking
+}
+
+type IManageBookingLookupUseCase interface {
+	Execute(ctx context.Context, input ManageBookingLookupInput) (*ManageBookingLookupOutput, error)
+}
+
+type ManageBookingLookupUseCase struct {
+	bookingRepository adapters.IBookingRepository
+	tokenMaker        token.Maker
+	tokenDuration     time.Duration
+}
+
+func NewManageBookingLookupUseCase(bookingRepository adapters.IBookingRepository, tokenMaker token.Maker, tokenDuration time.Duration) IManageBookingLookupUseCase {
+	return &ManageBookingLookupUseCase{
+		bookingRepository: bookingRepository,
+		tokenMaker:        tokenMaker,
+		tokenDuration:     tokenDuration,
+	}
+}
+
+// Execute matches the PNR and a passenger last name and issues a token scoped to that booking.
+// Any mismatch is reported as ErrBookingNotFound so callers cannot tell which part was wrong.
+func (u *ManageBookingLookupUseCase) Execute(ctx context.Context, input ManageBookingLookupInput) (*ManageBookingLookupOutput, error) {
+	pnr := utils.NormalizePNR(input.PNR)
+	lastName := strings.TrimSpace(input.LastName)
+	if !utils.IsValidPNR(pnr) || lastName == "" {
+		return nil, adapters.ErrBookingNotFound
+	}
+
+	booking, err := u.bookingRepository.GetBookingByPNRAndLastName(ctx, pnr, lastName)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return nil, adapters.ErrBookingNotFound
+		}
+		return nil, err
+	}
+
+	manageToken, payload, err := u.tokenMaker.CreateToken(booking.BookingID, ManageBookingRole, u.tokenDuration, token.TokenTypeManageBooking)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManageBookingLookupOutput{
+		Token:     manageToken,
+		ExpiresAt: payload.ExpiredAt,
+		Booking:   booking,
+	}, nil
+}
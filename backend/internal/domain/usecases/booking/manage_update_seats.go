@@ -0,0 +1,33 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/ticket"
+)
+
+type IUpdateManagedSeatsUseCase interface {
+	Execute(ctx context.Context, bookingID int64, updates []entities.SeatUpdate) (entities.SeatSelectionResult, error)
+}
+
+type UpdateManagedSeatsUseCase struct {
+	updateSeatsUseCase ticket.IUpdateSeatsUseCase
+}
+
+func NewUpdateManagedSeatsUseCase(updateSeatsUseCase ticket.IUpdateSeatsUseCase) IUpdateManagedSeatsUseCase {
+	return &UpdateManagedSeatsUseCase{
+		updateSeatsUseCase: updateSeatsUseCase,
+	}
+}
+
+// Execute changes seats on tickets of a single booking, with the same seat fees and
+// eligibility rules as the ticket API. Tickets from other bookings are reported as not
+// found so a scoped token cannot be used to probe foreign ticket IDs.
+func (u *UpdateManagedSeatsUseCase) Execute(ctx context.Context, bookingID int64, updates []entities.SeatUpdate) (entities.SeatSelectionResult, error) {
+	if bookingID == 0 {
+		return entities.SeatSelectionResult{}, adapters.ErrTicketNotFound
+	}
+	return u.updateSeatsUseCase.Execute(ctx, bookingID, updates)
+}
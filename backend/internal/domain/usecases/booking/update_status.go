@@ -0,0 +1,75 @@
+package booking
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IUpdateBookingStatusUseCase interface {
+	Execute(ctx context.Context, params entities.UpdateBookingStatusParams) (entities.Booking, error)
+}
+
+type UpdateBookingStatusUseCase struct {
+	bookingRepository adapters.IBookingRepository
+	flightRepository  adapters.IFlightRepository
+	loyaltyScheduler  adapters.ILoyaltyScheduler
+}
+
+func NewUpdateBookingStatusUseCase(bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, loyaltyScheduler adapters.ILoyaltyScheduler) IUpdateBookingStatusUseCase {
+	return &UpdateBookingStatusUseCase{
+		bookingRepository: bookingRepository,
+		flightRepository:  flightRepository,
+		loyaltyScheduler:  loyaltyScheduler,
+	}
+}
+
+// Execute moves the booking to params.Status. The transition is validated inside the
+// transaction, so an invalid move returns *entities.StatusTransitionError untouched.
+// Cancelling is refused with ErrCancelThroughCancelBooking: it must go through
+// CancelBookingUseCase so tickets, seats and refunds follow the booking.
+// Once a booking is confirmed, its flights are scheduled to credit loyalty points on landing.
+func (u *UpdateBookingStatusUseCase) Execute(ctx context.Context, params entities.UpdateBookingStatusParams) (entities.Booking, error) {
+	if params.Status == entities.BookingStatusCancelled {
+		return entities.Booking{}, adapters.ErrCancelThroughCancelBooking
+	}
+
+	booking, err := u.bookingRepository.UpdateBookingStatus(ctx, params)
+	if err != nil {
+		return entities.Booking{}, err
+	}
+	if booking.Status != entities.BookingStatusConfirmed {
+		return booking, nil
+	}
+
+	scheduleBookingLoyaltyAccrual(ctx, u.bookingRepository, u.flightRepository, u.loyaltyScheduler, booking.BookingID)
+	return booking, nil
+}
+
+// scheduleBookingLoyaltyAccrual schedules the loyalty points of every flight of a
+// confirmed booking. The booking is already confirmed, so failures are only logged.
+func scheduleBookingLoyaltyAccrual(ctx context.Context, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, loyaltyScheduler adapters.ILoyaltyScheduler, bookingID int64) {
+	details, _, _, err := bookingRepository.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to load booking to schedule loyalty points")
+		return
+	}
+	for _, segment := range details.Segments {
+		flight, err := flightRepository.GetFlightByID(ctx, segment.FlightID)
+		if err != nil {
+			log.Error().Err(err).Int64("flight_id", segment.FlightID).Msg("failed to load flight to schedule loyalty points")
+			continue
+		}
+		scheduleLoyaltyAccrual(ctx, loyaltyScheduler, *flight)
+	}
+}
+
+// scheduleLoyaltyAccrual asks for the passengers of flight to be credited when it lands.
+// The change that earned the points is already saved, so failures are only logged.
+func scheduleLoyaltyAccrual(ctx context.Context, loyaltyScheduler adapters.ILoyaltyScheduler, flight entities.Flight) {
+	if err := loyaltyScheduler.ScheduleAccrual(ctx, flight); err != nil {
+		log.Error().Err(err).Int64("flight_id", flight.FlightID).Msg("failed to schedule loyalty points")
+	}
+}
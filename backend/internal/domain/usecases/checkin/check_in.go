@@ -0,0 +1,115 @@
+package checkin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type ICheckInUseCase interface {
+	Execute(ctx context.Context, params entities.CheckInParams) ([]entities.TicketCheckIn, error)
+}
+
+type CheckInUseCase struct {
+	checkInRepository adapters.ICheckInRepository
+	bookingRepository adapters.IBookingRepository
+	flightRepository  adapters.IFlightRepository
+	policy            entities.CheckInPolicy
+}
+
+func NewCheckInUseCase(checkInRepository adapters.ICheckInRepository, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, policy entities.CheckInPolicy) ICheckInUseCase {
+	return &CheckInUseCase{
+		checkInRepository: checkInRepository,
+		bookingRepository: bookingRepository,
+		flightRepository:  flightRepository,
+		policy:            policy,
+	}
+}
+
+// Execute checks in passengers of a paid booking inside the check-in window of their
+// flights, once their travel documents on file are valid for the trip. The passengers
+// may travel on different segments; they are checked in together or not at all.
+func (u *CheckInUseCase) Execute(ctx context.Context, params entities.CheckInParams) ([]entities.TicketCheckIn, error) {
+	booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, params.BookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return nil, adapters.ErrBookingNotFound
+		}
+		return nil, err
+	}
+	if booking.Status != entities.BookingStatusConfirmed {
+		return nil, adapters.ErrBookingNotConfirmed
+	}
+
+	segments, err := ticketsBySegment(booking, params.TicketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Giấy tờ được kiểm tra theo quy định quốc tế nếu có chặng nào của hành trình là quốc tế,
+	// và hộ chiếu phải còn hạn tính từ ngày bay chặng cuối
+	flights := make([]entities.Flight, 0, len(booking.Segments))
+	for _, segment := range booking.Segments {
+		flight, err := u.flightRepository.GetFlightByID(ctx, segment.FlightID)
+		if err != nil {
+			return nil, err
+		}
+		flights = append(flights, *flight)
+	}
+	international := u.policy.Documents.International(flights)
+	var returnDate time.Time
+	if len(flights) > 0 {
+		returnDate = flights[len(flights)-1].DepartureTime
+	}
+
+	// 2. Kiểm tra từng chặng theo cửa sổ làm thủ tục của chuyến bay
+	now := time.Now()
+	checkIns := []entities.TicketCheckIn{}
+	for i, segment := range booking.Segments {
+		ticketIDs, ok := segments[segment.FlightID]
+		if !ok {
+			continue
+		}
+		segmentCheckIns, err := u.policy.CheckIn(segment, flights[i], ticketIDs, international, returnDate, now)
+		if err != nil {
+			return nil, err
+		}
+		checkIns = append(checkIns, segmentCheckIns...)
+	}
+
+	// 3. Ghi nhận thủ tục của tất cả hành khách trong một giao dịch
+	return u.checkInRepository.CheckInTickets(ctx, checkIns)
+}
+
+// ticketsBySegment groups ticketIDs by the flight of their segment. Tickets that are not
+// active tickets of the booking are reported as not found.
+func ticketsBySegment(booking entities.Booking, ticketIDs []int64) (map[int64][]int64, error) {
+	segments := make(map[int64][]int64)
+	seen := make(map[int64]bool, len(ticketIDs))
+	for _, ticketID := range ticketIDs {
+		if seen[ticketID] {
+			continue
+		}
+		seen[ticketID] = true
+
+		found := false
+		for _, segment := range booking.Segments {
+			for _, ticket := range segment.Tickets {
+				if ticket.TicketID == ticketID && ticket.Status == entities.TicketStatusActive {
+					segments[segment.FlightID] = append(segments[segment.FlightID], ticketID)
+					found = true
+				}
+			}
+		}
+		if !found {
+			return nil, adapters.ErrTicketNotFound
+		}
+	}
+	if len(segments) == 0 {
+		return nil, adapters.ErrTicketNotFound
+	}
+	return segments, nil
+}
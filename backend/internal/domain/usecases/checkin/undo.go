@@ -0,0 +1,68 @@
+package checkin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IUndoCheckInUseCase interface {
+	Execute(ctx context.Context, params entities.CheckInParams) ([]entities.TicketCheckIn, error)
+}
+
+type UndoCheckInUseCase struct {
+	checkInRepository adapters.ICheckInRepository
+	bookingRepository adapters.IBookingRepository
+	flightRepository  adapters.IFlightRepository
+	policy            entities.CheckInPolicy
+}
+
+func NewUndoCheckInUseCase(checkInRepository adapters.ICheckInRepository, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, policy entities.CheckInPolicy) IUndoCheckInUseCase {
+	return &UndoCheckInUseCase{
+		checkInRepository: checkInRepository,
+		bookingRepository: bookingRepository,
+		flightRepository:  flightRepository,
+		policy:            policy,
+	}
+}
+
+// Execute undoes the check-in of passengers of the booking while the check-in window of
+// their flights is still open, so their seats can be changed again.
+func (u *UndoCheckInUseCase) Execute(ctx context.Context, params entities.CheckInParams) ([]entities.TicketCheckIn, error) {
+	booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, params.BookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return nil, adapters.ErrBookingNotFound
+		}
+		return nil, err
+	}
+
+	segments, err := ticketsBySegment(booking, params.TicketIDs)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	ticketIDs := []int64{}
+	for _, segment := range booking.Segments {
+		segmentTicketIDs, ok := segments[segment.FlightID]
+		if !ok {
+			continue
+		}
+		flight, err := u.flightRepository.GetFlightByID(ctx, segment.FlightID)
+		if err != nil {
+			return nil, err
+		}
+		checkIns, err := u.policy.UndoCheckIn(segment, *flight, segmentTicketIDs, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, checkIn := range checkIns {
+			ticketIDs = append(ticketIDs, checkIn.TicketID)
+		}
+	}
+
+	return u.checkInRepository.UndoCheckIn(ctx, booking.BookingID, ticketIDs)
+}
@@ -0,0 +1,32 @@
+package companion
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type ICreateCompanionUseCase interface {
+	Execute(ctx context.Context, companion entities.Companion) (entities.Companion, error)
+}
+
+type CreateCompanionUseCase struct {
+	companionRepository adapters.ICompanionRepository
+}
+
+func NewCreateCompanionUseCase(companionRepository adapters.ICompanionRepository) ICreateCompanionUseCase {
+	return &CreateCompanionUseCase{
+		companionRepository: companionRepository,
+	}
+}
+
+// Execute saves a new companion profile for companion.UserID, up to entities.MaxCompanions per customer.
+func (u *CreateCompanionUseCase) Execute(ctx context.Context, companion entities.Companion) (entities.Companion, error) {
+	if err := companion.Validate(time.Now()); err != nil {
+		return entities.Companion{}, err
+	}
+
+	return u.companionRepository.CreateCompanion(ctx, companion)
+}
@@ -0,0 +1,27 @@
+package companion
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IDeleteCompanionUseCase interface {
+	Execute(ctx context.Context, companionID int64, userID int64) (entities.Companion, error)
+}
+
+type DeleteCompanionUseCase struct {
+	companionRepository adapters.ICompanionRepository
+}
+
+func NewDeleteCompanionUseCase(companionRepository adapters.ICompanionRepository) IDeleteCompanionUseCase {
+	return &DeleteCompanionUseCase{
+		companionRepository: companionRepository,
+	}
+}
+
+// Execute removes one of the customer's companion profiles.
+func (u *DeleteCompanionUseCase) Execute(ctx context.Context, companionID int64, userID int64) (entities.Companion, error) {
+	return u.companionRepository.DeleteCompanion(ctx, companionID, userID)
+}
@@ -0,0 +1,27 @@
+package companion
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListCompanionsUseCase interface {
+	Execute(ctx context.Context, userID int64) ([]entities.Companion, error)
+}
+
+type ListCompanionsUseCase struct {
+	companionRepository adapters.ICompanionRepository
+}
+
+func NewListCompanionsUseCase(companionRepository adapters.ICompanionRepository) IListCompanionsUseCase {
+	return &ListCompanionsUseCase{
+		companionRepository: companionRepository,
+	}
+}
+
+// Execute lists the customer's companion profiles by name.
+func (u *ListCompanionsUseCase) Execute(ctx context.Context, userID int64) ([]entities.Companion, error) {
+	return u.companionRepository.ListCompanions(ctx, userID)
+}
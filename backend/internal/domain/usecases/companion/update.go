@@ -0,0 +1,32 @@
+package companion
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IUpdateCompanionUseCase interface {
+	Execute(ctx context.Context, companion entities.Companion) (entities.Companion, error)
+}
+
+type UpdateCompanionUseCase struct {
+	companionRepository adapters.ICompanionRepository
+}
+
+func NewUpdateCompanionUseCase(companionRepository adapters.ICompanionRepository) IUpdateCompanionUseCase {
+	return &UpdateCompanionUseCase{
+		companionRepository: companionRepository,
+	}
+}
+
+// Execute replaces one of the customer's companion profiles. Tickets already booked keep
+// the details copied when they were issued.
+func (u *UpdateCompanionUseCase) Execute(ctx context.Context, companion entities.Companion) (entities.Companion, error) {
+	if err := companion.Validate(time.Now()); err != nil {
+		return entities.Companion{}, err
+	}
+	return u.companionRepository.UpdateCompanion(ctx, companion)
+}
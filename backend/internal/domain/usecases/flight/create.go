@@ -13,12 +13,20 @@ type ICreateFlightUseCase interface {
 
 type CreateFlightUseCase struct {
 	flightRepository adapters.IFlightRepository
+	homeCountry      string
 }
 
-func NewCreateFlightUseCase(flightRepository adapters.IFlightRepository) ICreateFlightUseCase {
-	return &CreateFlightUseCase{flightRepository: flightRepository}
+func NewCreateFlightUseCase(flightRepository adapters.IFlightRepository, homeCountry string) ICreateFlightUseCase {
+	return &CreateFlightUseCase{flightRepository: flightRepository, homeCountry: homeCountry}
 }
 
 func (u *CreateFlightUseCase) Execute(ctx context.Context, flight entities.Flight) (entities.Flight, error) {
+	// Chuyến không ghi quốc gia của sân bay được coi là bay trong nước
+	if flight.DepartureCountry == "" {
+		flight.DepartureCountry = u.homeCountry
+	}
+	if flight.ArrivalCountry == "" {
+		flight.ArrivalCountry = u.homeCountry
+	}
 	return u.flightRepository.CreateFlight(ctx, flight)
 }
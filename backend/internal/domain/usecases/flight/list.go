@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
 )
@@ -13,12 +14,16 @@ type IListFlightsUseCase interface {
 }
 
 type listFlightsUseCase struct {
-	flightRepository adapters.IFlightRepository
+	flightRepository     adapters.IFlightRepository
+	currentFares         pricing.IGetCurrentFaresUseCase
+	fareFamilyRepository adapters.IFareFamilyRepository
 }
 
-func NewlistFlightsUseCase(flightRepository adapters.IFlightRepository) IListFlightsUseCase {
+func NewlistFlightsUseCase(flightRepository adapters.IFlightRepository, currentFares pricing.IGetCurrentFaresUseCase, fareFamilyRepository adapters.IFareFamilyRepository) IListFlightsUseCase {
 	return &listFlightsUseCase{
-		flightRepository: flightRepository,
+		flightRepository:     flightRepository,
+		currentFares:         currentFares,
+		fareFamilyRepository: fareFamilyRepository,
 	}
 }
 
@@ -32,6 +37,16 @@ func (u *listFlightsUseCase) Execute(ctx context.Context, page int, limit int) (
 		return nil, err
 	}
 
+	fares, err := u.currentFares.ExecuteMany(ctx, flights)
+	if err != nil {
+		return nil, err
+	}
+
+	fareFamilies, err := u.fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Map flights to DTO
-	return mappers.ToFlightSearchResponses(flights), nil
+	return mappers.ToFlightSearchResponses(flights, fares, fareFamilies), nil
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
 )
@@ -15,12 +16,16 @@ type ISearchFlightsUseCase interface {
 }
 
 type SearchFlightsUseCase struct {
-	flightRepository adapters.IFlightRepository
+	flightRepository     adapters.IFlightRepository
+	currentFares         pricing.IGetCurrentFaresUseCase
+	fareFamilyRepository adapters.IFareFamilyRepository
 }
 
-func NewSearchFlightsUseCase(flightRepository adapters.IFlightRepository) ISearchFlightsUseCase {
+func NewSearchFlightsUseCase(flightRepository adapters.IFlightRepository, currentFares pricing.IGetCurrentFaresUseCase, fareFamilyRepository adapters.IFareFamilyRepository) ISearchFlightsUseCase {
 	return &SearchFlightsUseCase{
-		flightRepository: flightRepository,
+		flightRepository:     flightRepository,
+		currentFares:         currentFares,
+		fareFamilyRepository: fareFamilyRepository,
 	}
 }
 
@@ -40,6 +45,17 @@ func (u *SearchFlightsUseCase) Execute(ctx context.Context, departureCity, arriv
 		return nil, err
 	}
 
+	// Price every cabin at its current fare
+	fares, err := u.currentFares.ExecuteMany(ctx, flights)
+	if err != nil {
+		return nil, err
+	}
+
+	fareFamilies, err := u.fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Map flights to DTO
-	return mappers.ToFlightSearchResponses(flights), nil
+	return mappers.ToFlightSearchResponses(flights, fares, fareFamilies), nil
 }
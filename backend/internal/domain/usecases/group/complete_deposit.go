@@ -0,0 +1,53 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+)
+
+type CompleteGroupDepositUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+	paymentGateway         adapters.PaymentGateway
+	paymentRepository      adapters.IPaymentRepository
+}
+
+func NewCompleteGroupDepositUseCase(groupBookingRepository adapters.IGroupBookingRepository, paymentGateway adapters.PaymentGateway, paymentRepository adapters.IPaymentRepository) payment.IPaymentSettler {
+	return &CompleteGroupDepositUseCase{
+		groupBookingRepository: groupBookingRepository,
+		paymentGateway:         paymentGateway,
+		paymentRepository:      paymentRepository,
+	}
+}
+
+// Execute marks the deposit of a group paid once its payment is captured. A group that
+// was released, or whose deposit another payment already paid, has the payment paid back
+// straight through the gateway, since it has no booking to record a refund against.
+func (u *CompleteGroupDepositUseCase) Execute(ctx context.Context, event entities.PaymentEvent) error {
+	result, err := u.groupBookingRepository.CompleteGroupDeposit(ctx, event)
+	if err != nil {
+		return err
+	}
+	if result.Paid || result.Payment.Refundable() == 0 {
+		return nil
+	}
+
+	log.Info().Int64("group_booking_id", result.GroupBooking.GroupBookingID).
+		Str("status", string(result.GroupBooking.Status)).
+		Msg("refunding group deposit received for a group no longer awaiting it")
+	amount := result.Payment.Refundable()
+	metadata := map[string]string{
+		"group_booking_id": strconv.FormatInt(result.GroupBooking.GroupBookingID, 10),
+		"payment_id":       strconv.FormatInt(result.Payment.PaymentID, 10),
+	}
+	if _, err := u.paymentGateway.CreateRefund(result.Payment.IntentID, amount, metadata); err != nil {
+		return fmt.Errorf("failed to refund group deposit: %w", err)
+	}
+	_, err = u.paymentRepository.RecordRefund(ctx, result.Payment.PaymentID, amount)
+	return err
+}
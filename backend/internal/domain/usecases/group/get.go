@@ -0,0 +1,41 @@
+package group
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetGroupBookingUseCase interface {
+	Execute(ctx context.Context, groupBookingID int64, requesterEmail string) (entities.GroupBooking, error)
+}
+
+type GetGroupBookingUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+}
+
+func NewGetGroupBookingUseCase(groupBookingRepository adapters.IGroupBookingRepository) IGetGroupBookingUseCase {
+	return &GetGroupBookingUseCase{
+		groupBookingRepository: groupBookingRepository,
+	}
+}
+
+// Execute returns the group with its passenger names.
+func (u *GetGroupBookingUseCase) Execute(ctx context.Context, groupBookingID int64, requesterEmail string) (entities.GroupBooking, error) {
+	return loadRequesterGroup(ctx, u.groupBookingRepository, groupBookingID, requesterEmail)
+}
+
+// loadRequesterGroup returns the group, hiding it from customers who did not request it.
+// An empty requesterEmail stands for an admin.
+func loadRequesterGroup(ctx context.Context, groupBookingRepository adapters.IGroupBookingRepository, groupBookingID int64, requesterEmail string) (entities.GroupBooking, error) {
+	group, err := groupBookingRepository.GetGroupBooking(ctx, groupBookingID)
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+	if requesterEmail != "" && !strings.EqualFold(group.UserEmail, requesterEmail) {
+		return entities.GroupBooking{}, adapters.ErrGroupBookingNotFound
+	}
+	return group, nil
+}
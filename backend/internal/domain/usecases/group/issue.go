@@ -0,0 +1,79 @@
+package group
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IIssueGroupBookingUseCase interface {
+	Execute(ctx context.Context, groupBookingID int64, requesterEmail string) (entities.GroupTicketingResult, error)
+}
+
+type IssueGroupBookingUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+	ticketNumberPrefix     string
+}
+
+func NewIssueGroupBookingUseCase(groupBookingRepository adapters.IGroupBookingRepository, ticketNumberPrefix string) IIssueGroupBookingUseCase {
+	return &IssueGroupBookingUseCase{
+		groupBookingRepository: groupBookingRepository,
+		ticketNumberPrefix:     ticketNumberPrefix,
+	}
+}
+
+// Execute tickets a group whose deposit is paid and whose passengers are all named. It
+// creates a booking with one ticket per passenger on each quoted flight, priced at the
+// group fare. The deposit is deducted from the booking, which is then paid like any
+// other booking; the seats blocked for the group become the booking's tickets.
+func (u *IssueGroupBookingUseCase) Execute(ctx context.Context, groupBookingID int64, requesterEmail string) (entities.GroupTicketingResult, error) {
+	group, err := loadRequesterGroup(ctx, u.groupBookingRepository, groupBookingID, requesterEmail)
+	if err != nil {
+		return entities.GroupTicketingResult{}, err
+	}
+	if err := group.CheckTicketing(); err != nil {
+		return entities.GroupTicketingResult{}, err
+	}
+
+	// Chặng đi và chặng về (nếu có) theo báo giá, mỗi khách một vé trên từng chặng
+	tripType := entities.OneWayTrip
+	flightIDs := []int64{group.OutboundFlightID}
+	if group.ReturnFlightID != 0 {
+		tripType = entities.RoundTrip
+		flightIDs = append(flightIDs, group.ReturnFlightID)
+	}
+	booking := entities.CreateBookingParams{
+		Email:              group.UserEmail,
+		DepartureCity:      group.DepartureCity,
+		ArrivalCity:        group.ArrivalCity,
+		TripType:           tripType,
+		TicketNumberPrefix: u.ticketNumberPrefix,
+	}
+	for i, flightID := range flightIDs {
+		travelDate := group.DepartureDate
+		if i > 0 && group.ReturnDate != nil {
+			travelDate = *group.ReturnDate
+		}
+		fare := group.SegmentFare(i, len(flightIDs))
+		segment := entities.BookingSegment{SegmentOrder: i + 1, FlightID: flightID}
+		for _, passenger := range group.Passengers {
+			segment.Tickets = append(segment.Tickets, entities.Ticket{
+				Price:         int32(fare),
+				FlightClass:   group.FlightClass,
+				FareFamily:    entities.FareFamilyClassic,
+				PassengerType: entities.PassengerTypeAt(passenger.DateOfBirth, travelDate),
+				FareItems: []entities.FareItem{{
+					Type:        entities.FareItemBaseFare,
+					Code:        entities.FareCodeGroup,
+					Description: "Group fare",
+					Amount:      fare,
+				}},
+				Owner: passenger,
+			})
+		}
+		booking.Segments = append(booking.Segments, segment)
+	}
+
+	return u.groupBookingRepository.IssueGroupBooking(ctx, group.GroupBookingID, booking)
+}
@@ -0,0 +1,27 @@
+package group
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListGroupBookingsUseCase interface {
+	Execute(ctx context.Context, requesterEmail string) ([]entities.GroupBooking, error)
+}
+
+type ListGroupBookingsUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+}
+
+func NewListGroupBookingsUseCase(groupBookingRepository adapters.IGroupBookingRepository) IListGroupBookingsUseCase {
+	return &ListGroupBookingsUseCase{
+		groupBookingRepository: groupBookingRepository,
+	}
+}
+
+// Execute lists the groups of the requester; an empty requesterEmail (admin) lists them all.
+func (u *ListGroupBookingsUseCase) Execute(ctx context.Context, requesterEmail string) ([]entities.GroupBooking, error) {
+	return u.groupBookingRepository.ListGroupBookings(ctx, requesterEmail)
+}
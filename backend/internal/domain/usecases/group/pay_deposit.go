@@ -0,0 +1,66 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IPayGroupDepositUseCase interface {
+	Execute(ctx context.Context, groupBookingID int64, requesterEmail string) (entities.GroupDepositResult, error)
+}
+
+type PayGroupDepositUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+	paymentGateway         adapters.PaymentGateway
+	currency               string
+}
+
+func NewPayGroupDepositUseCase(groupBookingRepository adapters.IGroupBookingRepository, paymentGateway adapters.PaymentGateway, currency string) IPayGroupDepositUseCase {
+	return &PayGroupDepositUseCase{
+		groupBookingRepository: groupBookingRepository,
+		paymentGateway:         paymentGateway,
+		currency:               currency,
+	}
+}
+
+// Execute creates the payment intent collecting the deposit of a quoted group. The
+// deposit only counts as paid, keeping the seats blocked past the deposit deadline, once
+// the payment webhook confirms the intent.
+func (u *PayGroupDepositUseCase) Execute(ctx context.Context, groupBookingID int64, requesterEmail string) (entities.GroupDepositResult, error) {
+	group, err := loadRequesterGroup(ctx, u.groupBookingRepository, groupBookingID, requesterEmail)
+	if err != nil {
+		return entities.GroupDepositResult{}, err
+	}
+	if err := group.CheckDepositPayable(time.Now()); err != nil {
+		return entities.GroupDepositResult{}, err
+	}
+
+	// 1. Tạo payment intent cho tiền cọc
+	var result entities.GroupDepositResult
+	metadata := map[string]string{
+		"group_booking_id": strconv.FormatInt(group.GroupBookingID, 10),
+		"purpose":          string(entities.PaymentPurposeGroupDeposit),
+	}
+	intent, err := u.paymentGateway.CreatePaymentIntent(group.DepositAmount, u.currency, metadata)
+	if err != nil {
+		return entities.GroupDepositResult{}, fmt.Errorf("failed to create payment intent: %w", err)
+	}
+	result.PaymentClientSecret = intent.ClientSecret
+
+	// 2. Ghi payment chờ thu; hạn cọc có thể vừa qua trong lúc tạo thanh toán
+	result.GroupBooking, err = u.groupBookingRepository.RequestGroupDeposit(ctx, group.GroupBookingID, entities.Payment{
+		IntentID: intent.ID,
+		Amount:   group.DepositAmount,
+		Currency: u.currency,
+	})
+	if err != nil {
+		return entities.GroupDepositResult{}, err
+	}
+	result.GroupBooking.Passengers = group.Passengers
+	return result, nil
+}
@@ -0,0 +1,132 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/worker"
+)
+
+type IQuoteGroupBookingUseCase interface {
+	Execute(ctx context.Context, groupBookingID int64, quote entities.GroupQuote) (entities.GroupBooking, error)
+}
+
+type QuoteGroupBookingUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+	flightRepository       adapters.IFlightRepository
+	taskDistributor        worker.TaskDistributor
+}
+
+func NewQuoteGroupBookingUseCase(groupBookingRepository adapters.IGroupBookingRepository, flightRepository adapters.IFlightRepository, taskDistributor worker.TaskDistributor) IQuoteGroupBookingUseCase {
+	return &QuoteGroupBookingUseCase{
+		groupBookingRepository: groupBookingRepository,
+		flightRepository:       flightRepository,
+		taskDistributor:        taskDistributor,
+	}
+}
+
+// Execute answers a group request with a group fare on chosen flights. The quote blocks
+// seats for the whole group until the deposit deadline, when a scheduled task releases
+// them unless the deposit has been paid.
+func (u *QuoteGroupBookingUseCase) Execute(ctx context.Context, groupBookingID int64, quote entities.GroupQuote) (entities.GroupBooking, error) {
+	group, err := u.groupBookingRepository.GetGroupBooking(ctx, groupBookingID)
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+
+	// 1. Kiểm tra chuyến bay khớp hành trình và điều kiện báo giá
+	now := time.Now()
+	outbound, err := u.flightRepository.GetFlightByID(ctx, quote.OutboundFlightID)
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+	flights := []entities.Flight{*outbound}
+	var inbound *entities.Flight
+	if quote.ReturnFlightID != 0 {
+		inbound, err = u.flightRepository.GetFlightByID(ctx, quote.ReturnFlightID)
+		if err != nil {
+			return entities.GroupBooking{}, err
+		}
+		flights = append(flights, *inbound)
+	}
+	if err := group.CheckQuote(quote, *outbound, inbound, now); err != nil {
+		return entities.GroupBooking{}, err
+	}
+
+	// 2. Chuyến bay phải còn đủ chỗ cho cả đoàn, không tính chỗ đoàn đang giữ
+	for _, flight := range flights {
+		taken, err := u.flightRepository.CountSoldSeats(ctx, flight.FlightID)
+		if err != nil {
+			return entities.GroupBooking{}, err
+		}
+		if group.HoldsSeats(now) && (group.OutboundFlightID == flight.FlightID || group.ReturnFlightID == flight.FlightID) {
+			taken -= int64(group.Headcount)
+		}
+		if flight.Capacity()-taken < int64(group.Headcount) {
+			return entities.GroupBooking{}, adapters.ErrNotEnoughSeats
+		}
+	}
+
+	// 3. Lưu báo giá và lên lịch trả chỗ khi hết hạn cọc
+	group, err = u.groupBookingRepository.QuoteGroupBooking(ctx, groupBookingID, quote)
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+	err = u.taskDistributor.DistributeTaskReleaseGroupBooking(ctx, &worker.PayloadReleaseGroupBooking{
+		GroupBookingID:  group.GroupBookingID,
+		DepositDeadline: quote.DepositDeadline,
+	}, asynq.MaxRetry(10), asynq.ProcessAt(quote.DepositDeadline), asynq.Queue(worker.QueueCritical))
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+
+	if err := u.sendQuoteEmail(ctx, group, flights); err != nil {
+		log.Error().Err(err).Int64("group_booking_id", group.GroupBookingID).Msg("failed to enqueue quote email")
+	}
+	return group, nil
+}
+
+func (u *QuoteGroupBookingUseCase) sendQuoteEmail(ctx context.Context, group entities.GroupBooking, flights []entities.Flight) error {
+	flightLines := ""
+	for _, flight := range flights {
+		flightLines += fmt.Sprintf("<li>%s: %s - %s, khởi hành %s</li>", flight.FlightNumber, flight.DepartureCity, flight.ArrivalCity, flight.DepartureTime.Format("02/01/2006 15:04"))
+	}
+	taskPayload := &worker.PayloadSendVerifyEmail{
+		To:      group.UserEmail,
+		Subject: fmt.Sprintf("Báo giá đặt chỗ đoàn #%d", group.GroupBookingID),
+		Body: fmt.Sprintf(
+			`<html>
+				<body>
+					<h2>Xin chào,</h2>
+					<p>Yêu cầu đặt chỗ cho đoàn <b>%d khách</b> của bạn đã được báo giá.</p>
+					<ul>%s</ul>
+					<p><strong>Giá vé mỗi khách:</strong> %d</p>
+					<p><strong>Tổng tiền:</strong> %d</p>
+					<p><strong>Tiền cọc:</strong> %d, thanh toán trước %s</p>
+					<p>Danh sách tên khách có thể bổ sung đến %s.</p>
+					<p>Nếu chưa đặt cọc trước hạn, các chỗ đang giữ cho đoàn sẽ được trả lại.</p>
+					<br>
+					<p>Trân trọng,<br>
+					<b>Đội ngũ Qairlines</b></p>
+				</body>
+				</html>`,
+			group.Headcount,
+			flightLines,
+			group.FarePerPassenger,
+			group.TotalFare(),
+			group.DepositAmount,
+			group.DepositDeadline.Format("02/01/2006 15:04"),
+			group.NameCutoff.Format("02/01/2006 15:04"),
+		),
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+	return u.taskDistributor.DistributeTaskSendVerifyEmail(ctx, taskPayload, opts...)
+}
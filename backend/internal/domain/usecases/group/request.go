@@ -0,0 +1,33 @@
+package group
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IRequestGroupBookingUseCase interface {
+	Execute(ctx context.Context, group entities.GroupBooking) (entities.GroupBooking, error)
+}
+
+type RequestGroupBookingUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+	minPassengers          int32
+}
+
+func NewRequestGroupBookingUseCase(groupBookingRepository adapters.IGroupBookingRepository, minPassengers int32) IRequestGroupBookingUseCase {
+	return &RequestGroupBookingUseCase{
+		groupBookingRepository: groupBookingRepository,
+		minPassengers:          minPassengers,
+	}
+}
+
+// Execute records a group request for an admin to quote.
+func (u *RequestGroupBookingUseCase) Execute(ctx context.Context, group entities.GroupBooking) (entities.GroupBooking, error) {
+	if err := group.ValidateRequest(u.minPassengers, time.Now()); err != nil {
+		return entities.GroupBooking{}, err
+	}
+	return u.groupBookingRepository.CreateGroupBooking(ctx, group)
+}
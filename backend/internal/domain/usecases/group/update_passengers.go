@@ -0,0 +1,82 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IUpdateGroupPassengersUseCase interface {
+	Execute(ctx context.Context, groupBookingID int64, requesterEmail string, passengers []entities.TicketOwner) (entities.GroupBooking, error)
+}
+
+type UpdateGroupPassengersUseCase struct {
+	groupBookingRepository adapters.IGroupBookingRepository
+	flightRepository       adapters.IFlightRepository
+	documentPolicy         entities.DocumentPolicy
+}
+
+func NewUpdateGroupPassengersUseCase(groupBookingRepository adapters.IGroupBookingRepository, flightRepository adapters.IFlightRepository, documentPolicy entities.DocumentPolicy) IUpdateGroupPassengersUseCase {
+	return &UpdateGroupPassengersUseCase{
+		groupBookingRepository: groupBookingRepository,
+		flightRepository:       flightRepository,
+		documentPolicy:         documentPolicy,
+	}
+}
+
+// Execute replaces the passenger names of the group. Names may be given in several
+// rounds, each one sending the full list, until the name cutoff. Names and documents
+// are checked against the quoted flights as when booking.
+func (u *UpdateGroupPassengersUseCase) Execute(ctx context.Context, groupBookingID int64, requesterEmail string, passengers []entities.TicketOwner) (entities.GroupBooking, error) {
+	group, err := loadRequesterGroup(ctx, u.groupBookingRepository, groupBookingID, requesterEmail)
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+	if err := group.CheckPassengers(passengers, time.Now()); err != nil {
+		return entities.GroupBooking{}, err
+	}
+	if fields, err := u.checkDocuments(ctx, group, passengers); err != nil {
+		return entities.GroupBooking{}, err
+	} else if len(fields) > 0 {
+		return entities.GroupBooking{}, &entities.DocumentError{Fields: fields}
+	}
+
+	group.Passengers, err = u.groupBookingRepository.ReplaceGroupPassengers(ctx, group.GroupBookingID, passengers)
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+	return group, nil
+}
+
+// checkDocuments returns the problems with the names and documents of passengers on the
+// quoted flights of the group, named by their JSON path in the request.
+func (u *UpdateGroupPassengersUseCase) checkDocuments(ctx context.Context, group entities.GroupBooking, passengers []entities.TicketOwner) ([]entities.FieldError, error) {
+	var flights []entities.Flight
+	for _, flightID := range []int64{group.OutboundFlightID, group.ReturnFlightID} {
+		if flightID == 0 {
+			continue
+		}
+		flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+		if err != nil {
+			return nil, err
+		}
+		flights = append(flights, *flight)
+	}
+	if len(flights) == 0 {
+		return nil, nil
+	}
+
+	international := u.documentPolicy.International(flights)
+	departureDate := flights[0].DepartureTime
+	returnDate := flights[len(flights)-1].DepartureTime
+	var fields []entities.FieldError
+	for i, passenger := range passengers {
+		for _, field := range u.documentPolicy.CheckPassenger(passenger, international, departureDate, returnDate) {
+			fields = append(fields, entities.FieldError{Field: fmt.Sprintf("passengers[%d].%s", i, field.Field), Message: field.Message})
+		}
+	}
+	return fields, nil
+}
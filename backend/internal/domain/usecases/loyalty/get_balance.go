@@ -0,0 +1,37 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetLoyaltyBalanceUseCase interface {
+	Execute(ctx context.Context, userID int64) (entities.LoyaltyAccount, error)
+}
+
+type GetLoyaltyBalanceUseCase struct {
+	loyaltyRepository adapters.ILoyaltyRepository
+	loyaltyRules      entities.LoyaltyRules
+}
+
+func NewGetLoyaltyBalanceUseCase(loyaltyRepository adapters.ILoyaltyRepository, loyaltyRules entities.LoyaltyRules) IGetLoyaltyBalanceUseCase {
+	return &GetLoyaltyBalanceUseCase{
+		loyaltyRepository: loyaltyRepository,
+		loyaltyRules:      loyaltyRules,
+	}
+}
+
+// Execute returns the points the customer can still use and what they are worth.
+func (u *GetLoyaltyBalanceUseCase) Execute(ctx context.Context, userID int64) (entities.LoyaltyAccount, error) {
+	points, err := u.loyaltyRepository.GetBalance(ctx, userID, time.Now())
+	if err != nil {
+		return entities.LoyaltyAccount{}, err
+	}
+	return entities.LoyaltyAccount{
+		Points: points,
+		Value:  int64(points) * u.loyaltyRules.PointValue,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package loyalty
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetLoyaltyTierUseCase interface {
+	Execute(ctx context.Context, userID int64) (entities.TierStatus, error)
+}
+
+type GetLoyaltyTierUseCase struct {
+	loyaltyRepository adapters.ILoyaltyRepository
+	tierPolicy        entities.TierPolicy
+}
+
+func NewGetLoyaltyTierUseCase(loyaltyRepository adapters.ILoyaltyRepository, tierPolicy entities.TierPolicy) IGetLoyaltyTierUseCase {
+	return &GetLoyaltyTierUseCase{
+		loyaltyRepository: loyaltyRepository,
+		tierPolicy:        tierPolicy,
+	}
+}
+
+// Execute returns the customer's tier with its benefits, the qualifying points earned in
+// the rolling window and how many more the next tier needs.
+func (u *GetLoyaltyTierUseCase) Execute(ctx context.Context, userID int64) (entities.TierStatus, error) {
+	tier, err := u.loyaltyRepository.GetTier(ctx, userID)
+	if err != nil {
+		return entities.TierStatus{}, err
+	}
+	now := time.Now()
+	points, err := u.loyaltyRepository.GetQualifyingPoints(ctx, userID, u.tierPolicy.WindowStart(now))
+	if err != nil {
+		return entities.TierStatus{}, err
+	}
+	return u.tierPolicy.Status(tier, points, now), nil
+}
@@ -0,0 +1,102 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IRedeemLoyaltyPointsUseCase interface {
+	Execute(ctx context.Context, userID int64, email string, bookingID int64, points int32) (entities.RedeemLoyaltyResult, error)
+}
+
+type RedeemLoyaltyPointsUseCase struct {
+	loyaltyRepository adapters.ILoyaltyRepository
+	bookingRepository adapters.IBookingRepository
+	loyaltyRules      entities.LoyaltyRules
+	walletRepository  adapters.IWalletRepository
+	paymentRepository adapters.IPaymentRepository
+	flightRepository  adapters.IFlightRepository
+	loyaltyScheduler  adapters.ILoyaltyScheduler
+}
+
+func NewRedeemLoyaltyPointsUseCase(loyaltyRepository adapters.ILoyaltyRepository, bookingRepository adapters.IBookingRepository, loyaltyRules entities.LoyaltyRules, walletRepository adapters.IWalletRepository, paymentRepository adapters.IPaymentRepository, flightRepository adapters.IFlightRepository, loyaltyScheduler adapters.ILoyaltyScheduler) IRedeemLoyaltyPointsUseCase {
+	return &RedeemLoyaltyPointsUseCase{
+		loyaltyRepository: loyaltyRepository,
+		bookingRepository: bookingRepository,
+		loyaltyRules:      loyaltyRules,
+		walletRepository:  walletRepository,
+		paymentRepository: paymentRepository,
+		flightRepository:  flightRepository,
+		loyaltyScheduler:  loyaltyScheduler,
+	}
+}
+
+// Execute pays part or all of the customer's unpaid booking with points. The payment
+// intent created afterwards only charges what the points did not cover; when the points
+// cover the whole booking it is confirmed in the same transaction as the redemption.
+func (u *RedeemLoyaltyPointsUseCase) Execute(ctx context.Context, userID int64, email string, bookingID int64, points int32) (entities.RedeemLoyaltyResult, error) {
+	booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.RedeemLoyaltyResult{}, adapters.ErrBookingNotFound
+		}
+		return entities.RedeemLoyaltyResult{}, err
+	}
+	// Khách hàng chỉ được dùng điểm cho booking của chính mình
+	if !strings.EqualFold(booking.UserEmail, email) {
+		return entities.RedeemLoyaltyResult{}, adapters.ErrBookingNotFound
+	}
+
+	var amountDue int64
+	for _, segment := range booking.Segments {
+		for _, ticket := range segment.Tickets {
+			amountDue += ticket.AmountDue()
+		}
+	}
+	// Phần đã trả bằng ví không được trả lại bằng điểm
+	walletPaid, err := u.walletRepository.GetPaidAmount(ctx, bookingID)
+	if err != nil {
+		return entities.RedeemLoyaltyResult{}, err
+	}
+	amountDue -= walletPaid
+	// Tiền đã thu trước cho booking, như tiền cọc của đoàn, được trừ vào số phải trả
+	captured, err := u.paymentRepository.ListCapturedPayments(ctx, bookingID)
+	if err != nil {
+		return entities.RedeemLoyaltyResult{}, err
+	}
+	for _, payment := range captured {
+		amountDue -= payment.Refundable()
+	}
+
+	result, err := u.loyaltyRepository.RedeemPoints(ctx, entities.RedeemLoyaltyParams{
+		UserID:     userID,
+		BookingID:  bookingID,
+		Points:     points,
+		PointValue: u.loyaltyRules.PointValue,
+		AmountDue:  amountDue,
+	})
+	if err != nil {
+		return entities.RedeemLoyaltyResult{}, err
+	}
+	if !result.Confirmed {
+		return result, nil
+	}
+
+	// Booking đã được xác nhận nên lỗi khi lên lịch cộng điểm chỉ được ghi log
+	for _, segment := range booking.Segments {
+		flight, err := u.flightRepository.GetFlightByID(ctx, segment.FlightID)
+		if err != nil {
+			log.Error().Err(err).Int64("flight_id", segment.FlightID).Msg("failed to load flight to schedule loyalty points")
+			continue
+		}
+		if err := u.loyaltyScheduler.ScheduleAccrual(ctx, *flight); err != nil {
+			log.Error().Err(err).Int64("flight_id", flight.FlightID).Msg("failed to schedule loyalty points")
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package loyalty_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	mockadapters "github.com/spaghetti-lover/qairlines/internal/domain/mock/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/loyalty"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedeemLoyaltyPointsUseCase(t *testing.T) {
+	booking := entities.Booking{
+		BookingID: 42,
+		UserEmail: "an@example.com",
+		Status:    entities.BookingStatusPending,
+		Segments: []entities.BookingSegment{
+			{FlightID: 3, Tickets: []entities.Ticket{{TicketID: 1, Price: 1500000, Status: entities.TicketStatusActive}}},
+		},
+	}
+	rules := entities.LoyaltyRules{PointValue: 100}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(loyaltyRepo *mockadapters.MockILoyaltyRepository, flightRepo *mockadapters.MockIFlightRepository, scheduler *mockadapters.MockILoyaltyScheduler)
+		check      func(t *testing.T, result entities.RedeemLoyaltyResult, err error)
+	}{
+		{
+			name: "PartlyPaid",
+			buildStubs: func(loyaltyRepo *mockadapters.MockILoyaltyRepository, flightRepo *mockadapters.MockIFlightRepository, scheduler *mockadapters.MockILoyaltyScheduler) {
+				loyaltyRepo.EXPECT().
+					RedeemPoints(gomock.Any(), entities.RedeemLoyaltyParams{UserID: 7, BookingID: 42, Points: 2000, PointValue: 100, AmountDue: 1000000}).
+					Times(1).
+					Return(entities.RedeemLoyaltyResult{AmountDue: 800000, Booking: booking}, nil)
+				flightRepo.EXPECT().GetFlightByID(gomock.Any(), gomock.Any()).Times(0)
+				scheduler.EXPECT().ScheduleAccrual(gomock.Any(), gomock.Any()).Times(0)
+			},
+			check: func(t *testing.T, result entities.RedeemLoyaltyResult, err error) {
+				require.NoError(t, err)
+				require.False(t, result.Confirmed)
+				require.Equal(t, int64(800000), result.AmountDue)
+			},
+		},
+		{
+			// Điểm trả hết phần còn lại: booking được xác nhận và chuyến bay được lên lịch cộng điểm
+			name: "PaidInFull",
+			buildStubs: func(loyaltyRepo *mockadapters.MockILoyaltyRepository, flightRepo *mockadapters.MockIFlightRepository, scheduler *mockadapters.MockILoyaltyScheduler) {
+				confirmed := booking
+				confirmed.Status = entities.BookingStatusConfirmed
+				loyaltyRepo.EXPECT().
+					RedeemPoints(gomock.Any(), entities.RedeemLoyaltyParams{UserID: 7, BookingID: 42, Points: 2000, PointValue: 100, AmountDue: 1000000}).
+					Times(1).
+					Return(entities.RedeemLoyaltyResult{AmountDue: 0, Booking: confirmed, Confirmed: true}, nil)
+				flightRepo.EXPECT().GetFlightByID(gomock.Any(), int64(3)).Times(1).Return(&entities.Flight{FlightID: 3}, nil)
+				scheduler.EXPECT().ScheduleAccrual(gomock.Any(), entities.Flight{FlightID: 3}).Times(1).Return(nil)
+			},
+			check: func(t *testing.T, result entities.RedeemLoyaltyResult, err error) {
+				require.NoError(t, err)
+				require.True(t, result.Confirmed)
+				require.Equal(t, entities.BookingStatusConfirmed, result.Booking.Status)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			loyaltyRepo := mockadapters.NewMockILoyaltyRepository(ctrl)
+			bookingRepo := mockadapters.NewMockIBookingRepository(ctrl)
+			walletRepo := mockadapters.NewMockIWalletRepository(ctrl)
+			paymentRepo := mockadapters.NewMockIPaymentRepository(ctrl)
+			flightRepo := mockadapters.NewMockIFlightRepository(ctrl)
+			scheduler := mockadapters.NewMockILoyaltyScheduler(ctrl)
+
+			bookingRepo.EXPECT().GetBookingByID(gomock.Any(), int64(42)).Times(1).Return(booking, nil, nil, nil)
+			walletRepo.EXPECT().GetPaidAmount(gomock.Any(), int64(42)).Times(1).Return(int64(200000), nil)
+			// Tiền cọc đã thu bằng thẻ không được trả lại bằng điểm
+			paymentRepo.EXPECT().ListCapturedPayments(gomock.Any(), int64(42)).Times(1).Return([]entities.Payment{
+				{Status: entities.PaymentStatusSucceeded, AmountReceived: 300000},
+			}, nil)
+			tc.buildStubs(loyaltyRepo, flightRepo, scheduler)
+
+			useCase := loyalty.NewRedeemLoyaltyPointsUseCase(loyaltyRepo, bookingRepo, rules, walletRepo, paymentRepo, flightRepo, scheduler)
+			result, err := useCase.Execute(context.Background(), 7, "AN@example.com", 42, 2000)
+			tc.check(t, result, err)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package loyalty
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetLoyaltyStatementUseCase interface {
+	Execute(ctx context.Context, userID int64, page int, limit int) (entities.LoyaltyStatement, error)
+}
+
+type GetLoyaltyStatementUseCase struct {
+	loyaltyRepository adapters.ILoyaltyRepository
+}
+
+func NewGetLoyaltyStatementUseCase(loyaltyRepository adapters.ILoyaltyRepository) IGetLoyaltyStatementUseCase {
+	return &GetLoyaltyStatementUseCase{
+		loyaltyRepository: loyaltyRepository,
+	}
+}
+
+func (u *GetLoyaltyStatementUseCase) Execute(ctx context.Context, userID int64, page int, limit int) (entities.LoyaltyStatement, error) {
+	offset := (page - 1) * limit
+	return u.loyaltyRepository.ListTransactions(ctx, userID, int32(limit), int32(offset))
+}
@@ -2,28 +2,93 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 )
 
 type ICreatePaymentIntentUsecase interface {
-	Execute(ctx context.Context, bookingID int64, amount int64, currency string) (string, error)
+	Execute(ctx context.Context, bookingID int64, currency string) (clientSecret string, amount int64, err error)
 }
 
 type CreatePaymentIntentUseCase struct {
-	gateway adapters.PaymentGateway
+	gateway           adapters.PaymentGateway
+	bookingRepository adapters.IBookingRepository
+	loyaltyRepository adapters.ILoyaltyRepository
+	walletRepository  adapters.IWalletRepository
+	paymentRepository adapters.IPaymentRepository
 }
 
-func NewCreatePaymentIntentUseCase(gateway adapters.PaymentGateway) ICreatePaymentIntentUsecase {
-	return &CreatePaymentIntentUseCase{gateway: gateway}
+func NewCreatePaymentIntentUseCase(gateway adapters.PaymentGateway, bookingRepository adapters.IBookingRepository, loyaltyRepository adapters.ILoyaltyRepository, walletRepository adapters.IWalletRepository, paymentRepository adapters.IPaymentRepository) ICreatePaymentIntentUsecase {
+	return &CreatePaymentIntentUseCase{gateway: gateway, bookingRepository: bookingRepository, loyaltyRepository: loyaltyRepository, walletRepository: walletRepository, paymentRepository: paymentRepository}
 }
 
-func (u *CreatePaymentIntentUseCase) Execute(ctx context.Context, bookingID int64, amount int64, currency string) (string, error) {
-	metadata := map[string]string{"booking_id": fmt.Sprintf("%d", bookingID)}
-	clientSecret, err := u.gateway.CreatePaymentIntent(amount, currency, metadata)
+// Execute charges a pending booking for its active tickets and their add-ons, less what
+// was paid with loyalty points and travel credit and what was already captured for it,
+// such as a group deposit; any other booking returns
+// adapters.ErrBookingNotPayable. The amount is computed on the server and returned so the
+// client can show what is being charged. Nothing is charged when points and credit cover
+// it all. The booking is confirmed once the payment webhook reports the intent as paid.
+func (u *CreatePaymentIntentUseCase) Execute(ctx context.Context, bookingID int64, currency string) (string, int64, error) {
+	booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, bookingID)
 	if err != nil {
-		return "", err
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return "", 0, adapters.ErrBookingNotFound
+		}
+		return "", 0, err
 	}
-	return clientSecret, nil
+	// Booking đã thanh toán thì các khoản mua thêm có payment riêng, không thu lại cả booking
+	if booking.Status != entities.BookingStatusPending {
+		return "", 0, adapters.ErrBookingNotPayable
+	}
+
+	var amountDue int64
+	for _, segment := range booking.Segments {
+		for _, ticket := range segment.Tickets {
+			amountDue += ticket.AmountDue()
+		}
+	}
+	redeemed, err := u.loyaltyRepository.GetRedeemedAmount(ctx, bookingID)
+	if err != nil {
+		return "", 0, err
+	}
+	walletPaid, err := u.walletRepository.GetPaidAmount(ctx, bookingID)
+	if err != nil {
+		return "", 0, err
+	}
+	// Tiền đã thu trước cho booking, như tiền cọc của đoàn, được trừ vào số phải trả
+	captured, err := u.paymentRepository.ListCapturedPayments(ctx, bookingID)
+	if err != nil {
+		return "", 0, err
+	}
+	for _, payment := range captured {
+		amountDue -= payment.Refundable()
+	}
+	amountDue -= redeemed + walletPaid
+	if amountDue <= 0 {
+		return "", 0, nil
+	}
+
+	metadata := map[string]string{
+		"booking_id": fmt.Sprintf("%d", bookingID),
+		"purpose":    string(entities.PaymentPurposeBooking),
+	}
+	intent, err := u.gateway.CreatePaymentIntent(amountDue, currency, metadata)
+	if err != nil {
+		return "", 0, err
+	}
+	_, err = u.paymentRepository.CreatePayment(ctx, entities.Payment{
+		BookingID:   bookingID,
+		Purpose:     entities.PaymentPurposeBooking,
+		ReferenceID: bookingID,
+		IntentID:    intent.ID,
+		Amount:      amountDue,
+		Currency:    currency,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return intent.ClientSecret, amountDue, nil
 }
@@ -0,0 +1,70 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// IPaymentSettler applies what a captured payment paid for. The use cases owning a
+// payment purpose implement it; a payment settled before returns adapters.ErrPaymentSettled.
+type IPaymentSettler interface {
+	Execute(ctx context.Context, event entities.PaymentEvent) error
+}
+
+type IHandlePaymentEventUseCase interface {
+	Execute(ctx context.Context, payload []byte, signature string) error
+}
+
+type HandlePaymentEventUseCase struct {
+	gateway           adapters.PaymentGateway
+	paymentRepository adapters.IPaymentRepository
+	settlers          map[entities.PaymentPurpose]IPaymentSettler
+}
+
+func NewHandlePaymentEventUseCase(gateway adapters.PaymentGateway, paymentRepository adapters.IPaymentRepository, settlers map[entities.PaymentPurpose]IPaymentSettler) IHandlePaymentEventUseCase {
+	return &HandlePaymentEventUseCase{
+		gateway:           gateway,
+		paymentRepository: paymentRepository,
+		settlers:          settlers,
+	}
+}
+
+// Execute handles a webhook call of the payment gateway. A captured payment is settled
+// by the use case of its purpose, a failed one releases what was waiting for it.
+// Events about intents this service did not record and events delivered twice are
+// acknowledged without effect, so the gateway stops retrying them.
+func (u *HandlePaymentEventUseCase) Execute(ctx context.Context, payload []byte, signature string) error {
+	event, err := u.gateway.ParseEvent(payload, signature)
+	if err != nil {
+		return adapters.ErrInvalidPaymentEvent
+	}
+
+	switch event.Type {
+	case entities.PaymentEventSucceeded:
+		payment, err := u.paymentRepository.GetPaymentByIntentID(ctx, event.IntentID)
+		if err != nil {
+			if errors.Is(err, adapters.ErrPaymentNotFound) {
+				return nil
+			}
+			return err
+		}
+		settler, ok := u.settlers[payment.Purpose]
+		if !ok {
+			return fmt.Errorf("no settler for payment purpose %q", payment.Purpose)
+		}
+		err = settler.Execute(ctx, event)
+		if err != nil && !errors.Is(err, adapters.ErrPaymentSettled) {
+			return err
+		}
+	case entities.PaymentEventFailed:
+		_, err := u.paymentRepository.FailPayment(ctx, event.IntentID)
+		if err != nil && !errors.Is(err, adapters.ErrPaymentNotFound) && !errors.Is(err, adapters.ErrPaymentSettled) {
+			return err
+		}
+	}
+	return nil
+}
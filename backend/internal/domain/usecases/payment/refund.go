@@ -0,0 +1,68 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IRefundPaymentUseCase interface {
+	Execute(ctx context.Context, refund entities.Refund) (entities.Refund, error)
+}
+
+type RefundPaymentUseCase struct {
+	gateway           adapters.PaymentGateway
+	paymentRepository adapters.IPaymentRepository
+	bookingRepository adapters.IBookingRepository
+}
+
+func NewRefundPaymentUseCase(gateway adapters.PaymentGateway, paymentRepository adapters.IPaymentRepository, bookingRepository adapters.IBookingRepository) IRefundPaymentUseCase {
+	return &RefundPaymentUseCase{
+		gateway:           gateway,
+		paymentRepository: paymentRepository,
+		bookingRepository: bookingRepository,
+	}
+}
+
+// Execute pays a pending refund back through the payment gateway, against the payments
+// captured for its booking. Only what was captured can be paid back: when too little was
+// captured the refund stays pending for the back office, and when the gateway refuses it
+// the refund is marked failed. Either way the refund is returned with its new status.
+func (u *RefundPaymentUseCase) Execute(ctx context.Context, refund entities.Refund) (entities.Refund, error) {
+	if refund.Status != entities.RefundStatusPending || refund.Method != entities.RefundMethodOriginal {
+		return refund, nil
+	}
+
+	payments, err := u.paymentRepository.ListCapturedPayments(ctx, refund.BookingID)
+	if err != nil {
+		return refund, err
+	}
+	parts, unallocated := entities.AllocateRefund(payments, refund.Amount)
+	if unallocated > 0 {
+		return refund, nil
+	}
+
+	metadata := map[string]string{
+		"booking_id": strconv.FormatInt(refund.BookingID, 10),
+		"refund_id":  strconv.FormatInt(refund.RefundID, 10),
+	}
+	status := entities.RefundStatusCompleted
+	for _, part := range parts {
+		if _, err := u.gateway.CreateRefund(part.Payment.IntentID, part.Amount, metadata); err != nil {
+			status = entities.RefundStatusFailed
+			break
+		}
+		if _, err := u.paymentRepository.RecordRefund(ctx, part.Payment.PaymentID, part.Amount); err != nil {
+			return refund, err
+		}
+	}
+
+	updated, err := u.bookingRepository.UpdateRefundStatus(ctx, refund.RefundID, status)
+	if err != nil {
+		return refund, fmt.Errorf("failed to update refund %d: %w", refund.RefundID, err)
+	}
+	return updated, nil
+}
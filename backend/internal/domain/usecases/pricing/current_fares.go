@@ -0,0 +1,87 @@
+package pricing
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetCurrentFaresUseCase interface {
+	Execute(ctx context.Context, flight entities.Flight) (map[entities.FlightClass]int64, error)
+	// ExecuteMany prices a list of flights, such as search results, with one lookup of the
+	// curves and one count of the sold seats for all of them.
+	ExecuteMany(ctx context.Context, flights []entities.Flight) ([]map[entities.FlightClass]int64, error)
+}
+
+type GetCurrentFaresUseCase struct {
+	pricingCurveRepository adapters.IPricingCurveRepository
+	flightRepository       adapters.IFlightRepository
+	cabins                 entities.CabinFares
+	layout                 entities.CabinLayout
+}
+
+func NewGetCurrentFaresUseCase(pricingCurveRepository adapters.IPricingCurveRepository, flightRepository adapters.IFlightRepository, cabins entities.CabinFares, layout entities.CabinLayout) IGetCurrentFaresUseCase {
+	return &GetCurrentFaresUseCase{
+		pricingCurveRepository: pricingCurveRepository,
+		flightRepository:       flightRepository,
+		cabins:                 cabins,
+		layout:                 layout,
+	}
+}
+
+// Execute prices every cabin of flight from the load factor of that cabin and the time
+// left before departure.
+func (u *GetCurrentFaresUseCase) Execute(ctx context.Context, flight entities.Flight) (map[entities.FlightClass]int64, error) {
+	curves, err := u.pricingCurveRepository.ListPricingCurvesByRoute(ctx, flight.DepartureCity, flight.ArrivalCity)
+	if err != nil {
+		return nil, err
+	}
+
+	var soldSeats map[entities.FlightClass]int64
+	if len(curves) > 0 {
+		sold, err := u.flightRepository.CountSoldSeatsByClass(ctx, []int64{flight.FlightID})
+		if err != nil {
+			return nil, err
+		}
+		soldSeats = sold[flight.FlightID]
+	}
+
+	return entities.CurrentFares(flight, u.cabins, curves, u.layout.Loads(flight, soldSeats), time.Now()), nil
+}
+
+func (u *GetCurrentFaresUseCase) ExecuteMany(ctx context.Context, flights []entities.Flight) ([]map[entities.FlightClass]int64, error) {
+	allCurves, err := u.pricingCurveRepository.ListPricingCurves(ctx)
+	if err != nil {
+		return nil, err
+	}
+	curvesByRoute := make(map[[2]string][]entities.PricingCurve)
+	for _, curve := range allCurves {
+		route := [2]string{curve.DepartureCity, curve.ArrivalCity}
+		curvesByRoute[route] = append(curvesByRoute[route], curve)
+	}
+
+	// Chỉ đếm ghế đã bán của các chuyến có đường cong giá
+	var priced []int64
+	for _, flight := range flights {
+		if len(curvesByRoute[[2]string{flight.DepartureCity, flight.ArrivalCity}]) > 0 {
+			priced = append(priced, flight.FlightID)
+		}
+	}
+	var soldSeats map[int64]map[entities.FlightClass]int64
+	if len(priced) > 0 {
+		soldSeats, err = u.flightRepository.CountSoldSeatsByClass(ctx, priced)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	fares := make([]map[entities.FlightClass]int64, len(flights))
+	for i, flight := range flights {
+		curves := curvesByRoute[[2]string{flight.DepartureCity, flight.ArrivalCity}]
+		fares[i] = entities.CurrentFares(flight, u.cabins, curves, u.layout.Loads(flight, soldSeats[flight.FlightID]), now)
+	}
+	return fares, nil
+}
@@ -0,0 +1,25 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+)
+
+type IDeletePricingCurveUseCase interface {
+	Execute(ctx context.Context, curveID int64) error
+}
+
+type DeletePricingCurveUseCase struct {
+	pricingCurveRepository adapters.IPricingCurveRepository
+}
+
+func NewDeletePricingCurveUseCase(pricingCurveRepository adapters.IPricingCurveRepository) IDeletePricingCurveUseCase {
+	return &DeletePricingCurveUseCase{
+		pricingCurveRepository: pricingCurveRepository,
+	}
+}
+
+func (u *DeletePricingCurveUseCase) Execute(ctx context.Context, curveID int64) error {
+	return u.pricingCurveRepository.DeletePricingCurve(ctx, curveID)
+}
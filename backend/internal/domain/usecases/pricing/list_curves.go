@@ -0,0 +1,26 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListPricingCurvesUseCase interface {
+	Execute(ctx context.Context) ([]entities.PricingCurve, error)
+}
+
+type ListPricingCurvesUseCase struct {
+	pricingCurveRepository adapters.IPricingCurveRepository
+}
+
+func NewListPricingCurvesUseCase(pricingCurveRepository adapters.IPricingCurveRepository) IListPricingCurvesUseCase {
+	return &ListPricingCurvesUseCase{
+		pricingCurveRepository: pricingCurveRepository,
+	}
+}
+
+func (u *ListPricingCurvesUseCase) Execute(ctx context.Context) ([]entities.PricingCurve, error) {
+	return u.pricingCurveRepository.ListPricingCurves(ctx)
+}
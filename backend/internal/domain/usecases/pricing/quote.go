@@ -0,0 +1,73 @@
+package pricing
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type ICreateFareQuoteUseCase interface {
+	Execute(ctx context.Context, flightID int64) (entities.FareQuote, error)
+}
+
+type CreateFareQuoteUseCase struct {
+	flightRepository    adapters.IFlightRepository
+	fareQuoteRepository adapters.IFareQuoteRepository
+	currentFares        IGetCurrentFaresUseCase
+	quoteTTL            time.Duration
+}
+
+func NewCreateFareQuoteUseCase(flightRepository adapters.IFlightRepository, fareQuoteRepository adapters.IFareQuoteRepository, currentFares IGetCurrentFaresUseCase, quoteTTL time.Duration) ICreateFareQuoteUseCase {
+	return &CreateFareQuoteUseCase{
+		flightRepository:    flightRepository,
+		fareQuoteRepository: fareQuoteRepository,
+		currentFares:        currentFares,
+		quoteTTL:            quoteTTL,
+	}
+}
+
+// Execute locks the current fares of the flight for quoteTTL.
+func (u *CreateFareQuoteUseCase) Execute(ctx context.Context, flightID int64) (entities.FareQuote, error) {
+	flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+	if err != nil {
+		return entities.FareQuote{}, err
+	}
+	if flight.Status == entities.FlightCanceledStatus || !flight.DepartureTime.After(time.Now()) {
+		return entities.FareQuote{}, adapters.ErrFlightNotFound
+	}
+
+	fares, err := u.currentFares.Execute(ctx, *flight)
+	if err != nil {
+		return entities.FareQuote{}, err
+	}
+
+	quote := entities.FareQuote{
+		QuoteID:   uuid.NewString(),
+		FlightID:  flight.FlightID,
+		Fares:     fares,
+		ExpiresAt: time.Now().Add(u.quoteTTL),
+	}
+	if err := u.fareQuoteRepository.SaveFareQuote(ctx, quote, u.quoteTTL); err != nil {
+		return entities.FareQuote{}, err
+	}
+	return quote, nil
+}
+
+// LockedOrCurrentFares returns the fares locked by quoteID for flight, or the current
+// fares when no quote is given.
+func LockedOrCurrentFares(ctx context.Context, fareQuoteRepository adapters.IFareQuoteRepository, currentFares IGetCurrentFaresUseCase, flight entities.Flight, quoteID string) (map[entities.FlightClass]int64, error) {
+	if quoteID == "" {
+		return currentFares.Execute(ctx, flight)
+	}
+	quote, err := fareQuoteRepository.GetFareQuote(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.FlightID != flight.FlightID {
+		return nil, adapters.ErrFareQuoteMismatch
+	}
+	return quote.Fares, nil
+}
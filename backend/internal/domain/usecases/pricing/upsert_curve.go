@@ -0,0 +1,30 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IUpsertPricingCurveUseCase interface {
+	Execute(ctx context.Context, curve entities.PricingCurve) (entities.PricingCurve, error)
+}
+
+type UpsertPricingCurveUseCase struct {
+	pricingCurveRepository adapters.IPricingCurveRepository
+}
+
+func NewUpsertPricingCurveUseCase(pricingCurveRepository adapters.IPricingCurveRepository) IUpsertPricingCurveUseCase {
+	return &UpsertPricingCurveUseCase{
+		pricingCurveRepository: pricingCurveRepository,
+	}
+}
+
+// Execute creates the curve of a route and cabin, or replaces its steps when it already exists.
+func (u *UpsertPricingCurveUseCase) Execute(ctx context.Context, curve entities.PricingCurve) (entities.PricingCurve, error) {
+	if err := curve.Validate(); err != nil {
+		return entities.PricingCurve{}, err
+	}
+	return u.pricingCurveRepository.UpsertPricingCurve(ctx, curve)
+}
@@ -0,0 +1,31 @@
+package promo
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type ICreatePromoCodeUseCase interface {
+	Execute(ctx context.Context, promo entities.PromoCode) (entities.PromoCode, error)
+}
+
+type CreatePromoCodeUseCase struct {
+	promoCodeRepository adapters.IPromoCodeRepository
+}
+
+func NewCreatePromoCodeUseCase(promoCodeRepository adapters.IPromoCodeRepository) ICreatePromoCodeUseCase {
+	return &CreatePromoCodeUseCase{
+		promoCodeRepository: promoCodeRepository,
+	}
+}
+
+// Execute publishes a new promo code; codes are stored upper-case so customers can type them in any case.
+func (u *CreatePromoCodeUseCase) Execute(ctx context.Context, promo entities.PromoCode) (entities.PromoCode, error) {
+	promo.Code = entities.NormalizePromoCode(promo.Code)
+	if err := promo.Validate(); err != nil {
+		return entities.PromoCode{}, err
+	}
+	return u.promoCodeRepository.CreatePromoCode(ctx, promo)
+}
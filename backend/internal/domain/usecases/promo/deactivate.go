@@ -0,0 +1,27 @@
+package promo
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IDeactivatePromoCodeUseCase interface {
+	Execute(ctx context.Context, promoCodeID int64) (entities.PromoCode, error)
+}
+
+type DeactivatePromoCodeUseCase struct {
+	promoCodeRepository adapters.IPromoCodeRepository
+}
+
+func NewDeactivatePromoCodeUseCase(promoCodeRepository adapters.IPromoCodeRepository) IDeactivatePromoCodeUseCase {
+	return &DeactivatePromoCodeUseCase{
+		promoCodeRepository: promoCodeRepository,
+	}
+}
+
+// Execute stops a code from being redeemed; bookings that already used it keep their discount.
+func (u *DeactivatePromoCodeUseCase) Execute(ctx context.Context, promoCodeID int64) (entities.PromoCode, error) {
+	return u.promoCodeRepository.DeactivatePromoCode(ctx, promoCodeID)
+}
@@ -0,0 +1,26 @@
+package promo
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListPromoCodesUseCase interface {
+	Execute(ctx context.Context) ([]entities.PromoCode, error)
+}
+
+type ListPromoCodesUseCase struct {
+	promoCodeRepository adapters.IPromoCodeRepository
+}
+
+func NewListPromoCodesUseCase(promoCodeRepository adapters.IPromoCodeRepository) IListPromoCodesUseCase {
+	return &ListPromoCodesUseCase{
+		promoCodeRepository: promoCodeRepository,
+	}
+}
+
+func (u *ListPromoCodesUseCase) Execute(ctx context.Context) ([]entities.PromoCode, error) {
+	return u.promoCodeRepository.ListPromoCodes(ctx)
+}
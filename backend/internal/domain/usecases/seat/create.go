@@ -0,0 +1,39 @@
+package seat
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type ICreateSeatZoneUseCase interface {
+	Execute(ctx context.Context, zone entities.SeatZone) (entities.SeatZone, error)
+}
+
+type CreateSeatZoneUseCase struct {
+	seatZoneRepository adapters.ISeatZoneRepository
+	flightRepository   adapters.IFlightRepository
+}
+
+func NewCreateSeatZoneUseCase(seatZoneRepository adapters.ISeatZoneRepository, flightRepository adapters.IFlightRepository) ICreateSeatZoneUseCase {
+	return &CreateSeatZoneUseCase{
+		seatZoneRepository: seatZoneRepository,
+		flightRepository:   flightRepository,
+	}
+}
+
+// Execute prices a range of rows on a flight or on every flight of an aircraft type.
+func (u *CreateSeatZoneUseCase) Execute(ctx context.Context, zone entities.SeatZone) (entities.SeatZone, error) {
+	if err := zone.Validate(); err != nil {
+		return entities.SeatZone{}, err
+	}
+	if zone.FlightID != 0 {
+		if _, err := u.flightRepository.GetFlightByID(ctx, zone.FlightID); err != nil {
+			return entities.SeatZone{}, err
+		}
+		// Vùng ghế theo chuyến bay không phụ thuộc loại máy bay
+		zone.AircraftType = ""
+	}
+	return u.seatZoneRepository.CreateSeatZone(ctx, zone)
+}
@@ -0,0 +1,26 @@
+package seat
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+)
+
+type IDeleteSeatZoneUseCase interface {
+	Execute(ctx context.Context, seatZoneID int64) error
+}
+
+type DeleteSeatZoneUseCase struct {
+	seatZoneRepository adapters.ISeatZoneRepository
+}
+
+func NewDeleteSeatZoneUseCase(seatZoneRepository adapters.ISeatZoneRepository) IDeleteSeatZoneUseCase {
+	return &DeleteSeatZoneUseCase{
+		seatZoneRepository: seatZoneRepository,
+	}
+}
+
+// Execute removes a zone; seat fees already paid are kept on the tickets.
+func (u *DeleteSeatZoneUseCase) Execute(ctx context.Context, seatZoneID int64) error {
+	return u.seatZoneRepository.DeleteSeatZone(ctx, seatZoneID)
+}
@@ -0,0 +1,26 @@
+package seat
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListSeatZonesUseCase interface {
+	Execute(ctx context.Context) ([]entities.SeatZone, error)
+}
+
+type ListSeatZonesUseCase struct {
+	seatZoneRepository adapters.ISeatZoneRepository
+}
+
+func NewListSeatZonesUseCase(seatZoneRepository adapters.ISeatZoneRepository) IListSeatZonesUseCase {
+	return &ListSeatZonesUseCase{
+		seatZoneRepository: seatZoneRepository,
+	}
+}
+
+func (u *ListSeatZonesUseCase) Execute(ctx context.Context) ([]entities.SeatZone, error) {
+	return u.seatZoneRepository.ListSeatZones(ctx)
+}
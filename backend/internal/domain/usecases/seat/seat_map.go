@@ -0,0 +1,37 @@
+package seat
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetSeatMapUseCase interface {
+	Execute(ctx context.Context, flightID int64) (entities.SeatMap, error)
+}
+
+type GetSeatMapUseCase struct {
+	seatZoneRepository adapters.ISeatZoneRepository
+	flightRepository   adapters.IFlightRepository
+}
+
+func NewGetSeatMapUseCase(seatZoneRepository adapters.ISeatZoneRepository, flightRepository adapters.IFlightRepository) IGetSeatMapUseCase {
+	return &GetSeatMapUseCase{
+		seatZoneRepository: seatZoneRepository,
+		flightRepository:   flightRepository,
+	}
+}
+
+// Execute returns the priced zones of a flight, for the seat picker to show.
+func (u *GetSeatMapUseCase) Execute(ctx context.Context, flightID int64) (entities.SeatMap, error) {
+	flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := u.seatZoneRepository.ListSeatZonesForFlight(ctx, *flight)
+	if err != nil {
+		return nil, err
+	}
+	return entities.SeatMapForFlight(zones, *flight), nil
+}
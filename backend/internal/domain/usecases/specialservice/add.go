@@ -0,0 +1,68 @@
+package specialservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IAddSpecialServiceUseCase interface {
+	Execute(ctx context.Context, params entities.AddSpecialServiceParams) (entities.TicketSpecialService, error)
+}
+
+type AddSpecialServiceUseCase struct {
+	specialServiceRepository adapters.ISpecialServiceRepository
+	bookingRepository        adapters.IBookingRepository
+}
+
+func NewAddSpecialServiceUseCase(specialServiceRepository adapters.ISpecialServiceRepository, bookingRepository adapters.IBookingRepository) IAddSpecialServiceUseCase {
+	return &AddSpecialServiceUseCase{
+		specialServiceRepository: specialServiceRepository,
+		bookingRepository:        bookingRepository,
+	}
+}
+
+// Execute records a special service request for an active ticket of a pending or
+// confirmed booking whose flight has not departed. Codes with a per-flight limit, such
+// as pets in cabin, are refused once the flight has no place left. The booking status,
+// the ticket and the flight are checked while the request is stored.
+func (u *AddSpecialServiceUseCase) Execute(ctx context.Context, params entities.AddSpecialServiceParams) (entities.TicketSpecialService, error) {
+	booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, params.BookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.TicketSpecialService{}, adapters.ErrBookingNotFound
+		}
+		return entities.TicketSpecialService{}, err
+	}
+
+	ticket, segment, ok := findActiveTicket(booking, params.TicketID)
+	if !ok {
+		return entities.TicketSpecialService{}, adapters.ErrTicketNotFound
+	}
+
+	// Kiểm tra mã SSR phù hợp với hành khách rồi ghi nhận trong giới hạn của chuyến bay
+	catalog, err := u.specialServiceRepository.ListSpecialServices(ctx)
+	if err != nil {
+		return entities.TicketSpecialService{}, err
+	}
+	item, err := catalog.Request(params.Code, params.Note, ticket, segment.Tickets)
+	if err != nil {
+		return entities.TicketSpecialService{}, err
+	}
+	item.BookingID = booking.BookingID
+	return u.specialServiceRepository.AddTicketSpecialService(ctx, item, catalog.Limit(item.Code))
+}
+
+// findActiveTicket returns the active ticket of the booking with ticketID and its segment.
+func findActiveTicket(booking entities.Booking, ticketID int64) (entities.Ticket, entities.BookingSegment, bool) {
+	for _, segment := range booking.Segments {
+		for _, ticket := range segment.Tickets {
+			if ticket.TicketID == ticketID && ticket.Status == entities.TicketStatusActive {
+				return ticket, segment, true
+			}
+		}
+	}
+	return entities.Ticket{}, entities.BookingSegment{}, false
+}
@@ -0,0 +1,37 @@
+package specialservice
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListSpecialServicesUseCase interface {
+	Execute(ctx context.Context) (entities.SpecialServiceCatalog, error)
+}
+
+type ListSpecialServicesUseCase struct {
+	specialServiceRepository adapters.ISpecialServiceRepository
+}
+
+func NewListSpecialServicesUseCase(specialServiceRepository adapters.ISpecialServiceRepository) IListSpecialServicesUseCase {
+	return &ListSpecialServicesUseCase{
+		specialServiceRepository: specialServiceRepository,
+	}
+}
+
+// Execute returns the SSR codes passengers can request, inactive ones left out.
+func (u *ListSpecialServicesUseCase) Execute(ctx context.Context) (entities.SpecialServiceCatalog, error) {
+	catalog, err := u.specialServiceRepository.ListSpecialServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	active := entities.SpecialServiceCatalog{}
+	for _, service := range catalog {
+		if service.Active {
+			active = append(active, service)
+		}
+	}
+	return active, nil
+}
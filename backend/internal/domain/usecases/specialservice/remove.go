@@ -0,0 +1,68 @@
+package specialservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IRemoveSpecialServiceUseCase interface {
+	Execute(ctx context.Context, params entities.RemoveSpecialServiceParams) (entities.TicketSpecialService, error)
+}
+
+type RemoveSpecialServiceUseCase struct {
+	specialServiceRepository adapters.ISpecialServiceRepository
+	bookingRepository        adapters.IBookingRepository
+	flightRepository         adapters.IFlightRepository
+}
+
+func NewRemoveSpecialServiceUseCase(specialServiceRepository adapters.ISpecialServiceRepository, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository) IRemoveSpecialServiceUseCase {
+	return &RemoveSpecialServiceUseCase{
+		specialServiceRepository: specialServiceRepository,
+		bookingRepository:        bookingRepository,
+		flightRepository:         flightRepository,
+	}
+}
+
+// Execute withdraws a special service request of the booking before its flight departs,
+// giving its place back to other passengers of the flight.
+func (u *RemoveSpecialServiceUseCase) Execute(ctx context.Context, params entities.RemoveSpecialServiceParams) (entities.TicketSpecialService, error) {
+	booking, _, _, err := u.bookingRepository.GetBookingByID(ctx, params.BookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.TicketSpecialService{}, adapters.ErrBookingNotFound
+		}
+		return entities.TicketSpecialService{}, err
+	}
+
+	item, ok := findTicketSpecialService(booking, params.TicketSpecialServiceID)
+	if !ok {
+		return entities.TicketSpecialService{}, adapters.ErrTicketSpecialServiceNotFound
+	}
+	flight, err := u.flightRepository.GetFlightByID(ctx, item.FlightID)
+	if err != nil {
+		return entities.TicketSpecialService{}, err
+	}
+	if !flight.DepartureTime.After(time.Now()) {
+		return entities.TicketSpecialService{}, &entities.SpecialServiceError{Code: item.Code, Reason: "flight has already departed"}
+	}
+
+	return u.specialServiceRepository.RemoveTicketSpecialService(ctx, params)
+}
+
+// findTicketSpecialService returns the special service request of the booking with id.
+func findTicketSpecialService(booking entities.Booking, id int64) (entities.TicketSpecialService, bool) {
+	for _, segment := range booking.Segments {
+		for _, ticket := range segment.Tickets {
+			for _, item := range ticket.SpecialServices {
+				if item.TicketSpecialServiceID == id {
+					return item, true
+				}
+			}
+		}
+	}
+	return entities.TicketSpecialService{}, false
+}
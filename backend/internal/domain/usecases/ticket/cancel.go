@@ -3,34 +3,61 @@ package ticket
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/rs/zerolog/log"
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 )
 
 type ICancelTicketUseCase interface {
-	Execute(ctx context.Context, ticketID int64) (*entities.Ticket, error)
+	Execute(ctx context.Context, ticketID int64) (entities.CancelTicketResult, error)
 }
 
 type CancelTicketUseCase struct {
-	ticketRepository adapters.ITicketRepository
+	ticketRepository     adapters.ITicketRepository
+	waitlistScheduler    adapters.IWaitlistScheduler
+	refundPolicy         entities.RefundPolicy
+	fareFamilyRepository adapters.IFareFamilyRepository
 }
 
-func NewCancelTicketUseCase(ticketRepository adapters.ITicketRepository) ICancelTicketUseCase {
+func NewCancelTicketUseCase(ticketRepository adapters.ITicketRepository, waitlistScheduler adapters.IWaitlistScheduler, refundPolicy entities.RefundPolicy, fareFamilyRepository adapters.IFareFamilyRepository) ICancelTicketUseCase {
 	return &CancelTicketUseCase{
-		ticketRepository: ticketRepository,
+		ticketRepository:     ticketRepository,
+		waitlistScheduler:    waitlistScheduler,
+		refundPolicy:         refundPolicy,
+		fareFamilyRepository: fareFamilyRepository,
 	}
 }
-func (u *CancelTicketUseCase) Execute(ctx context.Context, ticketID int64) (*entities.Ticket, error) {
-	ticket, err := u.ticketRepository.CancelTicket(ctx, ticketID)
+
+// Execute cancels the ticket with its unused add-ons and, since its seat goes back on sale,
+// asks the worker to offer the seat to the next customer waitlisted in the same cabin. The
+// points and travel credit that paid the booking are given back in proportion to what the
+// fare rules refund, and the rest of the refund is recorded when the booking was paid.
+func (u *CancelTicketUseCase) Execute(ctx context.Context, ticketID int64) (entities.CancelTicketResult, error) {
+	fareFamilies, err := u.fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return entities.CancelTicketResult{}, err
+	}
+	result, err := u.ticketRepository.CancelTicket(ctx, entities.CancelTicketParams{
+		TicketID:     ticketID,
+		RefundPolicy: u.refundPolicy,
+		FareFamilies: fareFamilies,
+		CancelledAt:  time.Now(),
+	})
 	if err != nil {
 		if errors.Is(err, adapters.ErrTicketNotFound) {
-			return nil, adapters.ErrTicketNotFound
+			return entities.CancelTicketResult{}, adapters.ErrTicketNotFound
 		}
 		if errors.Is(err, adapters.ErrTicketCannotBeCancelled) {
-			return nil, adapters.ErrTicketCannotBeCancelled
+			return entities.CancelTicketResult{}, adapters.ErrTicketCannotBeCancelled
 		}
-		return nil, err
+		return entities.CancelTicketResult{}, err
+	}
+
+	// Vé đã huỷ thành công nên lỗi gửi task chỉ được ghi log
+	if err := u.waitlistScheduler.OfferTicketSeat(ctx, *result.Ticket); err != nil {
+		log.Error().Err(err).Int64("ticket_id", result.Ticket.TicketID).Msg("failed to enqueue waitlist offer")
 	}
-	return ticket, nil
+	return result, nil
 }
@@ -0,0 +1,35 @@
+package ticket
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+)
+
+type CompleteSeatSelectionUseCase struct {
+	ticketRepository adapters.ITicketRepository
+	refundPayment    payment.IRefundPaymentUseCase
+}
+
+func NewCompleteSeatSelectionUseCase(ticketRepository adapters.ITicketRepository, refundPayment payment.IRefundPaymentUseCase) payment.IPaymentSettler {
+	return &CompleteSeatSelectionUseCase{
+		ticketRepository: ticketRepository,
+		refundPayment:    refundPayment,
+	}
+}
+
+// Execute gives the seats of a paid seat selection once its fees are captured. When the
+// seats could not be given any more, what was captured is refunded instead.
+func (u *CompleteSeatSelectionUseCase) Execute(ctx context.Context, event entities.PaymentEvent) error {
+	result, err := u.ticketRepository.CompleteSeatSelection(ctx, event)
+	if err != nil {
+		return err
+	}
+	if result.Refund != nil {
+		_, err := u.refundPayment.Execute(ctx, *result.Refund)
+		return err
+	}
+	return nil
+}
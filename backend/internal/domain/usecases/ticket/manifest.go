@@ -0,0 +1,47 @@
+package ticket
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetFlightManifestUseCase interface {
+	Execute(ctx context.Context, flightID int64) (entities.FlightManifest, error)
+}
+
+type GetFlightManifestUseCase struct {
+	flightRepository         adapters.IFlightRepository
+	ticketRepository         adapters.ITicketRepository
+	specialServiceRepository adapters.ISpecialServiceRepository
+}
+
+func NewGetFlightManifestUseCase(flightRepository adapters.IFlightRepository, ticketRepository adapters.ITicketRepository, specialServiceRepository adapters.ISpecialServiceRepository) IGetFlightManifestUseCase {
+	return &GetFlightManifestUseCase{
+		flightRepository:         flightRepository,
+		ticketRepository:         ticketRepository,
+		specialServiceRepository: specialServiceRepository,
+	}
+}
+
+// Execute returns the passengers of the flight with their seats and special service codes.
+func (u *GetFlightManifestUseCase) Execute(ctx context.Context, flightID int64) (entities.FlightManifest, error) {
+	flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			return entities.FlightManifest{}, adapters.ErrFlightNotFound
+		}
+		return entities.FlightManifest{}, err
+	}
+	tickets, err := u.ticketRepository.GetTicketsByFlightID(ctx, flightID)
+	if err != nil {
+		return entities.FlightManifest{}, err
+	}
+	services, err := u.specialServiceRepository.ListFlightSpecialServices(ctx, flightID)
+	if err != nil {
+		return entities.FlightManifest{}, err
+	}
+	return entities.NewFlightManifest(*flight, tickets, services), nil
+}
@@ -0,0 +1,41 @@
+package ticket
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/pkg/utils"
+)
+
+type ISearchTicketByNumberUseCase interface {
+	Execute(ctx context.Context, ticketNumber string) (*entities.Ticket, error)
+}
+
+type SearchTicketByNumberUseCase struct {
+	ticketRepository adapters.ITicketRepository
+}
+
+func NewSearchTicketByNumberUseCase(ticketRepository adapters.ITicketRepository) ISearchTicketByNumberUseCase {
+	return &SearchTicketByNumberUseCase{
+		ticketRepository: ticketRepository,
+	}
+}
+
+func (u *SearchTicketByNumberUseCase) Execute(ctx context.Context, ticketNumber string) (*entities.Ticket, error) {
+	ticketNumber = utils.NormalizeTicketNumber(ticketNumber)
+	if !utils.IsValidTicketNumber(ticketNumber) {
+		return nil, adapters.ErrInvalidTicketNumber
+	}
+
+	ticket, err := u.ticketRepository.GetTicketByNumber(ctx, ticketNumber)
+	if err != nil {
+		if errors.Is(err, adapters.ErrTicketNotFound) {
+			return nil, adapters.ErrTicketNotFound
+		}
+		return nil, err
+	}
+
+	return ticket, nil
+}
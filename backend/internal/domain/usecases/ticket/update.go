@@ -3,48 +3,225 @@ package ticket
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
-	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 )
 
 type IUpdateSeatsUseCase interface {
-	Execute(ctx context.Context, updates []dto.UpdateSeatRequest) ([]entities.Ticket, error)
+	// Execute changes seats; a non-zero bookingID restricts the tickets to that booking.
+	Execute(ctx context.Context, bookingID int64, updates []entities.SeatUpdate) (entities.SeatSelectionResult, error)
 }
 
 type UpdateSeatsUseCase struct {
-	ticketRepository adapters.ITicketRepository
+	ticketRepository     adapters.ITicketRepository
+	fareFamilyRepository adapters.IFareFamilyRepository
+	bookingRepository    adapters.IBookingRepository
+	flightRepository     adapters.IFlightRepository
+	seatZoneRepository   adapters.ISeatZoneRepository
+	ancillaryRepository  adapters.IAncillaryRepository
+	paymentGateway       adapters.PaymentGateway
+	currency             string
+	loyaltyRepository    adapters.ILoyaltyRepository
+	tierPolicy           entities.TierPolicy
 }
 
-func NewUpdateSeatsUseCase(ticketRepository adapters.ITicketRepository) IUpdateSeatsUseCase {
+func NewUpdateSeatsUseCase(ticketRepository adapters.ITicketRepository, fareFamilyRepository adapters.IFareFamilyRepository, bookingRepository adapters.IBookingRepository, flightRepository adapters.IFlightRepository, seatZoneRepository adapters.ISeatZoneRepository, ancillaryRepository adapters.IAncillaryRepository, paymentGateway adapters.PaymentGateway, currency string, loyaltyRepository adapters.ILoyaltyRepository, tierPolicy entities.TierPolicy) IUpdateSeatsUseCase {
 	return &UpdateSeatsUseCase{
-		ticketRepository: ticketRepository,
+		ticketRepository:     ticketRepository,
+		fareFamilyRepository: fareFamilyRepository,
+		bookingRepository:    bookingRepository,
+		flightRepository:     flightRepository,
+		seatZoneRepository:   seatZoneRepository,
+		ancillaryRepository:  ancillaryRepository,
+		paymentGateway:       paymentGateway,
+		currency:             currency,
+		loyaltyRepository:    loyaltyRepository,
+		tierPolicy:           tierPolicy,
 	}
 }
 
-func (u *UpdateSeatsUseCase) Execute(ctx context.Context, updates []dto.UpdateSeatRequest) ([]entities.Ticket, error) {
-	var responses []entities.Ticket
+// Execute gives the requested seats to tickets of one booking. Seats in a priced zone
+// cost the zone price less the seat fees already paid on the ticket; on a pending booking
+// the fee is added to the amount paid for the booking. A confirmed booking is charged
+// through its own payment intent and the selection is only recorded as pending: the seats
+// change once the payment webhook confirms the fees. Tiers with free seat selection pay
+// no seat fee.
+func (u *UpdateSeatsUseCase) Execute(ctx context.Context, bookingID int64, updates []entities.SeatUpdate) (entities.SeatSelectionResult, error) {
+	fareFamilies, err := u.fareFamilyRepository.ListFareFamilies(ctx)
+	if err != nil {
+		return entities.SeatSelectionResult{}, err
+	}
 
+	// 1. Kiểm tra quyền chọn ghế và định giá từng ghế
+	var booking entities.Booking
+	var benefits entities.TierBenefits
+	seatMaps := make(map[int64]entities.SeatMap)
+	requested := make(map[string]bool, len(updates))
+	var result entities.SeatSelectionResult
 	for _, update := range updates {
-		ticketID, err := strconv.ParseInt(update.TicketID, 10, 64)
+		ticketID := update.TicketID
+		current, err := u.ticketRepository.GetTicketByID(ctx, ticketID)
 		if err != nil {
-			return nil, err
+			if errors.Is(err, adapters.ErrTicketNotFound) {
+				return entities.SeatSelectionResult{}, adapters.ErrTicketNotFound
+			}
+			return entities.SeatSelectionResult{}, err
+		}
+		if bookingID != 0 && current.BookingID != bookingID {
+			return entities.SeatSelectionResult{}, adapters.ErrTicketNotFound
+		}
+		if current.Status != entities.TicketStatusActive {
+			return entities.SeatSelectionResult{}, adapters.ErrInvalidSeat
+		}
+		// Gói giá của vé phải cho phép chọn ghế
+		if err := fareFamilies.CheckSeatSelection(*current); err != nil {
+			return entities.SeatSelectionResult{}, err
+		}
+
+		// Mỗi lần chỉ đổi ghế trong một booking để thu phí một lần
+		if booking.BookingID == 0 {
+			booking, _, _, err = u.bookingRepository.GetBookingByID(ctx, current.BookingID)
+			if err != nil {
+				return entities.SeatSelectionResult{}, err
+			}
+			// Hạng thành viên của tài khoản đặt chỗ có thể miễn phí chọn ghế
+			tier, err := u.loyaltyRepository.GetTierByEmail(ctx, booking.UserEmail)
+			if err != nil {
+				return entities.SeatSelectionResult{}, err
+			}
+			benefits = u.tierPolicy.Benefits(tier)
+		} else if current.BookingID != booking.BookingID {
+			return entities.SeatSelectionResult{}, adapters.ErrInvalidSeat
+		}
+		ticket, holdsInfant, ok := findBookingTicket(booking, ticketID)
+		if !ok {
+			return entities.SeatSelectionResult{}, adapters.ErrTicketNotFound
+		}
+		// Hành khách đã làm thủ tục phải huỷ thủ tục trước khi đổi ghế
+		if ticket.CheckedInAt != nil {
+			return entities.SeatSelectionResult{}, &entities.CheckInError{TicketID: ticket.TicketID, FlightID: ticket.FlightID, Reason: "undo the check-in before changing the seat"}
 		}
-		ticket, err := u.ticketRepository.UpdateSeat(ctx, ticketID, update.SeatCode)
+
+		seatMap, ok := seatMaps[ticket.FlightID]
+		if !ok {
+			seatMap, err = u.loadSeatMap(ctx, ticket.FlightID)
+			if err != nil {
+				return entities.SeatSelectionResult{}, err
+			}
+			seatMaps[ticket.FlightID] = seatMap
+		}
+		selection, err := seatMap.Select(ticket, update.SeatCode, holdsInfant)
+		if err != nil {
+			return entities.SeatSelectionResult{}, err
+		}
+		selection = benefits.ApplyToSeat(selection)
+
+		// Hai vé trong cùng yêu cầu không được chọn cùng một ghế
+		seatKey := fmt.Sprintf("%d/%s", ticket.FlightID, update.SeatCode)
+		if requested[seatKey] {
+			return entities.SeatSelectionResult{}, adapters.ErrSeatUnavailable
+		}
+		requested[seatKey] = true
+
+		if current.Seat.SeatCode != update.SeatCode {
+			taken, err := u.ticketRepository.IsSeatTaken(ctx, ticket.FlightID, update.SeatCode)
+			if err != nil {
+				return entities.SeatSelectionResult{}, err
+			}
+			if taken {
+				return entities.SeatSelectionResult{}, adapters.ErrSeatUnavailable
+			}
+		}
+
+		result.Selections = append(result.Selections, selection)
+		result.AmountDue += selection.Fee
+	}
+
+	// 2. Booking đã thanh toán thì thu phí chọn ghế riêng; ghế chỉ được đổi khi webhook xác nhận tiền đã về
+	if booking.Status == entities.BookingStatusConfirmed && result.AmountDue > 0 {
+		metadata := map[string]string{
+			"booking_id": strconv.FormatInt(booking.BookingID, 10),
+			"purpose":    string(entities.PaymentPurposeSeatSelection),
+		}
+		intent, err := u.paymentGateway.CreatePaymentIntent(result.AmountDue, u.currency, metadata)
+		if err != nil {
+			return entities.SeatSelectionResult{}, fmt.Errorf("failed to create payment intent: %w", err)
+		}
+		err = u.ticketRepository.RequestSeatSelection(ctx, booking.BookingID, result.Selections, entities.Payment{
+			IntentID: intent.ID,
+			Amount:   result.AmountDue,
+			Currency: u.currency,
+		})
+		if err != nil {
+			return entities.SeatSelectionResult{}, err
+		}
+		result.Status = entities.SeatSelectionStatusPending
+		result.PaymentClientSecret = intent.ClientSecret
+		return result, nil
+	}
+
+	// 3. Không có phí phải thu riêng nên đổi ghế ngay và ghi nhận phí chọn ghế trên vé
+	for _, selection := range result.Selections {
+		ticket, err := u.ticketRepository.UpdateSeat(ctx, selection.TicketID, selection.SeatCode)
 		if err != nil {
 			if errors.Is(err, adapters.ErrTicketNotFound) {
-				return nil, adapters.ErrTicketNotFound
+				return entities.SeatSelectionResult{}, adapters.ErrTicketNotFound
 			}
 			if errors.Is(err, adapters.ErrInvalidSeat) {
-				return nil, adapters.ErrInvalidSeat
+				return entities.SeatSelectionResult{}, adapters.ErrInvalidSeat
+			}
+			if errors.Is(err, adapters.ErrSeatUnavailable) {
+				return entities.SeatSelectionResult{}, adapters.ErrSeatUnavailable
 			}
-			return nil, err
+			return entities.SeatSelectionResult{}, err
 		}
+		if selection.Fee > 0 {
+			item := selection.FeeItem()
+			item.BookingID = booking.BookingID
+			fee, err := u.ancillaryRepository.AddTicketAncillary(ctx, item)
+			if err != nil {
+				return entities.SeatSelectionResult{}, err
+			}
+			ticket.Ancillaries = append(ticket.Ancillaries, fee)
+		}
+		result.Tickets = append(result.Tickets, *ticket)
+	}
+	result.Status = entities.SeatSelectionStatusCompleted
 
-		responses = append(responses, *ticket)
+	return result, nil
+}
+
+func (u *UpdateSeatsUseCase) loadSeatMap(ctx context.Context, flightID int64) (entities.SeatMap, error) {
+	flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := u.seatZoneRepository.ListSeatZonesForFlight(ctx, *flight)
+	if err != nil {
+		return nil, err
 	}
+	return entities.SeatMapForFlight(zones, *flight), nil
+}
 
-	return responses, nil
+// findBookingTicket returns the ticket of the booking with ticketID and whether an
+// infant travels on its passenger's lap.
+func findBookingTicket(booking entities.Booking, ticketID int64) (entities.Ticket, bool, bool) {
+	for _, segment := range booking.Segments {
+		for _, ticket := range segment.Tickets {
+			if ticket.TicketID != ticketID {
+				continue
+			}
+			holdsInfant := false
+			for _, other := range segment.Tickets {
+				if other.PassengerType == entities.PassengerTypeInfant && other.AccompanyingTicketID == ticketID && other.Status == entities.TicketStatusActive {
+					holdsInfant = true
+				}
+			}
+			return ticket, holdsInfant, true
+		}
+	}
+	return entities.Ticket{}, false, false
 }
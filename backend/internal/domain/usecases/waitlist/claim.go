@@ -0,0 +1,54 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
+)
+
+type IClaimWaitlistOfferUseCase interface {
+	Execute(ctx context.Context, claimToken string, email string) (entities.ClaimWaitlistResult, error)
+}
+
+type ClaimWaitlistOfferUseCase struct {
+	waitlistRepository adapters.IWaitlistRepository
+	createFareQuote    pricing.ICreateFareQuoteUseCase
+}
+
+func NewClaimWaitlistOfferUseCase(waitlistRepository adapters.IWaitlistRepository, createFareQuote pricing.ICreateFareQuoteUseCase) IClaimWaitlistOfferUseCase {
+	return &ClaimWaitlistOfferUseCase{
+		waitlistRepository: waitlistRepository,
+		createFareQuote:    createFareQuote,
+	}
+}
+
+// Execute claims the seat offered by the link in the waitlist email and locks the
+// current fares of the flight, so the customer books the seat with the returned quote.
+func (u *ClaimWaitlistOfferUseCase) Execute(ctx context.Context, claimToken string, email string) (entities.ClaimWaitlistResult, error) {
+	entry, err := u.waitlistRepository.GetWaitlistEntryByClaimToken(ctx, claimToken)
+	if err != nil {
+		return entities.ClaimWaitlistResult{}, err
+	}
+	if err := entry.CheckClaimable(email, time.Now()); err != nil {
+		return entities.ClaimWaitlistResult{}, err
+	}
+
+	// Lời mời có thể vừa hết hạn giữa lúc kiểm tra và lúc nhận ghế
+	entry, err = u.waitlistRepository.ClaimWaitlistOffer(ctx, entry.WaitlistEntryID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrWaitlistEntryNotFound) {
+			return entities.ClaimWaitlistResult{}, &entities.WaitlistError{Reason: "the offer has expired"}
+		}
+		return entities.ClaimWaitlistResult{}, err
+	}
+
+	quote, err := u.createFareQuote.Execute(ctx, entry.FlightID)
+	if err != nil {
+		return entities.ClaimWaitlistResult{}, err
+	}
+	return entities.ClaimWaitlistResult{Entry: entry, Quote: quote}, nil
+}
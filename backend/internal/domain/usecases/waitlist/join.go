@@ -0,0 +1,50 @@
+package waitlist
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IJoinWaitlistUseCase interface {
+	Execute(ctx context.Context, flightID int64, class entities.FlightClass, email string) (entities.WaitlistEntry, error)
+}
+
+type JoinWaitlistUseCase struct {
+	waitlistRepository adapters.IWaitlistRepository
+	flightRepository   adapters.IFlightRepository
+	cabinLayout        entities.CabinLayout
+}
+
+func NewJoinWaitlistUseCase(waitlistRepository adapters.IWaitlistRepository, flightRepository adapters.IFlightRepository, cabinLayout entities.CabinLayout) IJoinWaitlistUseCase {
+	return &JoinWaitlistUseCase{
+		waitlistRepository: waitlistRepository,
+		flightRepository:   flightRepository,
+		cabinLayout:        cabinLayout,
+	}
+}
+
+// Execute puts the customer on the waitlist of a cabin. A cabin is full once every seat
+// of its rows is sold, blocked for a group or held for another waitlist offer.
+func (u *JoinWaitlistUseCase) Execute(ctx context.Context, flightID int64, class entities.FlightClass, email string) (entities.WaitlistEntry, error) {
+	flight, err := u.flightRepository.GetFlightByID(ctx, flightID)
+	if err != nil {
+		return entities.WaitlistEntry{}, err
+	}
+	if flight.Status == entities.FlightCanceledStatus || !flight.DepartureTime.After(time.Now()) {
+		return entities.WaitlistEntry{}, adapters.ErrFlightNotFound
+	}
+
+	// Chỉ cho vào danh sách chờ khi hạng ghế đã hết chỗ
+	sold, err := u.flightRepository.CountSoldSeatsByClass(ctx, []int64{flightID})
+	if err != nil {
+		return entities.WaitlistEntry{}, err
+	}
+	if sold[flightID][class] < u.cabinLayout.Capacity(*flight, class) {
+		return entities.WaitlistEntry{}, adapters.ErrCabinNotFull
+	}
+
+	return u.waitlistRepository.CreateWaitlistEntry(ctx, flightID, class, email)
+}
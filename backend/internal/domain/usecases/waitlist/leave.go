@@ -0,0 +1,42 @@
+package waitlist
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type ILeaveWaitlistUseCase interface {
+	Execute(ctx context.Context, entryID int64, email string) (entities.WaitlistEntry, error)
+}
+
+type LeaveWaitlistUseCase struct {
+	waitlistRepository adapters.IWaitlistRepository
+	waitlistScheduler  adapters.IWaitlistScheduler
+}
+
+func NewLeaveWaitlistUseCase(waitlistRepository adapters.IWaitlistRepository, waitlistScheduler adapters.IWaitlistScheduler) ILeaveWaitlistUseCase {
+	return &LeaveWaitlistUseCase{
+		waitlistRepository: waitlistRepository,
+		waitlistScheduler:  waitlistScheduler,
+	}
+}
+
+// Execute removes the customer from the waitlist. A customer declining an open offer
+// hands the seat on to the next one in line straight away.
+func (u *LeaveWaitlistUseCase) Execute(ctx context.Context, entryID int64, email string) (entities.WaitlistEntry, error) {
+	entry, err := u.waitlistRepository.CancelWaitlistEntry(ctx, entryID, email)
+	if err != nil {
+		return entities.WaitlistEntry{}, err
+	}
+
+	// Mã nhận ghế chỉ được cấp khi khách đã được mời
+	if entry.ClaimToken != "" {
+		if err := u.waitlistScheduler.OfferDeclinedSeat(ctx, entry); err != nil {
+			log.Error().Err(err).Int64("waitlist_entry_id", entry.WaitlistEntryID).Msg("failed to enqueue waitlist offer after the entry was cancelled")
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,27 @@
+package waitlist
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IListWaitlistEntriesUseCase interface {
+	Execute(ctx context.Context, email string) ([]entities.WaitlistEntry, error)
+}
+
+type ListWaitlistEntriesUseCase struct {
+	waitlistRepository adapters.IWaitlistRepository
+}
+
+func NewListWaitlistEntriesUseCase(waitlistRepository adapters.IWaitlistRepository) IListWaitlistEntriesUseCase {
+	return &ListWaitlistEntriesUseCase{
+		waitlistRepository: waitlistRepository,
+	}
+}
+
+// Execute lists the customer's waitlist entries, newest first.
+func (u *ListWaitlistEntriesUseCase) Execute(ctx context.Context, email string) ([]entities.WaitlistEntry, error) {
+	return u.waitlistRepository.ListWaitlistEntriesByEmail(ctx, email)
+}
@@ -0,0 +1,27 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+)
+
+type IGetWalletBalanceUseCase interface {
+	Execute(ctx context.Context, userID int64) (int64, error)
+}
+
+type GetWalletBalanceUseCase struct {
+	walletRepository adapters.IWalletRepository
+}
+
+func NewGetWalletBalanceUseCase(walletRepository adapters.IWalletRepository) IGetWalletBalanceUseCase {
+	return &GetWalletBalanceUseCase{
+		walletRepository: walletRepository,
+	}
+}
+
+// Execute returns the travel credit the customer can still spend.
+func (u *GetWalletBalanceUseCase) Execute(ctx context.Context, userID int64) (int64, error) {
+	return u.walletRepository.GetBalance(ctx, userID, time.Now())
+}
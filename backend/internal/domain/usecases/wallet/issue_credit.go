@@ -0,0 +1,37 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IIssueWalletCreditUseCase interface {
+	Execute(ctx context.Context, params entities.IssueWalletCreditParams) (entities.WalletTransaction, error)
+}
+
+type IssueWalletCreditUseCase struct {
+	walletRepository adapters.IWalletRepository
+	creditTTL        time.Duration
+}
+
+func NewIssueWalletCreditUseCase(walletRepository adapters.IWalletRepository, creditTTL time.Duration) IIssueWalletCreditUseCase {
+	return &IssueWalletCreditUseCase{
+		walletRepository: walletRepository,
+		creditTTL:        creditTTL,
+	}
+}
+
+// Execute credits goodwill to one customer's wallet. Credit without an expiry expires
+// after the configured lifetime of travel credit.
+func (u *IssueWalletCreditUseCase) Execute(ctx context.Context, params entities.IssueWalletCreditParams) (entities.WalletTransaction, error) {
+	if err := params.Validate(); err != nil {
+		return entities.WalletTransaction{}, err
+	}
+	if params.ExpiresAt.IsZero() {
+		params.ExpiresAt = time.Now().Add(u.creditTTL)
+	}
+	return u.walletRepository.IssueCredit(ctx, params)
+}
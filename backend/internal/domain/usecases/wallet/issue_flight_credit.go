@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IIssueFlightWalletCreditUseCase interface {
+	Execute(ctx context.Context, flightID int64, params entities.IssueWalletCreditParams) ([]entities.FlightWalletCredit, error)
+}
+
+type IssueFlightWalletCreditUseCase struct {
+	walletRepository adapters.IWalletRepository
+	flightRepository adapters.IFlightRepository
+	creditTTL        time.Duration
+}
+
+func NewIssueFlightWalletCreditUseCase(walletRepository adapters.IWalletRepository, flightRepository adapters.IFlightRepository, creditTTL time.Duration) IIssueFlightWalletCreditUseCase {
+	return &IssueFlightWalletCreditUseCase{
+		walletRepository: walletRepository,
+		flightRepository: flightRepository,
+		creditTTL:        creditTTL,
+	}
+}
+
+// Execute credits goodwill to every customer account holding active tickets on a
+// disrupted flight. params.Amount is given per ticket, so a customer travelling with
+// three passengers receives three times the amount. Guest bookings have no wallet and
+// are skipped. A failure for one customer is logged and does not stop the others.
+func (u *IssueFlightWalletCreditUseCase) Execute(ctx context.Context, flightID int64, params entities.IssueWalletCreditParams) ([]entities.FlightWalletCredit, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := u.flightRepository.GetFlightByID(ctx, flightID); err != nil {
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			return nil, adapters.ErrFlightNotFound
+		}
+		return nil, err
+	}
+	if params.ExpiresAt.IsZero() {
+		params.ExpiresAt = time.Now().Add(u.creditTTL)
+	}
+
+	customers, err := u.walletRepository.ListFlightCustomers(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+
+	credits := make([]entities.FlightWalletCredit, 0, len(customers))
+	for _, customer := range customers {
+		credit := params
+		credit.UserID = customer.UserID
+		credit.Email = ""
+		credit.Amount = params.Amount * customer.Tickets
+		transaction, err := u.walletRepository.IssueCredit(ctx, credit)
+		if err != nil {
+			log.Error().Err(err).Int64("user_id", customer.UserID).Int64("flight_id", flightID).Msg("failed to credit customer")
+			continue
+		}
+		customer.Transaction = transaction
+		credits = append(credits, customer)
+	}
+	if len(credits) == 0 && len(customers) > 0 {
+		return nil, fmt.Errorf("failed to credit any customer of flight %d", flightID)
+	}
+	return credits, nil
+}
@@ -0,0 +1,97 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IPayWithWalletUseCase interface {
+	Execute(ctx context.Context, userID int64, email string, bookingID int64, amount int64) (entities.PayWithWalletResult, error)
+}
+
+type PayWithWalletUseCase struct {
+	walletRepository  adapters.IWalletRepository
+	bookingRepository adapters.IBookingRepository
+	loyaltyRepository adapters.ILoyaltyRepository
+	paymentRepository adapters.IPaymentRepository
+	flightRepository  adapters.IFlightRepository
+	loyaltyScheduler  adapters.ILoyaltyScheduler
+}
+
+func NewPayWithWalletUseCase(walletRepository adapters.IWalletRepository, bookingRepository adapters.IBookingRepository, loyaltyRepository adapters.ILoyaltyRepository, paymentRepository adapters.IPaymentRepository, flightRepository adapters.IFlightRepository, loyaltyScheduler adapters.ILoyaltyScheduler) IPayWithWalletUseCase {
+	return &PayWithWalletUseCase{
+		walletRepository:  walletRepository,
+		bookingRepository: bookingRepository,
+		loyaltyRepository: loyaltyRepository,
+		paymentRepository: paymentRepository,
+		flightRepository:  flightRepository,
+		loyaltyScheduler:  loyaltyScheduler,
+	}
+}
+
+// Execute pays part or all of the customer's unpaid booking with travel credit. The
+// payment intent created afterwards only charges what the credit did not cover; when the
+// credit covers the whole booking it is confirmed in the same transaction as the payment.
+func (u *PayWithWalletUseCase) Execute(ctx context.Context, userID int64, email string, bookingID int64, amount int64) (entities.PayWithWalletResult, error) {
+	details, _, _, err := u.bookingRepository.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			return entities.PayWithWalletResult{}, adapters.ErrBookingNotFound
+		}
+		return entities.PayWithWalletResult{}, err
+	}
+	// Khách hàng chỉ được dùng ví cho booking của chính mình
+	if !strings.EqualFold(details.UserEmail, email) {
+		return entities.PayWithWalletResult{}, adapters.ErrBookingNotFound
+	}
+
+	var amountDue int64
+	for _, segment := range details.Segments {
+		for _, ticket := range segment.Tickets {
+			amountDue += ticket.AmountDue()
+		}
+	}
+	redeemed, err := u.loyaltyRepository.GetRedeemedAmount(ctx, bookingID)
+	if err != nil {
+		return entities.PayWithWalletResult{}, err
+	}
+	// Tiền đã thu trước cho booking, như tiền cọc của đoàn, được trừ vào số phải trả
+	captured, err := u.paymentRepository.ListCapturedPayments(ctx, bookingID)
+	if err != nil {
+		return entities.PayWithWalletResult{}, err
+	}
+	for _, payment := range captured {
+		amountDue -= payment.Refundable()
+	}
+
+	result, err := u.walletRepository.PayBooking(ctx, entities.PayWithWalletParams{
+		UserID:    userID,
+		BookingID: bookingID,
+		Amount:    amount,
+		AmountDue: amountDue - redeemed,
+	})
+	if err != nil {
+		return entities.PayWithWalletResult{}, err
+	}
+	if !result.Confirmed {
+		return result, nil
+	}
+
+	// Booking đã được xác nhận nên lỗi khi lên lịch cộng điểm chỉ được ghi log
+	for _, segment := range details.Segments {
+		flight, err := u.flightRepository.GetFlightByID(ctx, segment.FlightID)
+		if err != nil {
+			log.Error().Err(err).Int64("flight_id", segment.FlightID).Msg("failed to load flight to schedule loyalty points")
+			continue
+		}
+		if err := u.loyaltyScheduler.ScheduleAccrual(ctx, *flight); err != nil {
+			log.Error().Err(err).Int64("flight_id", flight.FlightID).Msg("failed to schedule loyalty points")
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,27 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type IGetWalletStatementUseCase interface {
+	Execute(ctx context.Context, userID int64, page int, limit int) (entities.WalletStatement, error)
+}
+
+type GetWalletStatementUseCase struct {
+	walletRepository adapters.IWalletRepository
+}
+
+func NewGetWalletStatementUseCase(walletRepository adapters.IWalletRepository) IGetWalletStatementUseCase {
+	return &GetWalletStatementUseCase{
+		walletRepository: walletRepository,
+	}
+}
+
+func (u *GetWalletStatementUseCase) Execute(ctx context.Context, userID int64, page int, limit int) (entities.WalletStatement, error) {
+	offset := (page - 1) * limit
+	return u.walletRepository.ListTransactions(ctx, userID, int32(limit), int32(offset))
+}
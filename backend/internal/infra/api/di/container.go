@@ -4,15 +4,28 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/spaghetti-lover/qairlines/config"
 	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/admin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/ancillary"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/auth"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/checkin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/companion"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/customer"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/flight"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/group"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/loyalty"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/news"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/promo"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/seat"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/specialservice"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/ticket"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/waitlist"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/wallet"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
 	"github.com/spaghetti-lover/qairlines/internal/infra/cache"
 	"github.com/spaghetti-lover/qairlines/internal/infra/postgresql"
@@ -22,18 +35,32 @@ import (
 )
 
 type Container struct {
-	HealthHandler   *handlers.HealthHandler
-	CustomerHandler *handlers.CustomerHandler
-	AuthHandler     *handlers.AuthHandler
-	NewsHandler     *handlers.NewsHandler
-	AdminHandler    *handlers.AdminHandler
-	FlightHandler   *handlers.FlightHandler
-	TicketHandler   *handlers.TicketHandler
-	BookingHandler  *handlers.BookingHandler
-	PaymentHandler  *handlers.PaymentHandler
-	TokenMaker      token.Maker
-	TaskDistributor worker.TaskDistributor
-	RedisClient     *redis.Client
+	HealthHandler         *handlers.HealthHandler
+	CustomerHandler       *handlers.CustomerHandler
+	AuthHandler           *handlers.AuthHandler
+	NewsHandler           *handlers.NewsHandler
+	AdminHandler          *handlers.AdminHandler
+	FlightHandler         *handlers.FlightHandler
+	TicketHandler         *handlers.TicketHandler
+	BookingHandler        *handlers.BookingHandler
+	ManageBookingHandler  *handlers.ManageBookingHandler
+	PaymentHandler        *handlers.PaymentHandler
+	PricingHandler        *handlers.PricingHandler
+	AncillaryHandler      *handlers.AncillaryHandler
+	SeatZoneHandler       *handlers.SeatZoneHandler
+	WaitlistHandler       *handlers.WaitlistHandler
+	GroupBookingHandler   *handlers.GroupBookingHandler
+	PromoCodeHandler      *handlers.PromoCodeHandler
+	LoyaltyHandler        *handlers.LoyaltyHandler
+	WalletHandler         *handlers.WalletHandler
+	TripHandler           *handlers.TripHandler
+	CompanionHandler      *handlers.CompanionHandler
+	SpecialServiceHandler *handlers.SpecialServiceHandler
+	TokenMaker            token.Maker
+	UserRepo              adapters.IUserRepository
+	TaskDistributor       worker.TaskDistributor
+	RedisClient           *redis.Client
+	IdempotencyRepo       adapters.IIdempotencyRepository
 }
 
 func NewContainer(cfg config.Config, redisClient *redis.Client, store *db.Store, taskDistributor worker.TaskDistributor) (*Container, error) {
@@ -43,7 +70,9 @@ func NewContainer(cfg config.Config, redisClient *redis.Client, store *db.Store,
 		return nil, err
 	}
 
-	stripeGateway := stripe.NewStripeGateway(cfg.StripeSecretKey)
+	stripeGateway := stripe.NewStripeGateway(cfg.StripeSecretKey, cfg.StripeWebhookSecret)
+	loyaltyScheduler := worker.NewLoyaltyScheduler(taskDistributor)
+	waitlistScheduler := worker.NewWaitlistScheduler(taskDistributor)
 
 	// Repositories
 	healthRepo := postgresql.NewHealthRepositoryPostgres(store)
@@ -55,6 +84,21 @@ func NewContainer(cfg config.Config, redisClient *redis.Client, store *db.Store,
 	ticketRepo := postgresql.NewTicketRepositoryPostgres(store)
 	bookingRepo := postgresql.NewBookingRepositoryPostgres(store)
 	cacheRepo := cache.NewRedisCacheService(redisClient)
+	idempotencyRepo := cache.NewRedisIdempotencyRepository(redisClient)
+	pricingCurveRepo := postgresql.NewPricingCurveRepositoryPostgres(store)
+	fareQuoteRepo := cache.NewRedisFareQuoteRepository(redisClient)
+	fareFamilyRepo := cache.NewCachedFareFamilyRepository(postgresql.NewFareFamilyRepositoryPostgres(store), cacheRepo, cfg.FareFamilyCacheTTL)
+	ancillaryRepo := postgresql.NewAncillaryRepositoryPostgres(store)
+	seatZoneRepo := postgresql.NewSeatZoneRepositoryPostgres(store)
+	waitlistRepo := postgresql.NewWaitlistRepositoryPostgres(store)
+	groupBookingRepo := postgresql.NewGroupBookingRepositoryPostgres(store)
+	promoCodeRepo := postgresql.NewPromoCodeRepositoryPostgres(store)
+	loyaltyRepo := postgresql.NewLoyaltyRepositoryPostgres(store)
+	walletRepo := postgresql.NewWalletRepositoryPostgres(store)
+	companionRepo := postgresql.NewCompanionRepositoryPostgres(store)
+	specialServiceRepo := postgresql.NewSpecialServiceRepositoryPostgres(store)
+	checkInRepo := postgresql.NewCheckInRepositoryPostgres(store)
+	paymentRepo := postgresql.NewPaymentRepositoryPostgres(store)
 
 	// Use Cases
 	healthUseCase := usecases.NewHealthUseCase(healthRepo)
@@ -75,20 +119,142 @@ func NewContainer(cfg config.Config, redisClient *redis.Client, store *db.Store,
 	updateAdminUseCase := admin.NewUpdateAdminUseCase(adminRepo, userRepo)
 	getCurrentAdminUseCase := admin.NewGetCurrentAdminUseCase(adminRepo)
 	deleteAdminUseCase := admin.NewDeleteAdminUseCase(adminRepo)
-	flightCreateUseCase := flight.NewCreateFlightUseCase(flightRepo)
+	flightCreateUseCase := flight.NewCreateFlightUseCase(flightRepo, cfg.HomeCountry)
 	flightGetUseCase := flight.NewGetFlightUseCase(flightRepo)
 	flightUpdateUseCase := flight.NewUpdateFlightTimesUseCase(flightRepo)
 	flightGetAllUseCase := flight.NewGetAllFlightsUseCase(flightRepo, ticketRepo)
 	flightDeleteUseCase := flight.NewDeleteFlightUseCase(flightRepo)
-	flightSearchUseCase := flight.NewSearchFlightsUseCase(flightRepo)
-	flightSuggestedUseCase := flight.NewlistFlightsUseCase(flightRepo)
+	cabinFares := entities.CabinFares{
+		entities.FlightClassEconomy:    cfg.EconomyFarePercent,
+		entities.FlightClassBusiness:   cfg.BusinessFarePercent,
+		entities.FlightClassFirstClass: cfg.FirstClassFarePercent,
+	}
+	cabinLayout := entities.CabinLayout{FirstClassRows: cfg.FirstClassRows, BusinessRows: cfg.BusinessRows}
+	tierPolicy := entities.TierPolicy{
+		Window:                 cfg.LoyaltyTierWindow,
+		SilverPoints:           cfg.LoyaltySilverPoints,
+		GoldPoints:             cfg.LoyaltyGoldPoints,
+		PlatinumPoints:         cfg.LoyaltyPlatinumPoints,
+		SilverExtraBaggageKg:   cfg.LoyaltySilverExtraBaggageKg,
+		GoldExtraBaggageKg:     cfg.LoyaltyGoldExtraBaggageKg,
+		PlatinumExtraBaggageKg: cfg.LoyaltyPlatinumExtraBaggageKg,
+		FreeSeatSelectionTier:  entities.LoyaltyTier(cfg.LoyaltyFreeSeatSelectionTier),
+		WaitlistPriorityTier:   entities.LoyaltyTier(cfg.LoyaltyWaitlistPriorityTier),
+		PriorityBoardingTier:   entities.LoyaltyTier(cfg.LoyaltyPriorityBoardingTier),
+		LoungeAccessTier:       entities.LoyaltyTier(cfg.LoyaltyLoungeAccessTier),
+	}
+	pricingCurrentFaresUseCase := pricing.NewGetCurrentFaresUseCase(pricingCurveRepo, flightRepo, cabinFares, cabinLayout)
+	pricingListCurvesUseCase := pricing.NewListPricingCurvesUseCase(pricingCurveRepo)
+	pricingUpsertCurveUseCase := pricing.NewUpsertPricingCurveUseCase(pricingCurveRepo)
+	pricingDeleteCurveUseCase := pricing.NewDeletePricingCurveUseCase(pricingCurveRepo)
+	pricingCreateQuoteUseCase := pricing.NewCreateFareQuoteUseCase(flightRepo, fareQuoteRepo, pricingCurrentFaresUseCase, cfg.FareQuoteTTL)
+	flightSearchUseCase := flight.NewSearchFlightsUseCase(flightRepo, pricingCurrentFaresUseCase, fareFamilyRepo)
+	flightSuggestedUseCase := flight.NewlistFlightsUseCase(flightRepo, pricingCurrentFaresUseCase, fareFamilyRepo)
 	ticketGetTicketByFlightIDUseCase := ticket.NewGetTicketsByFlightIDUseCase(ticketRepo)
-	ticketCancelUseCase := ticket.NewCancelTicketUseCase(ticketRepo)
 	ticketGetUseCase := ticket.NewGetTicketUseCase(ticketRepo)
-	ticketUpdateUseCase := ticket.NewUpdateSeatsUseCase(ticketRepo)
-	bookingCreateUseCase := booking.NewCreateBookingUseCase(bookingRepo, flightRepo, taskDistributor)
+	ticketUpdateUseCase := ticket.NewUpdateSeatsUseCase(ticketRepo, fareFamilyRepo, bookingRepo, flightRepo, seatZoneRepo, ancillaryRepo, stripeGateway, cfg.PaymentCurrency, loyaltyRepo, tierPolicy)
+	ticketSearchByNumberUseCase := ticket.NewSearchTicketByNumberUseCase(ticketRepo)
+	pricingRules := entities.PricingRules{
+		Cabins: cabinFares,
+		Passengers: entities.PassengerPolicy{
+			ChildFarePercent:   cfg.ChildFarePercent,
+			InfantFarePercent:  cfg.InfantFarePercent,
+			MaxInfantsPerAdult: cfg.MaxInfantsPerAdult,
+		},
+		VATPercent:  cfg.FareVATPercent,
+		AirportFee:  cfg.AirportFee,
+		SecurityFee: cfg.SecurityFee,
+	}
+	documentPolicy := entities.DocumentPolicy{
+		HomeCountry:            cfg.HomeCountry,
+		PassportValidityMonths: cfg.PassportValidityMonths,
+	}
+	bookingCreateUseCase := booking.NewCreateBookingUseCase(bookingRepo, flightRepo, taskDistributor, cfg.AirlineTicketPrefix, cfg.MinConnectionTime, pricingRules, pricingCurrentFaresUseCase, fareQuoteRepo, fareFamilyRepo, ancillaryRepo, seatZoneRepo, promoCodeRepo, loyaltyRepo, companionRepo, documentPolicy, cabinLayout, tierPolicy)
 	bookingGetUseCase := booking.NewGetBookingUseCase(bookingRepo)
-	paymentUsecase := payment.NewCreatePaymentIntentUseCase(stripeGateway)
+	bookingGetIDUseCase := booking.NewGetBookingIDUseCase(bookingRepo)
+	bookingUpdateStatusUseCase := booking.NewUpdateBookingStatusUseCase(bookingRepo, flightRepo, loyaltyScheduler)
+	refundPolicy := entities.RefundPolicy{
+		FullRefundBefore:    cfg.RefundFullBefore,
+		PartialRefundBefore: cfg.RefundPartialBefore,
+		PartialRefundPercent: map[entities.FlightClass]int{
+			entities.FlightClassEconomy:    cfg.RefundPartialPercentEconomy,
+			entities.FlightClassBusiness:   cfg.RefundPartialPercentBusiness,
+			entities.FlightClassFirstClass: cfg.RefundPartialPercentFirstClass,
+		},
+	}
+	ticketCancelUseCase := ticket.NewCancelTicketUseCase(ticketRepo, waitlistScheduler, refundPolicy, fareFamilyRepo)
+	paymentRefundUseCase := payment.NewRefundPaymentUseCase(stripeGateway, paymentRepo, bookingRepo)
+	bookingCancelUseCase := booking.NewCancelBookingUseCase(bookingRepo, taskDistributor, waitlistScheduler, refundPolicy, fareFamilyRepo, cfg.WalletCreditTTL)
+	bookingQuoteFlightChangeUseCase := booking.NewQuoteFlightChangeUseCase(bookingRepo, flightRepo, cfg.FlightChangeFee, pricingRules, pricingCurrentFaresUseCase, fareFamilyRepo)
+	bookingChangeFlightUseCase := booking.NewChangeFlightUseCase(bookingRepo, flightRepo, stripeGateway, cfg.FlightChangeFee, cfg.PaymentCurrency, pricingRules, pricingCurrentFaresUseCase, fareFamilyRepo, loyaltyScheduler, paymentRefundUseCase)
+	manageBookingLookupUseCase := booking.NewManageBookingLookupUseCase(bookingRepo, tokenMaker, cfg.ManageBookingTokenDuration)
+	manageBookingGetUseCase := booking.NewGetManagedBookingUseCase(bookingRepo)
+	manageBookingUpdateSeatsUseCase := booking.NewUpdateManagedSeatsUseCase(ticketUpdateUseCase)
+	manageBookingBoardingPassesUseCase := booking.NewGetBoardingPassesUseCase(bookingRepo, flightRepo, fareFamilyRepo, loyaltyRepo, tierPolicy)
+	tripListUseCase := booking.NewListTripsUseCase(bookingRepo, flightRepo)
+	paymentUsecase := payment.NewCreatePaymentIntentUseCase(stripeGateway, bookingRepo, loyaltyRepo, walletRepo, paymentRepo)
+	// Mỗi mục đích thanh toán có use case áp dụng khoản tiền đã thu
+	paymentSettlers := map[entities.PaymentPurpose]payment.IPaymentSettler{
+		entities.PaymentPurposeBooking:       booking.NewConfirmBookingPaymentUseCase(paymentRepo, bookingRepo, flightRepo, paymentRefundUseCase, loyaltyScheduler),
+		entities.PaymentPurposeFlightChange:  booking.NewCompleteFlightChangeUseCase(bookingRepo, flightRepo, paymentRefundUseCase, loyaltyScheduler),
+		entities.PaymentPurposeSeatSelection: ticket.NewCompleteSeatSelectionUseCase(ticketRepo, paymentRefundUseCase),
+		entities.PaymentPurposeAncillary:     ancillary.NewActivateAncillaryUseCase(ancillaryRepo, paymentRefundUseCase),
+		entities.PaymentPurposeGroupDeposit:  group.NewCompleteGroupDepositUseCase(groupBookingRepo, stripeGateway, paymentRepo),
+	}
+	paymentHandleEventUseCase := payment.NewHandlePaymentEventUseCase(stripeGateway, paymentRepo, paymentSettlers)
+	ancillaryListUseCase := ancillary.NewListAncillariesUseCase(ancillaryRepo)
+	ancillaryUpsertUseCase := ancillary.NewUpsertAncillaryUseCase(ancillaryRepo)
+	ancillaryDeleteUseCase := ancillary.NewDeleteAncillaryUseCase(ancillaryRepo)
+	ancillaryOffersUseCase := ancillary.NewListAncillaryOffersUseCase(ancillaryRepo, flightRepo)
+	ancillaryPurchaseUseCase := ancillary.NewPurchaseAncillaryUseCase(ancillaryRepo, bookingRepo, flightRepo, stripeGateway, cfg.PaymentCurrency)
+	ancillaryCancelUseCase := ancillary.NewCancelAncillaryUseCase(ancillaryRepo, bookingRepo, flightRepo, paymentRefundUseCase)
+	seatZoneListUseCase := seat.NewListSeatZonesUseCase(seatZoneRepo)
+	seatZoneCreateUseCase := seat.NewCreateSeatZoneUseCase(seatZoneRepo, flightRepo)
+	seatZoneDeleteUseCase := seat.NewDeleteSeatZoneUseCase(seatZoneRepo)
+	seatMapUseCase := seat.NewGetSeatMapUseCase(seatZoneRepo, flightRepo)
+	waitlistJoinUseCase := waitlist.NewJoinWaitlistUseCase(waitlistRepo, flightRepo, cabinLayout)
+	waitlistListUseCase := waitlist.NewListWaitlistEntriesUseCase(waitlistRepo)
+	waitlistLeaveUseCase := waitlist.NewLeaveWaitlistUseCase(waitlistRepo, waitlistScheduler)
+	waitlistClaimUseCase := waitlist.NewClaimWaitlistOfferUseCase(waitlistRepo, pricingCreateQuoteUseCase)
+	groupRequestUseCase := group.NewRequestGroupBookingUseCase(groupBookingRepo, cfg.GroupBookingMinPassengers)
+	groupListUseCase := group.NewListGroupBookingsUseCase(groupBookingRepo)
+	groupGetUseCase := group.NewGetGroupBookingUseCase(groupBookingRepo)
+	groupQuoteUseCase := group.NewQuoteGroupBookingUseCase(groupBookingRepo, flightRepo, taskDistributor)
+	groupPayDepositUseCase := group.NewPayGroupDepositUseCase(groupBookingRepo, stripeGateway, cfg.PaymentCurrency)
+	groupUpdatePassengersUseCase := group.NewUpdateGroupPassengersUseCase(groupBookingRepo, flightRepo, documentPolicy)
+	groupIssueUseCase := group.NewIssueGroupBookingUseCase(groupBookingRepo, cfg.AirlineTicketPrefix)
+	promoListUseCase := promo.NewListPromoCodesUseCase(promoCodeRepo)
+	promoCreateUseCase := promo.NewCreatePromoCodeUseCase(promoCodeRepo)
+	promoDeactivateUseCase := promo.NewDeactivatePromoCodeUseCase(promoCodeRepo)
+	loyaltyRules := entities.LoyaltyRules{
+		AmountPerPoint: cfg.LoyaltyAmountPerPoint,
+		PointValue:     cfg.LoyaltyPointValue,
+		PointsTTL:      cfg.LoyaltyPointsTTL,
+	}
+	loyaltyBalanceUseCase := loyalty.NewGetLoyaltyBalanceUseCase(loyaltyRepo, loyaltyRules)
+	loyaltyStatementUseCase := loyalty.NewGetLoyaltyStatementUseCase(loyaltyRepo)
+	loyaltyRedeemUseCase := loyalty.NewRedeemLoyaltyPointsUseCase(loyaltyRepo, bookingRepo, loyaltyRules, walletRepo, paymentRepo, flightRepo, loyaltyScheduler)
+	loyaltyTierUseCase := loyalty.NewGetLoyaltyTierUseCase(loyaltyRepo, tierPolicy)
+	walletBalanceUseCase := wallet.NewGetWalletBalanceUseCase(walletRepo)
+	walletStatementUseCase := wallet.NewGetWalletStatementUseCase(walletRepo)
+	walletPayUseCase := wallet.NewPayWithWalletUseCase(walletRepo, bookingRepo, loyaltyRepo, paymentRepo, flightRepo, loyaltyScheduler)
+	walletIssueCreditUseCase := wallet.NewIssueWalletCreditUseCase(walletRepo, cfg.WalletCreditTTL)
+	walletIssueFlightCreditUseCase := wallet.NewIssueFlightWalletCreditUseCase(walletRepo, flightRepo, cfg.WalletCreditTTL)
+	companionListUseCase := companion.NewListCompanionsUseCase(companionRepo)
+	companionCreateUseCase := companion.NewCreateCompanionUseCase(companionRepo)
+	companionUpdateUseCase := companion.NewUpdateCompanionUseCase(companionRepo)
+	companionDeleteUseCase := companion.NewDeleteCompanionUseCase(companionRepo)
+	specialServiceListUseCase := specialservice.NewListSpecialServicesUseCase(specialServiceRepo)
+	specialServiceAddUseCase := specialservice.NewAddSpecialServiceUseCase(specialServiceRepo, bookingRepo)
+	specialServiceRemoveUseCase := specialservice.NewRemoveSpecialServiceUseCase(specialServiceRepo, bookingRepo, flightRepo)
+	checkInPolicy := entities.CheckInPolicy{
+		OpensBefore:  cfg.CheckInOpensBefore,
+		ClosesBefore: cfg.CheckInClosesBefore,
+		Documents:    documentPolicy,
+	}
+	checkInUseCase := checkin.NewCheckInUseCase(checkInRepo, bookingRepo, flightRepo, checkInPolicy)
+	checkInUndoUseCase := checkin.NewUndoCheckInUseCase(checkInRepo, bookingRepo, flightRepo, checkInPolicy)
+	ticketManifestUseCase := ticket.NewGetFlightManifestUseCase(flightRepo, ticketRepo, specialServiceRepo)
 
 	// Handlers
 	healthHandler := handlers.NewHealthHandler(healthUseCase)
@@ -97,21 +263,47 @@ func NewContainer(cfg config.Config, redisClient *redis.Client, store *db.Store,
 	newsHandler := handlers.NewNewsHandler(newsGetAllWithAuthorUseCase, newsDeleteUseCase, newsCreateUseCase, newsUpdateUseCase, newsGetUseCase, &cfg)
 	adminHandler := handlers.NewAdminHandler(adminCreateUseCase, getCurrentAdminUseCase, ListAdminsUseCase, updateAdminUseCase, deleteAdminUseCase)
 	flightHandler := handlers.NewFlightHandler(flightCreateUseCase, flightGetUseCase, flightUpdateUseCase, flightGetAllUseCase, flightDeleteUseCase, flightSearchUseCase, flightSuggestedUseCase)
-	ticketHandler := handlers.NewTicketHandler(ticketGetTicketByFlightIDUseCase, ticketGetUseCase, ticketCancelUseCase, ticketUpdateUseCase)
-	bookingHandler := handlers.NewBookingHandler(bookingCreateUseCase, tokenMaker, userRepo, bookingGetUseCase)
-	paymentHandler := handlers.NewPaymentHandler(paymentUsecase)
+	ticketHandler := handlers.NewTicketHandler(ticketGetTicketByFlightIDUseCase, ticketGetUseCase, ticketCancelUseCase, ticketUpdateUseCase, ticketSearchByNumberUseCase, ticketManifestUseCase)
+	bookingHandler := handlers.NewBookingHandler(bookingCreateUseCase, tokenMaker, userRepo, bookingGetUseCase, bookingGetIDUseCase, bookingUpdateStatusUseCase, bookingCancelUseCase, bookingQuoteFlightChangeUseCase, bookingChangeFlightUseCase, ancillaryPurchaseUseCase, ancillaryCancelUseCase)
+	manageBookingHandler := handlers.NewManageBookingHandler(manageBookingLookupUseCase, manageBookingGetUseCase, manageBookingUpdateSeatsUseCase, bookingCancelUseCase, tokenMaker, ancillaryPurchaseUseCase, ancillaryCancelUseCase, manageBookingBoardingPassesUseCase, specialServiceAddUseCase, specialServiceRemoveUseCase, checkInUseCase, checkInUndoUseCase)
+	paymentHandler := handlers.NewPaymentHandler(paymentUsecase, paymentHandleEventUseCase, bookingGetIDUseCase)
+	pricingHandler := handlers.NewPricingHandler(pricingListCurvesUseCase, pricingUpsertCurveUseCase, pricingDeleteCurveUseCase, pricingCreateQuoteUseCase)
+	ancillaryHandler := handlers.NewAncillaryHandler(ancillaryListUseCase, ancillaryUpsertUseCase, ancillaryDeleteUseCase, ancillaryOffersUseCase)
+	seatZoneHandler := handlers.NewSeatZoneHandler(seatZoneListUseCase, seatZoneCreateUseCase, seatZoneDeleteUseCase, seatMapUseCase)
+	waitlistHandler := handlers.NewWaitlistHandler(waitlistJoinUseCase, waitlistListUseCase, waitlistLeaveUseCase, waitlistClaimUseCase)
+	groupBookingHandler := handlers.NewGroupBookingHandler(groupRequestUseCase, groupListUseCase, groupGetUseCase, groupQuoteUseCase, groupPayDepositUseCase, groupUpdatePassengersUseCase, groupIssueUseCase)
+	promoCodeHandler := handlers.NewPromoCodeHandler(promoListUseCase, promoCreateUseCase, promoDeactivateUseCase)
+	loyaltyHandler := handlers.NewLoyaltyHandler(loyaltyBalanceUseCase, loyaltyStatementUseCase, loyaltyRedeemUseCase, loyaltyTierUseCase)
+	tripHandler := handlers.NewTripHandler(tripListUseCase)
+	walletHandler := handlers.NewWalletHandler(walletBalanceUseCase, walletStatementUseCase, walletPayUseCase, walletIssueCreditUseCase, walletIssueFlightCreditUseCase)
+	companionHandler := handlers.NewCompanionHandler(companionListUseCase, companionCreateUseCase, companionUpdateUseCase, companionDeleteUseCase)
+	specialServiceHandler := handlers.NewSpecialServiceHandler(specialServiceListUseCase)
 
 	return &Container{
-		HealthHandler:   healthHandler,
-		CustomerHandler: customerHandler,
-		AuthHandler:     authHandler,
-		NewsHandler:     newsHandler,
-		AdminHandler:    adminHandler,
-		FlightHandler:   flightHandler,
-		TicketHandler:   ticketHandler,
-		BookingHandler:  bookingHandler,
-		PaymentHandler:  paymentHandler,
-		TokenMaker:      tokenMaker,
-		RedisClient:     redisClient,
+		HealthHandler:         healthHandler,
+		CustomerHandler:       customerHandler,
+		AuthHandler:           authHandler,
+		NewsHandler:           newsHandler,
+		AdminHandler:          adminHandler,
+		FlightHandler:         flightHandler,
+		TicketHandler:         ticketHandler,
+		BookingHandler:        bookingHandler,
+		ManageBookingHandler:  manageBookingHandler,
+		PaymentHandler:        paymentHandler,
+		PricingHandler:        pricingHandler,
+		AncillaryHandler:      ancillaryHandler,
+		SeatZoneHandler:       seatZoneHandler,
+		WaitlistHandler:       waitlistHandler,
+		GroupBookingHandler:   groupBookingHandler,
+		PromoCodeHandler:      promoCodeHandler,
+		LoyaltyHandler:        loyaltyHandler,
+		WalletHandler:         walletHandler,
+		TripHandler:           tripHandler,
+		CompanionHandler:      companionHandler,
+		SpecialServiceHandler: specialServiceHandler,
+		TokenMaker:            tokenMaker,
+		UserRepo:              userRepo,
+		RedisClient:           redisClient,
+		IdempotencyRepo:       idempotencyRepo,
 	}, nil
 }
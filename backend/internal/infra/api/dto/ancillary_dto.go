@@ -0,0 +1,47 @@
+package dto
+
+type AncillaryRequest struct {
+	Code        string `json:"code" binding:"required"`
+	Type        string `json:"type" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	// DepartureCity, ArrivalCity và FlightClass để trống nghĩa là áp dụng cho mọi tuyến và hạng ghế
+	DepartureCity string `json:"departureCity"`
+	ArrivalCity   string `json:"arrivalCity"`
+	FlightClass   string `json:"flightClass"`
+	Price         int64  `json:"price"`
+	MaxQuantity   int32  `json:"maxQuantity"`
+	// Active mặc định là true
+	Active *bool `json:"active"`
+}
+
+type AncillaryResponse struct {
+	AncillaryID   string `json:"ancillaryId"`
+	Code          string `json:"code"`
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	DepartureCity string `json:"departureCity"`
+	ArrivalCity   string `json:"arrivalCity"`
+	FlightClass   string `json:"flightClass"`
+	Price         int64  `json:"price"`
+	MaxQuantity   int32  `json:"maxQuantity"`
+	Active        bool   `json:"active"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
+type PurchaseAncillaryRequest struct {
+	TicketID string `json:"ticketId" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	Quantity int32  `json:"quantity"`
+}
+
+type PurchaseAncillaryResponse struct {
+	Ancillary           TicketAncillaryResponse `json:"ancillary"`
+	PaymentClientSecret string                  `json:"paymentClientSecret,omitempty"`
+}
+
+type CancelAncillaryResponse struct {
+	Ancillary TicketAncillaryResponse `json:"ancillary"`
+	Refund    *RefundResponse         `json:"refund"`
+}
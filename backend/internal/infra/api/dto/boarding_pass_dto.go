@@ -0,0 +1,26 @@
+package dto
+
+type BoardingPassResponse struct {
+	TicketID      string `json:"ticketId"`
+	TicketNumber  string `json:"ticketNumber"`
+	BookingPNR    string `json:"bookingPnr"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	PassengerType string `json:"passengerType"`
+	FlightID      string `json:"flightId"`
+	FlightNumber  string `json:"flightNumber"`
+	DepartureCity string `json:"departureCity"`
+	ArrivalCity   string `json:"arrivalCity"`
+	DepartureTime string `json:"departureTime"`
+	SeatCode      string `json:"seatCode"`
+	FlightClass   string `json:"flightClass"`
+	FareFamily    string `json:"fareFamily"`
+	// CheckedBaggageKg gồm hành lý của gói giá và phần cộng thêm theo hạng thành viên
+	CheckedBaggageKg int32    `json:"checkedBaggageKg"`
+	LoyaltyTier      string   `json:"loyaltyTier"`
+	PriorityBoarding bool     `json:"priorityBoarding"`
+	LoungeAccess     bool     `json:"loungeAccess"`
+	Ancillaries      []string `json:"ancillaries,omitempty"`
+	SpecialServices  []string `json:"specialServices,omitempty"`
+	CheckedIn        bool     `json:"checkedIn"`
+}
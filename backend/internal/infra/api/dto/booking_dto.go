@@ -12,12 +12,44 @@ type CreateBookingRequest struct {
 	TripType                string              `json:"tripType"`
 	DepartureTicketDataList []TicketDataRequest `json:"departureTicketDataList"`
 	ReturnTicketDataList    []TicketDataRequest `json:"returnTicketDataList"`
+	// DepartureQuoteID và ReturnQuoteID là mã giá đã khóa của từng chiều, có thể bỏ trống
+	DepartureQuoteID string `json:"departureQuoteId"`
+	ReturnQuoteID    string `json:"returnQuoteId"`
+	// Segments liệt kê các chặng theo thứ tự bay, dùng khi tripType là multiCity
+	Segments []BookingSegmentRequest `json:"segments"`
+	// TotalPrice là tổng tiền hiển thị cho khách, chỉ dùng để đối chiếu: khi gửi lên (kể cả 0)
+	// mà khác giá server tính thì booking bị từ chối. Số tiền phải trả luôn do server tính
+	TotalPrice *int64 `json:"totalPrice"`
+	// PromoCodes là các mã khuyến mãi khách nhập, chỉ được dùng nhiều mã khi tất cả cho phép dùng chung
+	PromoCodes []string `json:"promoCodes"`
+}
+
+type BookingSegmentRequest struct {
+	FlightID       string              `json:"flightId"`
+	QuoteID        string              `json:"quoteId"`
+	TicketDataList []TicketDataRequest `json:"ticketDataList"`
 }
 
 type TicketDataRequest struct {
+	// Price không còn được dùng để tính tiền; giá vé do server tính
 	Price       int32     `json:"price"`
 	FlightClass string    `json:"flightClass"`
 	OwnerData   OwnerData `json:"ownerData"`
+	// AccompanyingPassenger là vị trí (từ 0) của người lớn đi kèm em bé trong cùng danh sách vé
+	AccompanyingPassenger *int `json:"accompanyingPassenger"`
+	// FareFamily là gói giá lite/classic/flex, mặc định classic
+	FareFamily string `json:"fareFamily"`
+	// Ancillaries là các dịch vụ bổ trợ mua kèm vé, giá do server tính
+	Ancillaries []AncillaryItemRequest `json:"ancillaries"`
+	// SeatCode là ghế chọn trước khi đặt (ví dụ 12A), ghế thuộc vùng thu phí được cộng vào tổng tiền
+	SeatCode string `json:"seatCode"`
+	// CompanionID là hồ sơ người đi cùng đã lưu; khi có, tên, ngày sinh và giấy tờ lấy từ hồ sơ thay cho ownerData
+	CompanionID string `json:"companionId"`
+}
+
+type AncillaryItemRequest struct {
+	Code     string `json:"code"`
+	Quantity int32  `json:"quantity"`
 }
 
 type OwnerData struct {
@@ -28,33 +60,191 @@ type OwnerData struct {
 	DateOfBirth        string              `json:"dateOfBirth"`
 	Gender             entities.GenderType `json:"gender"`
 	Address            string              `json:"address"`
+	// PassportNumber là số hộ chiếu, bắt buộc với hành trình quốc tế
+	PassportNumber string `json:"passportNumber"`
+	// PassportCountry là nước cấp hộ chiếu (ISO 3166-1 alpha-2, ví dụ VN)
+	PassportCountry string `json:"passportCountry"`
+	// PassportExpiry là ngày hết hạn hộ chiếu theo định dạng YYYY-MM-DD
+	PassportExpiry string `json:"passportExpiry"`
 }
 
 type CreateBookingResponse struct {
-	BookingID         string               `json:"bookingId"`
-	DepartureFlightID string               `json:"departureFlightId"`
-	ReturnFlightID    string               `json:"returnFlightId"`
-	TripType          string               `json:"tripType"`
-	DepartureTickets  []TicketDataResponse `json:"departureTickets"`
-	ReturnTickets     []TicketDataResponse `json:"returnTickets"`
+	// BookingID là mã PNR; ID nội bộ của booking không được trả cho khách
+	BookingID         string                   `json:"bookingId"`
+	PNR               string                   `json:"pnr"`
+	DepartureFlightID string                   `json:"departureFlightId"`
+	ReturnFlightID    string                   `json:"returnFlightId"`
+	TripType          string                   `json:"tripType"`
+	DepartureTickets  []TicketDataResponse     `json:"departureTickets"`
+	ReturnTickets     []TicketDataResponse     `json:"returnTickets"`
+	Segments          []BookingSegmentResponse `json:"segments"`
+	TotalPrice        int64                    `json:"totalPrice"`
+}
+
+type BookingSegmentResponse struct {
+	SegmentOrder int                  `json:"segmentOrder"`
+	FlightID     string               `json:"flightId"`
+	Tickets      []TicketDataResponse `json:"tickets"`
 }
 
 type TicketDataResponse struct {
-	TicketID    string    `json:"ticketId"`
-	SeatID      string    `json:"seatId"`
-	Price       int32     `json:"price"`
-	FlightClass string    `json:"flightClass"`
-	OwnerData   OwnerData `json:"ownerData"`
+	TicketID             string             `json:"ticketId"`
+	TicketNumber         string             `json:"ticketNumber"`
+	SeatID               string             `json:"seatId"`
+	Price                int32              `json:"price"`
+	FlightClass          string             `json:"flightClass"`
+	FareFamily           string             `json:"fareFamily"`
+	PassengerType        string             `json:"passengerType"`
+	AccompanyingTicketID string             `json:"accompanyingTicketId"`
+	OwnerData            OwnerData          `json:"ownerData"`
+	FareBreakdown        []FareItemResponse `json:"fareBreakdown"`
+	// Ancillaries được tính riêng với Price
+	Ancillaries []TicketAncillaryResponse `json:"ancillaries"`
+	// SpecialServices là các yêu cầu dịch vụ đặc biệt (SSR) của hành khách
+	SpecialServices []TicketSpecialServiceResponse `json:"specialServices"`
+	// CheckedInAt là thời điểm làm thủ tục trực tuyến, rỗng khi hành khách chưa làm thủ tục
+	CheckedInAt string `json:"checkedInAt"`
+}
+
+type TicketAncillaryResponse struct {
+	TicketAncillaryID string `json:"ticketAncillaryId"`
+	TicketID          string `json:"ticketId"`
+	Code              string `json:"code"`
+	Type              string `json:"type"`
+	Name              string `json:"name"`
+	Quantity          int32  `json:"quantity"`
+	UnitPrice         int64  `json:"unitPrice"`
+	Amount            int64  `json:"amount"`
+	Status            string `json:"status"`
+	CreatedAt         string `json:"createdAt"`
+	CancelledAt       string `json:"cancelledAt,omitempty"`
+}
+
+type FareItemResponse struct {
+	Type        string `json:"type"`
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"`
 }
 
 type GetBookingResponse struct {
-	BookingID         string   `json:"bookingId"`
-	Email             string   `json:"email"`
-	TripType          string   `json:"tripType"`
-	DepartureFlightID string   `json:"departureFlightId"`
-	ReturnFlightID    string   `json:"returnFlightId"`
-	DepartureTickets  []string `json:"departureIdTickets"`
-	ReturnTickets     []string `json:"returnIdTickets"`
-	CreatedAt         string   `json:"createdAt"`
-	UpdatedAt         string   `json:"updatedAt"`
+	BookingID              string                          `json:"bookingId"`
+	PNR                    string                          `json:"pnr"`
+	Email                  string                          `json:"email"`
+	TripType               string                          `json:"tripType"`
+	DepartureFlightID      string                          `json:"departureFlightId"`
+	ReturnFlightID         string                          `json:"returnFlightId"`
+	DepartureTickets       []string                        `json:"departureIdTickets"`
+	ReturnTickets          []string                        `json:"returnIdTickets"`
+	DepartureTicketNumbers []string                        `json:"departureTicketNumbers"`
+	ReturnTicketNumbers    []string                        `json:"returnTicketNumbers"`
+	Segments               []BookingSegmentSummaryResponse `json:"segments"`
+	Status                 string                          `json:"status"`
+	StatusHistory          []BookingStatusHistoryResponse  `json:"statusHistory"`
+	CreatedAt              string                          `json:"createdAt"`
+	UpdatedAt              string                          `json:"updatedAt"`
+}
+
+type BookingSegmentSummaryResponse struct {
+	SegmentOrder  int      `json:"segmentOrder"`
+	FlightID      string   `json:"flightId"`
+	TicketIDs     []string `json:"ticketIds"`
+	TicketNumbers []string `json:"ticketNumbers"`
+}
+
+type UpdateBookingStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason"`
+}
+
+type BookingStatusHistoryResponse struct {
+	FromStatus string `json:"fromStatus"`
+	ToStatus   string `json:"toStatus"`
+	Actor      string `json:"actor"`
+	Reason     string `json:"reason"`
+	CreatedAt  string `json:"createdAt"`
+}
+
+type UpdateBookingStatusResponse struct {
+	BookingID     string                         `json:"bookingId"`
+	PNR           string                         `json:"pnr"`
+	Status        string                         `json:"status"`
+	StatusHistory []BookingStatusHistoryResponse `json:"statusHistory"`
+	UpdatedAt     string                         `json:"updatedAt"`
+}
+
+type ManageBookingLookupRequest struct {
+	PNR      string `json:"pnr" binding:"required"`
+	LastName string `json:"lastName" binding:"required"`
+}
+
+type ManageBookingLookupResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expiresAt"`
+	PNR       string `json:"pnr"`
+}
+
+type CancelBookingRequest struct {
+	Reason string `json:"reason"`
+	// RefundToWallet nhận hoàn tiền dưới dạng tín dụng trong ví thay vì tiền mặt
+	RefundToWallet bool `json:"refundToWallet"`
+}
+
+type CancelBookingResponse struct {
+	BookingID          string                         `json:"bookingId"`
+	PNR                string                         `json:"pnr"`
+	Status             string                         `json:"status"`
+	StatusHistory      []BookingStatusHistoryResponse `json:"statusHistory"`
+	CancelledTicketIDs []string                       `json:"cancelledTicketIds"`
+	Refund             *RefundResponse                `json:"refund"`
+	WalletCredit       *WalletTransactionResponse     `json:"walletCredit,omitempty"`
+	UpdatedAt          string                         `json:"updatedAt"`
+}
+
+type RefundResponse struct {
+	RefundID  string `json:"refundId"`
+	Amount    int64  `json:"amount"`
+	Status    string `json:"status"`
+	Method    string `json:"method"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type FlightChangeQuoteResponse struct {
+	FlightID       string `json:"flightId"`
+	FlightNumber   string `json:"flightNumber"`
+	DepartureTime  string `json:"departureTime"`
+	ArrivalTime    string `json:"arrivalTime"`
+	CurrentFare    int64  `json:"currentFare"`
+	NewFare        int64  `json:"newFare"`
+	ChangeFee      int64  `json:"changeFee"`
+	FareDifference int64  `json:"fareDifference"`
+	AmountDue      int64  `json:"amountDue"`
+	RefundAmount   int64  `json:"refundAmount"`
+}
+
+type ChangeFlightRequest struct {
+	FromFlightID string `json:"fromFlightId" binding:"required"`
+	ToFlightID   string `json:"toFlightId" binding:"required"`
+}
+
+type ChangedTicketResponse struct {
+	TicketID     string `json:"ticketId"`
+	TicketNumber string `json:"ticketNumber"`
+	FlightID     string `json:"flightId"`
+	FlightClass  string `json:"flightClass"`
+	Price        int32  `json:"price"`
+}
+
+type ChangeFlightResponse struct {
+	FlightChangeID      string                    `json:"flightChangeId"`
+	Status              string                    `json:"status"`
+	BookingID           string                    `json:"bookingId"`
+	PNR                 string                    `json:"pnr"`
+	DepartureFlightID   string                    `json:"departureFlightId"`
+	ReturnFlightID      string                    `json:"returnFlightId"`
+	Tickets             []ChangedTicketResponse   `json:"tickets"`
+	Quote               FlightChangeQuoteResponse `json:"quote"`
+	PaymentClientSecret string                    `json:"paymentClientSecret,omitempty"`
+	Refund              *RefundResponse           `json:"refund"`
+	UpdatedAt           string                    `json:"updatedAt"`
 }
@@ -0,0 +1,15 @@
+package dto
+
+type CheckInRequest struct {
+	TicketIDs []string `json:"ticketIds" binding:"required,min=1"`
+}
+
+type UndoCheckInRequest struct {
+	TicketIDs []string `json:"ticketIds" binding:"required,min=1"`
+}
+
+type TicketCheckInResponse struct {
+	TicketID    string `json:"ticketId"`
+	FlightID    string `json:"flightId"`
+	CheckedInAt string `json:"checkedInAt"`
+}
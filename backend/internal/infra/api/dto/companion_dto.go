@@ -0,0 +1,28 @@
+package dto
+
+type CompanionRequest struct {
+	FirstName string `json:"firstName" binding:"required"`
+	LastName  string `json:"lastName" binding:"required"`
+	Gender    string `json:"gender" binding:"required"`
+	// DateOfBirth và DocumentExpiry có dạng YYYY-MM-DD
+	DateOfBirth string `json:"dateOfBirth" binding:"required"`
+	// DocumentType là passport hoặc id_card
+	DocumentType   string `json:"documentType" binding:"required"`
+	DocumentNumber string `json:"documentNumber" binding:"required"`
+	DocumentExpiry string `json:"documentExpiry" binding:"required"`
+	// DocumentCountry là nước cấp hộ chiếu (ví dụ VN), bắt buộc khi documentType là passport
+	DocumentCountry string `json:"documentCountry"`
+}
+
+type CompanionResponse struct {
+	CompanionID     string `json:"companionId"`
+	FirstName       string `json:"firstName"`
+	LastName        string `json:"lastName"`
+	Gender          string `json:"gender"`
+	DateOfBirth     string `json:"dateOfBirth"`
+	DocumentType    string `json:"documentType"`
+	DocumentNumber  string `json:"documentNumber"`
+	DocumentExpiry  string `json:"documentExpiry"`
+	DocumentCountry string `json:"documentCountry"`
+	UpdatedAt       string `json:"updatedAt"`
+}
@@ -13,6 +13,8 @@ type CreateFlightRequest struct {
 	ArrivalCity      string                `json:"arrivalCity"`
 	DepartureAirport string                `json:"departureAirport"`
 	ArrivalAirport   string                `json:"arrivalAirport"`
+	DepartureCountry string                `json:"departureCountry"`
+	ArrivalCountry   string                `json:"arrivalCountry"`
 	DepartureTime    time.Time             `json:"departureTime"`
 	ArrivalTime      time.Time             `json:"arrivalTime"`
 	BasePrice        int32                 `json:"basePrice"`
@@ -77,6 +79,25 @@ type FlightSearchResponse struct {
 	ArrivalAirport   string `json:"arrivalAirport"`
 	AircraftType     string `json:"aircraftType"`
 	BasePrice        int    `json:"basePrice"`
+	// CurrentPrice là giá hạng phổ thông hiện tại; Prices là giá hiện tại của từng hạng ghế
+	CurrentPrice int64            `json:"currentPrice"`
+	Prices       map[string]int64 `json:"prices"`
+	// FareFamilies là các gói giá đang bán của từng hạng ghế kèm quy định
+	FareFamilies []FareFamilyOfferResponse `json:"fareFamilies"`
+}
+
+type FareFamilyOfferResponse struct {
+	FlightClass      string `json:"flightClass"`
+	FareFamily       string `json:"fareFamily"`
+	Name             string `json:"name"`
+	Price            int64  `json:"price"`
+	CabinBaggageKg   int32  `json:"cabinBaggageKg"`
+	CheckedBaggageKg int32  `json:"checkedBaggageKg"`
+	Changeable       bool   `json:"changeable"`
+	ChangeFeeWaived  bool   `json:"changeFeeWaived"`
+	Refundable       bool   `json:"refundable"`
+	FullRefund       bool   `json:"fullRefund"`
+	SeatSelection    bool   `json:"seatSelection"`
 }
 type GetFlightsWithTicketsResponse struct {
 	Flights []FlightWithTickets `json:"flights"`
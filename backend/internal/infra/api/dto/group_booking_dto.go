@@ -0,0 +1,79 @@
+package dto
+
+type GroupBookingRequest struct {
+	DepartureCity string `json:"departureCity" binding:"required"`
+	ArrivalCity   string `json:"arrivalCity" binding:"required"`
+	// DepartureDate và ReturnDate theo định dạng 2006-01-02, ReturnDate để trống nếu đi một chiều
+	DepartureDate string `json:"departureDate" binding:"required"`
+	ReturnDate    string `json:"returnDate"`
+	FlightClass   string `json:"flightClass" binding:"required"`
+	Headcount     int32  `json:"headcount" binding:"required"`
+	Note          string `json:"note"`
+}
+
+type GroupQuoteRequest struct {
+	OutboundFlightID string `json:"outboundFlightId" binding:"required"`
+	ReturnFlightID   string `json:"returnFlightId"`
+	FarePerPassenger int64  `json:"farePerPassenger" binding:"required"`
+	DepositAmount    int64  `json:"depositAmount" binding:"required"`
+	// DepositDeadline và NameCutoff theo định dạng RFC3339
+	DepositDeadline string `json:"depositDeadline" binding:"required"`
+	NameCutoff      string `json:"nameCutoff" binding:"required"`
+}
+
+type GroupPassengersRequest struct {
+	Passengers []OwnerData `json:"passengers"`
+}
+
+type GroupBookingResponse struct {
+	GroupBookingID   string              `json:"groupBookingId"`
+	UserEmail        string              `json:"userEmail"`
+	DepartureCity    string              `json:"departureCity"`
+	ArrivalCity      string              `json:"arrivalCity"`
+	DepartureDate    string              `json:"departureDate"`
+	ReturnDate       string              `json:"returnDate,omitempty"`
+	FlightClass      string              `json:"flightClass"`
+	Headcount        int32               `json:"headcount"`
+	Note             string              `json:"note"`
+	Status           string              `json:"status"`
+	OutboundFlightID string              `json:"outboundFlightId,omitempty"`
+	ReturnFlightID   string              `json:"returnFlightId,omitempty"`
+	FarePerPassenger int64               `json:"farePerPassenger"`
+	TotalFare        int64               `json:"totalFare"`
+	DepositAmount    int64               `json:"depositAmount"`
+	DepositDeadline  string              `json:"depositDeadline,omitempty"`
+	NameCutoff       string              `json:"nameCutoff,omitempty"`
+	DepositPaidAt    string              `json:"depositPaidAt,omitempty"`
+	BookingID        string              `json:"bookingId,omitempty"`
+	Passengers       []GroupPassengerDTO `json:"passengers"`
+	CreatedAt        string              `json:"createdAt"`
+}
+
+type GroupPassengerDTO struct {
+	FirstName          string `json:"firstName"`
+	LastName           string `json:"lastName"`
+	PhoneNumber        string `json:"phoneNumber"`
+	DateOfBirth        string `json:"dateOfBirth"`
+	Gender             string `json:"gender"`
+	IdentityCardNumber string `json:"identityCardNumber"`
+	Address            string `json:"address"`
+	// PassportNumber là số hộ chiếu, bắt buộc với hành trình quốc tế
+	PassportNumber string `json:"passportNumber"`
+	// PassportCountry là nước cấp hộ chiếu (ISO 3166-1 alpha-2, ví dụ VN)
+	PassportCountry string `json:"passportCountry"`
+	// PassportExpiry là ngày hết hạn hộ chiếu theo định dạng YYYY-MM-DD
+	PassportExpiry string `json:"passportExpiry"`
+}
+
+type GroupDepositResponse struct {
+	GroupBooking        GroupBookingResponse `json:"groupBooking"`
+	PaymentClientSecret string               `json:"paymentClientSecret"`
+}
+
+type GroupTicketingResponse struct {
+	GroupBooking  GroupBookingResponse `json:"groupBooking"`
+	BookingID     string               `json:"bookingId"`
+	PNR           string               `json:"pnr"`
+	BookingStatus string               `json:"bookingStatus"`
+	AmountDue     int64                `json:"amountDue"`
+}
@@ -0,0 +1,63 @@
+package dto
+
+type LoyaltyBalanceResponse struct {
+	Points int32 `json:"points"`
+	// Value là số tiền có thể trừ khi thanh toán bằng toàn bộ số điểm
+	Value int64 `json:"value"`
+}
+
+type LoyaltyStatementParams struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100" default:"10"`
+	Page  int `form:"page" binding:"omitempty,min=1" default:"1"`
+}
+
+type LoyaltyTransactionResponse struct {
+	TransactionID string `json:"transactionId"`
+	Kind          string `json:"kind"`
+	Points        int32  `json:"points"`
+	Amount        int64  `json:"amount"`
+	TicketID      string `json:"ticketId,omitempty"`
+	BookingID     string `json:"bookingId,omitempty"`
+	Description   string `json:"description"`
+	ExpiresAt     string `json:"expiresAt,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+type LoyaltyStatementResponse struct {
+	Transactions []LoyaltyTransactionResponse `json:"transactions"`
+	Total        int64                        `json:"total"`
+	Page         int                          `json:"page"`
+	Limit        int                          `json:"limit"`
+}
+
+type RedeemLoyaltyRequest struct {
+	BookingID string `json:"bookingId" binding:"required"`
+	Points    int32  `json:"points" binding:"required"`
+}
+
+type RedeemLoyaltyResponse struct {
+	Transaction LoyaltyTransactionResponse `json:"transaction"`
+	Balance     int32                      `json:"balance"`
+	// AmountDue là số tiền booking còn phải thanh toán sau khi trừ điểm
+	AmountDue     int64  `json:"amountDue"`
+	BookingStatus string `json:"bookingStatus"`
+}
+
+type LoyaltyTierResponse struct {
+	Tier             string `json:"tier"`
+	QualifyingPoints int32  `json:"qualifyingPoints"`
+	// QualifiedTier là hạng đạt được theo điểm hiện tại, áp dụng ở lần xét hạng kế tiếp
+	QualifiedTier    string               `json:"qualifiedTier"`
+	NextTier         string               `json:"nextTier,omitempty"`
+	PointsToNextTier int32                `json:"pointsToNextTier"`
+	WindowStart      string               `json:"windowStart"`
+	Benefits         TierBenefitsResponse `json:"benefits"`
+}
+
+type TierBenefitsResponse struct {
+	ExtraCheckedBaggageKg int32 `json:"extraCheckedBaggageKg"`
+	FreeSeatSelection     bool  `json:"freeSeatSelection"`
+	WaitlistPriority      bool  `json:"waitlistPriority"`
+	PriorityBoarding      bool  `json:"priorityBoarding"`
+	LoungeAccess          bool  `json:"loungeAccess"`
+}
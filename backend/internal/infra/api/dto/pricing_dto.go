@@ -0,0 +1,40 @@
+package dto
+
+type PriceStepRequest struct {
+	Threshold int   `json:"threshold"`
+	Percent   int64 `json:"percent"`
+}
+
+type PricingCurveRequest struct {
+	DepartureCity        string             `json:"departureCity" binding:"required"`
+	ArrivalCity          string             `json:"arrivalCity" binding:"required"`
+	FlightClass          string             `json:"flightClass" binding:"required"`
+	LoadFactorSteps      []PriceStepRequest `json:"loadFactorSteps"`
+	DaysToDepartureSteps []PriceStepRequest `json:"daysToDepartureSteps"`
+}
+
+type PriceStepResponse struct {
+	Threshold int   `json:"threshold"`
+	Percent   int64 `json:"percent"`
+}
+
+type PricingCurveResponse struct {
+	CurveID              string              `json:"curveId"`
+	DepartureCity        string              `json:"departureCity"`
+	ArrivalCity          string              `json:"arrivalCity"`
+	FlightClass          string              `json:"flightClass"`
+	LoadFactorSteps      []PriceStepResponse `json:"loadFactorSteps"`
+	DaysToDepartureSteps []PriceStepResponse `json:"daysToDepartureSteps"`
+	UpdatedAt            string              `json:"updatedAt"`
+}
+
+type FareQuoteRequest struct {
+	FlightID string `json:"flightId" binding:"required"`
+}
+
+type FareQuoteResponse struct {
+	QuoteID   string           `json:"quoteId"`
+	FlightID  string           `json:"flightId"`
+	Prices    map[string]int64 `json:"prices"`
+	ExpiresAt string           `json:"expiresAt"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+type PromoCodeRequest struct {
+	Code          string `json:"code" binding:"required"`
+	Description   string `json:"description"`
+	DiscountType  string `json:"discountType" binding:"required"`
+	DiscountValue int64  `json:"discountValue"`
+	// MaxDiscount giới hạn số tiền giảm của mã giảm theo %, 0 là không giới hạn
+	MaxDiscount int64 `json:"maxDiscount"`
+	// ValidFrom và ValidUntil theo định dạng RFC3339
+	ValidFrom  string `json:"validFrom" binding:"required"`
+	ValidUntil string `json:"validUntil" binding:"required"`
+	// DepartureCity, ArrivalCity và FlightClasses để trống nghĩa là áp dụng cho mọi tuyến và hạng ghế
+	DepartureCity      string   `json:"departureCity"`
+	ArrivalCity        string   `json:"arrivalCity"`
+	FlightClasses      []string `json:"flightClasses"`
+	MaxUses            int32    `json:"maxUses"`
+	MaxUsesPerCustomer int32    `json:"maxUsesPerCustomer"`
+	Stackable          bool     `json:"stackable"`
+}
+
+type PromoCodeResponse struct {
+	PromoCodeID        string   `json:"promoCodeId"`
+	Code               string   `json:"code"`
+	Description        string   `json:"description"`
+	DiscountType       string   `json:"discountType"`
+	DiscountValue      int64    `json:"discountValue"`
+	MaxDiscount        int64    `json:"maxDiscount"`
+	ValidFrom          string   `json:"validFrom"`
+	ValidUntil         string   `json:"validUntil"`
+	DepartureCity      string   `json:"departureCity"`
+	ArrivalCity        string   `json:"arrivalCity"`
+	FlightClasses      []string `json:"flightClasses"`
+	MaxUses            int32    `json:"maxUses"`
+	MaxUsesPerCustomer int32    `json:"maxUsesPerCustomer"`
+	Stackable          bool     `json:"stackable"`
+	Active             bool     `json:"active"`
+	UpdatedAt          string   `json:"updatedAt"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+type SeatZoneRequest struct {
+	// FlightID để trống nghĩa là áp dụng cho mọi chuyến bay dùng loại máy bay AircraftType
+	FlightID     string `json:"flightId"`
+	AircraftType string `json:"aircraftType"`
+	Type         string `json:"type" binding:"required"`
+	Name         string `json:"name" binding:"required"`
+	RowFrom      int32  `json:"rowFrom" binding:"required"`
+	RowTo        int32  `json:"rowTo" binding:"required"`
+	Price        int64  `json:"price"`
+}
+
+type SeatZoneResponse struct {
+	SeatZoneID   string `json:"seatZoneId"`
+	FlightID     string `json:"flightId"`
+	AircraftType string `json:"aircraftType"`
+	Type         string `json:"type"`
+	Name         string `json:"name"`
+	RowFrom      int32  `json:"rowFrom"`
+	RowTo        int32  `json:"rowTo"`
+	Price        int64  `json:"price"`
+	UpdatedAt    string `json:"updatedAt"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+type SpecialServiceResponse struct {
+	Code     string `json:"code"`
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	// FlightLimit là số yêu cầu tối đa trên một chuyến bay, 0 là không giới hạn
+	FlightLimit int32 `json:"flightLimit"`
+}
+
+type AddSpecialServiceRequest struct {
+	TicketID string `json:"ticketId" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	// Note là thông tin thêm cho nhân viên, ví dụ loài và cân nặng của thú cưng
+	Note string `json:"note"`
+}
+
+type TicketSpecialServiceResponse struct {
+	TicketSpecialServiceID string `json:"ticketSpecialServiceId"`
+	TicketID               string `json:"ticketId"`
+	FlightID               string `json:"flightId"`
+	Code                   string `json:"code"`
+	Category               string `json:"category"`
+	Name                   string `json:"name"`
+	Note                   string `json:"note"`
+	CreatedAt              string `json:"createdAt"`
+}
+
+type ManifestPassengerResponse struct {
+	TicketID        string   `json:"ticketId"`
+	TicketNumber    string   `json:"ticketNumber"`
+	BookingPNR      string   `json:"bookingPnr"`
+	FirstName       string   `json:"firstName"`
+	LastName        string   `json:"lastName"`
+	PassengerType   string   `json:"passengerType"`
+	SeatCode        string   `json:"seatCode"`
+	FlightClass     string   `json:"flightClass"`
+	SpecialServices []string `json:"specialServices"`
+}
+
+type FlightManifestResponse struct {
+	FlightID             string                      `json:"flightId"`
+	FlightNumber         string                      `json:"flightNumber"`
+	DepartureCity        string                      `json:"departureCity"`
+	ArrivalCity          string                      `json:"arrivalCity"`
+	DepartureTime        string                      `json:"departureTime"`
+	Passengers           []ManifestPassengerResponse `json:"passengers"`
+	SpecialServiceCounts map[string]int              `json:"specialServiceCounts"`
+}
@@ -1,30 +1,34 @@
 package dto
 
 type GetTicketByFlightIDResponse struct {
-	TicketID    int64               `json:"ticketId"`
-	SeatID      int64               `json:"seatId"`
-	FlightClass string              `json:"flightClass"`
-	Price       int32               `json:"price"`
-	Status      string              `json:"status"`
-	BookingID   int64               `json:"bookingId"`
-	FlightID    int64               `json:"flightId"`
-	CreatedAt   string              `json:"createdAt"`
-	UpdatedAt   string              `json:"updatedAt"`
-	Seat        SeatResponse        `json:"seat"`
-	Owner       TicketOwnerResponse `json:"owner"`
+	TicketID     int64               `json:"ticketId"`
+	TicketNumber string              `json:"ticketNumber"`
+	SeatID       int64               `json:"seatId"`
+	FlightClass  string              `json:"flightClass"`
+	Price        int32               `json:"price"`
+	Status       string              `json:"status"`
+	BookingID    int64               `json:"bookingId"`
+	BookingPNR   string              `json:"bookingPnr"`
+	FlightID     int64               `json:"flightId"`
+	CreatedAt    string              `json:"createdAt"`
+	UpdatedAt    string              `json:"updatedAt"`
+	Seat         SeatResponse        `json:"seat"`
+	Owner        TicketOwnerResponse `json:"owner"`
 }
 
 type GetTicketResponse struct {
-	TicketID    int64               `json:"ticketId"`
-	Status      string              `json:"status"`
-	SeatCode    string              `json:"seatCode"`
-	FlightClass string              `json:"flightClass"`
-	Price       int32               `json:"price"`
-	OwnerData   TicketOwnerResponse `json:"ownerData"`
-	BookingID   int64               `json:"bookingId"`
-	FlightID    int64               `json:"flightId"`
-	CreatedAt   string              `json:"createdAt"`
-	UpdatedAt   string              `json:"updatedAt"`
+	TicketID     int64               `json:"ticketId"`
+	TicketNumber string              `json:"ticketNumber"`
+	Status       string              `json:"status"`
+	SeatCode     string              `json:"seatCode"`
+	FlightClass  string              `json:"flightClass"`
+	Price        int32               `json:"price"`
+	OwnerData    TicketOwnerResponse `json:"ownerData"`
+	BookingID    int64               `json:"bookingId"`
+	BookingPNR   string              `json:"bookingPnr"`
+	FlightID     int64               `json:"flightId"`
+	CreatedAt    string              `json:"createdAt"`
+	UpdatedAt    string              `json:"updatedAt"`
 }
 
 type SeatResponse struct {
@@ -42,15 +46,17 @@ type TicketOwnerResponse struct {
 }
 
 type CancelTicketResponse struct {
-	TicketID    int64               `json:"ticketId"`
-	Status      string              `json:"status"`
-	SeatCode    string              `json:"seatCode"`
-	FlightClass string              `json:"flightClass"`
-	Price       int32               `json:"price"`
-	OwnerData   TicketOwnerResponse `json:"ownerData"`
-	BookingID   int64               `json:"bookingId"`
-	FlightID    int64               `json:"flightId"`
-	UpdatedAt   string              `json:"updatedAt"`
+	TicketID     int64               `json:"ticketId"`
+	TicketNumber string              `json:"ticketNumber"`
+	Status       string              `json:"status"`
+	SeatCode     string              `json:"seatCode"`
+	FlightClass  string              `json:"flightClass"`
+	Price        int32               `json:"price"`
+	OwnerData    TicketOwnerResponse `json:"ownerData"`
+	BookingID    int64               `json:"bookingId"`
+	BookingPNR   string              `json:"bookingPnr"`
+	FlightID     int64               `json:"flightId"`
+	UpdatedAt    string              `json:"updatedAt"`
 }
 
 type UpdateSeatRequest struct {
@@ -62,6 +68,14 @@ type UpdateSeatResponse struct {
 	TicketID int64  `json:"ticketId"`
 	SeatCode string `json:"seatCode"`
 	Status   string `json:"status"`
+	SeatZone string `json:"seatZone,omitempty"`
+	Fee      int64  `json:"fee"`
+}
+
+type UpdateSeatsResponse struct {
+	Seats               []UpdateSeatResponse `json:"seats"`
+	AmountDue           int64                `json:"amountDue"`
+	PaymentClientSecret string               `json:"paymentClientSecret,omitempty"`
 }
 
 type UpdateFlightTimesRequest struct {
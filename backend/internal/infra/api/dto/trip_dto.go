@@ -0,0 +1,56 @@
+package dto
+
+type TripListParams struct {
+	// When chọn tab chuyến sắp tới (upcoming) hoặc đã bay (past), mặc định upcoming
+	When   string `form:"when" binding:"omitempty,oneof=upcoming past"`
+	Status string `form:"status" binding:"omitempty,oneof=confirmed pending cancelled"`
+	// From và To (YYYY-MM-DD) lọc theo ngày khởi hành của chặng đầu, tính cả hai đầu
+	From  string `form:"from"`
+	To    string `form:"to"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50" default:"10"`
+	Page  int    `form:"page" binding:"omitempty,min=1" default:"1"`
+}
+
+type TripTicketResponse struct {
+	TicketID      string `json:"ticketId"`
+	TicketNumber  string `json:"ticketNumber"`
+	PassengerType string `json:"passengerType"`
+	FirstName     string `json:"firstName"`
+	LastName      string `json:"lastName"`
+	SeatCode      string `json:"seatCode"`
+	FlightClass   string `json:"flightClass"`
+	FareFamily    string `json:"fareFamily"`
+	Status        string `json:"status"`
+}
+
+type TripSegmentResponse struct {
+	SegmentOrder  int                  `json:"segmentOrder"`
+	FlightID      string               `json:"flightId"`
+	FlightNumber  string               `json:"flightNumber"`
+	DepartureCity string               `json:"departureCity"`
+	ArrivalCity   string               `json:"arrivalCity"`
+	DepartureTime string               `json:"departureTime"`
+	ArrivalTime   string               `json:"arrivalTime"`
+	FlightStatus  string               `json:"flightStatus"`
+	Tickets       []TripTicketResponse `json:"tickets"`
+}
+
+type TripResponse struct {
+	BookingID     string                `json:"bookingId"`
+	PNR           string                `json:"pnr"`
+	TripType      string                `json:"tripType"`
+	Status        string                `json:"status"`
+	Upcoming      bool                  `json:"upcoming"`
+	DepartureTime string                `json:"departureTime"`
+	Segments      []TripSegmentResponse `json:"segments"`
+	CreatedAt     string                `json:"createdAt"`
+}
+
+type TripListResponse struct {
+	Trips         []TripResponse `json:"trips"`
+	Total         int64          `json:"total"`
+	Page          int            `json:"page"`
+	Limit         int            `json:"limit"`
+	UpcomingCount int64          `json:"upcomingCount"`
+	PastCount     int64          `json:"pastCount"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+type JoinWaitlistRequest struct {
+	FlightID    string `json:"flightId" binding:"required"`
+	FlightClass string `json:"flightClass" binding:"required"`
+}
+
+type ClaimWaitlistRequest struct {
+	// Token là mã trong đường dẫn nhận ghế gửi qua email
+	Token string `json:"token" binding:"required"`
+}
+
+type WaitlistEntryResponse struct {
+	WaitlistEntryID string `json:"waitlistEntryId"`
+	FlightID        string `json:"flightId"`
+	FlightClass     string `json:"flightClass"`
+	Status          string `json:"status"`
+	OfferExpiresAt  string `json:"offerExpiresAt,omitempty"`
+	JoinedAt        string `json:"joinedAt"`
+}
+
+type ClaimWaitlistResponse struct {
+	Entry WaitlistEntryResponse `json:"entry"`
+	// Quote giữ giá vé để khách đặt chỗ bằng quoteId
+	Quote FareQuoteResponse `json:"quote"`
+}
@@ -0,0 +1,64 @@
+package dto
+
+type WalletBalanceResponse struct {
+	Balance int64 `json:"balance"`
+}
+
+type WalletStatementParams struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100" default:"10"`
+	Page  int `form:"page" binding:"omitempty,min=1" default:"1"`
+}
+
+type WalletTransactionResponse struct {
+	TransactionID string `json:"transactionId"`
+	Kind          string `json:"kind"`
+	Amount        int64  `json:"amount"`
+	BookingID     string `json:"bookingId,omitempty"`
+	RefundID      string `json:"refundId,omitempty"`
+	IssuedBy      string `json:"issuedBy,omitempty"`
+	Description   string `json:"description"`
+	ExpiresAt     string `json:"expiresAt,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+type WalletStatementResponse struct {
+	Transactions []WalletTransactionResponse `json:"transactions"`
+	Total        int64                       `json:"total"`
+	Page         int                         `json:"page"`
+	Limit        int                         `json:"limit"`
+}
+
+type PayWithWalletRequest struct {
+	BookingID string `json:"bookingId" binding:"required"`
+	Amount    int64  `json:"amount" binding:"required"`
+}
+
+type PayWithWalletResponse struct {
+	Transaction WalletTransactionResponse `json:"transaction"`
+	Balance     int64                     `json:"balance"`
+	// AmountDue là số tiền booking còn phải thanh toán sau khi trừ ví
+	AmountDue     int64  `json:"amountDue"`
+	BookingStatus string `json:"bookingStatus"`
+}
+
+type IssueWalletCreditRequest struct {
+	Email     string `json:"email" binding:"required,email"`
+	Amount    int64  `json:"amount" binding:"required"`
+	Reason    string `json:"reason" binding:"required"`
+	BookingID string `json:"bookingId"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type IssueFlightWalletCreditRequest struct {
+	FlightID string `json:"flightId" binding:"required"`
+	// AmountPerTicket được nhân với số vé còn hiệu lực của mỗi khách trên chuyến bay
+	AmountPerTicket int64  `json:"amountPerTicket" binding:"required"`
+	Reason          string `json:"reason" binding:"required"`
+	ExpiresAt       string `json:"expiresAt"`
+}
+
+type FlightWalletCreditResponse struct {
+	UserID      string                    `json:"userId"`
+	Tickets     int64                     `json:"tickets"`
+	Transaction WalletTransactionResponse `json:"transaction"`
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/ancillary"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type AncillaryHandler struct {
+	listAncillariesUseCase     ancillary.IListAncillariesUseCase
+	upsertAncillaryUseCase     ancillary.IUpsertAncillaryUseCase
+	deleteAncillaryUseCase     ancillary.IDeleteAncillaryUseCase
+	listAncillaryOffersUseCase ancillary.IListAncillaryOffersUseCase
+}
+
+func NewAncillaryHandler(listAncillariesUseCase ancillary.IListAncillariesUseCase, upsertAncillaryUseCase ancillary.IUpsertAncillaryUseCase, deleteAncillaryUseCase ancillary.IDeleteAncillaryUseCase, listAncillaryOffersUseCase ancillary.IListAncillaryOffersUseCase) *AncillaryHandler {
+	return &AncillaryHandler{
+		listAncillariesUseCase:     listAncillariesUseCase,
+		upsertAncillaryUseCase:     upsertAncillaryUseCase,
+		deleteAncillaryUseCase:     deleteAncillaryUseCase,
+		listAncillaryOffersUseCase: listAncillaryOffersUseCase,
+	}
+}
+
+func (h *AncillaryHandler) ListAncillaries(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	catalog, err := h.listAncillariesUseCase.Execute(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Ancillaries retrieved successfully.",
+		"data":    mappers.ToAncillaryResponses(catalog),
+	})
+}
+
+// UpsertAncillary creates or replaces the price of an add-on on a route and cabin.
+func (h *AncillaryHandler) UpsertAncillary(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	var request dto.AncillaryRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ancillary data. Please check the input fields."})
+		return
+	}
+
+	saved, err := h.upsertAncillaryUseCase.Execute(ctx.Request.Context(), mappers.ToAncillaryEntity(request))
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidAncillary) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Ancillary saved successfully.",
+		"data":    mappers.ToAncillaryResponse(saved),
+	})
+}
+
+func (h *AncillaryHandler) DeleteAncillary(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	ancillaryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ancillary ID."})
+		return
+	}
+
+	if err := h.deleteAncillaryUseCase.Execute(ctx.Request.Context(), ancillaryID); err != nil {
+		if errors.Is(err, adapters.ErrAncillaryNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Ancillary not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Ancillary deleted successfully."})
+}
+
+// ListOffers lists the add-ons on sale for ?flightId= in ?flightClass=, priced for the flight's route.
+func (h *AncillaryHandler) ListOffers(ctx *gin.Context) {
+	flightID, err := strconv.ParseInt(ctx.Query("flightId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+	class := entities.FlightClass(ctx.DefaultQuery("flightClass", string(entities.FlightClassEconomy)))
+	if !class.Valid() {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight class."})
+		return
+	}
+
+	offers, err := h.listAncillaryOffersUseCase.Execute(ctx.Request.Context(), flightID, class)
+	if err != nil {
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Ancillary offers retrieved successfully.",
+		"data":    mappers.ToAncillaryResponses(offers),
+	})
+}
+
+// writeAncillaryError maps errors from the ancillary purchase and cancellation use cases to HTTP responses.
+func writeAncillaryError(ctx *gin.Context, err error) {
+	var ancillaryErr *entities.AncillaryError
+	switch {
+	case errors.As(err, &ancillaryErr):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": ancillaryErr.Error()})
+	case errors.Is(err, adapters.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+	case errors.Is(err, adapters.ErrTicketNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Ticket not found in this booking."})
+	case errors.Is(err, adapters.ErrTicketAncillaryNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Ancillary not found or already cancelled."})
+	case errors.Is(err, adapters.ErrBookingNotChangeable):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Booking cannot be changed in its current status."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
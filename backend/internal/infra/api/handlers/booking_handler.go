@@ -3,11 +3,14 @@ package handlers
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/ancillary"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
@@ -15,18 +18,32 @@ import (
 )
 
 type BookingHandler struct {
-	createBookingUseCase booking.ICreateBookingUseCase
-	tokenMaker           token.Maker
-	userRepository       adapters.IUserRepository
-	getBookingUseCase    booking.IGetBookingUseCase
+	createBookingUseCase       booking.ICreateBookingUseCase
+	tokenMaker                 token.Maker
+	userRepository             adapters.IUserRepository
+	getBookingUseCase          booking.IGetBookingUseCase
+	getBookingIDUseCase        booking.IGetBookingIDUseCase
+	updateBookingStatusUseCase booking.IUpdateBookingStatusUseCase
+	cancelBookingUseCase       booking.ICancelBookingUseCase
+	quoteFlightChangeUseCase   booking.IQuoteFlightChangeUseCase
+	changeFlightUseCase        booking.IChangeFlightUseCase
+	purchaseAncillaryUseCase   ancillary.IPurchaseAncillaryUseCase
+	cancelAncillaryUseCase     ancillary.ICancelAncillaryUseCase
 }
 
-func NewBookingHandler(createBookingUseCase booking.ICreateBookingUseCase, tokenMaker token.Maker, userRepository adapters.IUserRepository, getBookingUseCase booking.IGetBookingUseCase) *BookingHandler {
+func NewBookingHandler(createBookingUseCase booking.ICreateBookingUseCase, tokenMaker token.Maker, userRepository adapters.IUserRepository, getBookingUseCase booking.IGetBookingUseCase, getBookingIDUseCase booking.IGetBookingIDUseCase, updateBookingStatusUseCase booking.IUpdateBookingStatusUseCase, cancelBookingUseCase booking.ICancelBookingUseCase, quoteFlightChangeUseCase booking.IQuoteFlightChangeUseCase, changeFlightUseCase booking.IChangeFlightUseCase, purchaseAncillaryUseCase ancillary.IPurchaseAncillaryUseCase, cancelAncillaryUseCase ancillary.ICancelAncillaryUseCase) *BookingHandler {
 	return &BookingHandler{
-		createBookingUseCase: createBookingUseCase,
-		tokenMaker:           tokenMaker,
-		userRepository:       userRepository,
-		getBookingUseCase:    getBookingUseCase,
+		createBookingUseCase:       createBookingUseCase,
+		tokenMaker:                 tokenMaker,
+		userRepository:             userRepository,
+		getBookingUseCase:          getBookingUseCase,
+		getBookingIDUseCase:        getBookingIDUseCase,
+		updateBookingStatusUseCase: updateBookingStatusUseCase,
+		cancelBookingUseCase:       cancelBookingUseCase,
+		quoteFlightChangeUseCase:   quoteFlightChangeUseCase,
+		changeFlightUseCase:        changeFlightUseCase,
+		purchaseAncillaryUseCase:   purchaseAncillaryUseCase,
+		cancelAncillaryUseCase:     cancelAncillaryUseCase,
 	}
 }
 
@@ -72,6 +89,46 @@ func (h *BookingHandler) CreateBooking(ctx *gin.Context) {
 			ctx.JSON(http.StatusNotFound, gin.H{"message": "One or more flights not found."})
 			return
 		}
+		if errors.Is(err, adapters.ErrPriceMismatch) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": err.Error()})
+			return
+		}
+		if errors.Is(err, adapters.ErrFareQuoteNotFound) || errors.Is(err, adapters.ErrFareQuoteMismatch) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": err.Error()})
+			return
+		}
+		if errors.Is(err, adapters.ErrSeatUnavailable) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": "One or more seats are already taken."})
+			return
+		}
+		if errors.Is(err, adapters.ErrNotEnoughSeats) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": "Not enough seats left on the flight."})
+			return
+		}
+		if errors.Is(err, adapters.ErrCompanionNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "One or more companion profiles not found."})
+			return
+		}
+		var itineraryErr *entities.ItineraryError
+		if errors.As(err, &itineraryErr) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": itineraryErr.Error()})
+			return
+		}
+		var passengerErr *entities.PassengerError
+		if errors.As(err, &passengerErr) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": passengerErr.Error()})
+			return
+		}
+		var documentErr *entities.DocumentError
+		if errors.As(err, &documentErr) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid passenger documents.", "errors": documentErr.Fields})
+			return
+		}
+		var promoErr *entities.PromoCodeError
+		if errors.As(err, &promoErr) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": promoErr.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": fmt.Sprintf("An unexpected error occurred. %v", err.Error())})
 		return
 	}
@@ -84,32 +141,302 @@ func (h *BookingHandler) CreateBooking(ctx *gin.Context) {
 }
 
 func (h *BookingHandler) GetBooking(ctx *gin.Context) {
-	bookingIDStr := ctx.Query("id")
-	if bookingIDStr == "" {
-		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Booking ID is required."})
+	// id là mã PNR (6 ký tự) của booking, lastName là họ của một hành khách trong booking
+	reference := ctx.Query("id")
+	lastName := ctx.Query("lastName")
+	if reference == "" || lastName == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Booking ID and last name are required."})
 		return
 	}
 
-	bookingID, err := strconv.ParseInt(bookingIDStr, 10, 64)
+	booking, departureTickets, returnTickets, err := h.getBookingUseCase.Execute(ctx.Request.Context(), reference, lastName)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid booking ID."})
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	response := mappers.ToGetBookingResponse(booking, departureTickets, returnTickets)
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Booking details retrieved successfully.",
+		"data":    response,
+	})
+}
+
+func (h *BookingHandler) UpdateBookingStatus(ctx *gin.Context) {
+	isAdmin := ctx.GetHeader("admin")
+	if isAdmin != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	bookingID, ok := h.bookingIDFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.UpdateBookingStatusRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid status data. Please check the input fields."})
 		return
 	}
 
-	booking, departureTickets, returnTickets, err := h.getBookingUseCase.Execute(ctx.Request.Context(), bookingID)
+	booking, err := h.updateBookingStatusUseCase.Execute(ctx.Request.Context(), entities.UpdateBookingStatusParams{
+		BookingID: bookingID,
+		Status:    entities.BookingStatus(request.Status),
+		Actor:     "admin",
+		Reason:    request.Reason,
+	})
 	if err != nil {
 		if errors.Is(err, adapters.ErrBookingNotFound) {
 			ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
 			return
 		}
+		if errors.Is(err, adapters.ErrCancelThroughCancelBooking) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Use POST /booking/:id/cancel to cancel a booking."})
+			return
+		}
+		var transitionErr *entities.StatusTransitionError
+		if errors.As(err, &transitionErr) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": transitionErr.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
 		return
 	}
 
-	response := mappers.ToGetBookingResponse(booking, departureTickets, returnTickets)
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Booking status updated successfully.",
+		"data":    mappers.ToUpdateBookingStatusResponse(booking),
+	})
+}
+
+// CancelBooking cancels a whole booking. Admins may cancel any booking, customers only their own.
+func (h *BookingHandler) CancelBooking(ctx *gin.Context) {
+	actor, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+	bookingID, ok := h.bookingIDFromPath(ctx)
+	if !ok {
+		return
+	}
+	params := entities.CancelBookingParams{
+		BookingID:      bookingID,
+		Actor:          actor,
+		RequesterEmail: requesterEmail,
+	}
+
+	var request dto.CancelBookingRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid cancellation data."})
+		return
+	}
+	params.Reason = request.Reason
+	params.RefundToWallet = request.RefundToWallet
+
+	result, err := h.cancelBookingUseCase.Execute(ctx.Request.Context(), params)
+	if err != nil {
+		writeCancelBookingError(ctx, err)
+		return
+	}
 
 	ctx.JSON(http.StatusOK, gin.H{
-		"message": "Booking details retrieved successfully.",
-		"data":    response,
+		"message": "Booking cancelled successfully.",
+		"data":    mappers.ToCancelBookingResponse(result),
 	})
 }
+
+// QuoteFlightChange lists the flights the segment flown on ?flightId= can be moved to, with their fare difference.
+func (h *BookingHandler) QuoteFlightChange(ctx *gin.Context) {
+	flightID, err := strconv.ParseInt(ctx.Query("flightId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+
+	_, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+	bookingID, ok := h.bookingIDFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	quotes, err := h.quoteFlightChangeUseCase.Execute(ctx.Request.Context(), bookingID, flightID, requesterEmail)
+	if err != nil {
+		writeChangeFlightError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Flight change options retrieved successfully.",
+		"data":    mappers.ToFlightChangeQuoteResponses(quotes),
+	})
+}
+
+// ChangeFlight moves one segment of a confirmed booking to another flight on the same route.
+func (h *BookingHandler) ChangeFlight(ctx *gin.Context) {
+	var request dto.ChangeFlightRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight change data. Please check the input fields."})
+		return
+	}
+	fromFlightID, err := strconv.ParseInt(request.FromFlightID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+	toFlightID, err := strconv.ParseInt(request.ToFlightID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+
+	actor, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+	bookingID, ok := h.bookingIDFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := h.changeFlightUseCase.Execute(ctx.Request.Context(), entities.ChangeFlightParams{
+		BookingID:      bookingID,
+		FromFlightID:   fromFlightID,
+		ToFlightID:     toFlightID,
+		Actor:          actor,
+		RequesterEmail: requesterEmail,
+	})
+	if err != nil {
+		writeChangeFlightError(ctx, err)
+		return
+	}
+
+	// Vé chỉ được chuyển sau khi khách thanh toán phần chênh lệch
+	if result.Status == entities.FlightChangeStatusPending {
+		ctx.JSON(http.StatusAccepted, gin.H{
+			"message": "Flight change is awaiting payment.",
+			"data":    mappers.ToChangeFlightResponse(result),
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Flight changed successfully.",
+		"data":    mappers.ToChangeFlightResponse(result),
+	})
+}
+
+// PurchaseAncillary adds an add-on to a ticket of the booking. Admins may act on any booking, customers only their own.
+func (h *BookingHandler) PurchaseAncillary(ctx *gin.Context) {
+	var request dto.PurchaseAncillaryRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ancillary data. Please check the input fields."})
+		return
+	}
+	ticketID, err := strconv.ParseInt(request.TicketID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ticket ID."})
+		return
+	}
+
+	_, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+	bookingID, ok := h.bookingIDFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := h.purchaseAncillaryUseCase.Execute(ctx.Request.Context(), entities.PurchaseAncillaryParams{
+		BookingID:      bookingID,
+		TicketID:       ticketID,
+		Code:           request.Code,
+		Quantity:       request.Quantity,
+		RequesterEmail: requesterEmail,
+	})
+	if err != nil {
+		writeAncillaryError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Ancillary purchased successfully.",
+		"data":    mappers.ToPurchaseAncillaryResponse(result),
+	})
+}
+
+// CancelAncillary cancels an unused add-on of the booking and refunds it when the booking was paid.
+func (h *BookingHandler) CancelAncillary(ctx *gin.Context) {
+	itemID, err := strconv.ParseInt(ctx.Param("itemId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ancillary ID."})
+		return
+	}
+
+	_, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+	bookingID, ok := h.bookingIDFromPath(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := h.cancelAncillaryUseCase.Execute(ctx.Request.Context(), entities.CancelAncillaryParams{
+		BookingID:         bookingID,
+		TicketAncillaryID: itemID,
+		RequesterEmail:    requesterEmail,
+	})
+	if err != nil {
+		writeAncillaryError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Ancillary cancelled successfully.",
+		"data":    mappers.ToCancelAncillaryResponse(result),
+	})
+}
+
+// bookingIDFromPath resolves the PNR in the :id path parameter to the booking it names.
+func (h *BookingHandler) bookingIDFromPath(ctx *gin.Context) (int64, bool) {
+	bookingID, err := h.getBookingIDUseCase.Execute(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+			return 0, false
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return 0, false
+	}
+	return bookingID, true
+}
+
+// writeChangeFlightError maps errors from the flight change use cases to HTTP responses.
+func writeChangeFlightError(ctx *gin.Context, err error) {
+	var fareRuleErr *entities.FareRuleError
+	switch {
+	case errors.As(err, &fareRuleErr):
+		ctx.JSON(http.StatusForbidden, gin.H{"message": fareRuleErr.Error()})
+	case errors.Is(err, adapters.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+	case errors.Is(err, adapters.ErrFlightNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+	case errors.Is(err, adapters.ErrBookingNotChangeable):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Booking cannot be changed in its current status."})
+	case errors.Is(err, adapters.ErrTicketAlreadyCheckedIn):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Undo the check-in of the passengers before changing their flight."})
+	case errors.Is(err, adapters.ErrInvalidFlightChange):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "The selected flight is not a valid alternative for this booking."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
@@ -0,0 +1,258 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	mockbooking "github.com/spaghetti-lover/qairlines/internal/domain/mock/booking"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCancelBookingHandler(t *testing.T) {
+	testCases := []struct {
+		name          string
+		bookingID     string
+		isAdmin       bool
+		buildStubs    func(mockUseCase *mockbooking.MockICancelBookingUseCase)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "OK",
+			bookingID: "ABC234",
+			isAdmin:   true,
+			buildStubs: func(mockUseCase *mockbooking.MockICancelBookingUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), entities.CancelBookingParams{BookingID: 42, Actor: "admin", Reason: "schedule change"}).
+					Times(1).
+					Return(entities.CancelBookingResult{
+						Booking:          entities.Booking{BookingID: 42, PNR: "ABC234", Status: entities.BookingStatusCancelled},
+						Refund:           &entities.Refund{RefundID: 7, BookingID: 42, Amount: 500, Status: entities.RefundStatusPending},
+						CancelledTickets: []entities.Ticket{{TicketID: 1}, {TicketID: 2}},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var body struct {
+					Data struct {
+						BookingID string `json:"bookingId"`
+						Refund    struct {
+							Amount int64 `json:"amount"`
+						} `json:"refund"`
+						CancelledTicketIDs []string `json:"cancelledTicketIds"`
+					} `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				require.Equal(t, "ABC234", body.Data.BookingID)
+				require.Equal(t, int64(500), body.Data.Refund.Amount)
+				require.Equal(t, []string{"1", "2"}, body.Data.CancelledTicketIDs)
+			},
+		},
+		{
+			name:      "AlreadyCancelled",
+			bookingID: "ABC234",
+			isAdmin:   true,
+			buildStubs: func(mockUseCase *mockbooking.MockICancelBookingUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(entities.CancelBookingResult{}, &entities.StatusTransitionError{Entity: "booking", From: "cancelled", To: "cancelled"})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name:      "NotFound",
+			bookingID: "ABC234",
+			isAdmin:   true,
+			buildStubs: func(mockUseCase *mockbooking.MockICancelBookingUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(entities.CancelBookingResult{}, adapters.ErrBookingNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			// ID nội bộ dạng số không được chấp nhận thay cho PNR
+			name:      "NumericID",
+			bookingID: "42",
+			isAdmin:   true,
+			buildStubs: func(mockUseCase *mockbooking.MockICancelBookingUseCase) {
+				mockUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "Unauthorized",
+			bookingID: "ABC234",
+			buildStubs: func(mockUseCase *mockbooking.MockICancelBookingUseCase) {
+				mockUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := mockbooking.NewMockICancelBookingUseCase(ctrl)
+			tc.buildStubs(mockUseCase)
+			mockGetBookingID := newMockGetBookingIDUseCase(ctrl)
+
+			handler := handlers.NewBookingHandler(nil, nil, nil, nil, mockGetBookingID, nil, mockUseCase, nil, nil, nil, nil)
+			router := gin.Default()
+			router.POST("/api/booking/:id/cancel", handler.CancelBooking)
+
+			body, err := json.Marshal(gin.H{"reason": "schedule change"})
+			require.NoError(t, err)
+			req, _ := http.NewRequest("POST", "/api/booking/"+tc.bookingID+"/cancel", bytes.NewReader(body))
+			if tc.isAdmin {
+				req.Header.Set("admin", "true")
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+			tc.checkResponse(t, w)
+		})
+	}
+}
+
+func TestChangeFlightHandler(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(mockUseCase *mockbooking.MockIChangeFlightUseCase)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"fromFlightId": "10", "toFlightId": "11"},
+			buildStubs: func(mockUseCase *mockbooking.MockIChangeFlightUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), entities.ChangeFlightParams{BookingID: 42, FromFlightID: 10, ToFlightID: 11, Actor: "admin"}).
+					Times(1).
+					Return(entities.ChangeFlightResult{
+						Status:              entities.FlightChangeStatusCompleted,
+						Booking:             entities.Booking{BookingID: 42, PNR: "ABC234", DepartureFlightID: 11},
+						Tickets:             []entities.Ticket{{TicketID: 1, TicketNumber: "8880000000011", FlightID: 11, Price: 1200}},
+						Quote:               entities.FlightChangeQuote{Flight: entities.Flight{FlightID: 11}, AmountDue: 500},
+						PaymentClientSecret: "pi_secret",
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "pi_secret")
+				require.Contains(t, recorder.Body.String(), "8880000000011")
+			},
+		},
+		{
+			name: "AwaitingPayment",
+			body: gin.H{"fromFlightId": "10", "toFlightId": "11"},
+			buildStubs: func(mockUseCase *mockbooking.MockIChangeFlightUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(entities.ChangeFlightResult{
+						FlightChangeID:      5,
+						Status:              entities.FlightChangeStatusPending,
+						Booking:             entities.Booking{BookingID: 42, PNR: "ABC234", DepartureFlightID: 10},
+						Quote:               entities.FlightChangeQuote{Flight: entities.Flight{FlightID: 11}, AmountDue: 500},
+						PaymentClientSecret: "pi_secret",
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "pi_secret")
+				require.Contains(t, recorder.Body.String(), `"status":"pending"`)
+			},
+		},
+		{
+			name: "NotChangeable",
+			body: gin.H{"fromFlightId": "10", "toFlightId": "11"},
+			buildStubs: func(mockUseCase *mockbooking.MockIChangeFlightUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(entities.ChangeFlightResult{}, adapters.ErrBookingNotChangeable)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidAlternative",
+			body: gin.H{"fromFlightId": "10", "toFlightId": "12"},
+			buildStubs: func(mockUseCase *mockbooking.MockIChangeFlightUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(entities.ChangeFlightResult{}, adapters.ErrInvalidFlightChange)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MissingTargetFlight",
+			body: gin.H{"fromFlightId": "10"},
+			buildStubs: func(mockUseCase *mockbooking.MockIChangeFlightUseCase) {
+				mockUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := mockbooking.NewMockIChangeFlightUseCase(ctrl)
+			tc.buildStubs(mockUseCase)
+			mockGetBookingID := newMockGetBookingIDUseCase(ctrl)
+
+			handler := handlers.NewBookingHandler(nil, nil, nil, nil, mockGetBookingID, nil, nil, nil, mockUseCase, nil, nil)
+			router := gin.Default()
+			router.POST("/api/booking/:id/change", handler.ChangeFlight)
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+			req, _ := http.NewRequest("POST", "/api/booking/ABC234/change", bytes.NewReader(body))
+			req.Header.Set("admin", "true")
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+			tc.checkResponse(t, w)
+		})
+	}
+}
+
+// newMockGetBookingIDUseCase resolves the PNR ABC234 to booking 42 and nothing else.
+func newMockGetBookingIDUseCase(ctrl *gomock.Controller) *mockbooking.MockIGetBookingIDUseCase {
+	mockUseCase := mockbooking.NewMockIGetBookingIDUseCase(ctrl)
+	mockUseCase.EXPECT().Execute(gomock.Any(), "ABC234").AnyTimes().Return(int64(42), nil)
+	mockUseCase.EXPECT().Execute(gomock.Any(), gomock.Not("ABC234")).AnyTimes().Return(int64(0), adapters.ErrBookingNotFound)
+	return mockUseCase
+}
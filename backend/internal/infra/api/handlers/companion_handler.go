@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/companion"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type CompanionHandler struct {
+	listCompanionsUseCase  companion.IListCompanionsUseCase
+	createCompanionUseCase companion.ICreateCompanionUseCase
+	updateCompanionUseCase companion.IUpdateCompanionUseCase
+	deleteCompanionUseCase companion.IDeleteCompanionUseCase
+}
+
+func NewCompanionHandler(listCompanionsUseCase companion.IListCompanionsUseCase, createCompanionUseCase companion.ICreateCompanionUseCase, updateCompanionUseCase companion.IUpdateCompanionUseCase, deleteCompanionUseCase companion.IDeleteCompanionUseCase) *CompanionHandler {
+	return &CompanionHandler{
+		listCompanionsUseCase:  listCompanionsUseCase,
+		createCompanionUseCase: createCompanionUseCase,
+		updateCompanionUseCase: updateCompanionUseCase,
+		deleteCompanionUseCase: deleteCompanionUseCase,
+	}
+}
+
+// ListCompanions lists the companion profiles saved by the signed-in customer.
+func (h *CompanionHandler) ListCompanions(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	companions, err := h.listCompanionsUseCase.Execute(ctx.Request.Context(), user.UserID)
+	if err != nil {
+		writeCompanionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Companions retrieved successfully.",
+		"data":    mappers.ToCompanionResponses(companions),
+	})
+}
+
+// CreateCompanion saves a companion profile for the signed-in customer.
+func (h *CompanionHandler) CreateCompanion(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.CompanionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid companion data. Please check the input fields."})
+		return
+	}
+	profile, err := parseCompanionRequest(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+	profile.UserID = user.UserID
+
+	created, err := h.createCompanionUseCase.Execute(ctx.Request.Context(), profile)
+	if err != nil {
+		writeCompanionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Companion saved successfully.",
+		"data":    mappers.ToCompanionResponse(created),
+	})
+}
+
+// UpdateCompanion replaces one of the signed-in customer's companion profiles.
+func (h *CompanionHandler) UpdateCompanion(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	companionID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid companion ID."})
+		return
+	}
+	var request dto.CompanionRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid companion data. Please check the input fields."})
+		return
+	}
+	profile, err := parseCompanionRequest(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+	profile.CompanionID = companionID
+	profile.UserID = user.UserID
+
+	updated, err := h.updateCompanionUseCase.Execute(ctx.Request.Context(), profile)
+	if err != nil {
+		writeCompanionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Companion updated successfully.",
+		"data":    mappers.ToCompanionResponse(updated),
+	})
+}
+
+// DeleteCompanion removes one of the signed-in customer's companion profiles.
+func (h *CompanionHandler) DeleteCompanion(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	companionID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid companion ID."})
+		return
+	}
+
+	deleted, err := h.deleteCompanionUseCase.Execute(ctx.Request.Context(), companionID, user.UserID)
+	if err != nil {
+		writeCompanionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Companion deleted successfully.",
+		"data":    mappers.ToCompanionResponse(deleted),
+	})
+}
+
+// parseCompanionRequest reads the dates of a companion profile request.
+func parseCompanionRequest(request dto.CompanionRequest) (entities.Companion, error) {
+	dateOfBirth, err := time.Parse("2006-01-02", request.DateOfBirth)
+	if err != nil {
+		return entities.Companion{}, errors.New("Invalid date of birth. Use YYYY-MM-DD.")
+	}
+	documentExpiry, err := time.Parse("2006-01-02", request.DocumentExpiry)
+	if err != nil {
+		return entities.Companion{}, errors.New("Invalid document expiry date. Use YYYY-MM-DD.")
+	}
+	return entities.Companion{
+		FirstName:       strings.TrimSpace(request.FirstName),
+		LastName:        strings.TrimSpace(request.LastName),
+		Gender:          entities.GenderType(request.Gender),
+		DateOfBirth:     dateOfBirth,
+		DocumentType:    entities.DocumentType(request.DocumentType),
+		DocumentNumber:  strings.ToUpper(strings.TrimSpace(request.DocumentNumber)),
+		DocumentExpiry:  documentExpiry,
+		DocumentCountry: strings.ToUpper(strings.TrimSpace(request.DocumentCountry)),
+	}, nil
+}
+
+// writeCompanionError maps errors from the companion use cases to HTTP responses.
+func writeCompanionError(ctx *gin.Context, err error) {
+	var companionErr *entities.CompanionError
+	switch {
+	case errors.As(err, &companionErr):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": companionErr.Error()})
+	case errors.Is(err, adapters.ErrCompanionNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Companion not found."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
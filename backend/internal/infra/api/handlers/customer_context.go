@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/middleware"
+)
+
+// currentCustomer returns the customer stored by middleware.CustomerMiddleware, writing a 401 when there is none.
+func currentCustomer(ctx *gin.Context) (entities.User, bool) {
+	value, exists := ctx.Get(middleware.CustomerKey)
+	user, ok := value.(entities.User)
+	if !exists || !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authorization header is missing"})
+		return entities.User{}, false
+	}
+	return user, true
+}
+
+// currentRequester identifies who is acting on a booking. Admins may act on any booking;
+// customers are limited to their own bookings through the returned email. It writes the
+// error response itself when it returns false.
+func currentRequester(ctx *gin.Context) (actor string, requesterEmail string, ok bool) {
+	if ctx.GetHeader("admin") == "true" {
+		return "admin", "", true
+	}
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return "", "", false
+	}
+	return "customer", user.Email, true
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/group"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type GroupBookingHandler struct {
+	requestGroupBookingUseCase   group.IRequestGroupBookingUseCase
+	listGroupBookingsUseCase     group.IListGroupBookingsUseCase
+	getGroupBookingUseCase       group.IGetGroupBookingUseCase
+	quoteGroupBookingUseCase     group.IQuoteGroupBookingUseCase
+	payGroupDepositUseCase       group.IPayGroupDepositUseCase
+	updateGroupPassengersUseCase group.IUpdateGroupPassengersUseCase
+	issueGroupBookingUseCase     group.IIssueGroupBookingUseCase
+}
+
+func NewGroupBookingHandler(requestGroupBookingUseCase group.IRequestGroupBookingUseCase, listGroupBookingsUseCase group.IListGroupBookingsUseCase, getGroupBookingUseCase group.IGetGroupBookingUseCase, quoteGroupBookingUseCase group.IQuoteGroupBookingUseCase, payGroupDepositUseCase group.IPayGroupDepositUseCase, updateGroupPassengersUseCase group.IUpdateGroupPassengersUseCase, issueGroupBookingUseCase group.IIssueGroupBookingUseCase) *GroupBookingHandler {
+	return &GroupBookingHandler{
+		requestGroupBookingUseCase:   requestGroupBookingUseCase,
+		listGroupBookingsUseCase:     listGroupBookingsUseCase,
+		getGroupBookingUseCase:       getGroupBookingUseCase,
+		quoteGroupBookingUseCase:     quoteGroupBookingUseCase,
+		payGroupDepositUseCase:       payGroupDepositUseCase,
+		updateGroupPassengersUseCase: updateGroupPassengersUseCase,
+		issueGroupBookingUseCase:     issueGroupBookingUseCase,
+	}
+}
+
+// RequestGroupBooking records the signed-in customer's request to fly a group.
+func (h *GroupBookingHandler) RequestGroupBooking(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.GroupBookingRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid group booking data. Please check the input fields."})
+		return
+	}
+	groupBooking, err := mappers.ToGroupBookingEntity(request, user.Email)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid travel dates. Use the format YYYY-MM-DD."})
+		return
+	}
+
+	groupBooking, err = h.requestGroupBookingUseCase.Execute(ctx.Request.Context(), groupBooking)
+	if err != nil {
+		writeGroupBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Group booking request submitted successfully.",
+		"data":    mappers.ToGroupBookingResponse(groupBooking),
+	})
+}
+
+// ListGroupBookings lists every group for an admin, or the signed-in customer's groups.
+func (h *GroupBookingHandler) ListGroupBookings(ctx *gin.Context) {
+	_, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+
+	groups, err := h.listGroupBookingsUseCase.Execute(ctx.Request.Context(), requesterEmail)
+	if err != nil {
+		writeGroupBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Group bookings retrieved successfully.",
+		"data":    mappers.ToGroupBookingResponses(groups),
+	})
+}
+
+func (h *GroupBookingHandler) GetGroupBooking(ctx *gin.Context) {
+	groupBookingID, ok := parseGroupBookingID(ctx)
+	if !ok {
+		return
+	}
+	_, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+
+	groupBooking, err := h.getGroupBookingUseCase.Execute(ctx.Request.Context(), groupBookingID, requesterEmail)
+	if err != nil {
+		writeGroupBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Group booking retrieved successfully.",
+		"data":    mappers.ToGroupBookingResponse(groupBooking),
+	})
+}
+
+// QuoteGroupBooking lets an admin offer a group fare and block seats for the group.
+func (h *GroupBookingHandler) QuoteGroupBooking(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+	groupBookingID, ok := parseGroupBookingID(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.GroupQuoteRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid quote data. Please check the input fields."})
+		return
+	}
+	quote, err := mappers.ToGroupQuoteEntity(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid quote data. Flight IDs must be numbers and deadlines RFC3339 times."})
+		return
+	}
+
+	groupBooking, err := h.quoteGroupBookingUseCase.Execute(ctx.Request.Context(), groupBookingID, quote)
+	if err != nil {
+		writeGroupBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Group booking quoted successfully.",
+		"data":    mappers.ToGroupBookingResponse(groupBooking),
+	})
+}
+
+// PayGroupDeposit creates the payment collecting the deposit of the signed-in customer's quoted group.
+func (h *GroupBookingHandler) PayGroupDeposit(ctx *gin.Context) {
+	groupBookingID, ok := parseGroupBookingID(ctx)
+	if !ok {
+		return
+	}
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := h.payGroupDepositUseCase.Execute(ctx.Request.Context(), groupBookingID, user.Email)
+	if err != nil {
+		writeGroupBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Group deposit payment created successfully.",
+		"data":    mappers.ToGroupDepositResponse(result),
+	})
+}
+
+// UpdateGroupPassengers replaces the passenger names of a group before its name cutoff.
+func (h *GroupBookingHandler) UpdateGroupPassengers(ctx *gin.Context) {
+	groupBookingID, ok := parseGroupBookingID(ctx)
+	if !ok {
+		return
+	}
+	_, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.GroupPassengersRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid passenger data. Please check the input fields."})
+		return
+	}
+	passengers, err := mappers.ToGroupPassengerEntities(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid date of birth or passport expiry. Use the format YYYY-MM-DD."})
+		return
+	}
+
+	groupBooking, err := h.updateGroupPassengersUseCase.Execute(ctx.Request.Context(), groupBookingID, requesterEmail, passengers)
+	if err != nil {
+		writeGroupBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Group passengers updated successfully.",
+		"data":    mappers.ToGroupBookingResponse(groupBooking),
+	})
+}
+
+// IssueGroupBooking tickets a group whose deposit is paid, turning its passengers into a
+// booking. The balance left after the deposit is paid like any other booking.
+func (h *GroupBookingHandler) IssueGroupBooking(ctx *gin.Context) {
+	groupBookingID, ok := parseGroupBookingID(ctx)
+	if !ok {
+		return
+	}
+	_, requesterEmail, ok := currentRequester(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := h.issueGroupBookingUseCase.Execute(ctx.Request.Context(), groupBookingID, requesterEmail)
+	if err != nil {
+		writeGroupBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Group booking ticketed successfully.",
+		"data":    mappers.ToGroupTicketingResponse(result),
+	})
+}
+
+func parseGroupBookingID(ctx *gin.Context) (int64, bool) {
+	groupBookingID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid group booking ID."})
+		return 0, false
+	}
+	return groupBookingID, true
+}
+
+// writeGroupBookingError maps errors from the group booking use cases to HTTP responses.
+func writeGroupBookingError(ctx *gin.Context, err error) {
+	var groupErr *entities.GroupBookingError
+	var documentErr *entities.DocumentError
+	switch {
+	case errors.As(err, &groupErr):
+		ctx.JSON(http.StatusConflict, gin.H{"message": groupErr.Error()})
+	case errors.As(err, &documentErr):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid passenger documents.", "errors": documentErr.Fields})
+	case errors.Is(err, entities.ErrInvalidGroupBooking):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	case errors.Is(err, adapters.ErrGroupBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Group booking not found."})
+	case errors.Is(err, adapters.ErrFlightNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+	case errors.Is(err, adapters.ErrNotEnoughSeats):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Not enough seats left on the flight for the whole group."})
+	case errors.Is(err, adapters.ErrGroupBookingConflict):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "The group booking changed in the meantime. Please reload it and try again."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/loyalty"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type LoyaltyHandler struct {
+	getLoyaltyBalanceUseCase   loyalty.IGetLoyaltyBalanceUseCase
+	getLoyaltyStatementUseCase loyalty.IGetLoyaltyStatementUseCase
+	redeemLoyaltyPointsUseCase loyalty.IRedeemLoyaltyPointsUseCase
+	getLoyaltyTierUseCase      loyalty.IGetLoyaltyTierUseCase
+}
+
+func NewLoyaltyHandler(getLoyaltyBalanceUseCase loyalty.IGetLoyaltyBalanceUseCase, getLoyaltyStatementUseCase loyalty.IGetLoyaltyStatementUseCase, redeemLoyaltyPointsUseCase loyalty.IRedeemLoyaltyPointsUseCase, getLoyaltyTierUseCase loyalty.IGetLoyaltyTierUseCase) *LoyaltyHandler {
+	return &LoyaltyHandler{
+		getLoyaltyBalanceUseCase:   getLoyaltyBalanceUseCase,
+		getLoyaltyStatementUseCase: getLoyaltyStatementUseCase,
+		redeemLoyaltyPointsUseCase: redeemLoyaltyPointsUseCase,
+		getLoyaltyTierUseCase:      getLoyaltyTierUseCase,
+	}
+}
+
+// GetBalance returns the signed-in customer's points and what they are worth.
+func (h *LoyaltyHandler) GetBalance(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	account, err := h.getLoyaltyBalanceUseCase.Execute(ctx.Request.Context(), user.UserID)
+	if err != nil {
+		writeLoyaltyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Loyalty balance retrieved successfully.",
+		"data":    mappers.ToLoyaltyBalanceResponse(account),
+	})
+}
+
+// GetTier returns the signed-in customer's tier, its benefits and the progress to the next tier.
+func (h *LoyaltyHandler) GetTier(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	status, err := h.getLoyaltyTierUseCase.Execute(ctx.Request.Context(), user.UserID)
+	if err != nil {
+		writeLoyaltyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Loyalty tier retrieved successfully.",
+		"data":    mappers.ToLoyaltyTierResponse(status),
+	})
+}
+
+// GetStatement lists the signed-in customer's points transactions, newest first.
+func (h *LoyaltyHandler) GetStatement(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var params dto.LoyaltyStatementParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid page or limit."})
+		return
+	}
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	statement, err := h.getLoyaltyStatementUseCase.Execute(ctx.Request.Context(), user.UserID, params.Page, params.Limit)
+	if err != nil {
+		writeLoyaltyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Loyalty statement retrieved successfully.",
+		"data":    mappers.ToLoyaltyStatementResponse(statement, params.Page, params.Limit),
+	})
+}
+
+// RedeemPoints pays part of the signed-in customer's unpaid booking with points.
+func (h *LoyaltyHandler) RedeemPoints(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.RedeemLoyaltyRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid redemption data. Please check the input fields."})
+		return
+	}
+	bookingID, err := strconv.ParseInt(request.BookingID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid booking ID."})
+		return
+	}
+
+	result, err := h.redeemLoyaltyPointsUseCase.Execute(ctx.Request.Context(), user.UserID, user.Email, bookingID, request.Points)
+	if err != nil {
+		writeLoyaltyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Loyalty points redeemed successfully.",
+		"data":    mappers.ToRedeemLoyaltyResponse(result),
+	})
+}
+
+// writeLoyaltyError maps errors from the loyalty use cases to HTTP responses.
+func writeLoyaltyError(ctx *gin.Context, err error) {
+	var loyaltyErr *entities.LoyaltyError
+	switch {
+	case errors.As(err, &loyaltyErr):
+		ctx.JSON(http.StatusConflict, gin.H{"message": loyaltyErr.Error()})
+	case errors.Is(err, adapters.ErrCustomerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Loyalty account not found."})
+	case errors.Is(err, adapters.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
@@ -0,0 +1,478 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/ancillary"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/checkin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/specialservice"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+	"github.com/spaghetti-lover/qairlines/pkg/token"
+)
+
+// ManageBookingHandler serves the guest "manage booking" flow: a PNR + last name lookup
+// returns a short-lived token that only works for that booking.
+type ManageBookingHandler struct {
+	lookupUseCase               booking.IManageBookingLookupUseCase
+	getUseCase                  booking.IGetManagedBookingUseCase
+	updateSeatsUseCase          booking.IUpdateManagedSeatsUseCase
+	cancelBookingUseCase        booking.ICancelBookingUseCase
+	tokenMaker                  token.Maker
+	purchaseAncillaryUseCase    ancillary.IPurchaseAncillaryUseCase
+	cancelAncillaryUseCase      ancillary.ICancelAncillaryUseCase
+	boardingPassesUseCase       booking.IGetBoardingPassesUseCase
+	addSpecialServiceUseCase    specialservice.IAddSpecialServiceUseCase
+	removeSpecialServiceUseCase specialservice.IRemoveSpecialServiceUseCase
+	checkInUseCase              checkin.ICheckInUseCase
+	undoCheckInUseCase          checkin.IUndoCheckInUseCase
+}
+
+func NewManageBookingHandler(lookupUseCase booking.IManageBookingLookupUseCase, getUseCase booking.IGetManagedBookingUseCase, updateSeatsUseCase booking.IUpdateManagedSeatsUseCase, cancelBookingUseCase booking.ICancelBookingUseCase, tokenMaker token.Maker, purchaseAncillaryUseCase ancillary.IPurchaseAncillaryUseCase, cancelAncillaryUseCase ancillary.ICancelAncillaryUseCase, boardingPassesUseCase booking.IGetBoardingPassesUseCase, addSpecialServiceUseCase specialservice.IAddSpecialServiceUseCase, removeSpecialServiceUseCase specialservice.IRemoveSpecialServiceUseCase, checkInUseCase checkin.ICheckInUseCase, undoCheckInUseCase checkin.IUndoCheckInUseCase) *ManageBookingHandler {
+	return &ManageBookingHandler{
+		lookupUseCase:               lookupUseCase,
+		getUseCase:                  getUseCase,
+		updateSeatsUseCase:          updateSeatsUseCase,
+		cancelBookingUseCase:        cancelBookingUseCase,
+		tokenMaker:                  tokenMaker,
+		purchaseAncillaryUseCase:    purchaseAncillaryUseCase,
+		cancelAncillaryUseCase:      cancelAncillaryUseCase,
+		boardingPassesUseCase:       boardingPassesUseCase,
+		addSpecialServiceUseCase:    addSpecialServiceUseCase,
+		removeSpecialServiceUseCase: removeSpecialServiceUseCase,
+		checkInUseCase:              checkInUseCase,
+		undoCheckInUseCase:          undoCheckInUseCase,
+	}
+}
+
+func (h *ManageBookingHandler) Lookup(ctx *gin.Context) {
+	var request dto.ManageBookingLookupRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "PNR and last name are required."})
+		return
+	}
+
+	output, err := h.lookupUseCase.Execute(ctx.Request.Context(), booking.ManageBookingLookupInput{
+		PNR:      request.PNR,
+		LastName: request.LastName,
+	})
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "No booking matches this PNR and last name."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Booking found.",
+		"data":    mappers.ToManageBookingLookupResponse(output.Token, output.ExpiresAt, output.Booking),
+	})
+}
+
+func (h *ManageBookingHandler) GetBooking(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	booking, departureTickets, returnTickets, err := h.getUseCase.Execute(ctx.Request.Context(), bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Booking details retrieved successfully.",
+		"data":    mappers.ToGetBookingResponse(booking, departureTickets, returnTickets),
+	})
+}
+
+func (h *ManageBookingHandler) UpdateSeats(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	var request []dto.UpdateSeatRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid seat data. Please check the input fields."})
+		return
+	}
+	updates, err := mappers.ToSeatUpdateEntities(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ticket ID."})
+		return
+	}
+
+	result, err := h.updateSeatsUseCase.Execute(ctx.Request.Context(), bookingID, updates)
+	if err != nil {
+		if errors.Is(err, adapters.ErrTicketNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "One or more tickets not found in this booking."})
+			return
+		}
+		if errors.Is(err, adapters.ErrInvalidSeat) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid seat data. Please check the input fields."})
+			return
+		}
+		if errors.Is(err, adapters.ErrSeatUnavailable) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": "One or more seats are already taken."})
+			return
+		}
+		if errors.Is(err, adapters.ErrBookingNotChangeable) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": "Booking cannot be changed in its current status."})
+			return
+		}
+		var fareRuleErr *entities.FareRuleError
+		if errors.As(err, &fareRuleErr) {
+			ctx.JSON(http.StatusForbidden, gin.H{"message": fareRuleErr.Error()})
+			return
+		}
+		var seatRuleErr *entities.SeatRuleError
+		if errors.As(err, &seatRuleErr) {
+			ctx.JSON(http.StatusForbidden, gin.H{"message": seatRuleErr.Error()})
+			return
+		}
+		var checkInErr *entities.CheckInError
+		if errors.As(err, &checkInErr) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": checkInErr.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Seats updated successfully.",
+		"data":    mappers.ToUpdateSeatsResponse(result),
+	})
+}
+
+func (h *ManageBookingHandler) CancelBooking(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.CancelBookingRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid cancellation data."})
+		return
+	}
+
+	result, err := h.cancelBookingUseCase.Execute(ctx.Request.Context(), entities.CancelBookingParams{
+		BookingID:      bookingID,
+		Actor:          "manage-booking",
+		Reason:         request.Reason,
+		RefundToWallet: request.RefundToWallet,
+	})
+	if err != nil {
+		writeCancelBookingError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Booking cancelled successfully.",
+		"data":    mappers.ToCancelBookingResponse(result),
+	})
+}
+
+func (h *ManageBookingHandler) PurchaseAncillary(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.PurchaseAncillaryRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ancillary data. Please check the input fields."})
+		return
+	}
+	ticketID, err := strconv.ParseInt(request.TicketID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ticket ID."})
+		return
+	}
+
+	result, err := h.purchaseAncillaryUseCase.Execute(ctx.Request.Context(), entities.PurchaseAncillaryParams{
+		BookingID: bookingID,
+		TicketID:  ticketID,
+		Code:      request.Code,
+		Quantity:  request.Quantity,
+	})
+	if err != nil {
+		writeAncillaryError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Ancillary purchased successfully.",
+		"data":    mappers.ToPurchaseAncillaryResponse(result),
+	})
+}
+
+func (h *ManageBookingHandler) CancelAncillary(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+	itemID, err := strconv.ParseInt(ctx.Param("itemId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ancillary ID."})
+		return
+	}
+
+	result, err := h.cancelAncillaryUseCase.Execute(ctx.Request.Context(), entities.CancelAncillaryParams{
+		BookingID:         bookingID,
+		TicketAncillaryID: itemID,
+	})
+	if err != nil {
+		writeAncillaryError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Ancillary cancelled successfully.",
+		"data":    mappers.ToCancelAncillaryResponse(result),
+	})
+}
+
+// GetBoardingPasses returns the boarding passes of the booking's active tickets.
+func (h *ManageBookingHandler) GetBoardingPasses(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	passes, err := h.boardingPassesUseCase.Execute(ctx.Request.Context(), bookingID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrBookingNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+			return
+		}
+		if errors.Is(err, adapters.ErrBookingNotConfirmed) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": "Boarding passes are issued once the booking is paid."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Boarding passes retrieved successfully.",
+		"data":    mappers.ToBoardingPassesResponse(passes),
+	})
+}
+
+// AddSpecialService records a special service request (SSR) for a passenger of the booking.
+func (h *ManageBookingHandler) AddSpecialService(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.AddSpecialServiceRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid special service data. Please check the input fields."})
+		return
+	}
+	ticketID, err := strconv.ParseInt(request.TicketID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ticket ID."})
+		return
+	}
+
+	item, err := h.addSpecialServiceUseCase.Execute(ctx.Request.Context(), entities.AddSpecialServiceParams{
+		BookingID: bookingID,
+		TicketID:  ticketID,
+		Code:      request.Code,
+		Note:      request.Note,
+	})
+	if err != nil {
+		writeSpecialServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Special service requested successfully.",
+		"data":    mappers.ToTicketSpecialServiceResponse(item),
+	})
+}
+
+// RemoveSpecialService withdraws a special service request of the booking.
+func (h *ManageBookingHandler) RemoveSpecialService(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+	itemID, err := strconv.ParseInt(ctx.Param("itemId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid special service ID."})
+		return
+	}
+
+	item, err := h.removeSpecialServiceUseCase.Execute(ctx.Request.Context(), entities.RemoveSpecialServiceParams{
+		BookingID:              bookingID,
+		TicketSpecialServiceID: itemID,
+	})
+	if err != nil {
+		writeSpecialServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Special service removed successfully.",
+		"data":    mappers.ToTicketSpecialServiceResponse(item),
+	})
+}
+
+// CheckIn checks in passengers of the booking inside the online check-in window.
+func (h *ManageBookingHandler) CheckIn(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.CheckInRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid check-in data. Please check the input fields."})
+		return
+	}
+	ticketIDs, ok := parseTicketIDs(ctx, request.TicketIDs)
+	if !ok {
+		return
+	}
+
+	checkIns, err := h.checkInUseCase.Execute(ctx.Request.Context(), entities.CheckInParams{
+		BookingID: bookingID,
+		TicketIDs: ticketIDs,
+	})
+	if err != nil {
+		writeCheckInError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Passengers checked in successfully.",
+		"data":    mappers.ToTicketCheckInResponses(checkIns),
+	})
+}
+
+// UndoCheckIn undoes the check-in of passengers of the booking before the window closes.
+func (h *ManageBookingHandler) UndoCheckIn(ctx *gin.Context) {
+	bookingID, ok := h.authorize(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.UndoCheckInRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid check-in data. Please check the input fields."})
+		return
+	}
+	ticketIDs, ok := parseTicketIDs(ctx, request.TicketIDs)
+	if !ok {
+		return
+	}
+
+	checkIns, err := h.undoCheckInUseCase.Execute(ctx.Request.Context(), entities.CheckInParams{
+		BookingID: bookingID,
+		TicketIDs: ticketIDs,
+	})
+	if err != nil {
+		writeCheckInError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Check-in undone successfully.",
+		"data":    mappers.ToTicketCheckInResponses(checkIns),
+	})
+}
+
+// authorize checks the manage-booking bearer token and returns the booking it is scoped to.
+func (h *ManageBookingHandler) authorize(ctx *gin.Context) (int64, bool) {
+	const bearerPrefix = "Bearer "
+	authHeader := ctx.GetHeader("Authorization")
+	if !strings.HasPrefix(authHeader, bearerPrefix) || len(authHeader) == len(bearerPrefix) {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Manage booking token is missing."})
+		return 0, false
+	}
+
+	payload, err := h.tokenMaker.VerifyToken(authHeader[len(bearerPrefix):], token.TokenTypeManageBooking)
+	if err != nil || payload.Role != booking.ManageBookingRole {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Manage booking token is invalid or has expired."})
+		return 0, false
+	}
+	return payload.UserId, true
+}
+
+// writeCancelBookingError maps errors from the cancel booking use case to HTTP responses.
+func writeCancelBookingError(ctx *gin.Context, err error) {
+	if errors.Is(err, adapters.ErrBookingNotFound) {
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+		return
+	}
+	var transitionErr *entities.StatusTransitionError
+	if errors.As(err, &transitionErr) {
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Booking cannot be cancelled in its current status."})
+		return
+	}
+	var walletErr *entities.WalletError
+	if errors.As(err, &walletErr) {
+		ctx.JSON(http.StatusConflict, gin.H{"message": walletErr.Error()})
+		return
+	}
+	ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+}
+
+// parseTicketIDs parses the ticket IDs of a request body, answering 400 when one is invalid.
+func parseTicketIDs(ctx *gin.Context, values []string) ([]int64, bool) {
+	ticketIDs := make([]int64, 0, len(values))
+	for _, value := range values {
+		ticketID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ticket ID."})
+			return nil, false
+		}
+		ticketIDs = append(ticketIDs, ticketID)
+	}
+	return ticketIDs, true
+}
+
+// writeCheckInError maps errors from the check-in use cases to HTTP responses.
+func writeCheckInError(ctx *gin.Context, err error) {
+	var checkInErr *entities.CheckInError
+	var documentErr *entities.DocumentError
+	switch {
+	case errors.As(err, &checkInErr):
+		ctx.JSON(http.StatusConflict, gin.H{"message": checkInErr.Error()})
+	case errors.As(err, &documentErr):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Update the travel documents of the passengers before checking in.", "errors": documentErr.Fields})
+	case errors.Is(err, adapters.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+	case errors.Is(err, adapters.ErrTicketNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "One or more tickets not found in this booking."})
+	case errors.Is(err, adapters.ErrBookingNotConfirmed):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Check-in is available once the booking is paid."})
+	case errors.Is(err, adapters.ErrTicketAlreadyCheckedIn):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "One or more passengers are already checked in."})
+	case errors.Is(err, adapters.ErrTicketNotCheckedIn):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "One or more passengers are not checked in."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
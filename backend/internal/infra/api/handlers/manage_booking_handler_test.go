@@ -0,0 +1,171 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	mockbooking "github.com/spaghetti-lover/qairlines/internal/domain/mock/booking"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+	"github.com/spaghetti-lover/qairlines/pkg/token"
+	"github.com/spaghetti-lover/qairlines/pkg/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestManageBookingLookupHandler(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(mockUseCase *mockbooking.MockIManageBookingLookupUseCase)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"pnr": "ABC234", "lastName": "Nguyen"},
+			buildStubs: func(mockUseCase *mockbooking.MockIManageBookingLookupUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), booking.ManageBookingLookupInput{PNR: "ABC234", LastName: "Nguyen"}).
+					Times(1).
+					Return(&booking.ManageBookingLookupOutput{
+						Token:     "scoped-token",
+						ExpiresAt: time.Now().Add(time.Minute),
+						Booking:   entities.Booking{BookingID: 1, PNR: "ABC234"},
+					}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "scoped-token")
+			},
+		},
+		{
+			name: "NotFound",
+			body: gin.H{"pnr": "ABC234", "lastName": "Tran"},
+			buildStubs: func(mockUseCase *mockbooking.MockIManageBookingLookupUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, adapters.ErrBookingNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "MissingLastName",
+			body: gin.H{"pnr": "ABC234"},
+			buildStubs: func(mockUseCase *mockbooking.MockIManageBookingLookupUseCase) {
+				mockUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := mockbooking.NewMockIManageBookingLookupUseCase(ctrl)
+			tc.buildStubs(mockUseCase)
+
+			handler := handlers.NewManageBookingHandler(mockUseCase, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			router := gin.Default()
+			router.POST("/api/booking/manage", handler.Lookup)
+
+			body, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+			req, _ := http.NewRequest("POST", "/api/booking/manage", bytes.NewReader(body))
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+			tc.checkResponse(t, w)
+		})
+	}
+}
+
+func TestManageBookingGetHandler(t *testing.T) {
+	tokenMaker, err := token.NewPasetoMaker(utils.RandomString(32))
+	require.NoError(t, err)
+
+	const bookingID = int64(42)
+	manageToken, _, err := tokenMaker.CreateToken(bookingID, booking.ManageBookingRole, time.Minute, token.TokenTypeManageBooking)
+	require.NoError(t, err)
+	accessToken, _, err := tokenMaker.CreateToken(bookingID, "customer", time.Minute, token.TokenTypeAccessToken)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		authHeader    string
+		buildStubs    func(mockUseCase *mockbooking.MockIGetManagedBookingUseCase)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "OK",
+			authHeader: "Bearer " + manageToken,
+			buildStubs: func(mockUseCase *mockbooking.MockIGetManagedBookingUseCase) {
+				mockUseCase.EXPECT().
+					Execute(gomock.Any(), bookingID).
+					Times(1).
+					Return(entities.Booking{BookingID: bookingID, PNR: "ABC234"}, nil, nil, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "MissingToken",
+			authHeader: "",
+			buildStubs: func(mockUseCase *mockbooking.MockIGetManagedBookingUseCase) {
+				mockUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:       "AccessTokenRejected",
+			authHeader: "Bearer " + accessToken,
+			buildStubs: func(mockUseCase *mockbooking.MockIGetManagedBookingUseCase) {
+				mockUseCase.EXPECT().Execute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUseCase := mockbooking.NewMockIGetManagedBookingUseCase(ctrl)
+			tc.buildStubs(mockUseCase)
+
+			handler := handlers.NewManageBookingHandler(nil, mockUseCase, nil, nil, tokenMaker, nil, nil, nil, nil, nil, nil, nil)
+			router := gin.Default()
+			router.GET("/api/booking/manage", handler.GetBooking)
+
+			req, _ := http.NewRequest("GET", "/api/booking/manage", nil)
+			if tc.authHeader != "" {
+				req.Header.Set("Authorization", tc.authHeader)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+			tc.checkResponse(t, w)
+		})
+	}
+}
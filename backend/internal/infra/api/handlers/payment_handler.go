@@ -1,34 +1,77 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/payment"
 )
 
 type PaymentHandler struct {
-	createPaymentUseCase payment.ICreatePaymentIntentUsecase
+	createPaymentUseCase      payment.ICreatePaymentIntentUsecase
+	handlePaymentEventUseCase payment.IHandlePaymentEventUseCase
+	getBookingIDUseCase       booking.IGetBookingIDUseCase
 }
 
-func NewPaymentHandler(createPaymentUseCase payment.ICreatePaymentIntentUsecase) *PaymentHandler {
-	return &PaymentHandler{createPaymentUseCase: createPaymentUseCase}
+func NewPaymentHandler(createPaymentUseCase payment.ICreatePaymentIntentUsecase, handlePaymentEventUseCase payment.IHandlePaymentEventUseCase, getBookingIDUseCase booking.IGetBookingIDUseCase) *PaymentHandler {
+	return &PaymentHandler{createPaymentUseCase: createPaymentUseCase, handlePaymentEventUseCase: handlePaymentEventUseCase, getBookingIDUseCase: getBookingIDUseCase}
 }
 
 func (h *PaymentHandler) CreatePaymentIntent(ctx *gin.Context) {
+	// Số tiền do server tính từ booking; amount client gửi lên (nếu có) bị bỏ qua
+	// booking_id là mã PNR khách nhận được khi đặt vé
 	var req struct {
-		BookingID int64  `json:"booking_id"`
-		Amount    int64  `json:"amount"`
+		BookingID string `json:"booking_id"`
 		Currency  string `json:"currency"`
 	}
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	clientSecret, err := h.createPaymentUseCase.Execute(ctx, req.BookingID, req.Amount, req.Currency)
+	bookingID, err := h.getBookingIDUseCase.Execute(ctx.Request.Context(), req.BookingID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writePaymentIntentError(ctx, err)
 		return
 	}
-	ctx.JSON(http.StatusOK, gin.H{"client_secret": clientSecret})
+	clientSecret, amount, err := h.createPaymentUseCase.Execute(ctx, bookingID, req.Currency)
+	if err != nil {
+		writePaymentIntentError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"client_secret": clientSecret, "amount": amount})
+}
+
+// writePaymentIntentError maps errors from creating a payment intent to HTTP responses.
+func writePaymentIntentError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, adapters.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"error": "Booking not found."})
+	case errors.Is(err, adapters.ErrBookingNotPayable):
+		ctx.JSON(http.StatusConflict, gin.H{"error": "Booking is not awaiting payment."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "An unexpected error occurred."})
+	}
+}
+
+// HandleWebhook receives the payment events of the payment gateway. Anything but a 2xx
+// makes the gateway deliver the event again later.
+func (h *PaymentHandler) HandleWebhook(ctx *gin.Context) {
+	payload, err := ctx.GetRawData()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payload."})
+		return
+	}
+	err = h.handlePaymentEventUseCase.Execute(ctx.Request.Context(), payload, ctx.GetHeader("Stripe-Signature"))
+	if err != nil {
+		if errors.Is(err, adapters.ErrInvalidPaymentEvent) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Invalid signature."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "An unexpected error occurred."})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"received": true})
 }
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/pricing"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type PricingHandler struct {
+	listPricingCurvesUseCase  pricing.IListPricingCurvesUseCase
+	upsertPricingCurveUseCase pricing.IUpsertPricingCurveUseCase
+	deletePricingCurveUseCase pricing.IDeletePricingCurveUseCase
+	createFareQuoteUseCase    pricing.ICreateFareQuoteUseCase
+}
+
+func NewPricingHandler(listPricingCurvesUseCase pricing.IListPricingCurvesUseCase, upsertPricingCurveUseCase pricing.IUpsertPricingCurveUseCase, deletePricingCurveUseCase pricing.IDeletePricingCurveUseCase, createFareQuoteUseCase pricing.ICreateFareQuoteUseCase) *PricingHandler {
+	return &PricingHandler{
+		listPricingCurvesUseCase:  listPricingCurvesUseCase,
+		upsertPricingCurveUseCase: upsertPricingCurveUseCase,
+		deletePricingCurveUseCase: deletePricingCurveUseCase,
+		createFareQuoteUseCase:    createFareQuoteUseCase,
+	}
+}
+
+func (h *PricingHandler) ListPricingCurves(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	curves, err := h.listPricingCurvesUseCase.Execute(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Pricing curves retrieved successfully.",
+		"data":    mappers.ToPricingCurveResponses(curves),
+	})
+}
+
+// UpsertPricingCurve creates or replaces the pricing curve of a route and cabin.
+func (h *PricingHandler) UpsertPricingCurve(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	var request dto.PricingCurveRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid pricing curve data. Please check the input fields."})
+		return
+	}
+
+	curve, err := h.upsertPricingCurveUseCase.Execute(ctx.Request.Context(), mappers.ToPricingCurveEntity(request))
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidPricingCurve) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid pricing curve data. Please check the input fields."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Pricing curve saved successfully.",
+		"data":    mappers.ToPricingCurveResponse(curve),
+	})
+}
+
+func (h *PricingHandler) DeletePricingCurve(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	curveID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid pricing curve ID."})
+		return
+	}
+
+	if err := h.deletePricingCurveUseCase.Execute(ctx.Request.Context(), curveID); err != nil {
+		if errors.Is(err, adapters.ErrPricingCurveNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Pricing curve not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Pricing curve deleted successfully."})
+}
+
+// CreateFareQuote locks the current fares of a flight for a short window so that
+// the booking made with the returned quote ID is charged those fares.
+func (h *PricingHandler) CreateFareQuote(ctx *gin.Context) {
+	var request dto.FareQuoteRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid fare quote data. Please check the input fields."})
+		return
+	}
+	flightID, err := strconv.ParseInt(request.FlightID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+
+	quote, err := h.createFareQuoteUseCase.Execute(ctx.Request.Context(), flightID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Fare quote created successfully.",
+		"data":    mappers.ToFareQuoteResponse(quote),
+	})
+}
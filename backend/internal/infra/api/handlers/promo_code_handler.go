@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/promo"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type PromoCodeHandler struct {
+	listPromoCodesUseCase      promo.IListPromoCodesUseCase
+	createPromoCodeUseCase     promo.ICreatePromoCodeUseCase
+	deactivatePromoCodeUseCase promo.IDeactivatePromoCodeUseCase
+}
+
+func NewPromoCodeHandler(listPromoCodesUseCase promo.IListPromoCodesUseCase, createPromoCodeUseCase promo.ICreatePromoCodeUseCase, deactivatePromoCodeUseCase promo.IDeactivatePromoCodeUseCase) *PromoCodeHandler {
+	return &PromoCodeHandler{
+		listPromoCodesUseCase:      listPromoCodesUseCase,
+		createPromoCodeUseCase:     createPromoCodeUseCase,
+		deactivatePromoCodeUseCase: deactivatePromoCodeUseCase,
+	}
+}
+
+func (h *PromoCodeHandler) ListPromoCodes(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	promos, err := h.listPromoCodesUseCase.Execute(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Promo codes retrieved successfully.",
+		"data":    mappers.ToPromoCodeResponses(promos),
+	})
+}
+
+func (h *PromoCodeHandler) CreatePromoCode(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	var request dto.PromoCodeRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid promo code data. Please check the input fields."})
+		return
+	}
+	promoCode, err := mappers.ToPromoCodeEntity(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid validity window. Dates must be in RFC3339 format."})
+		return
+	}
+
+	created, err := h.createPromoCodeUseCase.Execute(ctx.Request.Context(), promoCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidPromoCode):
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		case errors.Is(err, adapters.ErrPromoCodeAlreadyExists):
+			ctx.JSON(http.StatusConflict, gin.H{"message": "Promo code already exists."})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Promo code created successfully.",
+		"data":    mappers.ToPromoCodeResponse(created),
+	})
+}
+
+// DeactivatePromoCode stops a code from being redeemed on new bookings.
+func (h *PromoCodeHandler) DeactivatePromoCode(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	promoCodeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid promo code ID."})
+		return
+	}
+
+	deactivated, err := h.deactivatePromoCodeUseCase.Execute(ctx.Request.Context(), promoCodeID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrPromoCodeNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Promo code not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Promo code deactivated successfully.",
+		"data":    mappers.ToPromoCodeResponse(deactivated),
+	})
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/seat"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type SeatZoneHandler struct {
+	listSeatZonesUseCase  seat.IListSeatZonesUseCase
+	createSeatZoneUseCase seat.ICreateSeatZoneUseCase
+	deleteSeatZoneUseCase seat.IDeleteSeatZoneUseCase
+	getSeatMapUseCase     seat.IGetSeatMapUseCase
+}
+
+func NewSeatZoneHandler(listSeatZonesUseCase seat.IListSeatZonesUseCase, createSeatZoneUseCase seat.ICreateSeatZoneUseCase, deleteSeatZoneUseCase seat.IDeleteSeatZoneUseCase, getSeatMapUseCase seat.IGetSeatMapUseCase) *SeatZoneHandler {
+	return &SeatZoneHandler{
+		listSeatZonesUseCase:  listSeatZonesUseCase,
+		createSeatZoneUseCase: createSeatZoneUseCase,
+		deleteSeatZoneUseCase: deleteSeatZoneUseCase,
+		getSeatMapUseCase:     getSeatMapUseCase,
+	}
+}
+
+func (h *SeatZoneHandler) ListSeatZones(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	zones, err := h.listSeatZonesUseCase.Execute(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Seat zones retrieved successfully.",
+		"data":    mappers.ToSeatZoneResponses(zones),
+	})
+}
+
+// CreateSeatZone prices a range of rows on a flight or on an aircraft type.
+func (h *SeatZoneHandler) CreateSeatZone(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	var request dto.SeatZoneRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid seat zone data. Please check the input fields."})
+		return
+	}
+	zone, err := mappers.ToSeatZoneEntity(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+
+	created, err := h.createSeatZoneUseCase.Execute(ctx.Request.Context(), zone)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidSeatZone) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Seat zone created successfully.",
+		"data":    mappers.ToSeatZoneResponse(created),
+	})
+}
+
+func (h *SeatZoneHandler) DeleteSeatZone(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	seatZoneID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid seat zone ID."})
+		return
+	}
+
+	if err := h.deleteSeatZoneUseCase.Execute(ctx.Request.Context(), seatZoneID); err != nil {
+		if errors.Is(err, adapters.ErrSeatZoneNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Seat zone not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Seat zone deleted successfully."})
+}
+
+// GetSeatMap lists the priced zones of ?flightId=; seats outside them are free.
+func (h *SeatZoneHandler) GetSeatMap(ctx *gin.Context) {
+	flightID, err := strconv.ParseInt(ctx.Query("flightId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+
+	seatMap, err := h.getSeatMapUseCase.Execute(ctx.Request.Context(), flightID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Seat map retrieved successfully.",
+		"data":    mappers.ToSeatZoneResponses(seatMap),
+	})
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/specialservice"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type SpecialServiceHandler struct {
+	listSpecialServicesUseCase specialservice.IListSpecialServicesUseCase
+}
+
+func NewSpecialServiceHandler(listSpecialServicesUseCase specialservice.IListSpecialServicesUseCase) *SpecialServiceHandler {
+	return &SpecialServiceHandler{
+		listSpecialServicesUseCase: listSpecialServicesUseCase,
+	}
+}
+
+// ListSpecialServices returns the SSR codes passengers can request.
+func (h *SpecialServiceHandler) ListSpecialServices(ctx *gin.Context) {
+	catalog, err := h.listSpecialServicesUseCase.Execute(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Special services retrieved successfully.",
+		"data":    mappers.ToSpecialServiceResponses(catalog),
+	})
+}
+
+// writeSpecialServiceError maps errors from the special service use cases to HTTP responses.
+func writeSpecialServiceError(ctx *gin.Context, err error) {
+	var specialServiceErr *entities.SpecialServiceError
+	switch {
+	case errors.As(err, &specialServiceErr):
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": specialServiceErr.Error()})
+	case errors.Is(err, adapters.ErrSpecialServiceFull):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "This special service is fully booked on the flight."})
+	case errors.Is(err, adapters.ErrSpecialServiceExists):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "The passenger already has this kind of special service."})
+	case errors.Is(err, adapters.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+	case errors.Is(err, adapters.ErrTicketNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Ticket not found in this booking."})
+	case errors.Is(err, adapters.ErrTicketSpecialServiceNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Special service request not found."})
+	case errors.Is(err, adapters.ErrBookingNotChangeable):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Booking cannot be changed in its current status."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/ticket"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
@@ -17,14 +18,18 @@ type TicketHandler struct {
 	getTicketUseCase            ticket.IGetTicketUseCase
 	cancelTicketUseCase         ticket.ICancelTicketUseCase
 	updateSeatsUseCase          ticket.IUpdateSeatsUseCase
+	searchTicketByNumberUseCase ticket.ISearchTicketByNumberUseCase
+	getFlightManifestUseCase    ticket.IGetFlightManifestUseCase
 }
 
-func NewTicketHandler(getTicketsByFlightIDUseCase ticket.IGetTicketsByFlightIDUseCase, getTicketUseCase ticket.IGetTicketUseCase, cancelTicketUseCase ticket.ICancelTicketUseCase, updateSeatsUseCase ticket.IUpdateSeatsUseCase) *TicketHandler {
+func NewTicketHandler(getTicketsByFlightIDUseCase ticket.IGetTicketsByFlightIDUseCase, getTicketUseCase ticket.IGetTicketUseCase, cancelTicketUseCase ticket.ICancelTicketUseCase, updateSeatsUseCase ticket.IUpdateSeatsUseCase, searchTicketByNumberUseCase ticket.ISearchTicketByNumberUseCase, getFlightManifestUseCase ticket.IGetFlightManifestUseCase) *TicketHandler {
 	return &TicketHandler{
 		getTicketsByFlightIDUseCase: getTicketsByFlightIDUseCase,
 		getTicketUseCase:            getTicketUseCase,
 		cancelTicketUseCase:         cancelTicketUseCase,
 		updateSeatsUseCase:          updateSeatsUseCase,
+		searchTicketByNumberUseCase: searchTicketByNumberUseCase,
+		getFlightManifestUseCase:    getFlightManifestUseCase,
 	}
 }
 
@@ -103,7 +108,7 @@ func (h *TicketHandler) CancelTicket(ctx *gin.Context) {
 		return
 	}
 
-	ticket, err := h.cancelTicketUseCase.Execute(ctx.Request.Context(), ticketID)
+	result, err := h.cancelTicketUseCase.Execute(ctx.Request.Context(), ticketID)
 	if err != nil {
 		if errors.Is(err, adapters.ErrTicketNotFound) {
 			ctx.JSON(http.StatusNotFound, gin.H{"message": "Ticket not found"})
@@ -119,18 +124,24 @@ func (h *TicketHandler) CancelTicket(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": "Ticket cancelled successfully.",
-		"ticket":  ticket,
+		"ticket":  result.Ticket,
+		"refund":  result.Refund,
 	})
 }
 
 func (h *TicketHandler) UpdateSeats(ctx *gin.Context) {
-	var updates []dto.UpdateSeatRequest
-	if err := ctx.ShouldBindJSON(&updates); err != nil {
+	var request []dto.UpdateSeatRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
 		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid seat data. Please check the input fields."})
 		return
 	}
+	updates, err := mappers.ToSeatUpdateEntities(request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid ticket ID."})
+		return
+	}
 
-	responses, err := h.updateSeatsUseCase.Execute(ctx.Request.Context(), updates)
+	result, err := h.updateSeatsUseCase.Execute(ctx.Request.Context(), 0, updates)
 	if err != nil {
 		if errors.Is(err, adapters.ErrTicketNotFound) {
 			ctx.JSON(http.StatusNotFound, gin.H{"message": "One or more tickets not found."})
@@ -140,12 +151,99 @@ func (h *TicketHandler) UpdateSeats(ctx *gin.Context) {
 			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid seat data. Please check the input fields."})
 			return
 		}
+		if errors.Is(err, adapters.ErrSeatUnavailable) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": "One or more seats are already taken."})
+			return
+		}
+		if errors.Is(err, adapters.ErrBookingNotChangeable) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": "Booking cannot be changed in its current status."})
+			return
+		}
+		var fareRuleErr *entities.FareRuleError
+		if errors.As(err, &fareRuleErr) {
+			ctx.JSON(http.StatusForbidden, gin.H{"message": fareRuleErr.Error()})
+			return
+		}
+		var seatRuleErr *entities.SeatRuleError
+		if errors.As(err, &seatRuleErr) {
+			ctx.JSON(http.StatusForbidden, gin.H{"message": seatRuleErr.Error()})
+			return
+		}
+		var checkInErr *entities.CheckInError
+		if errors.As(err, &checkInErr) {
+			ctx.JSON(http.StatusConflict, gin.H{"message": checkInErr.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later.", "error": err.Error()})
 		return
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": "Seats updated successfully.",
-		"data":    mappers.ToUpdateSeatResponses(responses),
+		"data":    mappers.ToUpdateSeatsResponse(result),
+	})
+}
+
+func (h *TicketHandler) SearchTicketByNumber(ctx *gin.Context) {
+	isAdmin := ctx.GetHeader("admin")
+	if isAdmin != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	ticketNumber := ctx.Query("number")
+	if ticketNumber == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "E-ticket number is required."})
+		return
+	}
+
+	ticket, err := h.searchTicketByNumberUseCase.Execute(ctx.Request.Context(), ticketNumber)
+	if err != nil {
+		if errors.Is(err, adapters.ErrInvalidTicketNumber) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid e-ticket number."})
+			return
+		}
+		if errors.Is(err, adapters.ErrTicketNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Ticket not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Ticket retrieved successfully.",
+		"data":    mappers.ToGetTicketResponse(*ticket),
+	})
+}
+
+// GetFlightManifest returns the passenger manifest of a flight with the special services
+// requested by each passenger.
+func (h *TicketHandler) GetFlightManifest(ctx *gin.Context) {
+	isAdmin := ctx.GetHeader("admin")
+	if isAdmin != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	flightID, err := strconv.ParseInt(ctx.Query("flightId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid Flight ID."})
+		return
+	}
+
+	manifest, err := h.getFlightManifestUseCase.Execute(ctx.Request.Context(), flightID)
+	if err != nil {
+		if errors.Is(err, adapters.ErrFlightNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Flight manifest retrieved successfully.",
+		"data":    mappers.ToFlightManifestResponse(manifest),
 	})
 }
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/booking"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type TripHandler struct {
+	listTripsUseCase booking.IListTripsUseCase
+}
+
+func NewTripHandler(listTripsUseCase booking.IListTripsUseCase) *TripHandler {
+	return &TripHandler{
+		listTripsUseCase: listTripsUseCase,
+	}
+}
+
+// ListTrips returns a page of the signed-in customer's upcoming or past bookings with
+// their flights, passengers, seats and statuses.
+func (h *TripHandler) ListTrips(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var params dto.TripListParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid trip filters."})
+		return
+	}
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	filter := entities.TripFilter{
+		Upcoming: params.When != "past",
+		Status:   entities.BookingStatus(params.Status),
+		Page:     params.Page,
+		Limit:    params.Limit,
+	}
+	if params.From != "" {
+		from, err := time.Parse("2006-01-02", params.From)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid start date. Use YYYY-MM-DD."})
+			return
+		}
+		filter.DepartsFrom = from
+	}
+	if params.To != "" {
+		to, err := time.Parse("2006-01-02", params.To)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid end date. Use YYYY-MM-DD."})
+			return
+		}
+		// Ngày kết thúc được tính trọn ngày
+		filter.DepartsTo = to.AddDate(0, 0, 1)
+	}
+	if err := filter.Validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid trip filters. %v", err)})
+		return
+	}
+
+	page, err := h.listTripsUseCase.Execute(ctx.Request.Context(), user.Email, filter)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Trips retrieved successfully.",
+		"data":    mappers.ToTripListResponse(page, time.Now(), params.Page, params.Limit),
+	})
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/waitlist"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type WaitlistHandler struct {
+	joinWaitlistUseCase        waitlist.IJoinWaitlistUseCase
+	listWaitlistEntriesUseCase waitlist.IListWaitlistEntriesUseCase
+	leaveWaitlistUseCase       waitlist.ILeaveWaitlistUseCase
+	claimWaitlistOfferUseCase  waitlist.IClaimWaitlistOfferUseCase
+}
+
+func NewWaitlistHandler(joinWaitlistUseCase waitlist.IJoinWaitlistUseCase, listWaitlistEntriesUseCase waitlist.IListWaitlistEntriesUseCase, leaveWaitlistUseCase waitlist.ILeaveWaitlistUseCase, claimWaitlistOfferUseCase waitlist.IClaimWaitlistOfferUseCase) *WaitlistHandler {
+	return &WaitlistHandler{
+		joinWaitlistUseCase:        joinWaitlistUseCase,
+		listWaitlistEntriesUseCase: listWaitlistEntriesUseCase,
+		leaveWaitlistUseCase:       leaveWaitlistUseCase,
+		claimWaitlistOfferUseCase:  claimWaitlistOfferUseCase,
+	}
+}
+
+// JoinWaitlist puts the signed-in customer on the waitlist of a sold-out cabin.
+func (h *WaitlistHandler) JoinWaitlist(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.JoinWaitlistRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid waitlist data. Please check the input fields."})
+		return
+	}
+	flightID, err := strconv.ParseInt(request.FlightID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+	class := entities.FlightClass(request.FlightClass)
+	if !class.Valid() {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight class."})
+		return
+	}
+
+	entry, err := h.joinWaitlistUseCase.Execute(ctx.Request.Context(), flightID, class, user.Email)
+	if err != nil {
+		writeWaitlistError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Joined the waitlist successfully.",
+		"data":    mappers.ToWaitlistEntryResponse(entry),
+	})
+}
+
+// ListWaitlistEntries lists the signed-in customer's waitlist entries.
+func (h *WaitlistHandler) ListWaitlistEntries(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	entries, err := h.listWaitlistEntriesUseCase.Execute(ctx.Request.Context(), user.Email)
+	if err != nil {
+		writeWaitlistError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Waitlist entries retrieved successfully.",
+		"data":    mappers.ToWaitlistEntryResponses(entries),
+	})
+}
+
+// LeaveWaitlist removes one of the signed-in customer's entries from the waitlist.
+func (h *WaitlistHandler) LeaveWaitlist(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid waitlist entry ID."})
+		return
+	}
+
+	entry, err := h.leaveWaitlistUseCase.Execute(ctx.Request.Context(), entryID, user.Email)
+	if err != nil {
+		writeWaitlistError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Left the waitlist successfully.",
+		"data":    mappers.ToWaitlistEntryResponse(entry),
+	})
+}
+
+// ClaimWaitlistOffer claims the seat offered by email and returns a fare quote to book it with.
+func (h *WaitlistHandler) ClaimWaitlistOffer(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.ClaimWaitlistRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid claim data. Please check the input fields."})
+		return
+	}
+
+	result, err := h.claimWaitlistOfferUseCase.Execute(ctx.Request.Context(), request.Token, user.Email)
+	if err != nil {
+		writeWaitlistError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Waitlist seat claimed successfully. Complete the booking before the quote expires.",
+		"data":    mappers.ToClaimWaitlistResponse(result),
+	})
+}
+
+// writeWaitlistError maps errors from the waitlist use cases to HTTP responses.
+func writeWaitlistError(ctx *gin.Context, err error) {
+	var waitlistErr *entities.WaitlistError
+	switch {
+	case errors.As(err, &waitlistErr):
+		ctx.JSON(http.StatusConflict, gin.H{"message": waitlistErr.Error()})
+	case errors.Is(err, adapters.ErrFlightNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+	case errors.Is(err, adapters.ErrWaitlistEntryNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Waitlist entry not found."})
+	case errors.Is(err, adapters.ErrAlreadyWaitlisted):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "You are already on the waitlist for this cabin."})
+	case errors.Is(err, adapters.ErrCabinNotFull):
+		ctx.JSON(http.StatusConflict, gin.H{"message": "Seats are still available in this cabin. Please book directly."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/domain/usecases/wallet"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/mappers"
+)
+
+type WalletHandler struct {
+	getWalletBalanceUseCase        wallet.IGetWalletBalanceUseCase
+	getWalletStatementUseCase      wallet.IGetWalletStatementUseCase
+	payWithWalletUseCase           wallet.IPayWithWalletUseCase
+	issueWalletCreditUseCase       wallet.IIssueWalletCreditUseCase
+	issueFlightWalletCreditUseCase wallet.IIssueFlightWalletCreditUseCase
+}
+
+func NewWalletHandler(getWalletBalanceUseCase wallet.IGetWalletBalanceUseCase, getWalletStatementUseCase wallet.IGetWalletStatementUseCase, payWithWalletUseCase wallet.IPayWithWalletUseCase, issueWalletCreditUseCase wallet.IIssueWalletCreditUseCase, issueFlightWalletCreditUseCase wallet.IIssueFlightWalletCreditUseCase) *WalletHandler {
+	return &WalletHandler{
+		getWalletBalanceUseCase:        getWalletBalanceUseCase,
+		getWalletStatementUseCase:      getWalletStatementUseCase,
+		payWithWalletUseCase:           payWithWalletUseCase,
+		issueWalletCreditUseCase:       issueWalletCreditUseCase,
+		issueFlightWalletCreditUseCase: issueFlightWalletCreditUseCase,
+	}
+}
+
+// GetBalance returns the travel credit the signed-in customer can spend.
+func (h *WalletHandler) GetBalance(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	balance, err := h.getWalletBalanceUseCase.Execute(ctx.Request.Context(), user.UserID)
+	if err != nil {
+		writeWalletError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Wallet balance retrieved successfully.",
+		"data":    dto.WalletBalanceResponse{Balance: balance},
+	})
+}
+
+// GetStatement lists the signed-in customer's wallet credits and debits, newest first.
+func (h *WalletHandler) GetStatement(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var params dto.WalletStatementParams
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid page or limit."})
+		return
+	}
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = 10
+	}
+
+	statement, err := h.getWalletStatementUseCase.Execute(ctx.Request.Context(), user.UserID, params.Page, params.Limit)
+	if err != nil {
+		writeWalletError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Wallet statement retrieved successfully.",
+		"data":    mappers.ToWalletStatementResponse(statement, params.Page, params.Limit),
+	})
+}
+
+// PayBooking pays part or all of the signed-in customer's unpaid booking with travel credit.
+func (h *WalletHandler) PayBooking(ctx *gin.Context) {
+	user, ok := currentCustomer(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.PayWithWalletRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid payment data. Please check the input fields."})
+		return
+	}
+	bookingID, err := strconv.ParseInt(request.BookingID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid booking ID."})
+		return
+	}
+
+	result, err := h.payWithWalletUseCase.Execute(ctx.Request.Context(), user.UserID, user.Email, bookingID, request.Amount)
+	if err != nil {
+		writeWalletError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "Booking paid with travel credit successfully.",
+		"data":    mappers.ToPayWithWalletResponse(result),
+	})
+}
+
+// IssueCredit lets an admin credit goodwill to a customer's wallet.
+func (h *WalletHandler) IssueCredit(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	var request dto.IssueWalletCreditRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid credit data. Please check the input fields."})
+		return
+	}
+	params := entities.IssueWalletCreditParams{
+		Email:       request.Email,
+		Amount:      request.Amount,
+		IssuedBy:    "admin",
+		Description: request.Reason,
+	}
+	if request.BookingID != "" {
+		bookingID, err := strconv.ParseInt(request.BookingID, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid booking ID."})
+			return
+		}
+		params.BookingID = bookingID
+	}
+	expiresAt, ok := parseCreditExpiry(ctx, request.ExpiresAt)
+	if !ok {
+		return
+	}
+	params.ExpiresAt = expiresAt
+
+	credit, err := h.issueWalletCreditUseCase.Execute(ctx.Request.Context(), params)
+	if err != nil {
+		writeWalletError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Travel credit issued successfully.",
+		"data":    mappers.ToWalletTransactionResponse(credit),
+	})
+}
+
+// IssueFlightCredit lets an admin credit goodwill to every customer on a disrupted flight.
+func (h *WalletHandler) IssueFlightCredit(ctx *gin.Context) {
+	if ctx.GetHeader("admin") != "true" {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Admin privileges required."})
+		return
+	}
+
+	var request dto.IssueFlightWalletCreditRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid credit data. Please check the input fields."})
+		return
+	}
+	flightID, err := strconv.ParseInt(request.FlightID, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid flight ID."})
+		return
+	}
+	expiresAt, ok := parseCreditExpiry(ctx, request.ExpiresAt)
+	if !ok {
+		return
+	}
+
+	credits, err := h.issueFlightWalletCreditUseCase.Execute(ctx.Request.Context(), flightID, entities.IssueWalletCreditParams{
+		Amount:      request.AmountPerTicket,
+		IssuedBy:    "admin",
+		Description: request.Reason,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		writeWalletError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": "Travel credit issued successfully.",
+		"data":    mappers.ToFlightWalletCreditsResponse(credits),
+	})
+}
+
+// parseCreditExpiry parses an optional RFC 3339 expiry, writing a 400 when it is invalid.
+func parseCreditExpiry(ctx *gin.Context, value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, true
+	}
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil || !expiresAt.After(time.Now()) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "Invalid expiry date."})
+		return time.Time{}, false
+	}
+	return expiresAt, true
+}
+
+// writeWalletError maps errors from the wallet use cases to HTTP responses.
+func writeWalletError(ctx *gin.Context, err error) {
+	var walletErr *entities.WalletError
+	switch {
+	case errors.As(err, &walletErr):
+		ctx.JSON(http.StatusConflict, gin.H{"message": walletErr.Error()})
+	case errors.Is(err, adapters.ErrCustomerNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Customer not found."})
+	case errors.Is(err, adapters.ErrBookingNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Booking not found."})
+	case errors.Is(err, adapters.ErrFlightNotFound):
+		ctx.JSON(http.StatusNotFound, gin.H{"message": "Flight not found."})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+	}
+}
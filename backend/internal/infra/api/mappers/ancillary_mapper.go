@@ -0,0 +1,71 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToAncillaryEntity(request dto.AncillaryRequest) entities.Ancillary {
+	active := true
+	if request.Active != nil {
+		active = *request.Active
+	}
+	maxQuantity := request.MaxQuantity
+	if maxQuantity == 0 {
+		maxQuantity = 1
+	}
+	return entities.Ancillary{
+		Code:          request.Code,
+		Type:          entities.AncillaryType(request.Type),
+		Name:          request.Name,
+		Description:   request.Description,
+		DepartureCity: request.DepartureCity,
+		ArrivalCity:   request.ArrivalCity,
+		FlightClass:   entities.FlightClass(request.FlightClass),
+		Price:         request.Price,
+		MaxQuantity:   maxQuantity,
+		Active:        active,
+	}
+}
+
+func ToAncillaryResponse(ancillary entities.Ancillary) dto.AncillaryResponse {
+	return dto.AncillaryResponse{
+		AncillaryID:   strconv.FormatInt(ancillary.AncillaryID, 10),
+		Code:          ancillary.Code,
+		Type:          string(ancillary.Type),
+		Name:          ancillary.Name,
+		Description:   ancillary.Description,
+		DepartureCity: ancillary.DepartureCity,
+		ArrivalCity:   ancillary.ArrivalCity,
+		FlightClass:   string(ancillary.FlightClass),
+		Price:         ancillary.Price,
+		MaxQuantity:   ancillary.MaxQuantity,
+		Active:        ancillary.Active,
+		UpdatedAt:     ancillary.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToAncillaryResponses(ancillaries []entities.Ancillary) []dto.AncillaryResponse {
+	responses := make([]dto.AncillaryResponse, 0, len(ancillaries))
+	for _, ancillary := range ancillaries {
+		responses = append(responses, ToAncillaryResponse(ancillary))
+	}
+	return responses
+}
+
+func ToPurchaseAncillaryResponse(result entities.PurchaseAncillaryResult) dto.PurchaseAncillaryResponse {
+	return dto.PurchaseAncillaryResponse{
+		Ancillary:           ToTicketAncillaryResponse(result.Ancillary),
+		PaymentClientSecret: result.PaymentClientSecret,
+	}
+}
+
+func ToCancelAncillaryResponse(result entities.CancelAncillaryResult) dto.CancelAncillaryResponse {
+	return dto.CancelAncillaryResponse{
+		Ancillary: ToTicketAncillaryResponse(result.Ancillary),
+		Refund:    mapRefundToResponse(result.Refund),
+	}
+}
@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToBoardingPassResponse(pass entities.BoardingPass) dto.BoardingPassResponse {
+	return dto.BoardingPassResponse{
+		TicketID:         strconv.FormatInt(pass.TicketID, 10),
+		TicketNumber:     pass.TicketNumber,
+		BookingPNR:       pass.BookingPNR,
+		FirstName:        pass.FirstName,
+		LastName:         pass.LastName,
+		PassengerType:    string(pass.PassengerType),
+		FlightID:         strconv.FormatInt(pass.FlightID, 10),
+		FlightNumber:     pass.FlightNumber,
+		DepartureCity:    pass.DepartureCity,
+		ArrivalCity:      pass.ArrivalCity,
+		DepartureTime:    pass.DepartureTime.Format(time.RFC3339),
+		SeatCode:         pass.SeatCode,
+		FlightClass:      string(pass.FlightClass),
+		FareFamily:       string(pass.FareFamily),
+		CheckedBaggageKg: pass.CheckedBaggageKg,
+		LoyaltyTier:      string(pass.LoyaltyTier),
+		PriorityBoarding: pass.PriorityBoarding,
+		LoungeAccess:     pass.LoungeAccess,
+		Ancillaries:      pass.Ancillaries,
+		SpecialServices:  pass.SpecialServices,
+		CheckedIn:        pass.CheckedIn,
+	}
+}
+
+func ToBoardingPassesResponse(passes []entities.BoardingPass) []dto.BoardingPassResponse {
+	responses := make([]dto.BoardingPassResponse, 0, len(passes))
+	for _, pass := range passes {
+		responses = append(responses, ToBoardingPassResponse(pass))
+	}
+	return responses
+}
@@ -1,7 +1,9 @@
 package mappers
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	db "github.com/spaghetti-lover/qairlines/db/sqlc"
@@ -9,34 +11,56 @@ import (
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 )
 
-func ToCreateBookingParams(request dto.CreateBookingRequest, departureFlight entities.Flight, returnFlight *entities.Flight, email string) entities.CreateBookingParams {
-	var returnFlightID string
-	if returnFlight != nil {
-		returnFlightID = strconv.FormatInt(returnFlight.FlightID, 10)
+// ToBookingSegmentRequests returns the flights of the request in travel order. oneWay and
+// roundTrip bookings still send their flights in the departure/return fields.
+func ToBookingSegmentRequests(request dto.CreateBookingRequest) []dto.BookingSegmentRequest {
+	switch entities.TripType(request.TripType) {
+	case entities.MultiCityTrip:
+		return request.Segments
+	case entities.RoundTrip:
+		return []dto.BookingSegmentRequest{
+			{FlightID: request.DepartureFlightID, QuoteID: request.DepartureQuoteID, TicketDataList: request.DepartureTicketDataList},
+			{FlightID: request.ReturnFlightID, QuoteID: request.ReturnQuoteID, TicketDataList: request.ReturnTicketDataList},
+		}
+	default:
+		return []dto.BookingSegmentRequest{
+			{FlightID: request.DepartureFlightID, QuoteID: request.DepartureQuoteID, TicketDataList: request.DepartureTicketDataList},
+		}
+	}
+}
+
+func ToCreateBookingParams(request dto.CreateBookingRequest, segments []dto.BookingSegmentRequest, flights []entities.Flight, email string) entities.CreateBookingParams {
+	bookingSegments := make([]entities.BookingSegment, len(flights))
+	for i, flight := range flights {
+		bookingSegments[i] = entities.BookingSegment{
+			SegmentOrder: i + 1,
+			FlightID:     flight.FlightID,
+			Tickets:      mapTicketDataList(segments[i].TicketDataList),
+		}
 	}
 
 	return entities.CreateBookingParams{
-		Email:                   email,
-		DepartureCity:           request.DepartureCity,
-		ArrivalCity:             request.ArrivalCity,
-		DepartureFlightID:       strconv.FormatInt(departureFlight.FlightID, 10),
-		ReturnFlightID:          returnFlightID,
-		TripType:                entities.TripType(request.TripType),
-		DepartureTicketDataList: mapTicketDataList(request.DepartureTicketDataList),
-		ReturnTicketDataList:    mapTicketDataList(request.ReturnTicketDataList),
+		Email:         email,
+		DepartureCity: request.DepartureCity,
+		ArrivalCity:   request.ArrivalCity,
+		TripType:      entities.TripType(request.TripType),
+		Segments:      bookingSegments,
 	}
 }
 
 func mapTicketDataList(ticketDataList []dto.TicketDataRequest) []entities.Ticket {
 	var mappedList []entities.Ticket
 	for _, ticket := range ticketDataList {
-		dateOfBirth, err := time.Parse("2006-01-02", ticket.OwnerData.DateOfBirth)
-		if err != nil {
-			return []entities.Ticket{}
-		}
+		// Ngày không hợp lệ để trống; lỗi định dạng được báo theo từng trường khi kiểm tra giấy tờ
+		dateOfBirth, _ := time.Parse("2006-01-02", ticket.OwnerData.DateOfBirth)
+		passportExpiry, _ := time.Parse("2006-01-02", ticket.OwnerData.PassportExpiry)
 		mappedList = append(mappedList, entities.Ticket{
-			Price:       ticket.Price,
-			FlightClass: entities.FlightClass(ticket.FlightClass),
+			Price:                 ticket.Price,
+			FlightClass:           entities.FlightClass(ticket.FlightClass),
+			FareFamily:            entities.FareFamilyCode(ticket.FareFamily),
+			AccompanyingPassenger: ticket.AccompanyingPassenger,
+			Ancillaries:           mapAncillaryItemRequests(ticket.Ancillaries),
+			Seat:                  entities.Seat{SeatCode: ticket.SeatCode},
 			Owner: entities.TicketOwner{
 				IdentificationNumber: ticket.OwnerData.IdentityCardNumber,
 				FirstName:            ticket.OwnerData.FirstName,
@@ -45,12 +69,40 @@ func mapTicketDataList(ticketDataList []dto.TicketDataRequest) []entities.Ticket
 				DateOfBirth:          dateOfBirth,
 				Gender:               entities.GenderType(ticket.OwnerData.Gender),
 				Address:              ticket.OwnerData.Address,
+				PassportNumber:       strings.ToUpper(strings.TrimSpace(ticket.OwnerData.PassportNumber)),
+				DocumentCountry:      strings.ToUpper(strings.TrimSpace(ticket.OwnerData.PassportCountry)),
+				DocumentExpiry:       passportExpiry,
 			},
 		})
 	}
 	return mappedList
 }
 
+// TicketDataPath is the JSON path of passenger of segment in request, used to report
+// field-level errors.
+func TicketDataPath(request dto.CreateBookingRequest, segment int, passenger int) string {
+	switch {
+	case entities.TripType(request.TripType) == entities.MultiCityTrip:
+		return fmt.Sprintf("segments[%d].ticketDataList[%d]", segment, passenger)
+	case segment == 0:
+		return fmt.Sprintf("departureTicketDataList[%d]", passenger)
+	default:
+		return fmt.Sprintf("returnTicketDataList[%d]", passenger)
+	}
+}
+
+// mapAncillaryItemRequests keeps only what the customer asked for; prices are set by the server
+func mapAncillaryItemRequests(items []dto.AncillaryItemRequest) []entities.TicketAncillary {
+	var ancillaries []entities.TicketAncillary
+	for _, item := range items {
+		ancillaries = append(ancillaries, entities.TicketAncillary{
+			Code:     item.Code,
+			Quantity: item.Quantity,
+		})
+	}
+	return ancillaries
+}
+
 func ToCreateBookingResponse(booking entities.Booking, departureTickets []entities.Ticket, returnTickets []entities.Ticket) dto.CreateBookingResponse {
 	var returnTicketsResponse []dto.TicketDataResponse
 	if returnTickets != nil {
@@ -58,13 +110,92 @@ func ToCreateBookingResponse(booking entities.Booking, departureTickets []entiti
 	}
 
 	return dto.CreateBookingResponse{
-		BookingID:         strconv.FormatInt(booking.BookingID, 10),
+		BookingID:         booking.PNR,
+		PNR:               booking.PNR,
 		DepartureFlightID: strconv.FormatInt(booking.DepartureFlightID, 10),
 		ReturnFlightID:    mapNullableInt64ToString(booking.ReturnFlightID),
 		TripType:          string(booking.TripType),
 		DepartureTickets:  mapTicketDataListToResponse(departureTickets),
 		ReturnTickets:     returnTicketsResponse,
+		Segments:          mapBookingSegmentsToResponse(booking.Segments),
+		TotalPrice:        bookingTotal(booking.Segments),
+	}
+}
+
+// bookingTotal sums the price of every ticket of the itinerary and of its add-ons
+func bookingTotal(segments []entities.BookingSegment) int64 {
+	var total int64
+	for _, segment := range segments {
+		for _, ticket := range segment.Tickets {
+			total += ticket.AmountDue()
+		}
 	}
+	return total
+}
+
+func mapFareItemsToResponse(items []entities.FareItem) []dto.FareItemResponse {
+	result := make([]dto.FareItemResponse, 0, len(items))
+	for _, item := range items {
+		result = append(result, dto.FareItemResponse{
+			Type:        string(item.Type),
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      item.Amount,
+		})
+	}
+	return result
+}
+
+func ToTicketAncillaryResponses(items []entities.TicketAncillary) []dto.TicketAncillaryResponse {
+	result := make([]dto.TicketAncillaryResponse, 0, len(items))
+	for _, item := range items {
+		result = append(result, ToTicketAncillaryResponse(item))
+	}
+	return result
+}
+
+func ToTicketAncillaryResponse(item entities.TicketAncillary) dto.TicketAncillaryResponse {
+	response := dto.TicketAncillaryResponse{
+		TicketAncillaryID: strconv.FormatInt(item.TicketAncillaryID, 10),
+		TicketID:          strconv.FormatInt(item.TicketID, 10),
+		Code:              item.Code,
+		Type:              string(item.Type),
+		Name:              item.Name,
+		Quantity:          item.Quantity,
+		UnitPrice:         item.UnitPrice,
+		Amount:            item.Amount,
+		Status:            string(item.Status),
+		CreatedAt:         item.CreatedAt.Format(time.RFC3339),
+	}
+	if item.CancelledAt != nil {
+		response.CancelledAt = item.CancelledAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func mapBookingSegmentsToResponse(segments []entities.BookingSegment) []dto.BookingSegmentResponse {
+	result := make([]dto.BookingSegmentResponse, 0, len(segments))
+	for _, segment := range segments {
+		result = append(result, dto.BookingSegmentResponse{
+			SegmentOrder: segment.SegmentOrder,
+			FlightID:     strconv.FormatInt(segment.FlightID, 10),
+			Tickets:      mapTicketDataListToResponse(segment.Tickets),
+		})
+	}
+	return result
+}
+
+func mapBookingSegmentsToSummaryResponse(segments []entities.BookingSegment) []dto.BookingSegmentSummaryResponse {
+	result := make([]dto.BookingSegmentSummaryResponse, 0, len(segments))
+	for _, segment := range segments {
+		result = append(result, dto.BookingSegmentSummaryResponse{
+			SegmentOrder:  segment.SegmentOrder,
+			FlightID:      strconv.FormatInt(segment.FlightID, 10),
+			TicketIDs:     mapTicketIDsToResponse(segment.Tickets),
+			TicketNumbers: mapTicketNumbersToResponse(segment.Tickets),
+		})
+	}
+	return result
 }
 
 func mapTicketDataListToResponse(ticketDataList []entities.Ticket) []dto.TicketDataResponse {
@@ -74,11 +205,21 @@ func mapTicketDataListToResponse(ticketDataList []entities.Ticket) []dto.TicketD
 		if ticket.SeatID != 0 {
 			seatID = strconv.FormatInt(ticket.SeatID, 10)
 		}
+		// Số hộ chiếu lưu thay bằng số giấy tờ tuỳ thân khi hành khách không dùng hộ chiếu
+		passportNumber, passportExpiry := "", ""
+		if ticket.Owner.DocumentCountry != "" {
+			passportNumber = ticket.Owner.PassportNumber
+			passportExpiry = ticket.Owner.DocumentExpiry.Format("2006-01-02")
+		}
 		mappedList = append(mappedList, dto.TicketDataResponse{
-			TicketID:    strconv.FormatInt(ticket.TicketID, 10),
-			SeatID:      seatID,
-			Price:       ticket.Price,
-			FlightClass: string(ticket.FlightClass),
+			TicketID:             strconv.FormatInt(ticket.TicketID, 10),
+			TicketNumber:         ticket.TicketNumber,
+			SeatID:               seatID,
+			Price:                ticket.Price,
+			FlightClass:          string(ticket.FlightClass),
+			FareFamily:           string(ticket.FareFamily),
+			PassengerType:        string(ticket.PassengerType),
+			AccompanyingTicketID: mapOptionalIDToString(ticket.AccompanyingTicketID),
 			OwnerData: dto.OwnerData{
 				IdentityCardNumber: ticket.Owner.IdentificationNumber,
 				FirstName:          ticket.Owner.FirstName,
@@ -87,12 +228,33 @@ func mapTicketDataListToResponse(ticketDataList []entities.Ticket) []dto.TicketD
 				DateOfBirth:        ticket.Owner.DateOfBirth.String(),
 				Gender:             ticket.Owner.Gender,
 				Address:            ticket.Owner.Address,
+				PassportNumber:     passportNumber,
+				PassportCountry:    ticket.Owner.DocumentCountry,
+				PassportExpiry:     passportExpiry,
 			},
+			FareBreakdown:   mapFareItemsToResponse(ticket.FareItems),
+			Ancillaries:     ToTicketAncillaryResponses(ticket.Ancillaries),
+			SpecialServices: ToTicketSpecialServiceResponses(ticket.SpecialServices),
+			CheckedInAt:     mapOptionalTimeToString(ticket.CheckedInAt),
 		})
 	}
 	return mappedList
 }
 
+func mapOptionalTimeToString(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func mapOptionalIDToString(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
 func mapNullableInt64ToString(value *int64) string {
 	if value == nil {
 		return ""
@@ -102,18 +264,48 @@ func mapNullableInt64ToString(value *int64) string {
 
 func ToGetBookingResponse(booking entities.Booking, departureTickets []entities.Ticket, returnTickets []entities.Ticket) dto.GetBookingResponse {
 	return dto.GetBookingResponse{
-		BookingID:         strconv.FormatInt(booking.BookingID, 10),
-		Email:             booking.UserEmail,
-		TripType:          string(booking.TripType),
-		DepartureFlightID: strconv.FormatInt(booking.DepartureFlightID, 10),
-		ReturnFlightID:    mapNullableInt64ToString(booking.ReturnFlightID),
-		DepartureTickets:  mapTicketIDsToResponse(departureTickets),
-		ReturnTickets:     mapTicketIDsToResponse(returnTickets),
-		CreatedAt:         booking.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:         booking.UpdatedAt.Format(time.RFC3339),
+		BookingID:              booking.PNR,
+		PNR:                    booking.PNR,
+		Email:                  booking.UserEmail,
+		TripType:               string(booking.TripType),
+		DepartureFlightID:      strconv.FormatInt(booking.DepartureFlightID, 10),
+		ReturnFlightID:         mapNullableInt64ToString(booking.ReturnFlightID),
+		DepartureTickets:       mapTicketIDsToResponse(departureTickets),
+		ReturnTickets:          mapTicketIDsToResponse(returnTickets),
+		DepartureTicketNumbers: mapTicketNumbersToResponse(departureTickets),
+		ReturnTicketNumbers:    mapTicketNumbersToResponse(returnTickets),
+		Segments:               mapBookingSegmentsToSummaryResponse(booking.Segments),
+		Status:                 string(booking.Status),
+		StatusHistory:          mapStatusHistoryToResponse(booking.StatusHistory),
+		CreatedAt:              booking.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:              booking.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToUpdateBookingStatusResponse(booking entities.Booking) dto.UpdateBookingStatusResponse {
+	return dto.UpdateBookingStatusResponse{
+		BookingID:     booking.PNR,
+		PNR:           booking.PNR,
+		Status:        string(booking.Status),
+		StatusHistory: mapStatusHistoryToResponse(booking.StatusHistory),
+		UpdatedAt:     booking.UpdatedAt.Format(time.RFC3339),
 	}
 }
 
+func mapStatusHistoryToResponse(history []entities.BookingStatusChange) []dto.BookingStatusHistoryResponse {
+	result := make([]dto.BookingStatusHistoryResponse, 0, len(history))
+	for _, change := range history {
+		result = append(result, dto.BookingStatusHistoryResponse{
+			FromStatus: string(change.FromStatus),
+			ToStatus:   string(change.ToStatus),
+			Actor:      change.Actor,
+			Reason:     change.Reason,
+			CreatedAt:  change.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return result
+}
+
 func mapTicketIDsToResponse(tickets []entities.Ticket) []string {
 	var ticketIDs []string
 	for _, ticket := range tickets {
@@ -122,6 +314,14 @@ func mapTicketIDsToResponse(tickets []entities.Ticket) []string {
 	return ticketIDs
 }
 
+func mapTicketNumbersToResponse(tickets []entities.Ticket) []string {
+	var ticketNumbers []string
+	for _, ticket := range tickets {
+		ticketNumbers = append(ticketNumbers, ticket.TicketNumber)
+	}
+	return ticketNumbers
+}
+
 func MapEntitiesTicketsToDbTicketData(tickets []entities.Ticket) []db.TicketData {
 	var result []db.TicketData
 	for _, t := range tickets {
@@ -141,3 +341,97 @@ func MapEntitiesTicketsToDbTicketData(tickets []entities.Ticket) []db.TicketData
 	}
 	return result
 }
+
+func ToManageBookingLookupResponse(token string, expiresAt time.Time, booking entities.Booking) dto.ManageBookingLookupResponse {
+	return dto.ManageBookingLookupResponse{
+		Token:     token,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+		PNR:       booking.PNR,
+	}
+}
+
+func ToCancelBookingResponse(result entities.CancelBookingResult) dto.CancelBookingResponse {
+	booking := result.Booking
+	cancelledTicketIDs := make([]string, 0, len(result.CancelledTickets))
+	for _, ticket := range result.CancelledTickets {
+		cancelledTicketIDs = append(cancelledTicketIDs, strconv.FormatInt(ticket.TicketID, 10))
+	}
+
+	response := dto.CancelBookingResponse{
+		BookingID:          booking.PNR,
+		PNR:                booking.PNR,
+		Status:             string(booking.Status),
+		StatusHistory:      mapStatusHistoryToResponse(booking.StatusHistory),
+		CancelledTicketIDs: cancelledTicketIDs,
+		UpdatedAt:          booking.UpdatedAt.Format(time.RFC3339),
+	}
+	response.Refund = mapRefundToResponse(result.Refund)
+	if result.WalletCredit != nil {
+		credit := ToWalletTransactionResponse(*result.WalletCredit)
+		response.WalletCredit = &credit
+	}
+	return response
+}
+
+func mapRefundToResponse(refund *entities.Refund) *dto.RefundResponse {
+	if refund == nil {
+		return nil
+	}
+	return &dto.RefundResponse{
+		RefundID:  strconv.FormatInt(refund.RefundID, 10),
+		Amount:    refund.Amount,
+		Status:    string(refund.Status),
+		Method:    string(refund.Method),
+		CreatedAt: refund.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToFlightChangeQuoteResponses(quotes []entities.FlightChangeQuote) []dto.FlightChangeQuoteResponse {
+	responses := make([]dto.FlightChangeQuoteResponse, 0, len(quotes))
+	for _, quote := range quotes {
+		responses = append(responses, toFlightChangeQuoteResponse(quote))
+	}
+	return responses
+}
+
+func toFlightChangeQuoteResponse(quote entities.FlightChangeQuote) dto.FlightChangeQuoteResponse {
+	return dto.FlightChangeQuoteResponse{
+		FlightID:       strconv.FormatInt(quote.Flight.FlightID, 10),
+		FlightNumber:   quote.Flight.FlightNumber,
+		DepartureTime:  quote.Flight.DepartureTime.Format(time.RFC3339),
+		ArrivalTime:    quote.Flight.ArrivalTime.Format(time.RFC3339),
+		CurrentFare:    quote.CurrentFare,
+		NewFare:        quote.NewFare,
+		ChangeFee:      quote.ChangeFee,
+		FareDifference: quote.FareDifference,
+		AmountDue:      quote.AmountDue,
+		RefundAmount:   quote.RefundAmount,
+	}
+}
+
+func ToChangeFlightResponse(result entities.ChangeFlightResult) dto.ChangeFlightResponse {
+	tickets := make([]dto.ChangedTicketResponse, 0, len(result.Tickets))
+	for _, ticket := range result.Tickets {
+		tickets = append(tickets, dto.ChangedTicketResponse{
+			TicketID:     strconv.FormatInt(ticket.TicketID, 10),
+			TicketNumber: ticket.TicketNumber,
+			FlightID:     strconv.FormatInt(ticket.FlightID, 10),
+			FlightClass:  string(ticket.FlightClass),
+			Price:        ticket.Price,
+		})
+	}
+
+	return dto.ChangeFlightResponse{
+		FlightChangeID:      strconv.FormatInt(result.FlightChangeID, 10),
+		Status:              string(result.Status),
+		BookingID:           result.Booking.PNR,
+		PNR:                 result.Booking.PNR,
+		DepartureFlightID:   strconv.FormatInt(result.Booking.DepartureFlightID, 10),
+		ReturnFlightID:      mapNullableInt64ToString(result.Booking.ReturnFlightID),
+		Tickets:             tickets,
+		Quote:               toFlightChangeQuoteResponse(result.Quote),
+		PaymentClientSecret: result.PaymentClientSecret,
+		Refund:              mapRefundToResponse(result.Refund),
+		UpdatedAt:           result.Booking.UpdatedAt.Format(time.RFC3339),
+	}
+}
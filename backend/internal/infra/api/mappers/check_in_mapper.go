@@ -0,0 +1,21 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToTicketCheckInResponses(checkIns []entities.TicketCheckIn) []dto.TicketCheckInResponse {
+	responses := make([]dto.TicketCheckInResponse, 0, len(checkIns))
+	for _, checkIn := range checkIns {
+		responses = append(responses, dto.TicketCheckInResponse{
+			TicketID:    strconv.FormatInt(checkIn.TicketID, 10),
+			FlightID:    strconv.FormatInt(checkIn.FlightID, 10),
+			CheckedInAt: checkIn.CheckedInAt.Format(time.RFC3339),
+		})
+	}
+	return responses
+}
@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToCompanionResponse(companion entities.Companion) dto.CompanionResponse {
+	return dto.CompanionResponse{
+		CompanionID:     strconv.FormatInt(companion.CompanionID, 10),
+		FirstName:       companion.FirstName,
+		LastName:        companion.LastName,
+		Gender:          string(companion.Gender),
+		DateOfBirth:     companion.DateOfBirth.Format("2006-01-02"),
+		DocumentType:    string(companion.DocumentType),
+		DocumentNumber:  companion.DocumentNumber,
+		DocumentExpiry:  companion.DocumentExpiry.Format("2006-01-02"),
+		DocumentCountry: companion.DocumentCountry,
+		UpdatedAt:       companion.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToCompanionResponses(companions []entities.Companion) []dto.CompanionResponse {
+	responses := make([]dto.CompanionResponse, 0, len(companions))
+	for _, companion := range companions {
+		responses = append(responses, ToCompanionResponse(companion))
+	}
+	return responses
+}
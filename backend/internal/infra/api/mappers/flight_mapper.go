@@ -2,6 +2,7 @@ package mappers
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
@@ -15,6 +16,8 @@ func CreateFlightRequestToEntity(req dto.CreateFlightRequest) entities.Flight {
 		ArrivalCity:      req.ArrivalCity,
 		DepartureAirport: req.DepartureAirport,
 		ArrivalAirport:   req.ArrivalAirport,
+		DepartureCountry: strings.ToUpper(strings.TrimSpace(req.DepartureCountry)),
+		ArrivalCountry:   strings.ToUpper(strings.TrimSpace(req.ArrivalCountry)),
 		DepartureTime:    req.DepartureTime,
 		ArrivalTime:      req.ArrivalTime,
 		BasePrice:        req.BasePrice,
@@ -99,10 +102,14 @@ func ToFlightResponses(flights []entities.Flight) []dto.GetAllFlightsResponse {
 	return flightResponses
 }
 
-func ToFlightSearchResponses(flights []entities.Flight) []dto.FlightSearchResponse {
+func ToFlightSearchResponses(flights []entities.Flight, fares []map[entities.FlightClass]int64, fareFamilies entities.FareFamilyCatalog) []dto.FlightSearchResponse {
 	var responses []dto.FlightSearchResponse
 
-	for _, flight := range flights {
+	for i, flight := range flights {
+		prices := make(map[string]int64, len(fares[i]))
+		for class, fare := range fares[i] {
+			prices[string(class)] = fare
+		}
 		responses = append(responses, dto.FlightSearchResponse{
 			FlightID:         strconv.FormatInt(flight.FlightID, 10),
 			FlightNumber:     flight.FlightNumber,
@@ -115,6 +122,9 @@ func ToFlightSearchResponses(flights []entities.Flight) []dto.FlightSearchRespon
 			ArrivalAirport:   flight.ArrivalAirport,
 			AircraftType:     flight.AircraftType,
 			BasePrice:        int(flight.BasePrice),
+			CurrentPrice:     fares[i][entities.FlightClassEconomy],
+			Prices:           prices,
+			FareFamilies:     toFareFamilyOffers(fares[i], fareFamilies),
 		})
 	}
 
@@ -170,3 +180,28 @@ func MapTicketsToTicketResponse(tickets []entities.Ticket) []dto.TicketResponse
 	}
 	return ticketResponses
 }
+
+// toFareFamilyOffers prices every fare family from the current fare of its cabin.
+func toFareFamilyOffers(fares map[entities.FlightClass]int64, fareFamilies entities.FareFamilyCatalog) []dto.FareFamilyOfferResponse {
+	offers := make([]dto.FareFamilyOfferResponse, 0, len(fareFamilies))
+	for _, family := range fareFamilies {
+		cabinFare, ok := fares[family.FlightClass]
+		if !ok {
+			continue
+		}
+		offers = append(offers, dto.FareFamilyOfferResponse{
+			FlightClass:      string(family.FlightClass),
+			FareFamily:       string(family.Code),
+			Name:             family.Name,
+			Price:            family.Fare(cabinFare),
+			CabinBaggageKg:   family.CabinBaggageKg,
+			CheckedBaggageKg: family.CheckedBaggageKg,
+			Changeable:       family.Changeable,
+			ChangeFeeWaived:  family.ChangeFeeWaived,
+			Refundable:       family.Refundable,
+			FullRefund:       family.FullRefund,
+			SeatSelection:    family.SeatSelection,
+		})
+	}
+	return offers
+}
@@ -0,0 +1,175 @@
+package mappers
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+const groupDateLayout = "2006-01-02"
+
+func ToGroupBookingEntity(request dto.GroupBookingRequest, userEmail string) (entities.GroupBooking, error) {
+	departureDate, err := time.Parse(groupDateLayout, request.DepartureDate)
+	if err != nil {
+		return entities.GroupBooking{}, err
+	}
+	group := entities.GroupBooking{
+		UserEmail:     userEmail,
+		DepartureCity: request.DepartureCity,
+		ArrivalCity:   request.ArrivalCity,
+		DepartureDate: departureDate,
+		FlightClass:   entities.FlightClass(request.FlightClass),
+		Headcount:     request.Headcount,
+		Note:          request.Note,
+	}
+	if request.ReturnDate != "" {
+		returnDate, err := time.Parse(groupDateLayout, request.ReturnDate)
+		if err != nil {
+			return entities.GroupBooking{}, err
+		}
+		group.ReturnDate = &returnDate
+	}
+	return group, nil
+}
+
+func ToGroupQuoteEntity(request dto.GroupQuoteRequest) (entities.GroupQuote, error) {
+	outboundFlightID, err := strconv.ParseInt(request.OutboundFlightID, 10, 64)
+	if err != nil {
+		return entities.GroupQuote{}, err
+	}
+	var returnFlightID int64
+	if request.ReturnFlightID != "" {
+		returnFlightID, err = strconv.ParseInt(request.ReturnFlightID, 10, 64)
+		if err != nil {
+			return entities.GroupQuote{}, err
+		}
+	}
+	depositDeadline, err := time.Parse(time.RFC3339, request.DepositDeadline)
+	if err != nil {
+		return entities.GroupQuote{}, err
+	}
+	nameCutoff, err := time.Parse(time.RFC3339, request.NameCutoff)
+	if err != nil {
+		return entities.GroupQuote{}, err
+	}
+	return entities.GroupQuote{
+		OutboundFlightID: outboundFlightID,
+		ReturnFlightID:   returnFlightID,
+		FarePerPassenger: request.FarePerPassenger,
+		DepositAmount:    request.DepositAmount,
+		DepositDeadline:  depositDeadline,
+		NameCutoff:       nameCutoff,
+	}, nil
+}
+
+func ToGroupPassengerEntities(request dto.GroupPassengersRequest) ([]entities.TicketOwner, error) {
+	passengers := make([]entities.TicketOwner, 0, len(request.Passengers))
+	for _, owner := range request.Passengers {
+		dateOfBirth, err := time.Parse(groupDateLayout, owner.DateOfBirth)
+		if err != nil {
+			return nil, err
+		}
+		var passportExpiry time.Time
+		if owner.PassportExpiry != "" {
+			passportExpiry, err = time.Parse(groupDateLayout, owner.PassportExpiry)
+			if err != nil {
+				return nil, err
+			}
+		}
+		passengers = append(passengers, entities.TicketOwner{
+			FirstName:            owner.FirstName,
+			LastName:             owner.LastName,
+			PhoneNumber:          owner.PhoneNumber,
+			Gender:               owner.Gender,
+			DateOfBirth:          dateOfBirth,
+			PassportNumber:       strings.ToUpper(strings.TrimSpace(owner.PassportNumber)),
+			IdentificationNumber: owner.IdentityCardNumber,
+			Address:              owner.Address,
+			DocumentExpiry:       passportExpiry,
+			DocumentCountry:      strings.ToUpper(strings.TrimSpace(owner.PassportCountry)),
+		})
+	}
+	return passengers, nil
+}
+
+func ToGroupBookingResponse(group entities.GroupBooking) dto.GroupBookingResponse {
+	response := dto.GroupBookingResponse{
+		GroupBookingID:   strconv.FormatInt(group.GroupBookingID, 10),
+		UserEmail:        group.UserEmail,
+		DepartureCity:    group.DepartureCity,
+		ArrivalCity:      group.ArrivalCity,
+		DepartureDate:    group.DepartureDate.Format(groupDateLayout),
+		FlightClass:      string(group.FlightClass),
+		Headcount:        group.Headcount,
+		Note:             group.Note,
+		Status:           string(group.Status),
+		OutboundFlightID: mapOptionalIDToString(group.OutboundFlightID),
+		ReturnFlightID:   mapOptionalIDToString(group.ReturnFlightID),
+		FarePerPassenger: group.FarePerPassenger,
+		TotalFare:        group.TotalFare(),
+		DepositAmount:    group.DepositAmount,
+		DepositDeadline:  formatOptionalTime(group.DepositDeadline),
+		NameCutoff:       formatOptionalTime(group.NameCutoff),
+		DepositPaidAt:    formatOptionalTime(group.DepositPaidAt),
+		BookingID:        mapOptionalIDToString(group.BookingID),
+		Passengers:       make([]dto.GroupPassengerDTO, 0, len(group.Passengers)),
+		CreatedAt:        group.CreatedAt.Format(time.RFC3339),
+	}
+	if group.ReturnDate != nil {
+		response.ReturnDate = group.ReturnDate.Format(groupDateLayout)
+	}
+	for _, passenger := range group.Passengers {
+		passportExpiry := ""
+		if !passenger.DocumentExpiry.IsZero() {
+			passportExpiry = passenger.DocumentExpiry.Format(groupDateLayout)
+		}
+		response.Passengers = append(response.Passengers, dto.GroupPassengerDTO{
+			FirstName:          passenger.FirstName,
+			LastName:           passenger.LastName,
+			PhoneNumber:        passenger.PhoneNumber,
+			DateOfBirth:        passenger.DateOfBirth.Format(groupDateLayout),
+			Gender:             string(passenger.Gender),
+			IdentityCardNumber: passenger.IdentificationNumber,
+			Address:            passenger.Address,
+			PassportNumber:     passenger.PassportNumber,
+			PassportCountry:    passenger.DocumentCountry,
+			PassportExpiry:     passportExpiry,
+		})
+	}
+	return response
+}
+
+func ToGroupBookingResponses(groups []entities.GroupBooking) []dto.GroupBookingResponse {
+	responses := make([]dto.GroupBookingResponse, 0, len(groups))
+	for _, group := range groups {
+		responses = append(responses, ToGroupBookingResponse(group))
+	}
+	return responses
+}
+
+func ToGroupDepositResponse(result entities.GroupDepositResult) dto.GroupDepositResponse {
+	return dto.GroupDepositResponse{
+		GroupBooking:        ToGroupBookingResponse(result.GroupBooking),
+		PaymentClientSecret: result.PaymentClientSecret,
+	}
+}
+
+func ToGroupTicketingResponse(result entities.GroupTicketingResult) dto.GroupTicketingResponse {
+	return dto.GroupTicketingResponse{
+		GroupBooking:  ToGroupBookingResponse(result.GroupBooking),
+		BookingID:     strconv.FormatInt(result.Booking.BookingID, 10),
+		PNR:           result.Booking.PNR,
+		BookingStatus: string(result.Booking.Status),
+		AmountDue:     result.AmountDue,
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,79 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToLoyaltyBalanceResponse(account entities.LoyaltyAccount) dto.LoyaltyBalanceResponse {
+	return dto.LoyaltyBalanceResponse{
+		Points: account.Points,
+		Value:  account.Value,
+	}
+}
+
+func ToLoyaltyTransactionResponse(transaction entities.LoyaltyTransaction) dto.LoyaltyTransactionResponse {
+	response := dto.LoyaltyTransactionResponse{
+		TransactionID: strconv.FormatInt(transaction.TransactionID, 10),
+		Kind:          string(transaction.Kind),
+		Points:        transaction.Points,
+		Amount:        transaction.Amount,
+		Description:   transaction.Description,
+		ExpiresAt:     formatOptionalTime(transaction.ExpiresAt),
+		CreatedAt:     transaction.CreatedAt.Format(time.RFC3339),
+	}
+	if transaction.TicketID != 0 {
+		response.TicketID = strconv.FormatInt(transaction.TicketID, 10)
+	}
+	if transaction.BookingID != 0 {
+		response.BookingID = strconv.FormatInt(transaction.BookingID, 10)
+	}
+	return response
+}
+
+func ToLoyaltyStatementResponse(statement entities.LoyaltyStatement, page int, limit int) dto.LoyaltyStatementResponse {
+	transactions := make([]dto.LoyaltyTransactionResponse, 0, len(statement.Transactions))
+	for _, transaction := range statement.Transactions {
+		transactions = append(transactions, ToLoyaltyTransactionResponse(transaction))
+	}
+	return dto.LoyaltyStatementResponse{
+		Transactions: transactions,
+		Total:        statement.Total,
+		Page:         page,
+		Limit:        limit,
+	}
+}
+
+func ToRedeemLoyaltyResponse(result entities.RedeemLoyaltyResult) dto.RedeemLoyaltyResponse {
+	return dto.RedeemLoyaltyResponse{
+		Transaction:   ToLoyaltyTransactionResponse(result.Transaction),
+		Balance:       result.Balance,
+		AmountDue:     result.AmountDue,
+		BookingStatus: string(result.Booking.Status),
+	}
+}
+
+func ToLoyaltyTierResponse(status entities.TierStatus) dto.LoyaltyTierResponse {
+	return dto.LoyaltyTierResponse{
+		Tier:             string(status.Tier),
+		QualifyingPoints: status.QualifyingPoints,
+		QualifiedTier:    string(status.QualifiedTier),
+		NextTier:         string(status.NextTier),
+		PointsToNextTier: status.PointsToNextTier,
+		WindowStart:      status.WindowStart.Format(time.RFC3339),
+		Benefits:         ToTierBenefitsResponse(status.Benefits),
+	}
+}
+
+func ToTierBenefitsResponse(benefits entities.TierBenefits) dto.TierBenefitsResponse {
+	return dto.TierBenefitsResponse{
+		ExtraCheckedBaggageKg: benefits.ExtraCheckedBaggageKg,
+		FreeSeatSelection:     benefits.FreeSeatSelection,
+		WaitlistPriority:      benefits.WaitlistPriority,
+		PriorityBoarding:      benefits.PriorityBoarding,
+		LoungeAccess:          benefits.LoungeAccess,
+	}
+}
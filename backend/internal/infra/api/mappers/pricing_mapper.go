@@ -0,0 +1,68 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToPricingCurveEntity(request dto.PricingCurveRequest) entities.PricingCurve {
+	return entities.PricingCurve{
+		DepartureCity:        request.DepartureCity,
+		ArrivalCity:          request.ArrivalCity,
+		FlightClass:          entities.FlightClass(request.FlightClass),
+		LoadFactorSteps:      toPriceSteps(request.LoadFactorSteps),
+		DaysToDepartureSteps: toPriceSteps(request.DaysToDepartureSteps),
+	}
+}
+
+func ToPricingCurveResponse(curve entities.PricingCurve) dto.PricingCurveResponse {
+	return dto.PricingCurveResponse{
+		CurveID:              strconv.FormatInt(curve.CurveID, 10),
+		DepartureCity:        curve.DepartureCity,
+		ArrivalCity:          curve.ArrivalCity,
+		FlightClass:          string(curve.FlightClass),
+		LoadFactorSteps:      toPriceStepResponses(curve.LoadFactorSteps),
+		DaysToDepartureSteps: toPriceStepResponses(curve.DaysToDepartureSteps),
+		UpdatedAt:            curve.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToPricingCurveResponses(curves []entities.PricingCurve) []dto.PricingCurveResponse {
+	responses := make([]dto.PricingCurveResponse, 0, len(curves))
+	for _, curve := range curves {
+		responses = append(responses, ToPricingCurveResponse(curve))
+	}
+	return responses
+}
+
+func ToFareQuoteResponse(quote entities.FareQuote) dto.FareQuoteResponse {
+	prices := make(map[string]int64, len(quote.Fares))
+	for class, fare := range quote.Fares {
+		prices[string(class)] = fare
+	}
+	return dto.FareQuoteResponse{
+		QuoteID:   quote.QuoteID,
+		FlightID:  strconv.FormatInt(quote.FlightID, 10),
+		Prices:    prices,
+		ExpiresAt: quote.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func toPriceSteps(requests []dto.PriceStepRequest) []entities.PriceStep {
+	steps := make([]entities.PriceStep, 0, len(requests))
+	for _, request := range requests {
+		steps = append(steps, entities.PriceStep{Threshold: request.Threshold, Percent: request.Percent})
+	}
+	return steps
+}
+
+func toPriceStepResponses(steps []entities.PriceStep) []dto.PriceStepResponse {
+	responses := make([]dto.PriceStepResponse, 0, len(steps))
+	for _, step := range steps {
+		responses = append(responses, dto.PriceStepResponse{Threshold: step.Threshold, Percent: step.Percent})
+	}
+	return responses
+}
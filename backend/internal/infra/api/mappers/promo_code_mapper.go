@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToPromoCodeEntity(request dto.PromoCodeRequest) (entities.PromoCode, error) {
+	validFrom, err := time.Parse(time.RFC3339, request.ValidFrom)
+	if err != nil {
+		return entities.PromoCode{}, err
+	}
+	validUntil, err := time.Parse(time.RFC3339, request.ValidUntil)
+	if err != nil {
+		return entities.PromoCode{}, err
+	}
+	flightClasses := make([]entities.FlightClass, len(request.FlightClasses))
+	for i, class := range request.FlightClasses {
+		flightClasses[i] = entities.FlightClass(class)
+	}
+	return entities.PromoCode{
+		Code:               request.Code,
+		Description:        request.Description,
+		DiscountType:       entities.PromoDiscountType(request.DiscountType),
+		DiscountValue:      request.DiscountValue,
+		MaxDiscount:        request.MaxDiscount,
+		ValidFrom:          validFrom,
+		ValidUntil:         validUntil,
+		DepartureCity:      request.DepartureCity,
+		ArrivalCity:        request.ArrivalCity,
+		FlightClasses:      flightClasses,
+		MaxUses:            request.MaxUses,
+		MaxUsesPerCustomer: request.MaxUsesPerCustomer,
+		Stackable:          request.Stackable,
+		Active:             true,
+	}, nil
+}
+
+func ToPromoCodeResponse(promo entities.PromoCode) dto.PromoCodeResponse {
+	flightClasses := make([]string, len(promo.FlightClasses))
+	for i, class := range promo.FlightClasses {
+		flightClasses[i] = string(class)
+	}
+	return dto.PromoCodeResponse{
+		PromoCodeID:        strconv.FormatInt(promo.PromoCodeID, 10),
+		Code:               promo.Code,
+		Description:        promo.Description,
+		DiscountType:       string(promo.DiscountType),
+		DiscountValue:      promo.DiscountValue,
+		MaxDiscount:        promo.MaxDiscount,
+		ValidFrom:          promo.ValidFrom.Format(time.RFC3339),
+		ValidUntil:         promo.ValidUntil.Format(time.RFC3339),
+		DepartureCity:      promo.DepartureCity,
+		ArrivalCity:        promo.ArrivalCity,
+		FlightClasses:      flightClasses,
+		MaxUses:            promo.MaxUses,
+		MaxUsesPerCustomer: promo.MaxUsesPerCustomer,
+		Stackable:          promo.Stackable,
+		Active:             promo.Active,
+		UpdatedAt:          promo.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToPromoCodeResponses(promos []entities.PromoCode) []dto.PromoCodeResponse {
+	responses := make([]dto.PromoCodeResponse, 0, len(promos))
+	for _, promo := range promos {
+		responses = append(responses, ToPromoCodeResponse(promo))
+	}
+	return responses
+}
@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToSeatZoneEntity(request dto.SeatZoneRequest) (entities.SeatZone, error) {
+	var flightID int64
+	if request.FlightID != "" {
+		var err error
+		flightID, err = strconv.ParseInt(request.FlightID, 10, 64)
+		if err != nil {
+			return entities.SeatZone{}, err
+		}
+	}
+	return entities.SeatZone{
+		FlightID:     flightID,
+		AircraftType: request.AircraftType,
+		Type:         entities.SeatZoneType(request.Type),
+		Name:         request.Name,
+		RowFrom:      request.RowFrom,
+		RowTo:        request.RowTo,
+		Price:        request.Price,
+	}, nil
+}
+
+func ToSeatZoneResponse(zone entities.SeatZone) dto.SeatZoneResponse {
+	return dto.SeatZoneResponse{
+		SeatZoneID:   strconv.FormatInt(zone.SeatZoneID, 10),
+		FlightID:     mapOptionalIDToString(zone.FlightID),
+		AircraftType: zone.AircraftType,
+		Type:         string(zone.Type),
+		Name:         zone.Name,
+		RowFrom:      zone.RowFrom,
+		RowTo:        zone.RowTo,
+		Price:        zone.Price,
+		UpdatedAt:    zone.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToSeatZoneResponses(zones []entities.SeatZone) []dto.SeatZoneResponse {
+	responses := make([]dto.SeatZoneResponse, 0, len(zones))
+	for _, zone := range zones {
+		responses = append(responses, ToSeatZoneResponse(zone))
+	}
+	return responses
+}
@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToSpecialServiceResponses(catalog entities.SpecialServiceCatalog) []dto.SpecialServiceResponse {
+	responses := make([]dto.SpecialServiceResponse, 0, len(catalog))
+	for _, service := range catalog {
+		responses = append(responses, dto.SpecialServiceResponse{
+			Code:        service.Code,
+			Category:    string(service.Category),
+			Name:        service.Name,
+			FlightLimit: service.FlightLimit,
+		})
+	}
+	return responses
+}
+
+func ToTicketSpecialServiceResponse(item entities.TicketSpecialService) dto.TicketSpecialServiceResponse {
+	return dto.TicketSpecialServiceResponse{
+		TicketSpecialServiceID: strconv.FormatInt(item.TicketSpecialServiceID, 10),
+		TicketID:               strconv.FormatInt(item.TicketID, 10),
+		FlightID:               strconv.FormatInt(item.FlightID, 10),
+		Code:                   item.Code,
+		Category:               string(item.Category),
+		Name:                   item.Name,
+		Note:                   item.Note,
+		CreatedAt:              item.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToTicketSpecialServiceResponses(items []entities.TicketSpecialService) []dto.TicketSpecialServiceResponse {
+	responses := make([]dto.TicketSpecialServiceResponse, 0, len(items))
+	for _, item := range items {
+		responses = append(responses, ToTicketSpecialServiceResponse(item))
+	}
+	return responses
+}
+
+func ToFlightManifestResponse(manifest entities.FlightManifest) dto.FlightManifestResponse {
+	passengers := make([]dto.ManifestPassengerResponse, 0, len(manifest.Passengers))
+	for _, passenger := range manifest.Passengers {
+		specialServices := passenger.SpecialServices
+		if specialServices == nil {
+			specialServices = []string{}
+		}
+		passengers = append(passengers, dto.ManifestPassengerResponse{
+			TicketID:        strconv.FormatInt(passenger.TicketID, 10),
+			TicketNumber:    passenger.TicketNumber,
+			BookingPNR:      passenger.BookingPNR,
+			FirstName:       passenger.FirstName,
+			LastName:        passenger.LastName,
+			PassengerType:   string(passenger.PassengerType),
+			SeatCode:        passenger.SeatCode,
+			FlightClass:     string(passenger.FlightClass),
+			SpecialServices: specialServices,
+		})
+	}
+	return dto.FlightManifestResponse{
+		FlightID:             strconv.FormatInt(manifest.Flight.FlightID, 10),
+		FlightNumber:         manifest.Flight.FlightNumber,
+		DepartureCity:        manifest.Flight.DepartureCity,
+		ArrivalCity:          manifest.Flight.ArrivalCity,
+		DepartureTime:        manifest.Flight.DepartureTime.Format(time.RFC3339),
+		Passengers:           passengers,
+		SpecialServiceCounts: manifest.SpecialServiceCounts,
+	}
+}
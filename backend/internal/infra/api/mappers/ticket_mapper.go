@@ -1,6 +1,9 @@
 package mappers
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
 )
@@ -10,15 +13,17 @@ func ToGetTicketsByFlightIDResponse(tickets []entities.Ticket) []dto.GetTicketBy
 
 	for _, ticket := range tickets {
 		responses = append(responses, dto.GetTicketByFlightIDResponse{
-			TicketID:    ticket.TicketID,
-			SeatID:      ticket.Seat.SeatID,
-			FlightClass: string(ticket.FlightClass),
-			Price:       ticket.Price,
-			Status:      string(ticket.Status),
-			BookingID:   ticket.BookingID,
-			FlightID:    ticket.FlightID,
-			CreatedAt:   ticket.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt:   ticket.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+			TicketID:     ticket.TicketID,
+			TicketNumber: ticket.TicketNumber,
+			SeatID:       ticket.Seat.SeatID,
+			FlightClass:  string(ticket.FlightClass),
+			Price:        ticket.Price,
+			Status:       string(ticket.Status),
+			BookingID:    ticket.BookingID,
+			BookingPNR:   ticket.BookingPNR,
+			FlightID:     ticket.FlightID,
+			CreatedAt:    ticket.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt:    ticket.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 			Seat: dto.SeatResponse{
 				SeatID:      ticket.Seat.SeatID,
 				SeatCode:    ticket.Seat.SeatCode,
@@ -39,52 +44,81 @@ func ToGetTicketsByFlightIDResponse(tickets []entities.Ticket) []dto.GetTicketBy
 
 func ToGetTicketResponse(ticket entities.Ticket) dto.GetTicketResponse {
 	return dto.GetTicketResponse{
-		TicketID:    ticket.TicketID,
-		Status:      string(ticket.Status),
-		SeatCode:    ticket.Seat.SeatCode,
-		FlightClass: string(ticket.FlightClass),
-		Price:       ticket.Price,
+		TicketID:     ticket.TicketID,
+		TicketNumber: ticket.TicketNumber,
+		Status:       string(ticket.Status),
+		SeatCode:     ticket.Seat.SeatCode,
+		FlightClass:  string(ticket.FlightClass),
+		Price:        ticket.Price,
 		OwnerData: dto.TicketOwnerResponse{
 			FirstName:   ticket.Owner.FirstName,
 			LastName:    ticket.Owner.LastName,
 			PhoneNumber: ticket.Owner.PhoneNumber,
 			Gender:      string(ticket.Owner.Gender),
 		},
-		BookingID: ticket.BookingID,
-		FlightID:  ticket.FlightID,
-		CreatedAt: ticket.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt: ticket.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		BookingID:  ticket.BookingID,
+		BookingPNR: ticket.BookingPNR,
+		FlightID:   ticket.FlightID,
+		CreatedAt:  ticket.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:  ticket.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 }
 
 func ToCancelTicketResponse(ticket *entities.Ticket) *dto.CancelTicketResponse {
 	return &dto.CancelTicketResponse{
-		TicketID:    ticket.TicketID,
-		Status:      string(ticket.Status),
-		SeatCode:    ticket.Seat.SeatCode,
-		FlightClass: string(ticket.FlightClass),
-		Price:       ticket.Price,
+		TicketID:     ticket.TicketID,
+		TicketNumber: ticket.TicketNumber,
+		Status:       string(ticket.Status),
+		SeatCode:     ticket.Seat.SeatCode,
+		FlightClass:  string(ticket.FlightClass),
+		Price:        ticket.Price,
 		OwnerData: dto.TicketOwnerResponse{
 			FirstName:   ticket.Owner.FirstName,
 			LastName:    ticket.Owner.LastName,
 			PhoneNumber: ticket.Owner.PhoneNumber,
 		},
-		BookingID: ticket.BookingID,
-		FlightID:  ticket.FlightID,
-		UpdatedAt: ticket.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		BookingID:  ticket.BookingID,
+		BookingPNR: ticket.BookingPNR,
+		FlightID:   ticket.FlightID,
+		UpdatedAt:  ticket.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}
 }
 
-func ToUpdateSeatResponses(tickets []entities.Ticket) []dto.UpdateSeatResponse {
-	var responses []dto.UpdateSeatResponse
+func ToSeatUpdateEntities(requests []dto.UpdateSeatRequest) ([]entities.SeatUpdate, error) {
+	updates := make([]entities.SeatUpdate, 0, len(requests))
+	for _, request := range requests {
+		ticketID, err := strconv.ParseInt(request.TicketID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ticket ID %q: %w", request.TicketID, err)
+		}
+		updates = append(updates, entities.SeatUpdate{TicketID: ticketID, SeatCode: request.SeatCode})
+	}
+	return updates, nil
+}
 
-	for _, ticket := range tickets {
-		responses = append(responses, dto.UpdateSeatResponse{
-			TicketID: ticket.TicketID,
-			SeatCode: ticket.Seat.SeatCode,
-			Status:   "Updated",
-		})
+func ToUpdateSeatsResponse(result entities.SeatSelectionResult) dto.UpdateSeatsResponse {
+	// Ghế của booking đã thanh toán chỉ được đổi sau khi phí chọn ghế được thu
+	status := "Updated"
+	if result.Status == entities.SeatSelectionStatusPending {
+		status = "PendingPayment"
+	}
+	seats := make([]dto.UpdateSeatResponse, 0, len(result.Selections))
+	for _, selection := range result.Selections {
+		seat := dto.UpdateSeatResponse{
+			TicketID: selection.TicketID,
+			SeatCode: selection.SeatCode,
+			Status:   status,
+			Fee:      selection.Fee,
+		}
+		if selection.Zone != nil {
+			seat.SeatZone = selection.Zone.Name
+		}
+		seats = append(seats, seat)
 	}
 
-	return responses
+	return dto.UpdateSeatsResponse{
+		Seats:               seats,
+		AmountDue:           result.AmountDue,
+		PaymentClientSecret: result.PaymentClientSecret,
+	}
 }
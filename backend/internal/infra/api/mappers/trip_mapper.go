@@ -0,0 +1,65 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToTripListResponse(page entities.TripPage, now time.Time, pageNumber int, limit int) dto.TripListResponse {
+	trips := make([]dto.TripResponse, 0, len(page.Trips))
+	for _, trip := range page.Trips {
+		trips = append(trips, toTripResponse(trip, now))
+	}
+	return dto.TripListResponse{
+		Trips:         trips,
+		Total:         page.Total,
+		Page:          pageNumber,
+		Limit:         limit,
+		UpcomingCount: page.UpcomingCount,
+		PastCount:     page.PastCount,
+	}
+}
+
+func toTripResponse(trip entities.Trip, now time.Time) dto.TripResponse {
+	segments := make([]dto.TripSegmentResponse, 0, len(trip.Segments))
+	for _, segment := range trip.Segments {
+		tickets := make([]dto.TripTicketResponse, 0, len(segment.Tickets))
+		for _, ticket := range segment.Tickets {
+			tickets = append(tickets, dto.TripTicketResponse{
+				TicketID:      strconv.FormatInt(ticket.TicketID, 10),
+				TicketNumber:  ticket.TicketNumber,
+				PassengerType: string(ticket.PassengerType),
+				FirstName:     ticket.Owner.FirstName,
+				LastName:      ticket.Owner.LastName,
+				SeatCode:      ticket.Seat.SeatCode,
+				FlightClass:   string(ticket.FlightClass),
+				FareFamily:    string(ticket.FareFamily),
+				Status:        string(ticket.Status),
+			})
+		}
+		segments = append(segments, dto.TripSegmentResponse{
+			SegmentOrder:  segment.SegmentOrder,
+			FlightID:      strconv.FormatInt(segment.Flight.FlightID, 10),
+			FlightNumber:  segment.Flight.FlightNumber,
+			DepartureCity: segment.Flight.DepartureCity,
+			ArrivalCity:   segment.Flight.ArrivalCity,
+			DepartureTime: segment.Flight.DepartureTime.Format(time.RFC3339),
+			ArrivalTime:   segment.Flight.ArrivalTime.Format(time.RFC3339),
+			FlightStatus:  string(segment.Flight.Status),
+			Tickets:       tickets,
+		})
+	}
+	return dto.TripResponse{
+		BookingID:     strconv.FormatInt(trip.Booking.BookingID, 10),
+		PNR:           trip.Booking.PNR,
+		TripType:      string(trip.Booking.TripType),
+		Status:        string(trip.Booking.Status),
+		Upcoming:      trip.Upcoming(now),
+		DepartureTime: trip.FirstDepartureTime.Format(time.RFC3339),
+		Segments:      segments,
+		CreatedAt:     trip.Booking.CreatedAt.Format(time.RFC3339),
+	}
+}
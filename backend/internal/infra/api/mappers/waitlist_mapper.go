@@ -0,0 +1,38 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToWaitlistEntryResponse(entry entities.WaitlistEntry) dto.WaitlistEntryResponse {
+	response := dto.WaitlistEntryResponse{
+		WaitlistEntryID: strconv.FormatInt(entry.WaitlistEntryID, 10),
+		FlightID:        strconv.FormatInt(entry.FlightID, 10),
+		FlightClass:     string(entry.FlightClass),
+		Status:          string(entry.Status),
+		JoinedAt:        entry.JoinedAt.Format(time.RFC3339),
+	}
+	if entry.OfferExpiresAt != nil {
+		response.OfferExpiresAt = entry.OfferExpiresAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func ToWaitlistEntryResponses(entries []entities.WaitlistEntry) []dto.WaitlistEntryResponse {
+	responses := make([]dto.WaitlistEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		responses = append(responses, ToWaitlistEntryResponse(entry))
+	}
+	return responses
+}
+
+func ToClaimWaitlistResponse(result entities.ClaimWaitlistResult) dto.ClaimWaitlistResponse {
+	return dto.ClaimWaitlistResponse{
+		Entry: ToWaitlistEntryResponse(result.Entry),
+		Quote: ToFareQuoteResponse(result.Quote),
+	}
+}
@@ -0,0 +1,62 @@
+package mappers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/dto"
+)
+
+func ToWalletTransactionResponse(transaction entities.WalletTransaction) dto.WalletTransactionResponse {
+	response := dto.WalletTransactionResponse{
+		TransactionID: strconv.FormatInt(transaction.TransactionID, 10),
+		Kind:          string(transaction.Kind),
+		Amount:        transaction.Amount,
+		IssuedBy:      transaction.IssuedBy,
+		Description:   transaction.Description,
+		ExpiresAt:     formatOptionalTime(transaction.ExpiresAt),
+		CreatedAt:     transaction.CreatedAt.Format(time.RFC3339),
+	}
+	if transaction.BookingID != 0 {
+		response.BookingID = strconv.FormatInt(transaction.BookingID, 10)
+	}
+	if transaction.RefundID != 0 {
+		response.RefundID = strconv.FormatInt(transaction.RefundID, 10)
+	}
+	return response
+}
+
+func ToWalletStatementResponse(statement entities.WalletStatement, page int, limit int) dto.WalletStatementResponse {
+	transactions := make([]dto.WalletTransactionResponse, 0, len(statement.Transactions))
+	for _, transaction := range statement.Transactions {
+		transactions = append(transactions, ToWalletTransactionResponse(transaction))
+	}
+	return dto.WalletStatementResponse{
+		Transactions: transactions,
+		Total:        statement.Total,
+		Page:         page,
+		Limit:        limit,
+	}
+}
+
+func ToPayWithWalletResponse(result entities.PayWithWalletResult) dto.PayWithWalletResponse {
+	return dto.PayWithWalletResponse{
+		Transaction:   ToWalletTransactionResponse(result.Transaction),
+		Balance:       result.Balance,
+		AmountDue:     result.AmountDue,
+		BookingStatus: string(result.Booking.Status),
+	}
+}
+
+func ToFlightWalletCreditsResponse(credits []entities.FlightWalletCredit) []dto.FlightWalletCreditResponse {
+	responses := make([]dto.FlightWalletCreditResponse, 0, len(credits))
+	for _, credit := range credits {
+		responses = append(responses, dto.FlightWalletCreditResponse{
+			UserID:      strconv.FormatInt(credit.UserID, 10),
+			Tickets:     credit.Tickets,
+			Transaction: ToWalletTransactionResponse(credit.Transaction),
+		})
+	}
+	return responses
+}
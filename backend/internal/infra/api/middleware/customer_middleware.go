@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/pkg/token"
+)
+
+// Define the key used to store the signed-in customer in the request context
+const CustomerKey = "customer"
+
+// CustomerMiddleware loads the customer signed in with the bearer access token and stores
+// the user under CustomerKey for the handlers after it.
+func CustomerMiddleware(tokenMaker token.Maker, userRepository adapters.IUserRepository) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		const bearerPrefix = "Bearer "
+		authHeader := ctx.GetHeader("Authorization")
+		if !strings.HasPrefix(authHeader, bearerPrefix) || len(authHeader) == len(bearerPrefix) {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Authorization header is missing"})
+			return
+		}
+		payload, err := tokenMaker.VerifyToken(authHeader[len(bearerPrefix):], token.TokenTypeAccessToken)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "Authentication failed. Invalid token"})
+			return
+		}
+		user, err := userRepository.GetUser(ctx.Request.Context(), payload.UserId)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+			return
+		}
+
+		ctx.Set(CustomerKey, user)
+		ctx.Next()
+	}
+}
+
+// RequesterMiddleware lets admin requests through as they are and runs customer for
+// everyone else, for endpoints both admins and the owning customer may call.
+func RequesterMiddleware(customer gin.HandlerFunc) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader("admin") == "true" {
+			ctx.Next()
+			return
+		}
+		customer(ctx)
+	}
+}
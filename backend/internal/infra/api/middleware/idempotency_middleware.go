@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+// responseRecorder keeps a copy of everything the handler writes so it can be replayed.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware deduplicates requests carrying an Idempotency-Key header.
+// The first request with a key is processed and its response stored for ttl; replays
+// with the same body get that response back, while a key reused with a different
+// body, or sent again while the first request is still running, gets 409 Conflict.
+// Requests without the header are passed through unchanged. Keys that cannot be released
+// or completed are logged to idempotencyLogger.
+func IdempotencyMiddleware(repository adapters.IIdempotencyRepository, ttl time.Duration, idempotencyLogger *zerolog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			ctx.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Idempotency-Key is too long."})
+			return
+		}
+
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"message": "Could not read request body."})
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		// Key được gắn với endpoint và người gọi để hai client khác nhau không đụng key của nhau
+		scopedKey := hashParts(ctx.Request.Method, ctx.FullPath(), ctx.GetHeader("Authorization"), key)
+		fingerprint := hashParts(ctx.Request.Method, ctx.Request.URL.Path, string(body))
+
+		existing, reserved, err := repository.Reserve(ctx.Request.Context(), scopedKey, fingerprint, ttl)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": "An unexpected error occurred. Please try again later."})
+			return
+		}
+		if !reserved {
+			replayIdempotentResponse(ctx, existing, fingerprint)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = recorder
+		ctx.Next()
+
+		// Lỗi máy chủ không được ghi nhớ để client có thể thử lại với cùng key
+		if recorder.Status() >= http.StatusInternalServerError {
+			if err := repository.Release(ctx.Request.Context(), scopedKey); err != nil {
+				idempotencyLogger.Error().
+					Err(err).
+					Str("path", ctx.Request.URL.Path).
+					Str("method", ctx.Request.Method).
+					Msg("failed to release idempotency key")
+			}
+			return
+		}
+
+		err = repository.Complete(ctx.Request.Context(), scopedKey, entities.IdempotencyRecord{
+			Fingerprint: fingerprint,
+			StatusCode:  recorder.Status(),
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}, ttl)
+		if err != nil {
+			idempotencyLogger.Error().
+				Err(err).
+				Str("path", ctx.Request.URL.Path).
+				Str("method", ctx.Request.Method).
+				Int("status", recorder.Status()).
+				Msg("failed to store idempotent response")
+		}
+	}
+}
+
+func replayIdempotentResponse(ctx *gin.Context, existing *entities.IdempotencyRecord, fingerprint string) {
+	if existing.Fingerprint != fingerprint {
+		ctx.AbortWithStatusJSON(http.StatusConflict, gin.H{"message": "Idempotency-Key has already been used with a different request."})
+		return
+	}
+	if !existing.Completed {
+		ctx.AbortWithStatusJSON(http.StatusConflict, gin.H{"message": "A request with this Idempotency-Key is still being processed."})
+		return
+	}
+
+	ctx.Header(IdempotentReplayedHeader, "true")
+	ctx.Data(existing.StatusCode, existing.ContentType, existing.Body)
+	ctx.Abort()
+}
+
+func hashParts(parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
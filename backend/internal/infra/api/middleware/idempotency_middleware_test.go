@@ -0,0 +1,129 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryIdempotencyRepository struct {
+	mu      sync.Mutex
+	records map[string]entities.IdempotencyRecord
+}
+
+func newMemoryIdempotencyRepository() *memoryIdempotencyRepository {
+	return &memoryIdempotencyRepository{records: make(map[string]entities.IdempotencyRecord)}
+}
+
+func (r *memoryIdempotencyRepository) Reserve(_ context.Context, key string, fingerprint string, _ time.Duration) (*entities.IdempotencyRecord, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.records[key]; ok {
+		return &existing, false, nil
+	}
+	r.records[key] = entities.IdempotencyRecord{Fingerprint: fingerprint}
+	return nil, true, nil
+}
+
+func (r *memoryIdempotencyRepository) Complete(_ context.Context, key string, record entities.IdempotencyRecord, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record.Completed = true
+	r.records[key] = record
+	return nil
+}
+
+func (r *memoryIdempotencyRepository) Release(_ context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.records, key)
+	return nil
+}
+
+func newIdempotentRouter(repository *memoryIdempotencyRepository, status int, calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	logger := zerolog.Nop()
+	router.POST("/api/booking", middleware.IdempotencyMiddleware(repository, time.Hour, &logger), func(ctx *gin.Context) {
+		*calls++
+		ctx.JSON(status, gin.H{"call": *calls})
+	})
+	return router
+}
+
+func sendIdempotent(router *gin.Engine, key string, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodPost, "/api/booking", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyMiddlewareReplaysFirstResponse(t *testing.T) {
+	calls := 0
+	router := newIdempotentRouter(newMemoryIdempotencyRepository(), http.StatusCreated, &calls)
+
+	first := sendIdempotent(router, "key-1", `{"flight":1}`)
+	require.Equal(t, http.StatusCreated, first.Code)
+
+	replay := sendIdempotent(router, "key-1", `{"flight":1}`)
+	require.Equal(t, http.StatusCreated, replay.Code)
+	require.Equal(t, first.Body.String(), replay.Body.String())
+	require.Equal(t, "true", replay.Header().Get(middleware.IdempotentReplayedHeader))
+	require.Equal(t, 1, calls)
+}
+
+func TestIdempotencyMiddlewareRejectsDifferentBody(t *testing.T) {
+	calls := 0
+	router := newIdempotentRouter(newMemoryIdempotencyRepository(), http.StatusCreated, &calls)
+
+	require.Equal(t, http.StatusCreated, sendIdempotent(router, "key-1", `{"flight":1}`).Code)
+	require.Equal(t, http.StatusConflict, sendIdempotent(router, "key-1", `{"flight":2}`).Code)
+	require.Equal(t, 1, calls)
+}
+
+func TestIdempotencyMiddlewareRejectsInFlightRequest(t *testing.T) {
+	repository := newMemoryIdempotencyRepository()
+	calls := 0
+	router := newIdempotentRouter(repository, http.StatusCreated, &calls)
+
+	// Giả lập một request khác đang xử lý với cùng key
+	require.Equal(t, http.StatusCreated, sendIdempotent(router, "key-1", `{"flight":1}`).Code)
+	for key, record := range repository.records {
+		record.Completed = false
+		repository.records[key] = record
+	}
+
+	require.Equal(t, http.StatusConflict, sendIdempotent(router, "key-1", `{"flight":1}`).Code)
+	require.Equal(t, 1, calls)
+}
+
+func TestIdempotencyMiddlewareAllowsRetryAfterServerError(t *testing.T) {
+	calls := 0
+	router := newIdempotentRouter(newMemoryIdempotencyRepository(), http.StatusInternalServerError, &calls)
+
+	require.Equal(t, http.StatusInternalServerError, sendIdempotent(router, "key-1", `{}`).Code)
+	require.Equal(t, http.StatusInternalServerError, sendIdempotent(router, "key-1", `{}`).Code)
+	require.Equal(t, 2, calls)
+}
+
+func TestIdempotencyMiddlewareWithoutKey(t *testing.T) {
+	calls := 0
+	router := newIdempotentRouter(newMemoryIdempotencyRepository(), http.StatusCreated, &calls)
+
+	sendIdempotent(router, "", `{}`)
+	sendIdempotent(router, "", `{}`)
+	require.Equal(t, 2, calls)
+}
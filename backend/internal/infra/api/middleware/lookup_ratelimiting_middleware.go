@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// LookupRateLimiter keeps its own per-IP limiters, separate from the global ones, so that
+// sensitive unauthenticated endpoints (e.g. manage-booking lookup) can be throttled much harder.
+type LookupRateLimiter struct {
+	mu          sync.Mutex
+	clients     map[string]*Client
+	limit       rate.Limit
+	burst       int
+	lastCleanup time.Time
+}
+
+// NewLookupRateLimiter allows requestsPerMinute requests per client IP, refilled evenly over the minute.
+func NewLookupRateLimiter(requestsPerMinute int) *LookupRateLimiter {
+	if requestsPerMinute <= 0 {
+		requestsPerMinute = 1
+	}
+	return &LookupRateLimiter{
+		clients:     make(map[string]*Client),
+		limit:       rate.Every(time.Minute / time.Duration(requestsPerMinute)),
+		burst:       requestsPerMinute,
+		lastCleanup: time.Now(),
+	}
+}
+
+func (l *LookupRateLimiter) allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastCleanup) > time.Minute {
+		for key, client := range l.clients {
+			if now.Sub(client.lastSeen) > 3*time.Minute {
+				delete(l.clients, key)
+			}
+		}
+		l.lastCleanup = now
+	}
+
+	client, exists := l.clients[ip]
+	if !exists {
+		client = &Client{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[ip] = client
+	}
+	client.lastSeen = now
+	return client.limiter.Allow()
+}
+
+// Middleware rejects requests above the limit with 429.
+func (l *LookupRateLimiter) Middleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !l.allow(getClientIP(ctx)) {
+			ctx.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error":   "Too many requests",
+				"message": "Too many lookup attempts. Please try again later.",
+			})
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterAncillaryRoutes(router *gin.RouterGroup, ancillaryHandler *handlers.AncillaryHandler) {
+	ancillaries := router.Group("/ancillaries")
+	{
+		ancillaries.GET("", ancillaryHandler.ListAncillaries)
+		ancillaries.PUT("", ancillaryHandler.UpsertAncillary)
+		ancillaries.DELETE("/:id", ancillaryHandler.DeleteAncillary)
+		ancillaries.GET("/offers", ancillaryHandler.ListOffers)
+	}
+}
@@ -5,10 +5,16 @@ import (
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
 )
 
-func RegisterBookingRoutes(router *gin.RouterGroup, bookingHandler *handlers.BookingHandler) {
+func RegisterBookingRoutes(router *gin.RouterGroup, bookingHandler *handlers.BookingHandler, idempotency gin.HandlerFunc, requester gin.HandlerFunc, lookupLimiter gin.HandlerFunc) {
 	booking := router.Group("/booking")
 	{
-		booking.POST("/", bookingHandler.CreateBooking)
-		booking.GET("/", bookingHandler.GetBooking)
+		booking.POST("/", idempotency, bookingHandler.CreateBooking)
+		booking.GET("/", lookupLimiter, bookingHandler.GetBooking)
+		booking.PUT("/:id/status", bookingHandler.UpdateBookingStatus)
+		booking.POST("/:id/cancel", requester, bookingHandler.CancelBooking)
+		booking.GET("/:id/change", requester, bookingHandler.QuoteFlightChange)
+		booking.POST("/:id/change", requester, bookingHandler.ChangeFlight)
+		booking.POST("/:id/ancillaries", requester, bookingHandler.PurchaseAncillary)
+		booking.POST("/:id/ancillaries/:itemId/cancel", requester, bookingHandler.CancelAncillary)
 	}
 }
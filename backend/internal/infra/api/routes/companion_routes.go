@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterCompanionRoutes(router *gin.RouterGroup, companionHandler *handlers.CompanionHandler, customer gin.HandlerFunc) {
+	companions := router.Group("/customer/companions", customer)
+	{
+		companions.GET("", companionHandler.ListCompanions)
+		companions.POST("", companionHandler.CreateCompanion)
+		companions.PUT("/:id", companionHandler.UpdateCompanion)
+		companions.DELETE("/:id", companionHandler.DeleteCompanion)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterGroupBookingRoutes(router *gin.RouterGroup, groupBookingHandler *handlers.GroupBookingHandler, customer gin.HandlerFunc, requester gin.HandlerFunc) {
+	groupBookings := router.Group("/group-bookings")
+	{
+		groupBookings.GET("", requester, groupBookingHandler.ListGroupBookings)
+		groupBookings.POST("", customer, groupBookingHandler.RequestGroupBooking)
+		groupBookings.GET("/:id", requester, groupBookingHandler.GetGroupBooking)
+		groupBookings.POST("/:id/quote", groupBookingHandler.QuoteGroupBooking)
+		groupBookings.POST("/:id/deposit", customer, groupBookingHandler.PayGroupDeposit)
+		groupBookings.PUT("/:id/passengers", requester, groupBookingHandler.UpdateGroupPassengers)
+		groupBookings.POST("/:id/tickets", requester, groupBookingHandler.IssueGroupBooking)
+	}
+}
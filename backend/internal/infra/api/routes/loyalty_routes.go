@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterLoyaltyRoutes(router *gin.RouterGroup, loyaltyHandler *handlers.LoyaltyHandler, customer gin.HandlerFunc) {
+	loyalty := router.Group("/loyalty", customer)
+	{
+		loyalty.GET("", loyaltyHandler.GetBalance)
+		loyalty.GET("/tier", loyaltyHandler.GetTier)
+		loyalty.GET("/statement", loyaltyHandler.GetStatement)
+		loyalty.POST("/redeem", loyaltyHandler.RedeemPoints)
+	}
+}
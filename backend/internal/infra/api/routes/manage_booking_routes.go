@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterManageBookingRoutes(router *gin.RouterGroup, manageBookingHandler *handlers.ManageBookingHandler, lookupLimiter gin.HandlerFunc) {
+	manage := router.Group("/booking/manage")
+	{
+		manage.POST("", lookupLimiter, manageBookingHandler.Lookup)
+		manage.GET("", manageBookingHandler.GetBooking)
+		manage.GET("/boarding-passes", manageBookingHandler.GetBoardingPasses)
+		manage.PUT("/seats", manageBookingHandler.UpdateSeats)
+		manage.POST("/cancel", manageBookingHandler.CancelBooking)
+		manage.POST("/ancillaries", manageBookingHandler.PurchaseAncillary)
+		manage.POST("/ancillaries/:itemId/cancel", manageBookingHandler.CancelAncillary)
+		manage.POST("/special-services", manageBookingHandler.AddSpecialService)
+		manage.DELETE("/special-services/:itemId", manageBookingHandler.RemoveSpecialService)
+		manage.POST("/check-in", manageBookingHandler.CheckIn)
+		manage.POST("/check-in/undo", manageBookingHandler.UndoCheckIn)
+	}
+}
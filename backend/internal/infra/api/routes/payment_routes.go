@@ -5,7 +5,9 @@ import (
 	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
 )
 
-func RegisterPaymentRoutes(router *gin.RouterGroup, paymentHandler *handlers.PaymentHandler) {
+func RegisterPaymentRoutes(router *gin.RouterGroup, paymentHandler *handlers.PaymentHandler, idempotency gin.HandlerFunc) {
 	payment := router.Group("/")
-	payment.POST("/payment-intents", paymentHandler.CreatePaymentIntent)
+	payment.POST("/payment-intents", idempotency, paymentHandler.CreatePaymentIntent)
+	// Webhook của cổng thanh toán, xác thực bằng chữ ký Stripe-Signature
+	payment.POST("/payments/webhook", paymentHandler.HandleWebhook)
 }
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterPricingRoutes(router *gin.RouterGroup, pricingHandler *handlers.PricingHandler) {
+	pricing := router.Group("/pricing")
+	{
+		pricing.GET("/curves", pricingHandler.ListPricingCurves)
+		pricing.PUT("/curves", pricingHandler.UpsertPricingCurve)
+		pricing.DELETE("/curves/:id", pricingHandler.DeletePricingCurve)
+		pricing.POST("/quotes", pricingHandler.CreateFareQuote)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterPromoCodeRoutes(router *gin.RouterGroup, promoCodeHandler *handlers.PromoCodeHandler) {
+	promoCodes := router.Group("/promo-codes")
+	{
+		promoCodes.GET("", promoCodeHandler.ListPromoCodes)
+		promoCodes.POST("", promoCodeHandler.CreatePromoCode)
+		promoCodes.DELETE("/:id", promoCodeHandler.DeactivatePromoCode)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterSeatZoneRoutes(router *gin.RouterGroup, seatZoneHandler *handlers.SeatZoneHandler) {
+	seatZones := router.Group("/seat-zones")
+	{
+		seatZones.GET("", seatZoneHandler.ListSeatZones)
+		seatZones.POST("", seatZoneHandler.CreateSeatZone)
+		seatZones.DELETE("/:id", seatZoneHandler.DeleteSeatZone)
+		seatZones.GET("/flight", seatZoneHandler.GetSeatMap)
+	}
+}
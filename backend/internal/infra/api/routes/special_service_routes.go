@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterSpecialServiceRoutes(router *gin.RouterGroup, specialServiceHandler *handlers.SpecialServiceHandler) {
+	specialServices := router.Group("/special-services")
+	{
+		specialServices.GET("", specialServiceHandler.ListSpecialServices)
+	}
+}
@@ -12,5 +12,7 @@ func RegisterTicketRoutes(router *gin.RouterGroup, ticketHandler *handlers.Ticke
 		ticket.PUT("/cancel", ticketHandler.CancelTicket)
 		ticket.GET("/", ticketHandler.GetTicket)
 		ticket.PUT("/update-seats", ticketHandler.UpdateSeats)
+		ticket.GET("/search", ticketHandler.SearchTicketByNumber)
+		ticket.GET("/manifest", ticketHandler.GetFlightManifest)
 	}
 }
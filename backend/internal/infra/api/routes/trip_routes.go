@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterTripRoutes(router *gin.RouterGroup, tripHandler *handlers.TripHandler, customer gin.HandlerFunc) {
+	trips := router.Group("/customer/trips", customer)
+	{
+		trips.GET("", tripHandler.ListTrips)
+	}
+}
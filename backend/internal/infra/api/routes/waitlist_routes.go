@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterWaitlistRoutes(router *gin.RouterGroup, waitlistHandler *handlers.WaitlistHandler, customer gin.HandlerFunc) {
+	waitlist := router.Group("/waitlist", customer)
+	{
+		waitlist.GET("", waitlistHandler.ListWaitlistEntries)
+		waitlist.POST("", waitlistHandler.JoinWaitlist)
+		waitlist.DELETE("/:id", waitlistHandler.LeaveWaitlist)
+		waitlist.POST("/claim", waitlistHandler.ClaimWaitlistOffer)
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/spaghetti-lover/qairlines/internal/infra/api/handlers"
+)
+
+func RegisterWalletRoutes(router *gin.RouterGroup, walletHandler *handlers.WalletHandler, customer gin.HandlerFunc) {
+	wallet := router.Group("/wallet")
+	{
+		wallet.GET("", customer, walletHandler.GetBalance)
+		wallet.GET("/statement", customer, walletHandler.GetStatement)
+		wallet.POST("/pay", customer, walletHandler.PayBooking)
+		wallet.POST("/credits", walletHandler.IssueCredit)
+		wallet.POST("/credits/flight", walletHandler.IssueFlightCredit)
+	}
+}
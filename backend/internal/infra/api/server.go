@@ -34,6 +34,7 @@ func NewServer(config config.Config, store db.Store, redis *redis.Client, taskDi
 	httpLogger := logger.NewLoggerWithPath("logs/http.log", "info")
 	recoveryLogger := logger.NewLoggerWithPath("logs/recovery.log", "warning")
 	rateLimiterLogger := logger.NewLoggerWithPath("logs/rate_limiter.log", "warning")
+	idempotencyLogger := logger.NewLoggerWithPath("logs/idempotency.log", "warning")
 
 	// Create a new Gin router
 	router := gin.Default()
@@ -47,6 +48,11 @@ func NewServer(config config.Config, store db.Store, redis *redis.Client, taskDi
 
 	// Group all APIs under "/api"
 	apiRouter := router.Group("/api")
+	// Chống tạo trùng booking/payment khi client gửi lại cùng một request
+	idempotency := middleware.IdempotencyMiddleware(container.IdempotencyRepo, config.IdempotencyKeyTTL, idempotencyLogger)
+	// Nạp khách hàng đăng nhập một lần cho các API của khách hàng
+	customer := middleware.CustomerMiddleware(container.TokenMaker, container.UserRepo)
+	requester := middleware.RequesterMiddleware(customer)
 
 	// Health API
 	router.GET("/health", container.HealthHandler.GetHealth)
@@ -62,14 +68,49 @@ func NewServer(config config.Config, store db.Store, redis *redis.Client, taskDi
 	routes.RegisterFlightRoutes(apiRouter, container.FlightHandler)
 	// Ticket API
 	routes.RegisterTicketRoutes(apiRouter, container.TicketHandler)
+	// Tra cứu booking và manage booking dùng chung một hạn mức, vì cả hai đều dò được cặp PNR + họ
+	manageBookingLimiter := middleware.NewLookupRateLimiter(config.ManageBookingLookupPerMin)
 	// Booking API
-	routes.RegisterBookingRoutes(apiRouter, container.BookingHandler)
+	routes.RegisterBookingRoutes(apiRouter, container.BookingHandler, idempotency, requester, manageBookingLimiter.Middleware())
+	// Manage Booking API (PNR + last name)
+	routes.RegisterManageBookingRoutes(apiRouter, container.ManageBookingHandler, manageBookingLimiter.Middleware())
 	// Statistic API
 	routes.RegisterStatisticRoutes(apiRouter)
 	// View Static File
 	router.StaticFS("/images", gin.Dir("./uploads", false))
 	// Payment API
-	routes.RegisterPaymentRoutes(apiRouter, container.PaymentHandler)
+	routes.RegisterPaymentRoutes(apiRouter, container.PaymentHandler, idempotency)
+	// Pricing API
+	routes.RegisterPricingRoutes(apiRouter, container.PricingHandler)
+	// Ancillary API
+	routes.RegisterAncillaryRoutes(apiRouter, container.AncillaryHandler)
+
+	// Seat Zone API
+	routes.RegisterSeatZoneRoutes(apiRouter, container.SeatZoneHandler)
+
+	// Waitlist API
+	routes.RegisterWaitlistRoutes(apiRouter, container.WaitlistHandler, customer)
+
+	// Group Booking API
+	routes.RegisterGroupBookingRoutes(apiRouter, container.GroupBookingHandler, customer, requester)
+
+	// Promo Code API
+	routes.RegisterPromoCodeRoutes(apiRouter, container.PromoCodeHandler)
+
+	// Loyalty API
+	routes.RegisterLoyaltyRoutes(apiRouter, container.LoyaltyHandler, customer)
+
+	// Wallet API
+	routes.RegisterWalletRoutes(apiRouter, container.WalletHandler, customer)
+
+	// Trips API
+	routes.RegisterTripRoutes(apiRouter, container.TripHandler, customer)
+
+	// Companions API
+	routes.RegisterCompanionRoutes(apiRouter, container.CompanionHandler, customer)
+
+	// Special Services API
+	routes.RegisterSpecialServiceRoutes(apiRouter, container.SpecialServiceHandler)
 
 	// Wrap router with CORS middleware
 	corsHandler := cors.New(cors.Options{
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+const fareFamiliesCacheKey = "fare_families"
+
+// CachedFareFamilyRepository keeps the fare family catalog, which only changes through
+// migrations, in the cache for ttl instead of reading it on every search and booking.
+type CachedFareFamilyRepository struct {
+	repository      adapters.IFareFamilyRepository
+	cacheRepository adapters.ICacheRepository
+	ttl             time.Duration
+}
+
+func NewCachedFareFamilyRepository(repository adapters.IFareFamilyRepository, cacheRepository adapters.ICacheRepository, ttl time.Duration) adapters.IFareFamilyRepository {
+	return &CachedFareFamilyRepository{
+		repository:      repository,
+		cacheRepository: cacheRepository,
+		ttl:             ttl,
+	}
+}
+
+func (r *CachedFareFamilyRepository) ListFareFamilies(ctx context.Context) (entities.FareFamilyCatalog, error) {
+	var cached entities.FareFamilyCatalog
+	if err := r.cacheRepository.Get(fareFamiliesCacheKey, &cached); err == nil {
+		return cached, nil
+	}
+
+	catalog, err := r.repository.ListFareFamilies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(catalog)
+	if err != nil {
+		return nil, err
+	}
+	// Không ghi được cache thì lần sau đọc lại từ database
+	_ = r.cacheRepository.Set(fareFamiliesCacheKey, data, r.ttl)
+	return catalog, nil
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+const (
+	fareQuoteKeyPrefix         = "fare_quote:"
+	fareQuoteReservationPrefix = "fare_quote_reservation:"
+	// fareQuoteReservationTTL giới hạn thời gian báo giá bị giữ nếu tiến trình dừng trước khi trả lại
+	fareQuoteReservationTTL = time.Minute
+)
+
+type RedisFareQuoteRepository struct {
+	rdb *redis.Client
+}
+
+func NewRedisFareQuoteRepository(rdb *redis.Client) adapters.IFareQuoteRepository {
+	return &RedisFareQuoteRepository{rdb: rdb}
+}
+
+func (r *RedisFareQuoteRepository) SaveFareQuote(ctx context.Context, quote entities.FareQuote, ttl time.Duration) error {
+	data, err := json.Marshal(quote)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, fareQuoteKeyPrefix+quote.QuoteID, data, ttl).Err()
+}
+
+func (r *RedisFareQuoteRepository) GetFareQuote(ctx context.Context, quoteID string) (*entities.FareQuote, error) {
+	data, err := r.rdb.Get(ctx, fareQuoteKeyPrefix+quoteID).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, adapters.ErrFareQuoteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var quote entities.FareQuote
+	if err := json.Unmarshal(data, &quote); err != nil {
+		return nil, err
+	}
+	return &quote, nil
+}
+
+func (r *RedisFareQuoteRepository) ReserveFareQuote(ctx context.Context, quoteID string) error {
+	// SETNX đảm bảo chỉ một booking giữ báo giá tại một thời điểm
+	reserved, err := r.rdb.SetNX(ctx, fareQuoteReservationPrefix+quoteID, 1, fareQuoteReservationTTL).Result()
+	if err != nil {
+		return err
+	}
+	if !reserved {
+		return adapters.ErrFareQuoteNotFound
+	}
+
+	exists, err := r.rdb.Exists(ctx, fareQuoteKeyPrefix+quoteID).Result()
+	if err == nil && exists == 0 {
+		err = adapters.ErrFareQuoteNotFound
+	}
+	if err != nil {
+		if releaseErr := r.ReleaseFareQuote(ctx, quoteID); releaseErr != nil {
+			return errors.Join(err, releaseErr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *RedisFareQuoteRepository) ReleaseFareQuote(ctx context.Context, quoteID string) error {
+	return r.rdb.Del(ctx, fareQuoteReservationPrefix+quoteID).Err()
+}
+
+func (r *RedisFareQuoteRepository) ConsumeFareQuote(ctx context.Context, quoteID string) error {
+	return r.rdb.Del(ctx, fareQuoteKeyPrefix+quoteID, fareQuoteReservationPrefix+quoteID).Err()
+}
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+const idempotencyKeyPrefix = "idempotency:"
+
+type RedisIdempotencyRepository struct {
+	rdb *redis.Client
+}
+
+func NewRedisIdempotencyRepository(rdb *redis.Client) adapters.IIdempotencyRepository {
+	return &RedisIdempotencyRepository{rdb: rdb}
+}
+
+func (r *RedisIdempotencyRepository) Reserve(ctx context.Context, key string, fingerprint string, ttl time.Duration) (*entities.IdempotencyRecord, bool, error) {
+	pending, err := json.Marshal(entities.IdempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, false, err
+	}
+
+	// SETNX đảm bảo chỉ một request được xử lý cho mỗi key
+	reserved, err := r.rdb.SetNX(ctx, idempotencyKeyPrefix+key, pending, ttl).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if reserved {
+		return nil, true, nil
+	}
+
+	data, err := r.rdb.Get(ctx, idempotencyKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// Key vừa hết hạn giữa SETNX và GET, thử lại một lần
+		reserved, err = r.rdb.SetNX(ctx, idempotencyKeyPrefix+key, pending, ttl).Result()
+		if err != nil {
+			return nil, false, err
+		}
+		if reserved {
+			return nil, true, nil
+		}
+		data, err = r.rdb.Get(ctx, idempotencyKeyPrefix+key).Bytes()
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var existing entities.IdempotencyRecord
+	if err := json.Unmarshal(data, &existing); err != nil {
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+func (r *RedisIdempotencyRepository) Complete(ctx context.Context, key string, record entities.IdempotencyRecord, ttl time.Duration) error {
+	record.Completed = true
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, idempotencyKeyPrefix+key, data, ttl).Err()
+}
+
+func (r *RedisIdempotencyRepository) Release(ctx context.Context, key string) error {
+	return r.rdb.Del(ctx, idempotencyKeyPrefix+key).Err()
+}
@@ -0,0 +1,185 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type AncillaryRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewAncillaryRepositoryPostgres(store *db.Store) adapters.IAncillaryRepository {
+	return &AncillaryRepositoryPostgres{store: *store}
+}
+
+func (r *AncillaryRepositoryPostgres) UpsertAncillary(ctx context.Context, ancillary entities.Ancillary) (entities.Ancillary, error) {
+	row, err := r.store.UpsertAncillary(ctx, db.UpsertAncillaryParams{
+		Code:          ancillary.Code,
+		AncillaryType: string(ancillary.Type),
+		Name:          ancillary.Name,
+		Description:   ancillary.Description,
+		DepartureCity: ancillary.DepartureCity,
+		ArrivalCity:   ancillary.ArrivalCity,
+		FlightClass:   string(ancillary.FlightClass),
+		Price:         ancillary.Price,
+		MaxQuantity:   ancillary.MaxQuantity,
+		Active:        ancillary.Active,
+	})
+	if err != nil {
+		return entities.Ancillary{}, fmt.Errorf("failed to save ancillary: %w", err)
+	}
+	return mapDBAncillaryToEntity(row), nil
+}
+
+func (r *AncillaryRepositoryPostgres) ListAncillaries(ctx context.Context) (entities.AncillaryCatalog, error) {
+	rows, err := r.store.ListAncillaries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ancillaries: %w", err)
+	}
+
+	catalog := make(entities.AncillaryCatalog, 0, len(rows))
+	for _, row := range rows {
+		catalog = append(catalog, mapDBAncillaryToEntity(row))
+	}
+	return catalog, nil
+}
+
+func (r *AncillaryRepositoryPostgres) DeleteAncillary(ctx context.Context, ancillaryID int64) error {
+	_, err := r.store.DeleteAncillary(ctx, ancillaryID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return adapters.ErrAncillaryNotFound
+		}
+		return fmt.Errorf("failed to delete ancillary: %w", err)
+	}
+	return nil
+}
+
+func (r *AncillaryRepositoryPostgres) AddTicketAncillary(ctx context.Context, item entities.TicketAncillary) (entities.TicketAncillary, error) {
+	params := mapTicketAncillaryToCreateParams(item)
+	params.Status = string(entities.AncillaryStatusActive)
+	row, err := r.store.CreateTicketAncillary(ctx, params)
+	if err != nil {
+		return entities.TicketAncillary{}, fmt.Errorf("failed to add ticket ancillary: %w", err)
+	}
+	return mapDBTicketAncillaryToEntity(row), nil
+}
+
+func (r *AncillaryRepositoryPostgres) RequestTicketAncillary(ctx context.Context, item entities.TicketAncillary, payment entities.Payment) (entities.TicketAncillary, error) {
+	txResult, err := r.store.RequestTicketAncillaryTx(ctx, db.RequestTicketAncillaryTxParams{
+		Ancillary: mapTicketAncillaryToCreateParams(item),
+		Payment: db.CreatePaymentParams{
+			IntentID: payment.IntentID,
+			Amount:   payment.Amount,
+			Currency: payment.Currency,
+		},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			return entities.TicketAncillary{}, adapters.ErrBookingNotFound
+		case errors.Is(err, db.ErrBookingNotConfirmed):
+			return entities.TicketAncillary{}, adapters.ErrBookingNotChangeable
+		}
+		return entities.TicketAncillary{}, err
+	}
+	return mapDBTicketAncillaryToEntity(txResult.Ancillary), nil
+}
+
+func (r *AncillaryRepositoryPostgres) ActivateTicketAncillary(ctx context.Context, event entities.PaymentEvent) (entities.AncillaryPaymentResult, error) {
+	txResult, err := r.store.ActivateTicketAncillaryTx(ctx, db.SettlePaymentParams{
+		IntentID:       event.IntentID,
+		AmountReceived: event.AmountReceived,
+	})
+	if err != nil {
+		return entities.AncillaryPaymentResult{}, mapSettlePaymentError(err)
+	}
+
+	result := entities.AncillaryPaymentResult{Ancillary: mapDBTicketAncillaryToEntity(txResult.Ancillary)}
+	if txResult.Refund != nil {
+		refund := mapDBRefundToEntity(*txResult.Refund)
+		result.Refund = &refund
+	}
+	return result, nil
+}
+
+func (r *AncillaryRepositoryPostgres) CancelTicketAncillary(ctx context.Context, arg entities.CancelAncillaryParams) (entities.CancelAncillaryResult, error) {
+	txResult, err := r.store.CancelTicketAncillaryTx(ctx, db.CancelTicketAncillaryTxParams{
+		TicketAncillaryID: arg.TicketAncillaryID,
+		BookingID:         arg.BookingID,
+		Reason:            arg.Reason,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.CancelAncillaryResult{}, adapters.ErrTicketAncillaryNotFound
+		}
+		return entities.CancelAncillaryResult{}, err
+	}
+
+	result := entities.CancelAncillaryResult{Ancillary: mapDBTicketAncillaryToEntity(txResult.Ancillary)}
+	if txResult.Refund != nil {
+		refund := mapDBRefundToEntity(*txResult.Refund)
+		result.Refund = &refund
+	}
+	return result, nil
+}
+
+func mapDBAncillaryToEntity(row db.Ancillary) entities.Ancillary {
+	return entities.Ancillary{
+		AncillaryID:   row.ID,
+		Code:          row.Code,
+		Type:          entities.AncillaryType(row.AncillaryType),
+		Name:          row.Name,
+		Description:   row.Description,
+		DepartureCity: row.DepartureCity,
+		ArrivalCity:   row.ArrivalCity,
+		FlightClass:   entities.FlightClass(row.FlightClass),
+		Price:         row.Price,
+		MaxQuantity:   row.MaxQuantity,
+		Active:        row.Active,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+func mapTicketAncillaryToCreateParams(item entities.TicketAncillary) db.CreateTicketAncillaryParams {
+	return db.CreateTicketAncillaryParams{
+		TicketID:      item.TicketID,
+		BookingID:     item.BookingID,
+		AncillaryID:   pgtype.Int8{Int64: item.AncillaryID, Valid: item.AncillaryID != 0},
+		Code:          item.Code,
+		AncillaryType: string(item.Type),
+		Name:          item.Name,
+		Quantity:      item.Quantity,
+		UnitPrice:     item.UnitPrice,
+		Amount:        item.Amount,
+	}
+}
+
+func mapDBTicketAncillaryToEntity(row db.TicketAncillary) entities.TicketAncillary {
+	item := entities.TicketAncillary{
+		TicketAncillaryID: row.ID,
+		TicketID:          row.TicketID,
+		BookingID:         row.BookingID,
+		AncillaryID:       row.AncillaryID.Int64,
+		Code:              row.Code,
+		Type:              entities.AncillaryType(row.AncillaryType),
+		Name:              row.Name,
+		Quantity:          row.Quantity,
+		UnitPrice:         row.UnitPrice,
+		Amount:            row.Amount,
+		Status:            entities.AncillaryStatus(row.Status),
+		CreatedAt:         row.CreatedAt,
+	}
+	if row.CancelledAt.Valid {
+		cancelledAt := row.CancelledAt.Time
+		item.CancelledAt = &cancelledAt
+	}
+	return item
+}
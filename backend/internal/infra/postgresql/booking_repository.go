@@ -2,7 +2,9 @@ package postgresql
 
 import (
 	"context"
-	"strconv"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	db "github.com/spaghetti-lover/qairlines/db/sqlc"
@@ -22,69 +24,56 @@ func NewBookingRepositoryPostgres(store *db.Store) *BookingRepositoryPostgres {
 
 func (r *BookingRepositoryPostgres) CreateBookingTx(ctx context.Context, booking entities.CreateBookingParams) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
 	// Chuyển đổi từ entities.CreateBookingParams sang db.CreateBookingTxParams
-	departureID, err := strconv.ParseInt(booking.DepartureFlightID, 10, 64)
-	if err != nil {
-		return entities.Booking{}, nil, nil, err
-	}
-
-	departureTicketData := make([]db.TicketData, len(booking.DepartureTicketDataList))
-	for i, ticketData := range booking.DepartureTicketDataList {
-		departureTicketData[i] = db.TicketData{
-			Price:       int64(ticketData.Price),
-			FlightClass: string(ticketData.FlightClass),
-			OwnerData: db.OwnerData{
-				IdentityCardNumber: ticketData.Owner.IdentificationNumber,
-				FirstName:          ticketData.Owner.FirstName,
-				LastName:           ticketData.Owner.LastName,
-				PhoneNumber:        ticketData.Owner.PhoneNumber,
-				DateOfBirth:        ticketData.Owner.DateOfBirth.String(),
-				Gender:             string(ticketData.Owner.Gender),
-				Address:            ticketData.Owner.Address,
-			},
-		}
-	}
-	returnTicketDataList := make([]db.TicketData, len(booking.ReturnTicketDataList))
-	var returnFlightID int64
-	if booking.ReturnFlightID != "" {
-		returnFlightID, err = strconv.ParseInt(booking.ReturnFlightID, 10, 64)
-
-		if err != nil && booking.TripType == entities.RoundTrip {
-			return entities.Booking{}, nil, nil, adapters.ErrFlightNotFound
-		} else if err != nil {
-			return entities.Booking{}, nil, nil, adapters.ErrFlightNotFound
-		} else if booking.TripType == entities.RoundTrip && returnFlightID == 0 {
+	segments := make([]db.SegmentData, len(booking.Segments))
+	for i, segment := range booking.Segments {
+		if segment.FlightID == 0 {
 			return entities.Booking{}, nil, nil, adapters.ErrFlightNotFound
 		}
-		for i, ticketData := range booking.ReturnTicketDataList {
-			returnTicketDataList[i] = db.TicketData{
-				Price:       int64(ticketData.Price),
-				FlightClass: string(ticketData.FlightClass),
-				OwnerData: db.OwnerData{
-					IdentityCardNumber: ticketData.Owner.IdentificationNumber,
-					FirstName:          ticketData.Owner.FirstName,
-					LastName:           ticketData.Owner.LastName,
-					PhoneNumber:        ticketData.Owner.PhoneNumber,
-					DateOfBirth:        ticketData.Owner.DateOfBirth.String(),
-					Gender:             string(ticketData.Owner.Gender),
-					Address:            ticketData.Owner.Address,
-				},
+		ticketData := make([]db.TicketData, len(segment.Tickets))
+		for j, ticket := range segment.Tickets {
+			ticketData[j] = mapEntityTicketToTicketData(ticket)
+		}
+		segments[i] = db.SegmentData{
+			FlightID:   segment.FlightID,
+			TicketData: ticketData,
+		}
+		if i < len(booking.Capacity) {
+			segments[i].Capacity = make(map[string]int64, len(booking.Capacity[i]))
+			for class, seats := range booking.Capacity[i] {
+				segments[i].Capacity[string(class)] = seats
 			}
 		}
 	}
 
+	promotions := make([]db.PromoRedemptionData, len(booking.Promotions))
+	for i, promotion := range booking.Promotions {
+		promotions[i] = db.PromoRedemptionData{
+			PromoCodeID: promotion.PromoCodeID,
+			Code:        promotion.Code,
+			Amount:      promotion.Amount,
+		}
+	}
+
 	txParams := db.CreateBookingTxParams{
-		UserEmail:           booking.Email,
-		TripType:            string(booking.TripType),
-		DepartureFlightID:   departureID,
-		ReturnFlightID:      returnFlightID,
-		DepartureTicketData: departureTicketData,
-		ReturnTicketData:    returnTicketDataList,
-		AfterCreate:         booking.AfterCreate,
+		UserEmail:          booking.Email,
+		DepartureCity:      booking.DepartureCity,
+		ArrivalCity:        booking.ArrivalCity,
+		TripType:           string(booking.TripType),
+		Segments:           segments,
+		TicketNumberPrefix: booking.TicketNumberPrefix,
+		Promotions:         promotions,
+		AfterCreate:        booking.AfterCreate,
 	}
 
 	// Gọi CreateBookingTx từ tầng SQLStore
 	txResult, err := r.store.CreateBookingTx(ctx, txParams)
 	if err != nil {
+		if errors.Is(err, db.ErrSeatTaken) {
+			return entities.Booking{}, nil, nil, adapters.ErrSeatUnavailable
+		}
+		if errors.Is(err, db.ErrCabinFull) {
+			return entities.Booking{}, nil, nil, adapters.ErrNotEnoughSeats
+		}
 		return entities.Booking{}, nil, nil, err
 	}
 
@@ -96,29 +85,511 @@ func (r *BookingRepositoryPostgres) GetBookingByID(ctx context.Context, bookingI
 	// Lấy thông tin booking
 	booking, err := r.store.GetBooking(ctx, bookingID)
 	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
+		}
 		return entities.Booking{}, nil, nil, err
 	}
 
-	// Lấy danh sách vé cho chuyến bay đi
-	departureTickets, err := r.store.GetTicketsByBookingIDAndType(ctx, db.GetTicketsByBookingIDAndTypeParams{
-		BookingID: pgtype.Int8{Int64: booking.BookingID, Valid: true},
-		Column2:   "departure",
-	})
+	return r.loadBookingDetails(ctx, booking)
+}
+
+func (r *BookingRepositoryPostgres) GetBookingByPNR(ctx context.Context, pnr string) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	booking, err := r.store.GetBookingByPNR(ctx, pnr)
 	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Booking{}, nil, nil, adapters.ErrBookingNotFound
+		}
 		return entities.Booking{}, nil, nil, err
 	}
 
-	// Lấy danh sách vé cho chuyến bay về
-	returnTickets, err := r.store.GetTicketsByBookingIDAndType(ctx, db.GetTicketsByBookingIDAndTypeParams{
-		BookingID: pgtype.Int8{Int64: booking.BookingID, Valid: true},
-		Column2:   "return",
+	return r.loadBookingDetails(ctx, booking)
+}
+
+func (r *BookingRepositoryPostgres) GetBookingByPNRAndLastName(ctx context.Context, pnr string, lastName string) (entities.Booking, error) {
+	booking, err := r.store.GetBookingByPNRAndLastName(ctx, db.GetBookingByPNRAndLastNameParams{
+		Pnr:      pnr,
+		LastName: lastName,
 	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Booking{}, adapters.ErrBookingNotFound
+		}
+		return entities.Booking{}, err
+	}
+
+	return mapDBBookingToEntity(booking), nil
+}
+
+func (r *BookingRepositoryPostgres) GetBookingIDByPNR(ctx context.Context, pnr string) (int64, error) {
+	booking, err := r.store.GetBookingByPNR(ctx, pnr)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return 0, adapters.ErrBookingNotFound
+		}
+		return 0, err
+	}
+	return booking.BookingID, nil
+}
+
+// loadBookingDetails fetches the segments, tickets and status history that belong to booking
+func (r *BookingRepositoryPostgres) loadBookingDetails(ctx context.Context, booking db.Booking) (entities.Booking, []entities.Ticket, []entities.Ticket, error) {
+	// Lấy danh sách chặng bay theo thứ tự
+	segments, err := r.store.ListBookingSegments(ctx, booking.BookingID)
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+
+	// Lấy toàn bộ vé của booking rồi chia theo chuyến bay
+	tickets, err := r.store.ListTicketsByBookingID(ctx, pgtype.Int8{Int64: booking.BookingID, Valid: true})
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+	fareItems, err := r.store.ListTicketFareItemsByBookingID(ctx, pgtype.Int8{Int64: booking.BookingID, Valid: true})
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+	fareItemsByTicket := make(map[int64][]entities.FareItem)
+	for _, item := range fareItems {
+		fareItemsByTicket[item.TicketID] = append(fareItemsByTicket[item.TicketID], entities.FareItem{
+			Type:        entities.FareItemType(item.ItemType),
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      item.Amount,
+		})
+	}
+	ancillaries, err := r.store.ListTicketAncillariesByBookingID(ctx, booking.BookingID)
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+	ancillariesByTicket := make(map[int64][]entities.TicketAncillary)
+	for _, item := range ancillaries {
+		ancillariesByTicket[item.TicketID] = append(ancillariesByTicket[item.TicketID], mapDBTicketAncillaryToEntity(item))
+	}
+	specialServices, err := r.store.ListTicketSpecialServicesByBookingID(ctx, booking.BookingID)
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+	specialServicesByTicket := make(map[int64][]entities.TicketSpecialService)
+	for _, item := range specialServices {
+		specialServicesByTicket[item.TicketID] = append(specialServicesByTicket[item.TicketID], mapDBTicketSpecialServiceToEntity(item))
+	}
+	checkIns, err := r.store.ListTicketCheckInsByBookingID(ctx, booking.BookingID)
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+	checkedInAtByTicket := make(map[int64]time.Time, len(checkIns))
+	for _, checkIn := range checkIns {
+		checkedInAtByTicket[checkIn.TicketID] = checkIn.CheckedInAt
+	}
+	// Lấy mã ghế và thông tin hành khách của từng vé
+	owners, err := r.store.ListTicketOwnersByBookingID(ctx, pgtype.Int8{Int64: booking.BookingID, Valid: true})
 	if err != nil {
 		return entities.Booking{}, nil, nil, err
 	}
+	ownersByTicket := make(map[int64]db.ListTicketOwnersByBookingIDRow, len(owners))
+	for _, owner := range owners {
+		ownersByTicket[owner.TicketID] = owner
+	}
+	ticketsByFlight := make(map[int64][]entities.Ticket)
+	for _, ticket := range mapDBTicketsToEntitiesTickets(tickets) {
+		ticket.FareItems = fareItemsByTicket[ticket.TicketID]
+		ticket.Ancillaries = ancillariesByTicket[ticket.TicketID]
+		ticket.SpecialServices = specialServicesByTicket[ticket.TicketID]
+		if checkedInAt, ok := checkedInAtByTicket[ticket.TicketID]; ok {
+			ticket.CheckedInAt = &checkedInAt
+		}
+		ticket.BookingPNR = booking.Pnr
+		if owner, ok := ownersByTicket[ticket.TicketID]; ok {
+			ticket.Seat = entities.Seat{SeatID: ticket.SeatID, FlightID: ticket.FlightID, SeatCode: owner.SeatCode.String, Class: ticket.FlightClass}
+			ticket.Owner = entities.TicketOwner{
+				TicketID:             ticket.TicketID,
+				FirstName:            owner.FirstName.String,
+				LastName:             owner.LastName.String,
+				PhoneNumber:          owner.PhoneNumber.String,
+				Gender:               entities.GenderType(owner.Gender),
+				DateOfBirth:          owner.DateOfBirth,
+				PassportNumber:       owner.PassportNumber.String,
+				IdentificationNumber: owner.IdentificationNumber.String,
+				Address:              owner.Address.String,
+				DocumentExpiry:       owner.DocumentExpiry.Time,
+				DocumentCountry:      owner.DocumentCountry,
+				CompanionID:          owner.CompanionID.Int64,
+			}
+		}
+		ticketsByFlight[ticket.FlightID] = append(ticketsByFlight[ticket.FlightID], ticket)
+	}
+
+	// Lấy lịch sử trạng thái
+	history, err := r.store.ListBookingStatusHistory(ctx, booking.BookingID)
+	if err != nil {
+		return entities.Booking{}, nil, nil, err
+	}
+
+	result := mapDBBookingToEntity(booking)
+	result.StatusHistory = mapDBStatusHistoryToEntities(history)
+	for _, segment := range segments {
+		result.Segments = append(result.Segments, entities.BookingSegment{
+			SegmentOrder: int(segment.SegmentOrder),
+			FlightID:     segment.FlightID,
+			Tickets:      ticketsByFlight[segment.FlightID],
+		})
+	}
+
+	var returnTickets []entities.Ticket
+	if booking.ReturnFlightID.Valid {
+		returnTickets = ticketsByFlight[booking.ReturnFlightID.Int64]
+	}
+	return result, ticketsByFlight[booking.DepartureFlightID.Int64], returnTickets, nil
+}
+
+func (r *BookingRepositoryPostgres) ListCustomerTrips(ctx context.Context, email string, filter entities.TripFilter) ([]entities.Trip, error) {
+	rows, err := r.store.ListCustomerTrips(ctx, db.ListCustomerTripsParams{
+		UserEmail:   pgtype.Text{String: email, Valid: true},
+		Now:         filter.Now,
+		Upcoming:    filter.Upcoming,
+		Status:      string(filter.Status),
+		DepartsFrom: pgtype.Timestamptz{Time: filter.DepartsFrom, Valid: !filter.DepartsFrom.IsZero()},
+		DepartsTo:   pgtype.Timestamptz{Time: filter.DepartsTo, Valid: !filter.DepartsTo.IsZero()},
+		Limit:       int32(filter.Limit),
+		Offset:      int32(filter.Offset()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customer trips: %w", err)
+	}
+
+	bookings := make([]db.Booking, 0, len(rows))
+	for _, row := range rows {
+		bookings = append(bookings, db.Booking{
+			BookingID:         row.BookingID,
+			UserEmail:         row.UserEmail,
+			TripType:          row.TripType,
+			DepartureFlightID: row.DepartureFlightID,
+			ReturnFlightID:    row.ReturnFlightID,
+			Status:            row.Status,
+			CreatedAt:         row.CreatedAt,
+			UpdatedAt:         row.UpdatedAt,
+			Pnr:               row.Pnr,
+		})
+	}
+	loaded, err := r.loadTripBookings(ctx, bookings)
+	if err != nil {
+		return nil, err
+	}
 
+	trips := make([]entities.Trip, 0, len(rows))
+	for i, row := range rows {
+		trips = append(trips, entities.Trip{
+			Booking:            loaded[i],
+			FirstDepartureTime: row.FirstDepartureTime,
+			LastDepartureTime:  row.LastDepartureTime,
+		})
+	}
+	return trips, nil
+}
+
+// loadTripBookings fetches the segments and tickets of a page of bookings with one query each,
+// keeping only the passenger names and seats the trip list shows. Bookings made before
+// segments were recorded get their departure and return flights as segments.
+func (r *BookingRepositoryPostgres) loadTripBookings(ctx context.Context, bookings []db.Booking) ([]entities.Booking, error) {
+	bookingIDs := make([]int64, 0, len(bookings))
+	for _, booking := range bookings {
+		bookingIDs = append(bookingIDs, booking.BookingID)
+	}
+
+	segments, err := r.store.ListBookingSegmentsByBookingIDs(ctx, bookingIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trip segments: %w", err)
+	}
+	segmentsByBooking := make(map[int64][]db.BookingSegment, len(bookings))
+	for _, segment := range segments {
+		segmentsByBooking[segment.BookingID] = append(segmentsByBooking[segment.BookingID], segment)
+	}
+
+	rows, err := r.store.ListTripTicketsByBookingIDs(ctx, bookingIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trip tickets: %w", err)
+	}
+	// Vé được nhóm theo booking rồi theo chuyến bay
+	tickets := make([]db.Ticket, 0, len(rows))
+	for _, row := range rows {
+		tickets = append(tickets, row.Ticket)
+	}
+	ticketsByBooking := make(map[int64]map[int64][]entities.Ticket, len(bookings))
+	for i, ticket := range mapDBTicketsToEntitiesTickets(tickets) {
+		row := rows[i]
+		ticket.Seat = entities.Seat{SeatID: ticket.SeatID, FlightID: ticket.FlightID, SeatCode: row.SeatCode.String, Class: ticket.FlightClass}
+		ticket.Owner = entities.TicketOwner{
+			TicketID:  ticket.TicketID,
+			FirstName: row.FirstName.String,
+			LastName:  row.LastName.String,
+		}
+		if ticketsByBooking[ticket.BookingID] == nil {
+			ticketsByBooking[ticket.BookingID] = make(map[int64][]entities.Ticket)
+		}
+		ticketsByBooking[ticket.BookingID][ticket.FlightID] = append(ticketsByBooking[ticket.BookingID][ticket.FlightID], ticket)
+	}
+
+	result := make([]entities.Booking, 0, len(bookings))
+	for _, booking := range bookings {
+		ticketsByFlight := ticketsByBooking[booking.BookingID]
+		loaded := mapDBBookingToEntity(booking)
+		bookingSegments := segmentsByBooking[booking.BookingID]
+		if len(bookingSegments) == 0 {
+			// Booking cũ chưa ghi chặng: chuyến đi là chặng 1, chuyến về là chặng 2
+			for order, flightID := range []pgtype.Int8{booking.DepartureFlightID, booking.ReturnFlightID} {
+				if flightID.Valid {
+					bookingSegments = append(bookingSegments, db.BookingSegment{BookingID: booking.BookingID, SegmentOrder: int16(order + 1), FlightID: flightID.Int64})
+				}
+			}
+		}
+		for _, segment := range bookingSegments {
+			loaded.Segments = append(loaded.Segments, entities.BookingSegment{
+				SegmentOrder: int(segment.SegmentOrder),
+				FlightID:     segment.FlightID,
+				Tickets:      ticketsByFlight[segment.FlightID],
+			})
+		}
+		result = append(result, loaded)
+	}
+	return result, nil
+}
+
+func (r *BookingRepositoryPostgres) CountCustomerTrips(ctx context.Context, email string, filter entities.TripFilter) (int64, error) {
+	count, err := r.store.CountCustomerTrips(ctx, db.CountCustomerTripsParams{
+		UserEmail:   pgtype.Text{String: email, Valid: true},
+		Now:         filter.Now,
+		Upcoming:    filter.Upcoming,
+		Status:      string(filter.Status),
+		DepartsFrom: pgtype.Timestamptz{Time: filter.DepartsFrom, Valid: !filter.DepartsFrom.IsZero()},
+		DepartsTo:   pgtype.Timestamptz{Time: filter.DepartsTo, Valid: !filter.DepartsTo.IsZero()},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count customer trips: %w", err)
+	}
+	return count, nil
+}
+
+func (r *BookingRepositoryPostgres) UpdateBookingStatus(ctx context.Context, arg entities.UpdateBookingStatusParams) (entities.Booking, error) {
+	txResult, err := r.store.UpdateBookingStatusTx(ctx, db.UpdateBookingStatusTxParams{
+		BookingID: arg.BookingID,
+		ToStatus:  arg.Status,
+		Actor:     arg.Actor,
+		Reason:    arg.Reason,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Booking{}, adapters.ErrBookingNotFound
+		}
+		return entities.Booking{}, err
+	}
+
+	history, err := r.store.ListBookingStatusHistory(ctx, arg.BookingID)
+	if err != nil {
+		return entities.Booking{}, err
+	}
+
+	booking := mapDBBookingToEntity(txResult.Booking)
+	booking.StatusHistory = mapDBStatusHistoryToEntities(history)
+	return booking, nil
+}
+
+func (r *BookingRepositoryPostgres) CancelBooking(ctx context.Context, arg entities.CancelBookingParams) (entities.CancelBookingResult, error) {
+	txResult, err := r.store.CancelBookingTx(ctx, db.CancelBookingTxParams{
+		BookingID:       arg.BookingID,
+		Actor:           arg.Actor,
+		Reason:          arg.Reason,
+		RefundPolicy:    arg.RefundPolicy,
+		FareFamilies:    arg.FareFamilies,
+		CancelledAt:     arg.CancelledAt,
+		RefundToWallet:  arg.RefundToWallet,
+		WalletCreditTTL: arg.WalletCreditTTL,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.CancelBookingResult{}, adapters.ErrBookingNotFound
+		}
+		return entities.CancelBookingResult{}, err
+	}
+
+	history, err := r.store.ListBookingStatusHistory(ctx, arg.BookingID)
+	if err != nil {
+		return entities.CancelBookingResult{}, err
+	}
+
+	booking := mapDBBookingToEntity(txResult.Booking)
+	booking.StatusHistory = mapDBStatusHistoryToEntities(history)
+
+	result := entities.CancelBookingResult{
+		Booking:          booking,
+		CancelledTickets: mapDBTicketsToEntitiesTickets(txResult.CancelledTickets),
+	}
+	if txResult.Refund != nil {
+		refund := mapDBRefundToEntity(*txResult.Refund)
+		result.Refund = &refund
+	}
+	if txResult.WalletCredit != nil {
+		credit := mapDBWalletTransactionToEntity(*txResult.WalletCredit)
+		result.WalletCredit = &credit
+	}
+	return result, nil
+}
+
+func (r *BookingRepositoryPostgres) ChangeFlight(ctx context.Context, arg entities.ChangeFlightParams) (entities.ChangeFlightResult, error) {
+	txResult, err := r.store.ChangeFlightTx(ctx, mapChangeFlightParams(arg))
+	if err != nil {
+		return entities.ChangeFlightResult{}, mapChangeFlightError(err)
+	}
+	return mapChangeFlightTxResult(txResult, arg.Quote), nil
+}
+
+func (r *BookingRepositoryPostgres) RequestFlightChange(ctx context.Context, arg entities.ChangeFlightParams, payment entities.Payment) (entities.ChangeFlightResult, error) {
+	txResult, err := r.store.RequestFlightChangeTx(ctx, db.RequestFlightChangeTxParams{
+		ChangeFlightTxParams: mapChangeFlightParams(arg),
+		Payment: db.CreatePaymentParams{
+			IntentID: payment.IntentID,
+			Amount:   payment.Amount,
+			Currency: payment.Currency,
+		},
+	})
+	if err != nil {
+		return entities.ChangeFlightResult{}, mapChangeFlightError(err)
+	}
+	return entities.ChangeFlightResult{
+		FlightChangeID: txResult.FlightChange.ID,
+		Status:         entities.FlightChangeStatus(txResult.FlightChange.Status),
+		Quote:          arg.Quote,
+	}, nil
+}
+
+func (r *BookingRepositoryPostgres) CompleteFlightChange(ctx context.Context, event entities.PaymentEvent) (entities.ChangeFlightResult, error) {
+	txResult, err := r.store.CompleteFlightChangeTx(ctx, db.SettlePaymentParams{
+		IntentID:       event.IntentID,
+		AmountReceived: event.AmountReceived,
+	})
+	if err != nil {
+		return entities.ChangeFlightResult{}, mapSettlePaymentError(err)
+	}
+	return mapChangeFlightTxResult(txResult.ChangeFlightTxResult, entities.FlightChangeQuote{}), nil
+}
+
+func mapChangeFlightParams(arg entities.ChangeFlightParams) db.ChangeFlightTxParams {
+	ticketFareItems := make(map[int64][]db.FareItemData, len(arg.Quote.TicketFareItems))
+	for ticketID, items := range arg.Quote.TicketFareItems {
+		ticketFareItems[ticketID] = mapFareItemsToData(items)
+	}
+	return db.ChangeFlightTxParams{
+		BookingID:       arg.BookingID,
+		FromFlightID:    arg.FromFlightID,
+		ToFlightID:      arg.ToFlightID,
+		TicketFares:     arg.Quote.TicketFares,
+		TicketFareItems: ticketFareItems,
+		AmountDue:       arg.Quote.AmountDue,
+		RefundAmount:    arg.Quote.RefundAmount,
+		Actor:           arg.Actor,
+		Reason:          fmt.Sprintf("flight change %d -> %d", arg.FromFlightID, arg.ToFlightID),
+	}
+}
+
+func mapChangeFlightError(err error) error {
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return adapters.ErrBookingNotFound
+	}
+	if errors.Is(err, db.ErrFlightChangeConflict) {
+		return adapters.ErrBookingNotChangeable
+	}
+	return err
+}
+
+func mapChangeFlightTxResult(txResult db.ChangeFlightTxResult, quote entities.FlightChangeQuote) entities.ChangeFlightResult {
+	result := entities.ChangeFlightResult{
+		FlightChangeID: txResult.FlightChange.ID,
+		Status:         entities.FlightChangeStatus(txResult.FlightChange.Status),
+		Booking:        mapDBBookingToEntity(txResult.Booking),
+		Tickets:        mapDBTicketsToEntitiesTickets(txResult.Tickets),
+		Quote:          quote,
+	}
+	if txResult.Refund != nil {
+		refund := mapDBRefundToEntity(*txResult.Refund)
+		result.Refund = &refund
+	}
+	return result
+}
+
+func (r *BookingRepositoryPostgres) UpdateRefundStatus(ctx context.Context, refundID int64, status entities.RefundStatus) (entities.Refund, error) {
+	refund, err := r.store.UpdateRefundStatus(ctx, db.UpdateRefundStatusParams{
+		ID:     refundID,
+		Status: string(status),
+	})
+	if err != nil {
+		return entities.Refund{}, err
+	}
+	return mapDBRefundToEntity(refund), nil
+}
+
+func mapEntityTicketToTicketData(ticket entities.Ticket) db.TicketData {
+	accompanyingIndex := -1
+	if ticket.AccompanyingPassenger != nil {
+		accompanyingIndex = *ticket.AccompanyingPassenger
+	}
+	return db.TicketData{
+		Price:             int64(ticket.Price),
+		FlightClass:       string(ticket.FlightClass),
+		FareFamily:        string(ticket.FareFamily),
+		PassengerType:     string(ticket.PassengerType),
+		AccompanyingIndex: accompanyingIndex,
+		FareItems:         mapFareItemsToData(ticket.FareItems),
+		Ancillaries:       mapTicketAncillariesToData(ticket.Ancillaries),
+		SeatCode:          ticket.Seat.SeatCode,
+		OwnerData: db.OwnerData{
+			IdentityCardNumber: ticket.Owner.IdentificationNumber,
+			FirstName:          ticket.Owner.FirstName,
+			LastName:           ticket.Owner.LastName,
+			PhoneNumber:        ticket.Owner.PhoneNumber,
+			DateOfBirth:        ticket.Owner.DateOfBirth.Format("2006-01-02"),
+			Gender:             string(ticket.Owner.Gender),
+			Address:            ticket.Owner.Address,
+			PassportNumber:     ticket.Owner.PassportNumber,
+			DocumentExpiry:     ticket.Owner.DocumentExpiry,
+			DocumentCountry:    ticket.Owner.DocumentCountry,
+			CompanionID:        ticket.Owner.CompanionID,
+		},
+	}
+}
+
+func mapFareItemsToData(items []entities.FareItem) []db.FareItemData {
+	data := make([]db.FareItemData, 0, len(items))
+	for _, item := range items {
+		data = append(data, db.FareItemData{
+			Type:        string(item.Type),
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      item.Amount,
+		})
+	}
+	return data
+}
+
+func mapTicketAncillariesToData(items []entities.TicketAncillary) []db.AncillaryData {
+	data := make([]db.AncillaryData, 0, len(items))
+	for _, item := range items {
+		data = append(data, db.AncillaryData{
+			AncillaryID: item.AncillaryID,
+			Code:        item.Code,
+			Type:        string(item.Type),
+			Name:        item.Name,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Amount:      item.Amount,
+		})
+	}
+	return data
+}
+
+func mapDBBookingToEntity(booking db.Booking) entities.Booking {
 	return entities.Booking{
 		BookingID:         booking.BookingID,
+		PNR:               booking.Pnr,
 		UserEmail:         booking.UserEmail.String,
 		TripType:          entities.TripType(booking.TripType),
 		DepartureFlightID: booking.DepartureFlightID.Int64,
@@ -126,22 +597,58 @@ func (r *BookingRepositoryPostgres) GetBookingByID(ctx context.Context, bookingI
 		CreatedAt:         booking.CreatedAt,
 		UpdatedAt:         booking.UpdatedAt,
 		Status:            entities.BookingStatus(booking.Status),
-	}, mapDBTicketsToEntitiesTickets(departureTickets), mapDBTicketsToEntitiesTickets(returnTickets), nil
+	}
+}
+
+func mapDBRefundToEntity(refund db.Refund) entities.Refund {
+	return entities.Refund{
+		RefundID:  refund.ID,
+		BookingID: refund.BookingID,
+		Amount:    refund.Amount,
+		Status:    entities.RefundStatus(refund.Status),
+		Reason:    refund.Reason,
+		Method:    entities.RefundMethod(refund.Method),
+		CreatedAt: refund.CreatedAt,
+	}
+}
+
+func mapDBStatusHistoryToEntities(rows []db.BookingStatusHistory) []entities.BookingStatusChange {
+	history := make([]entities.BookingStatusChange, 0, len(rows))
+	for _, row := range rows {
+		var fromStatus entities.BookingStatus
+		if row.FromStatus.Valid {
+			fromStatus = entities.BookingStatus(row.FromStatus.BookingStatus)
+		}
+		history = append(history, entities.BookingStatusChange{
+			ID:         row.ID,
+			BookingID:  row.BookingID,
+			FromStatus: fromStatus,
+			ToStatus:   entities.BookingStatus(row.ToStatus),
+			Actor:      row.Actor,
+			Reason:     row.Reason,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+	return history
 }
 
 func mapDBTicketsToEntitiesTickets(dbTickets []db.Ticket) []entities.Ticket {
 	var entityTickets []entities.Ticket
 	for _, dbTicket := range dbTickets {
 		entityTickets = append(entityTickets, entities.Ticket{
-			TicketID:    dbTicket.TicketID,
-			SeatID:      dbTicket.SeatID,
-			FlightClass: entities.FlightClass(dbTicket.FlightClass),
-			Price:       dbTicket.Price,
-			Status:      entities.TicketStatus(dbTicket.Status),
-			BookingID:   dbTicket.BookingID.Int64,
-			FlightID:    dbTicket.FlightID,
-			CreatedAt:   dbTicket.CreatedAt,
-			UpdatedAt:   dbTicket.UpdatedAt,
+			TicketID:             dbTicket.TicketID,
+			TicketNumber:         dbTicket.TicketNumber.String,
+			SeatID:               dbTicket.SeatID.Int64,
+			FlightClass:          entities.FlightClass(dbTicket.FlightClass),
+			FareFamily:           entities.FareFamilyCode(dbTicket.FareFamily),
+			Price:                dbTicket.Price,
+			Status:               entities.TicketStatus(dbTicket.Status),
+			BookingID:            dbTicket.BookingID.Int64,
+			FlightID:             dbTicket.FlightID,
+			CreatedAt:            dbTicket.CreatedAt,
+			UpdatedAt:            dbTicket.UpdatedAt,
+			PassengerType:        entities.PassengerType(dbTicket.PassengerType),
+			AccompanyingTicketID: dbTicket.AccompanyingTicketID.Int64,
 		})
 	}
 	return entityTickets
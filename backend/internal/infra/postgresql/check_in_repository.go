@@ -0,0 +1,66 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type CheckInRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewCheckInRepositoryPostgres(store *db.Store) adapters.ICheckInRepository {
+	return &CheckInRepositoryPostgres{store: *store}
+}
+
+func (r *CheckInRepositoryPostgres) CheckInTickets(ctx context.Context, checkIns []entities.TicketCheckIn) ([]entities.TicketCheckIn, error) {
+	params := make([]db.CreateTicketCheckInParams, 0, len(checkIns))
+	for _, checkIn := range checkIns {
+		params = append(params, db.CreateTicketCheckInParams{
+			TicketID:    checkIn.TicketID,
+			BookingID:   checkIn.BookingID,
+			FlightID:    checkIn.FlightID,
+			CheckedInAt: checkIn.CheckedInAt,
+		})
+	}
+	rows, err := r.store.CheckInTicketsTx(ctx, db.CheckInTicketsTxParams{CheckIns: params})
+	if err != nil {
+		if errors.Is(err, db.ErrTicketAlreadyCheckedIn) {
+			return nil, adapters.ErrTicketAlreadyCheckedIn
+		}
+		return nil, fmt.Errorf("failed to check in tickets: %w", err)
+	}
+	return mapDBTicketCheckInsToEntities(rows), nil
+}
+
+func (r *CheckInRepositoryPostgres) UndoCheckIn(ctx context.Context, bookingID int64, ticketIDs []int64) ([]entities.TicketCheckIn, error) {
+	rows, err := r.store.UndoCheckInTx(ctx, db.UndoCheckInTxParams{
+		BookingID: bookingID,
+		TicketIDs: ticketIDs,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrTicketNotCheckedIn) {
+			return nil, adapters.ErrTicketNotCheckedIn
+		}
+		return nil, fmt.Errorf("failed to undo check-in: %w", err)
+	}
+	return mapDBTicketCheckInsToEntities(rows), nil
+}
+
+func mapDBTicketCheckInsToEntities(rows []db.TicketCheckIn) []entities.TicketCheckIn {
+	checkIns := make([]entities.TicketCheckIn, 0, len(rows))
+	for _, row := range rows {
+		checkIns = append(checkIns, entities.TicketCheckIn{
+			TicketID:    row.TicketID,
+			BookingID:   row.BookingID,
+			FlightID:    row.FlightID,
+			CheckedInAt: row.CheckedInAt,
+		})
+	}
+	return checkIns
+}
@@ -0,0 +1,123 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type CompanionRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewCompanionRepositoryPostgres(store *db.Store) adapters.ICompanionRepository {
+	return &CompanionRepositoryPostgres{store: *store}
+}
+
+func (r *CompanionRepositoryPostgres) ListCompanions(ctx context.Context, userID int64) ([]entities.Companion, error) {
+	rows, err := r.store.ListCompanionsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list companions: %w", err)
+	}
+
+	companions := make([]entities.Companion, 0, len(rows))
+	for _, row := range rows {
+		companions = append(companions, mapDBCompanionToEntity(row))
+	}
+	return companions, nil
+}
+
+func (r *CompanionRepositoryPostgres) GetCompanionByEmail(ctx context.Context, companionID int64, email string) (entities.Companion, error) {
+	row, err := r.store.GetCompanionByEmail(ctx, db.GetCompanionByEmailParams{
+		ID:    companionID,
+		Email: email,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Companion{}, adapters.ErrCompanionNotFound
+		}
+		return entities.Companion{}, fmt.Errorf("failed to get companion: %w", err)
+	}
+	return mapDBCompanionToEntity(row), nil
+}
+
+func (r *CompanionRepositoryPostgres) CreateCompanion(ctx context.Context, companion entities.Companion) (entities.Companion, error) {
+	row, err := r.store.CreateCompanionTx(ctx, db.CreateCompanionTxParams{
+		CreateCompanionParams: db.CreateCompanionParams{
+			UserID:          companion.UserID,
+			FirstName:       companion.FirstName,
+			LastName:        companion.LastName,
+			Gender:          db.GenderType(companion.Gender),
+			DateOfBirth:     companion.DateOfBirth,
+			DocumentType:    string(companion.DocumentType),
+			DocumentNumber:  companion.DocumentNumber,
+			DocumentExpiry:  companion.DocumentExpiry,
+			DocumentCountry: companion.DocumentCountry,
+		},
+		MaxCompanions: entities.MaxCompanions,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrCompanionLimit) {
+			return entities.Companion{}, &entities.CompanionError{Reason: fmt.Sprintf("at most %d companions can be saved", entities.MaxCompanions)}
+		}
+		return entities.Companion{}, fmt.Errorf("failed to create companion: %w", err)
+	}
+	return mapDBCompanionToEntity(row), nil
+}
+
+func (r *CompanionRepositoryPostgres) UpdateCompanion(ctx context.Context, companion entities.Companion) (entities.Companion, error) {
+	row, err := r.store.UpdateCompanion(ctx, db.UpdateCompanionParams{
+		ID:              companion.CompanionID,
+		UserID:          companion.UserID,
+		FirstName:       companion.FirstName,
+		LastName:        companion.LastName,
+		Gender:          db.GenderType(companion.Gender),
+		DateOfBirth:     companion.DateOfBirth,
+		DocumentType:    string(companion.DocumentType),
+		DocumentNumber:  companion.DocumentNumber,
+		DocumentExpiry:  companion.DocumentExpiry,
+		DocumentCountry: companion.DocumentCountry,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Companion{}, adapters.ErrCompanionNotFound
+		}
+		return entities.Companion{}, fmt.Errorf("failed to update companion: %w", err)
+	}
+	return mapDBCompanionToEntity(row), nil
+}
+
+func (r *CompanionRepositoryPostgres) DeleteCompanion(ctx context.Context, companionID int64, userID int64) (entities.Companion, error) {
+	row, err := r.store.DeleteCompanion(ctx, db.DeleteCompanionParams{
+		ID:     companionID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Companion{}, adapters.ErrCompanionNotFound
+		}
+		return entities.Companion{}, fmt.Errorf("failed to delete companion: %w", err)
+	}
+	return mapDBCompanionToEntity(row), nil
+}
+
+func mapDBCompanionToEntity(row db.Companion) entities.Companion {
+	return entities.Companion{
+		CompanionID:     row.ID,
+		UserID:          row.UserID,
+		FirstName:       row.FirstName,
+		LastName:        row.LastName,
+		Gender:          entities.GenderType(row.Gender),
+		DateOfBirth:     row.DateOfBirth,
+		DocumentType:    entities.DocumentType(row.DocumentType),
+		DocumentNumber:  row.DocumentNumber,
+		DocumentExpiry:  row.DocumentExpiry,
+		DocumentCountry: row.DocumentCountry,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}
+}
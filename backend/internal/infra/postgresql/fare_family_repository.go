@@ -0,0 +1,48 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type FareFamilyRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewFareFamilyRepositoryPostgres(store *db.Store) adapters.IFareFamilyRepository {
+	return &FareFamilyRepositoryPostgres{store: *store}
+}
+
+func (r *FareFamilyRepositoryPostgres) ListFareFamilies(ctx context.Context) (entities.FareFamilyCatalog, error) {
+	rows, err := r.store.ListFareFamilies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fare families: %w", err)
+	}
+
+	catalog := make(entities.FareFamilyCatalog, 0, len(rows))
+	for _, row := range rows {
+		catalog = append(catalog, mapDBFareFamilyToEntity(row))
+	}
+	return catalog, nil
+}
+
+func mapDBFareFamilyToEntity(row db.FareFamily) entities.FareFamily {
+	return entities.FareFamily{
+		FareFamilyID:     row.ID,
+		FlightClass:      entities.FlightClass(row.FlightClass),
+		Code:             entities.FareFamilyCode(row.Family),
+		Name:             row.Name,
+		FarePercent:      int64(row.FarePercent),
+		CabinBaggageKg:   row.CabinBaggageKg,
+		CheckedBaggageKg: row.CheckedBaggageKg,
+		Changeable:       row.Changeable,
+		ChangeFeeWaived:  row.ChangeFeeWaived,
+		Refundable:       row.Refundable,
+		FullRefund:       row.FullRefund,
+		SeatSelection:    row.SeatSelection,
+	}
+}
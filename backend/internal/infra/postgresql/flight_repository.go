@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -32,6 +33,8 @@ func (r *FlightRepositoryPostgres) CreateFlight(ctx context.Context, flight enti
 		ArrivalTime:      flight.ArrivalTime,
 		BasePrice:        flight.BasePrice,
 		Status:           db.FlightStatus(flight.Status),
+		DepartureCountry: flight.DepartureCountry,
+		ArrivalCountry:   flight.ArrivalCountry,
 	})
 	if err != nil {
 		return entities.Flight{}, err
@@ -44,6 +47,8 @@ func (r *FlightRepositoryPostgres) CreateFlight(ctx context.Context, flight enti
 		ArrivalCity:      dbFlight.ArrivalCity.String,
 		DepartureAirport: dbFlight.DepartureAirport.String,
 		ArrivalAirport:   dbFlight.ArrivalAirport.String,
+		DepartureCountry: dbFlight.DepartureCountry,
+		ArrivalCountry:   dbFlight.ArrivalCountry,
 		DepartureTime:    dbFlight.DepartureTime,
 		ArrivalTime:      dbFlight.ArrivalTime,
 		BasePrice:        dbFlight.BasePrice,
@@ -54,21 +59,25 @@ func (r *FlightRepositoryPostgres) CreateFlight(ctx context.Context, flight enti
 func (r *FlightRepositoryPostgres) GetFlightByID(ctx context.Context, flightID int64) (*entities.Flight, error) {
 	dbFlight, err := r.store.GetFlight(ctx, flightID)
 	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, adapters.ErrFlightNotFound
+		}
 		return nil, err
 	}
-	return &entities.Flight{
-		FlightID:         dbFlight.FlightID,
-		FlightNumber:     dbFlight.FlightNumber,
-		AircraftType:     dbFlight.AircraftType.String,
-		DepartureCity:    dbFlight.DepartureCity.String,
-		ArrivalCity:      dbFlight.ArrivalCity.String,
-		DepartureAirport: dbFlight.DepartureAirport.String,
-		ArrivalAirport:   dbFlight.ArrivalAirport.String,
-		DepartureTime:    dbFlight.DepartureTime,
-		ArrivalTime:      dbFlight.ArrivalTime,
-		BasePrice:        dbFlight.BasePrice,
-		Status:           entities.FlightStatus(dbFlight.Status),
-	}, nil
+	flight := mapDBFlightToEntity(dbFlight)
+	return &flight, nil
+}
+
+func (r *FlightRepositoryPostgres) ListFlightsByIDs(ctx context.Context, flightIDs []int64) (map[int64]entities.Flight, error) {
+	dbFlights, err := r.store.ListFlightsByIDs(ctx, flightIDs)
+	if err != nil {
+		return nil, err
+	}
+	flights := make(map[int64]entities.Flight, len(dbFlights))
+	for _, dbFlight := range dbFlights {
+		flights[dbFlight.FlightID] = mapDBFlightToEntity(dbFlight)
+	}
+	return flights, nil
 }
 
 func (r *FlightRepositoryPostgres) UpdateFlightTimes(ctx context.Context, flightID int64, departureTime, arrivalTime time.Time) (*entities.Flight, error) {
@@ -193,3 +202,75 @@ func (r *FlightRepositoryPostgres) ListFlights(ctx context.Context, offset int,
 
 	return flights, nil
 }
+
+// ListAlternativeFlights returns the upcoming flights on the same route as flight, excluding flight itself.
+func (r *FlightRepositoryPostgres) ListAlternativeFlights(ctx context.Context, flight entities.Flight, after time.Time) ([]entities.Flight, error) {
+	rows, err := r.store.ListAlternativeFlights(ctx, db.ListAlternativeFlightsParams{
+		DepartureCity: pgtype.Text{String: flight.DepartureCity, Valid: true},
+		ArrivalCity:   pgtype.Text{String: flight.ArrivalCity, Valid: true},
+		DepartureTime: after,
+		FlightID:      flight.FlightID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alternative flights: %w", err)
+	}
+
+	flights := make([]entities.Flight, 0, len(rows))
+	for _, row := range rows {
+		flights = append(flights, mapDBFlightToEntity(row))
+	}
+	return flights, nil
+}
+
+// CountSoldSeats returns the number of seats held by active tickets on the flight,
+// together with the seats blocked for group bookings.
+func (r *FlightRepositoryPostgres) CountSoldSeats(ctx context.Context, flightID int64) (int64, error) {
+	count, err := r.store.CountSoldSeats(ctx, flightID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count sold seats: %w", err)
+	}
+	blocked, err := r.store.CountGroupBlockedSeats(ctx, pgtype.Int8{Int64: flightID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count group blocked seats: %w", err)
+	}
+	return count + blocked, nil
+}
+
+// CountSoldSeatsByClass returns the seats held by active tickets, blocked for group
+// bookings or offered to waitlisted customers in every cabin of the flights, in a
+// single query.
+func (r *FlightRepositoryPostgres) CountSoldSeatsByClass(ctx context.Context, flightIDs []int64) (map[int64]map[entities.FlightClass]int64, error) {
+	rows, err := r.store.CountSoldSeatsByClass(ctx, flightIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count sold seats by class: %w", err)
+	}
+	sold := make(map[int64]map[entities.FlightClass]int64, len(flightIDs))
+	for _, row := range rows {
+		if sold[row.FlightID] == nil {
+			sold[row.FlightID] = make(map[entities.FlightClass]int64)
+		}
+		sold[row.FlightID][entities.FlightClass(row.FlightClass)] = row.Sold
+	}
+	return sold, nil
+}
+
+func mapDBFlightToEntity(flight db.Flight) entities.Flight {
+	return entities.Flight{
+		FlightID:         flight.FlightID,
+		FlightNumber:     flight.FlightNumber,
+		Airline:          flight.Airline.String,
+		AircraftType:     flight.AircraftType.String,
+		DepartureCity:    flight.DepartureCity.String,
+		ArrivalCity:      flight.ArrivalCity.String,
+		DepartureAirport: flight.DepartureAirport.String,
+		ArrivalAirport:   flight.ArrivalAirport.String,
+		DepartureCountry: flight.DepartureCountry,
+		ArrivalCountry:   flight.ArrivalCountry,
+		DepartureTime:    flight.DepartureTime,
+		ArrivalTime:      flight.ArrivalTime,
+		BasePrice:        flight.BasePrice,
+		TotalSeatsRow:    flight.TotalSeatsRow,
+		TotalSeatsColumn: flight.TotalSeatsColumn,
+		Status:           entities.FlightStatus(flight.Status),
+	}
+}
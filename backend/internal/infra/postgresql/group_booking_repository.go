@@ -0,0 +1,263 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type GroupBookingRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewGroupBookingRepositoryPostgres(store *db.Store) adapters.IGroupBookingRepository {
+	return &GroupBookingRepositoryPostgres{store: *store}
+}
+
+func (r *GroupBookingRepositoryPostgres) CreateGroupBooking(ctx context.Context, group entities.GroupBooking) (entities.GroupBooking, error) {
+	row, err := r.store.CreateGroupBooking(ctx, db.CreateGroupBookingParams{
+		UserEmail:     group.UserEmail,
+		DepartureCity: group.DepartureCity,
+		ArrivalCity:   group.ArrivalCity,
+		DepartureDate: group.DepartureDate,
+		ReturnDate:    toPgTimestamptz(group.ReturnDate),
+		FlightClass:   db.FlightClass(group.FlightClass),
+		Headcount:     group.Headcount,
+		Note:          group.Note,
+	})
+	if err != nil {
+		return entities.GroupBooking{}, fmt.Errorf("failed to create group booking: %w", err)
+	}
+	return mapDBGroupBookingToEntity(row), nil
+}
+
+func (r *GroupBookingRepositoryPostgres) GetGroupBooking(ctx context.Context, groupBookingID int64) (entities.GroupBooking, error) {
+	row, err := r.store.GetGroupBooking(ctx, groupBookingID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.GroupBooking{}, adapters.ErrGroupBookingNotFound
+		}
+		return entities.GroupBooking{}, fmt.Errorf("failed to get group booking: %w", err)
+	}
+	group := mapDBGroupBookingToEntity(row)
+
+	passengers, err := r.store.ListGroupBookingPassengers(ctx, groupBookingID)
+	if err != nil {
+		return entities.GroupBooking{}, fmt.Errorf("failed to list group passengers: %w", err)
+	}
+	group.Passengers = mapDBGroupPassengersToEntities(passengers)
+	return group, nil
+}
+
+func (r *GroupBookingRepositoryPostgres) ListGroupBookings(ctx context.Context, email string) ([]entities.GroupBooking, error) {
+	var rows []db.GroupBooking
+	var err error
+	if email == "" {
+		rows, err = r.store.ListGroupBookings(ctx)
+	} else {
+		rows, err = r.store.ListGroupBookingsByEmail(ctx, email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group bookings: %w", err)
+	}
+
+	groups := make([]entities.GroupBooking, 0, len(rows))
+	for _, row := range rows {
+		groups = append(groups, mapDBGroupBookingToEntity(row))
+	}
+	return groups, nil
+}
+
+func (r *GroupBookingRepositoryPostgres) QuoteGroupBooking(ctx context.Context, groupBookingID int64, quote entities.GroupQuote) (entities.GroupBooking, error) {
+	row, err := r.store.QuoteGroupBooking(ctx, db.QuoteGroupBookingParams{
+		ID:               groupBookingID,
+		OutboundFlightID: pgtype.Int8{Int64: quote.OutboundFlightID, Valid: true},
+		ReturnFlightID:   pgtype.Int8{Int64: quote.ReturnFlightID, Valid: quote.ReturnFlightID != 0},
+		FarePerPassenger: quote.FarePerPassenger,
+		DepositAmount:    quote.DepositAmount,
+		DepositDeadline:  pgtype.Timestamptz{Time: quote.DepositDeadline, Valid: true},
+		NameCutoff:       pgtype.Timestamptz{Time: quote.NameCutoff, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.GroupBooking{}, adapters.ErrGroupBookingConflict
+		}
+		return entities.GroupBooking{}, fmt.Errorf("failed to quote group booking: %w", err)
+	}
+	return mapDBGroupBookingToEntity(row), nil
+}
+
+func (r *GroupBookingRepositoryPostgres) RequestGroupDeposit(ctx context.Context, groupBookingID int64, payment entities.Payment) (entities.GroupBooking, error) {
+	result, err := r.store.RequestGroupDepositTx(ctx, db.RequestGroupDepositTxParams{
+		GroupBookingID: groupBookingID,
+		Payment: db.CreatePaymentParams{
+			IntentID: payment.IntentID,
+			Amount:   payment.Amount,
+			Currency: payment.Currency,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.GroupBooking{}, adapters.ErrGroupBookingNotFound
+		}
+		if errors.Is(err, db.ErrGroupBookingChanged) {
+			return entities.GroupBooking{}, adapters.ErrGroupBookingConflict
+		}
+		return entities.GroupBooking{}, fmt.Errorf("failed to request group deposit: %w", err)
+	}
+	return mapDBGroupBookingToEntity(result.GroupBooking), nil
+}
+
+func (r *GroupBookingRepositoryPostgres) CompleteGroupDeposit(ctx context.Context, event entities.PaymentEvent) (entities.GroupDepositPaymentResult, error) {
+	result, err := r.store.CompleteGroupDepositTx(ctx, db.SettlePaymentParams{
+		IntentID:       event.IntentID,
+		AmountReceived: event.AmountReceived,
+	})
+	if err != nil {
+		return entities.GroupDepositPaymentResult{}, mapSettlePaymentError(err)
+	}
+	return entities.GroupDepositPaymentResult{
+		GroupBooking: mapDBGroupBookingToEntity(result.GroupBooking),
+		Payment:      mapDBPaymentToEntity(result.Payment),
+		Paid:         result.Paid,
+	}, nil
+}
+
+func (r *GroupBookingRepositoryPostgres) IssueGroupBooking(ctx context.Context, groupBookingID int64, booking entities.CreateBookingParams) (entities.GroupTicketingResult, error) {
+	segments := make([]db.SegmentData, len(booking.Segments))
+	for i, segment := range booking.Segments {
+		ticketData := make([]db.TicketData, len(segment.Tickets))
+		for j, ticket := range segment.Tickets {
+			ticketData[j] = mapEntityTicketToTicketData(ticket)
+		}
+		segments[i] = db.SegmentData{
+			FlightID:   segment.FlightID,
+			TicketData: ticketData,
+		}
+	}
+
+	result, err := r.store.IssueGroupBookingTx(ctx, db.IssueGroupBookingTxParams{
+		GroupBookingID: groupBookingID,
+		Booking: db.CreateBookingTxParams{
+			UserEmail:          booking.Email,
+			DepartureCity:      booking.DepartureCity,
+			ArrivalCity:        booking.ArrivalCity,
+			TripType:           string(booking.TripType),
+			Segments:           segments,
+			TicketNumberPrefix: booking.TicketNumberPrefix,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.GroupTicketingResult{}, adapters.ErrGroupBookingNotFound
+		}
+		if errors.Is(err, db.ErrGroupBookingChanged) {
+			return entities.GroupTicketingResult{}, adapters.ErrGroupBookingConflict
+		}
+		return entities.GroupTicketingResult{}, fmt.Errorf("failed to issue group booking: %w", err)
+	}
+	return entities.GroupTicketingResult{
+		GroupBooking: mapDBGroupBookingToEntity(result.GroupBooking),
+		Booking:      result.Booking,
+		AmountDue:    result.AmountDue,
+	}, nil
+}
+
+func (r *GroupBookingRepositoryPostgres) ReplaceGroupPassengers(ctx context.Context, groupBookingID int64, passengers []entities.TicketOwner) ([]entities.TicketOwner, error) {
+	params := make([]db.CreateGroupBookingPassengerParams, 0, len(passengers))
+	for _, passenger := range passengers {
+		params = append(params, db.CreateGroupBookingPassengerParams{
+			FirstName:            passenger.FirstName,
+			LastName:             passenger.LastName,
+			PhoneNumber:          passenger.PhoneNumber,
+			Gender:               db.GenderType(passenger.Gender),
+			DateOfBirth:          passenger.DateOfBirth,
+			IdentificationNumber: passenger.IdentificationNumber,
+			Address:              passenger.Address,
+			PassportNumber:       passenger.PassportNumber,
+			DocumentExpiry:       pgtype.Date{Time: passenger.DocumentExpiry, Valid: !passenger.DocumentExpiry.IsZero()},
+			DocumentCountry:      passenger.DocumentCountry,
+		})
+	}
+
+	result, err := r.store.ReplaceGroupBookingPassengersTx(ctx, db.ReplaceGroupBookingPassengersTxParams{
+		GroupBookingID: groupBookingID,
+		Passengers:     params,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, adapters.ErrGroupBookingNotFound
+		}
+		if errors.Is(err, db.ErrGroupNamesClosed) {
+			return nil, adapters.ErrGroupBookingConflict
+		}
+		return nil, fmt.Errorf("failed to update group passengers: %w", err)
+	}
+	return mapDBGroupPassengersToEntities(result.Passengers), nil
+}
+
+func mapDBGroupBookingToEntity(row db.GroupBooking) entities.GroupBooking {
+	return entities.GroupBooking{
+		GroupBookingID:   row.ID,
+		UserEmail:        row.UserEmail,
+		DepartureCity:    row.DepartureCity,
+		ArrivalCity:      row.ArrivalCity,
+		DepartureDate:    row.DepartureDate,
+		ReturnDate:       fromPgTimestamptz(row.ReturnDate),
+		FlightClass:      entities.FlightClass(row.FlightClass),
+		Headcount:        row.Headcount,
+		Note:             row.Note,
+		Status:           entities.GroupBookingStatus(row.Status),
+		OutboundFlightID: row.OutboundFlightID.Int64,
+		ReturnFlightID:   row.ReturnFlightID.Int64,
+		FarePerPassenger: row.FarePerPassenger,
+		DepositAmount:    row.DepositAmount,
+		DepositDeadline:  fromPgTimestamptz(row.DepositDeadline),
+		NameCutoff:       fromPgTimestamptz(row.NameCutoff),
+		DepositPaidAt:    fromPgTimestamptz(row.DepositPaidAt),
+		BookingID:        row.BookingID.Int64,
+		Passengers:       []entities.TicketOwner{},
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        row.UpdatedAt,
+	}
+}
+
+func mapDBGroupPassengersToEntities(rows []db.GroupBookingPassenger) []entities.TicketOwner {
+	passengers := make([]entities.TicketOwner, 0, len(rows))
+	for _, row := range rows {
+		passengers = append(passengers, entities.TicketOwner{
+			FirstName:            row.FirstName,
+			LastName:             row.LastName,
+			PhoneNumber:          row.PhoneNumber,
+			Gender:               entities.GenderType(row.Gender),
+			DateOfBirth:          row.DateOfBirth,
+			IdentificationNumber: row.IdentificationNumber,
+			Address:              row.Address,
+			PassportNumber:       row.PassportNumber,
+			DocumentExpiry:       row.DocumentExpiry.Time,
+			DocumentCountry:      row.DocumentCountry,
+		})
+	}
+	return passengers
+}
+
+func toPgTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func fromPgTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	value := t.Time
+	return &value
+}
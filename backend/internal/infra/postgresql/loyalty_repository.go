@@ -0,0 +1,145 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type LoyaltyRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewLoyaltyRepositoryPostgres(store *db.Store) adapters.ILoyaltyRepository {
+	return &LoyaltyRepositoryPostgres{store: *store}
+}
+
+func (r *LoyaltyRepositoryPostgres) GetBalance(ctx context.Context, userID int64, now time.Time) (int32, error) {
+	if err := r.store.ExpireLoyaltyPointsTx(ctx, userID, now); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return 0, adapters.ErrCustomerNotFound
+		}
+		return 0, fmt.Errorf("failed to expire loyalty points: %w", err)
+	}
+
+	customer, err := r.store.GetCustomer(ctx, userID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return 0, adapters.ErrCustomerNotFound
+		}
+		return 0, fmt.Errorf("failed to get loyalty balance: %w", err)
+	}
+	return customer.LoyaltyPoints.Int32, nil
+}
+
+func (r *LoyaltyRepositoryPostgres) ListTransactions(ctx context.Context, userID int64, limit int32, offset int32) (entities.LoyaltyStatement, error) {
+	rows, err := r.store.ListLoyaltyTransactions(ctx, db.ListLoyaltyTransactionsParams{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return entities.LoyaltyStatement{}, fmt.Errorf("failed to list loyalty transactions: %w", err)
+	}
+	total, err := r.store.CountLoyaltyTransactions(ctx, userID)
+	if err != nil {
+		return entities.LoyaltyStatement{}, fmt.Errorf("failed to count loyalty transactions: %w", err)
+	}
+
+	statement := entities.LoyaltyStatement{
+		Transactions: make([]entities.LoyaltyTransaction, 0, len(rows)),
+		Total:        total,
+	}
+	for _, row := range rows {
+		statement.Transactions = append(statement.Transactions, mapDBLoyaltyTransactionToEntity(row))
+	}
+	return statement, nil
+}
+
+func (r *LoyaltyRepositoryPostgres) RedeemPoints(ctx context.Context, arg entities.RedeemLoyaltyParams) (entities.RedeemLoyaltyResult, error) {
+	txResult, err := r.store.RedeemLoyaltyPointsTx(ctx, db.RedeemLoyaltyPointsTxParams{
+		UserID:     arg.UserID,
+		BookingID:  arg.BookingID,
+		Points:     arg.Points,
+		PointValue: arg.PointValue,
+		AmountDue:  arg.AmountDue,
+		Now:        time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.RedeemLoyaltyResult{}, adapters.ErrBookingNotFound
+		}
+		return entities.RedeemLoyaltyResult{}, err
+	}
+	return entities.RedeemLoyaltyResult{
+		Transaction: mapDBLoyaltyTransactionToEntity(txResult.Transaction),
+		Balance:     txResult.Balance,
+		AmountDue:   txResult.AmountDue,
+		Booking:     mapDBBookingToEntity(txResult.Booking),
+		Confirmed:   txResult.Confirmed,
+	}, nil
+}
+
+func (r *LoyaltyRepositoryPostgres) GetRedeemedAmount(ctx context.Context, bookingID int64) (int64, error) {
+	amount, err := r.store.SumLoyaltyRedeemedByBooking(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum redeemed points: %w", err)
+	}
+	return amount, nil
+}
+
+func (r *LoyaltyRepositoryPostgres) GetTier(ctx context.Context, userID int64) (entities.LoyaltyTier, error) {
+	tier, err := r.store.GetCustomerLoyaltyTier(ctx, userID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return "", adapters.ErrCustomerNotFound
+		}
+		return "", fmt.Errorf("failed to get loyalty tier: %w", err)
+	}
+	return entities.LoyaltyTier(tier), nil
+}
+
+func (r *LoyaltyRepositoryPostgres) GetTierByEmail(ctx context.Context, email string) (entities.LoyaltyTier, error) {
+	if email == "" {
+		return entities.LoyaltyTierMember, nil
+	}
+	tier, err := r.store.GetCustomerLoyaltyTierByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.LoyaltyTierMember, nil
+		}
+		return "", fmt.Errorf("failed to get loyalty tier: %w", err)
+	}
+	return entities.LoyaltyTier(tier), nil
+}
+
+func (r *LoyaltyRepositoryPostgres) GetQualifyingPoints(ctx context.Context, userID int64, since time.Time) (int32, error) {
+	points, err := r.store.GetCustomerQualifyingPoints(ctx, db.GetCustomerQualifyingPointsParams{
+		UserID:    userID,
+		CreatedAt: since,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum qualifying points: %w", err)
+	}
+	return points, nil
+}
+
+func mapDBLoyaltyTransactionToEntity(row db.LoyaltyTransaction) entities.LoyaltyTransaction {
+	return entities.LoyaltyTransaction{
+		TransactionID: row.ID,
+		Kind:          entities.LoyaltyTransactionKind(row.Kind),
+		Points:        row.Points,
+		Amount:        row.Amount,
+		TicketID:      row.TicketID.Int64,
+		BookingID:     row.BookingID.Int64,
+		Description:   row.Description,
+		ExpiresAt:     fromPgTimestamptz(row.ExpiresAt),
+		CreatedAt:     row.CreatedAt,
+	}
+}
@@ -0,0 +1,125 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type PaymentRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewPaymentRepositoryPostgres(store *db.Store) adapters.IPaymentRepository {
+	return &PaymentRepositoryPostgres{store: *store}
+}
+
+func (r *PaymentRepositoryPostgres) CreatePayment(ctx context.Context, payment entities.Payment) (entities.Payment, error) {
+	row, err := r.store.CreatePayment(ctx, db.CreatePaymentParams{
+		BookingID:   pgtype.Int8{Int64: payment.BookingID, Valid: payment.BookingID != 0},
+		Purpose:     string(payment.Purpose),
+		ReferenceID: payment.ReferenceID,
+		IntentID:    payment.IntentID,
+		Amount:      payment.Amount,
+		Currency:    payment.Currency,
+	})
+	if err != nil {
+		return entities.Payment{}, fmt.Errorf("failed to create payment: %w", err)
+	}
+	return mapDBPaymentToEntity(row), nil
+}
+
+func (r *PaymentRepositoryPostgres) GetPaymentByIntentID(ctx context.Context, intentID string) (entities.Payment, error) {
+	row, err := r.store.GetPaymentByIntentID(ctx, intentID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.Payment{}, adapters.ErrPaymentNotFound
+		}
+		return entities.Payment{}, fmt.Errorf("failed to get payment: %w", err)
+	}
+	return mapDBPaymentToEntity(row), nil
+}
+
+func (r *PaymentRepositoryPostgres) ListCapturedPayments(ctx context.Context, bookingID int64) ([]entities.Payment, error) {
+	rows, err := r.store.ListCapturedPaymentsByBookingID(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	payments := make([]entities.Payment, 0, len(rows))
+	for _, row := range rows {
+		payments = append(payments, mapDBPaymentToEntity(row))
+	}
+	return payments, nil
+}
+
+func (r *PaymentRepositoryPostgres) RecordRefund(ctx context.Context, paymentID int64, amount int64) (entities.Payment, error) {
+	row, err := r.store.AddPaymentRefund(ctx, db.AddPaymentRefundParams{
+		Amount: amount,
+		ID:     paymentID,
+	})
+	if err != nil {
+		return entities.Payment{}, fmt.Errorf("failed to record payment refund: %w", err)
+	}
+	return mapDBPaymentToEntity(row), nil
+}
+
+func (r *PaymentRepositoryPostgres) ConfirmBookingPayment(ctx context.Context, event entities.PaymentEvent) (entities.BookingPaymentResult, error) {
+	txResult, err := r.store.ConfirmBookingPaymentTx(ctx, db.SettlePaymentParams{
+		IntentID:       event.IntentID,
+		AmountReceived: event.AmountReceived,
+	})
+	if err != nil {
+		return entities.BookingPaymentResult{}, mapSettlePaymentError(err)
+	}
+
+	result := entities.BookingPaymentResult{
+		Payment:   mapDBPaymentToEntity(txResult.Payment),
+		Booking:   mapDBBookingToEntity(txResult.Booking),
+		Confirmed: txResult.Confirmed,
+	}
+	if txResult.Refund != nil {
+		refund := mapDBRefundToEntity(*txResult.Refund)
+		result.Refund = &refund
+	}
+	return result, nil
+}
+
+func (r *PaymentRepositoryPostgres) FailPayment(ctx context.Context, intentID string) (entities.Payment, error) {
+	row, err := r.store.FailPaymentTx(ctx, intentID)
+	if err != nil {
+		return entities.Payment{}, mapSettlePaymentError(err)
+	}
+	return mapDBPaymentToEntity(row), nil
+}
+
+// mapSettlePaymentError maps the errors of the transactions applying a payment.
+func mapSettlePaymentError(err error) error {
+	switch {
+	case errors.Is(err, db.ErrRecordNotFound):
+		return adapters.ErrPaymentNotFound
+	case errors.Is(err, db.ErrPaymentSettled):
+		return adapters.ErrPaymentSettled
+	}
+	return fmt.Errorf("failed to settle payment: %w", err)
+}
+
+func mapDBPaymentToEntity(payment db.Payment) entities.Payment {
+	return entities.Payment{
+		PaymentID:      payment.ID,
+		BookingID:      payment.BookingID.Int64,
+		Purpose:        entities.PaymentPurpose(payment.Purpose),
+		ReferenceID:    payment.ReferenceID,
+		IntentID:       payment.IntentID,
+		Amount:         payment.Amount,
+		AmountReceived: payment.AmountReceived,
+		AmountRefunded: payment.AmountRefunded,
+		Currency:       payment.Currency,
+		Status:         entities.PaymentStatus(payment.Status),
+		CreatedAt:      payment.CreatedAt,
+	}
+}
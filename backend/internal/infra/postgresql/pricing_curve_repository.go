@@ -0,0 +1,109 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type PricingCurveRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewPricingCurveRepositoryPostgres(store *db.Store) adapters.IPricingCurveRepository {
+	return &PricingCurveRepositoryPostgres{store: *store}
+}
+
+func (r *PricingCurveRepositoryPostgres) UpsertPricingCurve(ctx context.Context, curve entities.PricingCurve) (entities.PricingCurve, error) {
+	loadFactorSteps, err := marshalPriceSteps(curve.LoadFactorSteps)
+	if err != nil {
+		return entities.PricingCurve{}, err
+	}
+	daysToDepartureSteps, err := marshalPriceSteps(curve.DaysToDepartureSteps)
+	if err != nil {
+		return entities.PricingCurve{}, err
+	}
+
+	row, err := r.store.UpsertPricingCurve(ctx, db.UpsertPricingCurveParams{
+		DepartureCity:        curve.DepartureCity,
+		ArrivalCity:          curve.ArrivalCity,
+		FlightClass:          db.FlightClass(curve.FlightClass),
+		LoadFactorSteps:      loadFactorSteps,
+		DaysToDepartureSteps: daysToDepartureSteps,
+	})
+	if err != nil {
+		return entities.PricingCurve{}, fmt.Errorf("failed to save pricing curve: %w", err)
+	}
+	return mapDBPricingCurveToEntity(row)
+}
+
+func (r *PricingCurveRepositoryPostgres) ListPricingCurves(ctx context.Context) ([]entities.PricingCurve, error) {
+	rows, err := r.store.ListPricingCurves(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pricing curves: %w", err)
+	}
+	return mapDBPricingCurvesToEntities(rows)
+}
+
+func (r *PricingCurveRepositoryPostgres) ListPricingCurvesByRoute(ctx context.Context, departureCity, arrivalCity string) ([]entities.PricingCurve, error) {
+	rows, err := r.store.ListPricingCurvesByRoute(ctx, db.ListPricingCurvesByRouteParams{
+		DepartureCity: departureCity,
+		ArrivalCity:   arrivalCity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pricing curves: %w", err)
+	}
+	return mapDBPricingCurvesToEntities(rows)
+}
+
+func (r *PricingCurveRepositoryPostgres) DeletePricingCurve(ctx context.Context, curveID int64) error {
+	_, err := r.store.DeletePricingCurve(ctx, curveID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return adapters.ErrPricingCurveNotFound
+		}
+		return fmt.Errorf("failed to delete pricing curve: %w", err)
+	}
+	return nil
+}
+
+func marshalPriceSteps(steps []entities.PriceStep) ([]byte, error) {
+	if steps == nil {
+		steps = []entities.PriceStep{}
+	}
+	return json.Marshal(steps)
+}
+
+func mapDBPricingCurvesToEntities(rows []db.PricingCurve) ([]entities.PricingCurve, error) {
+	curves := make([]entities.PricingCurve, 0, len(rows))
+	for _, row := range rows {
+		curve, err := mapDBPricingCurveToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		curves = append(curves, curve)
+	}
+	return curves, nil
+}
+
+func mapDBPricingCurveToEntity(row db.PricingCurve) (entities.PricingCurve, error) {
+	curve := entities.PricingCurve{
+		CurveID:       row.ID,
+		DepartureCity: row.DepartureCity,
+		ArrivalCity:   row.ArrivalCity,
+		FlightClass:   entities.FlightClass(row.FlightClass),
+		UpdatedAt:     row.UpdatedAt,
+	}
+	if err := json.Unmarshal(row.LoadFactorSteps, &curve.LoadFactorSteps); err != nil {
+		return entities.PricingCurve{}, fmt.Errorf("failed to decode load factor steps: %w", err)
+	}
+	if err := json.Unmarshal(row.DaysToDepartureSteps, &curve.DaysToDepartureSteps); err != nil {
+		return entities.PricingCurve{}, fmt.Errorf("failed to decode days to departure steps: %w", err)
+	}
+	return curve, nil
+}
@@ -0,0 +1,112 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type PromoCodeRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewPromoCodeRepositoryPostgres(store *db.Store) adapters.IPromoCodeRepository {
+	return &PromoCodeRepositoryPostgres{store: *store}
+}
+
+func (r *PromoCodeRepositoryPostgres) CreatePromoCode(ctx context.Context, promo entities.PromoCode) (entities.PromoCode, error) {
+	flightClasses := make([]string, len(promo.FlightClasses))
+	for i, class := range promo.FlightClasses {
+		flightClasses[i] = string(class)
+	}
+
+	row, err := r.store.CreatePromoCode(ctx, db.CreatePromoCodeParams{
+		Code:               promo.Code,
+		Description:        promo.Description,
+		DiscountType:       string(promo.DiscountType),
+		DiscountValue:      promo.DiscountValue,
+		MaxDiscount:        promo.MaxDiscount,
+		ValidFrom:          promo.ValidFrom,
+		ValidUntil:         promo.ValidUntil,
+		DepartureCity:      promo.DepartureCity,
+		ArrivalCity:        promo.ArrivalCity,
+		FlightClasses:      flightClasses,
+		MaxUses:            promo.MaxUses,
+		MaxUsesPerCustomer: promo.MaxUsesPerCustomer,
+		Stackable:          promo.Stackable,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return entities.PromoCode{}, adapters.ErrPromoCodeAlreadyExists
+		}
+		return entities.PromoCode{}, fmt.Errorf("failed to create promo code: %w", err)
+	}
+	return mapDBPromoCodeToEntity(row), nil
+}
+
+func (r *PromoCodeRepositoryPostgres) GetPromoCodeByCode(ctx context.Context, code string) (entities.PromoCode, error) {
+	row, err := r.store.GetPromoCodeByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.PromoCode{}, adapters.ErrPromoCodeNotFound
+		}
+		return entities.PromoCode{}, fmt.Errorf("failed to get promo code: %w", err)
+	}
+	return mapDBPromoCodeToEntity(row), nil
+}
+
+func (r *PromoCodeRepositoryPostgres) ListPromoCodes(ctx context.Context) ([]entities.PromoCode, error) {
+	rows, err := r.store.ListPromoCodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", err)
+	}
+
+	promos := make([]entities.PromoCode, 0, len(rows))
+	for _, row := range rows {
+		promos = append(promos, mapDBPromoCodeToEntity(row))
+	}
+	return promos, nil
+}
+
+func (r *PromoCodeRepositoryPostgres) DeactivatePromoCode(ctx context.Context, promoCodeID int64) (entities.PromoCode, error) {
+	row, err := r.store.DeactivatePromoCode(ctx, promoCodeID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.PromoCode{}, adapters.ErrPromoCodeNotFound
+		}
+		return entities.PromoCode{}, fmt.Errorf("failed to deactivate promo code: %w", err)
+	}
+	return mapDBPromoCodeToEntity(row), nil
+}
+
+func mapDBPromoCodeToEntity(row db.PromoCode) entities.PromoCode {
+	flightClasses := make([]entities.FlightClass, len(row.FlightClasses))
+	for i, class := range row.FlightClasses {
+		flightClasses[i] = entities.FlightClass(class)
+	}
+
+	return entities.PromoCode{
+		PromoCodeID:        row.ID,
+		Code:               row.Code,
+		Description:        row.Description,
+		DiscountType:       entities.PromoDiscountType(row.DiscountType),
+		DiscountValue:      row.DiscountValue,
+		MaxDiscount:        row.MaxDiscount,
+		ValidFrom:          row.ValidFrom,
+		ValidUntil:         row.ValidUntil,
+		DepartureCity:      row.DepartureCity,
+		ArrivalCity:        row.ArrivalCity,
+		FlightClasses:      flightClasses,
+		MaxUses:            row.MaxUses,
+		MaxUsesPerCustomer: row.MaxUsesPerCustomer,
+		Stackable:          row.Stackable,
+		Active:             row.Active,
+		UpdatedAt:          row.UpdatedAt,
+	}
+}
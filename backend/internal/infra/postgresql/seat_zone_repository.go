@@ -0,0 +1,88 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type SeatZoneRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewSeatZoneRepositoryPostgres(store *db.Store) adapters.ISeatZoneRepository {
+	return &SeatZoneRepositoryPostgres{store: *store}
+}
+
+func (r *SeatZoneRepositoryPostgres) CreateSeatZone(ctx context.Context, zone entities.SeatZone) (entities.SeatZone, error) {
+	row, err := r.store.CreateSeatZone(ctx, db.CreateSeatZoneParams{
+		FlightID:     pgtype.Int8{Int64: zone.FlightID, Valid: zone.FlightID != 0},
+		AircraftType: zone.AircraftType,
+		ZoneType:     string(zone.Type),
+		Name:         zone.Name,
+		RowFrom:      zone.RowFrom,
+		RowTo:        zone.RowTo,
+		Price:        zone.Price,
+	})
+	if err != nil {
+		return entities.SeatZone{}, fmt.Errorf("failed to create seat zone: %w", err)
+	}
+	return mapDBSeatZoneToEntity(row), nil
+}
+
+func (r *SeatZoneRepositoryPostgres) ListSeatZones(ctx context.Context) ([]entities.SeatZone, error) {
+	rows, err := r.store.ListSeatZones(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seat zones: %w", err)
+	}
+	return mapDBSeatZonesToEntity(rows), nil
+}
+
+func (r *SeatZoneRepositoryPostgres) ListSeatZonesForFlight(ctx context.Context, flight entities.Flight) ([]entities.SeatZone, error) {
+	rows, err := r.store.ListSeatZonesForFlight(ctx, db.ListSeatZonesForFlightParams{
+		FlightID:     pgtype.Int8{Int64: flight.FlightID, Valid: true},
+		AircraftType: flight.AircraftType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seat zones of flight %d: %w", flight.FlightID, err)
+	}
+	return mapDBSeatZonesToEntity(rows), nil
+}
+
+func (r *SeatZoneRepositoryPostgres) DeleteSeatZone(ctx context.Context, seatZoneID int64) error {
+	_, err := r.store.DeleteSeatZone(ctx, seatZoneID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return adapters.ErrSeatZoneNotFound
+		}
+		return fmt.Errorf("failed to delete seat zone: %w", err)
+	}
+	return nil
+}
+
+func mapDBSeatZonesToEntity(rows []db.SeatZone) []entities.SeatZone {
+	zones := make([]entities.SeatZone, 0, len(rows))
+	for _, row := range rows {
+		zones = append(zones, mapDBSeatZoneToEntity(row))
+	}
+	return zones
+}
+
+func mapDBSeatZoneToEntity(row db.SeatZone) entities.SeatZone {
+	return entities.SeatZone{
+		SeatZoneID:   row.ID,
+		FlightID:     row.FlightID.Int64,
+		AircraftType: row.AircraftType,
+		Type:         entities.SeatZoneType(row.ZoneType),
+		Name:         row.Name,
+		RowFrom:      row.RowFrom,
+		RowTo:        row.RowTo,
+		Price:        row.Price,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type SpecialServiceRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewSpecialServiceRepositoryPostgres(store *db.Store) adapters.ISpecialServiceRepository {
+	return &SpecialServiceRepositoryPostgres{store: *store}
+}
+
+func (r *SpecialServiceRepositoryPostgres) ListSpecialServices(ctx context.Context) (entities.SpecialServiceCatalog, error) {
+	rows, err := r.store.ListSpecialServices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list special services: %w", err)
+	}
+	catalog := make(entities.SpecialServiceCatalog, 0, len(rows))
+	for _, row := range rows {
+		catalog = append(catalog, entities.SpecialService{
+			Code:        row.Code,
+			Category:    entities.SpecialServiceCategory(row.Category),
+			Name:        row.Name,
+			FlightLimit: row.FlightLimit,
+			Active:      row.Active,
+		})
+	}
+	return catalog, nil
+}
+
+func (r *SpecialServiceRepositoryPostgres) ListFlightSpecialServices(ctx context.Context, flightID int64) ([]entities.TicketSpecialService, error) {
+	rows, err := r.store.ListTicketSpecialServicesByFlightID(ctx, flightID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flight special services: %w", err)
+	}
+	items := make([]entities.TicketSpecialService, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, mapDBTicketSpecialServiceToEntity(row))
+	}
+	return items, nil
+}
+
+func (r *SpecialServiceRepositoryPostgres) AddTicketSpecialService(ctx context.Context, item entities.TicketSpecialService, flightLimit int32) (entities.TicketSpecialService, error) {
+	row, err := r.store.AddTicketSpecialServiceTx(ctx, db.AddTicketSpecialServiceTxParams{
+		CreateTicketSpecialServiceParams: db.CreateTicketSpecialServiceParams{
+			TicketID:  item.TicketID,
+			BookingID: item.BookingID,
+			FlightID:  item.FlightID,
+			Code:      item.Code,
+			Category:  string(item.Category),
+			Name:      item.Name,
+			Note:      item.Note,
+		},
+		FlightLimit: flightLimit,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrSpecialServiceFull):
+			return entities.TicketSpecialService{}, adapters.ErrSpecialServiceFull
+		case errors.Is(err, db.ErrSpecialServiceExists):
+			return entities.TicketSpecialService{}, adapters.ErrSpecialServiceExists
+		case errors.Is(err, db.ErrBookingNotChangeable):
+			return entities.TicketSpecialService{}, adapters.ErrBookingNotChangeable
+		case errors.Is(err, db.ErrTicketNotActive):
+			return entities.TicketSpecialService{}, adapters.ErrTicketNotFound
+		case errors.Is(err, db.ErrFlightDeparted):
+			return entities.TicketSpecialService{}, &entities.SpecialServiceError{Code: item.Code, Reason: "flight has already departed"}
+		}
+		return entities.TicketSpecialService{}, fmt.Errorf("failed to add special service: %w", err)
+	}
+	return mapDBTicketSpecialServiceToEntity(row), nil
+}
+
+func (r *SpecialServiceRepositoryPostgres) RemoveTicketSpecialService(ctx context.Context, arg entities.RemoveSpecialServiceParams) (entities.TicketSpecialService, error) {
+	row, err := r.store.DeleteTicketSpecialService(ctx, db.DeleteTicketSpecialServiceParams{
+		ID:        arg.TicketSpecialServiceID,
+		BookingID: arg.BookingID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.TicketSpecialService{}, adapters.ErrTicketSpecialServiceNotFound
+		}
+		return entities.TicketSpecialService{}, fmt.Errorf("failed to remove special service: %w", err)
+	}
+	return mapDBTicketSpecialServiceToEntity(row), nil
+}
+
+func mapDBTicketSpecialServiceToEntity(row db.TicketSpecialService) entities.TicketSpecialService {
+	return entities.TicketSpecialService{
+		TicketSpecialServiceID: row.ID,
+		TicketID:               row.TicketID,
+		BookingID:              row.BookingID,
+		FlightID:               row.FlightID,
+		Code:                   row.Code,
+		Category:               entities.SpecialServiceCategory(row.Category),
+		Name:                   row.Name,
+		Note:                   row.Note,
+		CreatedAt:              row.CreatedAt,
+	}
+}
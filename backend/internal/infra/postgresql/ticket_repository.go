@@ -3,7 +3,10 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	db "github.com/spaghetti-lover/qairlines/db/sqlc"
 	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
 	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
@@ -31,17 +34,20 @@ func (r *TicketRepositoryPostgres) GetTicketsByFlightID(ctx context.Context, fli
 	var result []entities.Ticket
 	for _, t := range tickets {
 		result = append(result, entities.Ticket{
-			TicketID:    t.TicketID,
-			SeatID:      t.SeatID,
-			FlightClass: entities.FlightClass(t.FlightClass),
-			Price:       t.Price,
-			Status:      entities.TicketStatus(t.Status),
-			BookingID:   t.BookingID.Int64,
-			FlightID:    t.FlightID,
-			CreatedAt:   t.CreatedAt,
-			UpdatedAt:   t.UpdatedAt,
+			TicketID:      t.TicketID,
+			TicketNumber:  t.TicketNumber.String,
+			SeatID:        t.SeatID.Int64,
+			FlightClass:   entities.FlightClass(t.FlightClass),
+			Price:         t.Price,
+			Status:        entities.TicketStatus(t.Status),
+			BookingID:     t.BookingID.Int64,
+			BookingPNR:    t.BookingPnr.String,
+			FlightID:      t.FlightID,
+			CreatedAt:     t.CreatedAt,
+			UpdatedAt:     t.UpdatedAt,
+			PassengerType: entities.PassengerType(t.PassengerType),
 			Seat: entities.Seat{
-				SeatID:      t.SeatID,
+				SeatID:      t.SeatID.Int64,
 				SeatCode:    t.SeatCode.String,
 				IsAvailable: t.IsAvailable.Bool,
 				Class:       entities.FlightClass(t.SeatClass.FlightClass),
@@ -61,68 +67,72 @@ func (r *TicketRepositoryPostgres) GetTicketsByFlightID(ctx context.Context, fli
 func (r *TicketRepositoryPostgres) GetTicketByID(ctx context.Context, ticketID int64) (*entities.Ticket, error) {
 	// Sử dụng sqlc để lấy vé theo ticketID
 	ticket, err := r.store.GetTicketByID(ctx, ticketID)
-	if err == sql.ErrNoRows {
+	if errors.Is(err, db.ErrRecordNotFound) {
 		return nil, adapters.ErrTicketNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &entities.Ticket{
-		TicketID:    ticket.TicketID,
-		Status:      entities.TicketStatus(ticket.Status),
-		FlightClass: entities.FlightClass(ticket.FlightClass),
-		Price:       ticket.Price,
-		BookingID:   ticket.BookingID.Int64,
-		FlightID:    ticket.FlightID,
-		CreatedAt:   ticket.CreatedAt,
-		UpdatedAt:   ticket.UpdatedAt,
-		Seat: entities.Seat{
-			SeatCode:    ticket.SeatCode.String,
-			SeatID:      ticket.SeatID.Int64,
-			IsAvailable: ticket.IsAvailable.Bool,
-			Class:       entities.FlightClass(ticket.SeatClass.FlightClass),
-			FlightID:    ticket.FlightID,
-		},
-		Owner: entities.TicketOwner{
-			FirstName:            ticket.OwnerFirstName.String,
-			LastName:             ticket.OwnerLastName.String,
-			PhoneNumber:          ticket.OwnerPhoneNumber.String,
-			Gender:               entities.GenderType(ticket.OwnerGender.GenderType),
-			DateOfBirth:          ticket.OwnerDateOfBirth,
-			PassportNumber:       ticket.OwnerPassportNumber.String,
-			IdentificationNumber: ticket.OwnerIdentificationNumber.String,
-			Address:              ticket.OwnerAddress.String,
-		},
-	}, nil
+	return mapDBTicketDetailsToEntity(ticket), nil
 }
 
-func (r *TicketRepositoryPostgres) CancelTicket(ctx context.Context, ticketID int64) (*entities.Ticket, error) {
-	row, err := r.store.CancelTicket(ctx, ticketID)
-	if err == sql.ErrNoRows {
+func (r *TicketRepositoryPostgres) GetTicketByNumber(ctx context.Context, ticketNumber string) (*entities.Ticket, error) {
+	ticket, err := r.store.GetTicketByNumber(ctx, pgtype.Text{String: ticketNumber, Valid: true})
+	if errors.Is(err, db.ErrRecordNotFound) {
 		return nil, adapters.ErrTicketNotFound
 	}
 	if err != nil {
-		return nil, adapters.ErrTicketCannotBeCancelled
+		return nil, err
 	}
 
-	return &entities.Ticket{
-		TicketID:    row.TicketID,
-		Status:      entities.TicketStatus(row.Status),
-		FlightClass: entities.FlightClass(row.FlightClass),
-		Price:       row.Price,
-		BookingID:   row.BookingID.Int64,
-		FlightID:    row.FlightID,
-		UpdatedAt:   row.UpdatedAt,
+	return mapDBTicketDetailsToEntity(db.GetTicketByIDRow(ticket)), nil
+}
+
+func (r *TicketRepositoryPostgres) CancelTicket(ctx context.Context, params entities.CancelTicketParams) (entities.CancelTicketResult, error) {
+	txResult, err := r.store.CancelTicketTx(ctx, db.CancelTicketTxParams{
+		TicketID:     params.TicketID,
+		RefundPolicy: params.RefundPolicy,
+		FareFamilies: params.FareFamilies,
+		CancelledAt:  params.CancelledAt,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.CancelTicketResult{}, adapters.ErrTicketNotFound
+		}
+		var transitionErr *entities.StatusTransitionError
+		if errors.As(err, &transitionErr) {
+			return entities.CancelTicketResult{}, adapters.ErrTicketCannotBeCancelled
+		}
+		return entities.CancelTicketResult{}, err
+	}
+
+	row := txResult.Ticket
+	result := entities.CancelTicketResult{Ticket: &entities.Ticket{
+		TicketID:     row.TicketID,
+		TicketNumber: row.TicketNumber.String,
+		SeatID:       row.SeatID.Int64,
+		Status:       entities.TicketStatus(row.Status),
+		FlightClass:  entities.FlightClass(row.FlightClass),
+		Price:        row.Price,
+		BookingID:    row.BookingID.Int64,
+		BookingPNR:   row.BookingPnr.String,
+		FlightID:     row.FlightID,
+		UpdatedAt:    row.UpdatedAt,
 		Seat: entities.Seat{
-			SeatCode: row.SeatCode,
+			SeatCode: row.SeatCode.String,
 		},
 		Owner: entities.TicketOwner{
 			FirstName:   row.OwnerFirstName.String,
 			LastName:    row.OwnerLastName.String,
 			PhoneNumber: row.OwnerPhoneNumber.String,
 		},
-	}, nil
+	}}
+	if txResult.Refund != nil {
+		refund := mapDBRefundToEntity(*txResult.Refund)
+		result.Refund = &refund
+	}
+	return result, nil
 }
 
 func (r *TicketRepositoryPostgres) UpdateSeat(ctx context.Context, ticketID int64, seatCode string) (*entities.Ticket, error) {
@@ -134,6 +144,9 @@ func (r *TicketRepositoryPostgres) UpdateSeat(ctx context.Context, ticketID int6
 		return nil, adapters.ErrTicketNotFound
 	}
 	if err != nil {
+		if db.IsUniqueViolation(err, db.SeatCodeUniqueIndex) {
+			return nil, adapters.ErrSeatUnavailable
+		}
 		return nil, err
 	}
 
@@ -144,3 +157,100 @@ func (r *TicketRepositoryPostgres) UpdateSeat(ctx context.Context, ticketID int6
 		},
 	}, nil
 }
+
+func (r *TicketRepositoryPostgres) IsSeatTaken(ctx context.Context, flightID int64, seatCode string) (bool, error) {
+	taken, err := r.store.IsSeatCodeTaken(ctx, db.IsSeatCodeTakenParams{
+		FlightID: pgtype.Int8{Int64: flightID, Valid: true},
+		SeatCode: seatCode,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check seat %s: %w", seatCode, err)
+	}
+	return taken, nil
+}
+
+func (r *TicketRepositoryPostgres) RequestSeatSelection(ctx context.Context, bookingID int64, selections []entities.SeatSelection, payment entities.Payment) error {
+	data := make([]db.SeatSelectionData, 0, len(selections))
+	var amountDue int64
+	for _, selection := range selections {
+		data = append(data, db.SeatSelectionData{
+			TicketID: selection.TicketID,
+			SeatCode: selection.SeatCode,
+			Fee:      selection.Fee,
+			FeeName:  selection.FeeItem().Name,
+		})
+		amountDue += selection.Fee
+	}
+
+	_, err := r.store.RequestSeatSelectionTx(ctx, db.RequestSeatSelectionTxParams{
+		BookingID:  bookingID,
+		Selections: data,
+		AmountDue:  amountDue,
+		Payment: db.CreatePaymentParams{
+			IntentID: payment.IntentID,
+			Amount:   payment.Amount,
+			Currency: payment.Currency,
+		},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			return adapters.ErrBookingNotFound
+		case errors.Is(err, db.ErrBookingNotConfirmed):
+			return adapters.ErrBookingNotChangeable
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *TicketRepositoryPostgres) CompleteSeatSelection(ctx context.Context, event entities.PaymentEvent) (entities.SeatSelectionPaymentResult, error) {
+	txResult, err := r.store.CompleteSeatSelectionTx(ctx, db.SettlePaymentParams{
+		IntentID:       event.IntentID,
+		AmountReceived: event.AmountReceived,
+	})
+	if err != nil {
+		return entities.SeatSelectionPaymentResult{}, mapSettlePaymentError(err)
+	}
+
+	result := entities.SeatSelectionPaymentResult{Status: entities.SeatSelectionStatus(txResult.SeatSelection.Status)}
+	if txResult.Refund != nil {
+		refund := mapDBRefundToEntity(*txResult.Refund)
+		result.Refund = &refund
+	}
+	return result, nil
+}
+
+// mapDBTicketDetailsToEntity maps a ticket joined with its seat, owner snapshot and booking.
+func mapDBTicketDetailsToEntity(ticket db.GetTicketByIDRow) *entities.Ticket {
+	return &entities.Ticket{
+		TicketID:     ticket.TicketID,
+		TicketNumber: ticket.TicketNumber.String,
+		Status:       entities.TicketStatus(ticket.Status),
+		FlightClass:  entities.FlightClass(ticket.FlightClass),
+		FareFamily:   entities.FareFamilyCode(ticket.FareFamily),
+		Price:        ticket.Price,
+		BookingID:    ticket.BookingID.Int64,
+		BookingPNR:   ticket.BookingPnr.String,
+		FlightID:     ticket.FlightID,
+		CreatedAt:    ticket.CreatedAt,
+		UpdatedAt:    ticket.UpdatedAt,
+		Seat: entities.Seat{
+			SeatCode:    ticket.SeatCode.String,
+			SeatID:      ticket.SeatID.Int64,
+			IsAvailable: ticket.IsAvailable.Bool,
+			Class:       entities.FlightClass(ticket.SeatClass.FlightClass),
+			FlightID:    ticket.FlightID,
+		},
+		Owner: entities.TicketOwner{
+			FirstName:            ticket.OwnerFirstName.String,
+			LastName:             ticket.OwnerLastName.String,
+			PhoneNumber:          ticket.OwnerPhoneNumber.String,
+			Gender:               entities.GenderType(ticket.OwnerGender.GenderType),
+			DateOfBirth:          ticket.OwnerDateOfBirth,
+			PassportNumber:       ticket.OwnerPassportNumber.String,
+			IdentificationNumber: ticket.OwnerIdentificationNumber.String,
+			Address:              ticket.OwnerAddress.String,
+		},
+	}
+}
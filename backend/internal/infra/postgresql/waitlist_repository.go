@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+type WaitlistRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewWaitlistRepositoryPostgres(store *db.Store) adapters.IWaitlistRepository {
+	return &WaitlistRepositoryPostgres{store: *store}
+}
+
+func (r *WaitlistRepositoryPostgres) CreateWaitlistEntry(ctx context.Context, flightID int64, class entities.FlightClass, email string) (entities.WaitlistEntry, error) {
+	row, err := r.store.CreateWaitlistEntry(ctx, db.CreateWaitlistEntryParams{
+		FlightID:    flightID,
+		FlightClass: db.FlightClass(class),
+		UserEmail:   email,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return entities.WaitlistEntry{}, adapters.ErrAlreadyWaitlisted
+		}
+		return entities.WaitlistEntry{}, fmt.Errorf("failed to join waitlist: %w", err)
+	}
+	return mapDBWaitlistEntryToEntity(row), nil
+}
+
+func (r *WaitlistRepositoryPostgres) ListWaitlistEntriesByEmail(ctx context.Context, email string) ([]entities.WaitlistEntry, error) {
+	rows, err := r.store.ListWaitlistEntriesByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist entries: %w", err)
+	}
+
+	entries := make([]entities.WaitlistEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, mapDBWaitlistEntryToEntity(row))
+	}
+	return entries, nil
+}
+
+func (r *WaitlistRepositoryPostgres) GetWaitlistEntryByClaimToken(ctx context.Context, claimToken string) (entities.WaitlistEntry, error) {
+	row, err := r.store.GetWaitlistEntryByClaimToken(ctx, pgtype.Text{String: claimToken, Valid: true})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.WaitlistEntry{}, adapters.ErrWaitlistEntryNotFound
+		}
+		return entities.WaitlistEntry{}, fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+	return mapDBWaitlistEntryToEntity(row), nil
+}
+
+func (r *WaitlistRepositoryPostgres) ClaimWaitlistOffer(ctx context.Context, entryID int64) (entities.WaitlistEntry, error) {
+	row, err := r.store.ClaimWaitlistOffer(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.WaitlistEntry{}, adapters.ErrWaitlistEntryNotFound
+		}
+		return entities.WaitlistEntry{}, fmt.Errorf("failed to claim waitlist offer: %w", err)
+	}
+	return mapDBWaitlistEntryToEntity(row), nil
+}
+
+func (r *WaitlistRepositoryPostgres) CancelWaitlistEntry(ctx context.Context, entryID int64, email string) (entities.WaitlistEntry, error) {
+	row, err := r.store.CancelWaitlistEntry(ctx, db.CancelWaitlistEntryParams{
+		ID:        entryID,
+		UserEmail: email,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.WaitlistEntry{}, adapters.ErrWaitlistEntryNotFound
+		}
+		return entities.WaitlistEntry{}, fmt.Errorf("failed to leave waitlist: %w", err)
+	}
+	return mapDBWaitlistEntryToEntity(row), nil
+}
+
+func mapDBWaitlistEntryToEntity(row db.WaitlistEntry) entities.WaitlistEntry {
+	entry := entities.WaitlistEntry{
+		WaitlistEntryID: row.ID,
+		FlightID:        row.FlightID,
+		FlightClass:     entities.FlightClass(row.FlightClass),
+		UserEmail:       row.UserEmail,
+		Status:          entities.WaitlistStatus(row.Status),
+		ClaimToken:      row.ClaimToken.String,
+		JoinedAt:        row.JoinedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}
+	if row.OfferExpiresAt.Valid {
+		expiresAt := row.OfferExpiresAt.Time
+		entry.OfferExpiresAt = &expiresAt
+	}
+	return entry
+}
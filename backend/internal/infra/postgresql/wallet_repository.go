@@ -0,0 +1,153 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type WalletRepositoryPostgres struct {
+	store db.Store
+}
+
+func NewWalletRepositoryPostgres(store *db.Store) adapters.IWalletRepository {
+	return &WalletRepositoryPostgres{store: *store}
+}
+
+func (r *WalletRepositoryPostgres) GetBalance(ctx context.Context, userID int64, now time.Time) (int64, error) {
+	customer, err := r.store.GetCustomer(ctx, userID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return 0, adapters.ErrCustomerNotFound
+		}
+		return 0, fmt.Errorf("failed to get wallet balance: %w", err)
+	}
+
+	// Tín dụng quá hạn chỉ được trừ khỏi số dư khi ví được dùng; ở đây chỉ không tính đến
+	expired, err := r.store.SumExpiredWalletCredit(ctx, db.SumExpiredWalletCreditParams{
+		UserID:    userID,
+		ExpiresAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum expired wallet credit: %w", err)
+	}
+	return max(customer.WalletBalance-expired, 0), nil
+}
+
+func (r *WalletRepositoryPostgres) ListTransactions(ctx context.Context, userID int64, limit int32, offset int32) (entities.WalletStatement, error) {
+	rows, err := r.store.ListWalletTransactions(ctx, db.ListWalletTransactionsParams{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return entities.WalletStatement{}, fmt.Errorf("failed to list wallet transactions: %w", err)
+	}
+	total, err := r.store.CountWalletTransactions(ctx, userID)
+	if err != nil {
+		return entities.WalletStatement{}, fmt.Errorf("failed to count wallet transactions: %w", err)
+	}
+
+	statement := entities.WalletStatement{
+		Transactions: make([]entities.WalletTransaction, 0, len(rows)),
+		Total:        total,
+	}
+	for _, row := range rows {
+		statement.Transactions = append(statement.Transactions, mapDBWalletTransactionToEntity(row))
+	}
+	return statement, nil
+}
+
+func (r *WalletRepositoryPostgres) PayBooking(ctx context.Context, arg entities.PayWithWalletParams) (entities.PayWithWalletResult, error) {
+	txResult, err := r.store.PayWithWalletTx(ctx, db.PayWithWalletTxParams{
+		UserID:    arg.UserID,
+		BookingID: arg.BookingID,
+		Amount:    arg.Amount,
+		AmountDue: arg.AmountDue,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.PayWithWalletResult{}, adapters.ErrBookingNotFound
+		}
+		return entities.PayWithWalletResult{}, err
+	}
+	return entities.PayWithWalletResult{
+		Transaction: mapDBWalletTransactionToEntity(txResult.Transaction),
+		Balance:     txResult.Balance,
+		AmountDue:   txResult.AmountDue,
+		Booking:     mapDBBookingToEntity(txResult.Booking),
+		Confirmed:   txResult.Confirmed,
+	}, nil
+}
+
+func (r *WalletRepositoryPostgres) GetPaidAmount(ctx context.Context, bookingID int64) (int64, error) {
+	amount, err := r.store.SumWalletPaidByBooking(ctx, pgtype.Int8{Int64: bookingID, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum wallet payments: %w", err)
+	}
+	return amount, nil
+}
+
+func (r *WalletRepositoryPostgres) IssueCredit(ctx context.Context, arg entities.IssueWalletCreditParams) (entities.WalletTransaction, error) {
+	if arg.UserID == 0 {
+		customer, err := r.store.GetCustomerByEmail(ctx, arg.Email)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return entities.WalletTransaction{}, adapters.ErrCustomerNotFound
+			}
+			return entities.WalletTransaction{}, fmt.Errorf("failed to get customer: %w", err)
+		}
+		arg.UserID = customer.UserID
+	}
+
+	credit, err := r.store.IssueWalletCreditTx(ctx, db.IssueWalletCreditTxParams{
+		UserID:      arg.UserID,
+		Kind:        entities.WalletGoodwill,
+		Amount:      arg.Amount,
+		BookingID:   arg.BookingID,
+		IssuedBy:    arg.IssuedBy,
+		Description: arg.Description,
+		ExpiresAt:   arg.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return entities.WalletTransaction{}, adapters.ErrCustomerNotFound
+		}
+		return entities.WalletTransaction{}, err
+	}
+	return mapDBWalletTransactionToEntity(credit), nil
+}
+
+func (r *WalletRepositoryPostgres) ListFlightCustomers(ctx context.Context, flightID int64) ([]entities.FlightWalletCredit, error) {
+	rows, err := r.store.ListFlightCustomerTickets(ctx, flightID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flight customers: %w", err)
+	}
+
+	customers := make([]entities.FlightWalletCredit, 0, len(rows))
+	for _, row := range rows {
+		customers = append(customers, entities.FlightWalletCredit{UserID: row.UserID, Tickets: row.Tickets})
+	}
+	return customers, nil
+}
+
+func mapDBWalletTransactionToEntity(row db.WalletTransaction) entities.WalletTransaction {
+	return entities.WalletTransaction{
+		TransactionID: row.ID,
+		Kind:          entities.WalletTransactionKind(row.Kind),
+		Amount:        row.Amount,
+		BookingID:     row.BookingID.Int64,
+		RefundID:      row.RefundID.Int64,
+		IssuedBy:      row.IssuedBy,
+		Description:   row.Description,
+		ExpiresAt:     fromPgTimestamptz(row.ExpiresAt),
+		CreatedAt:     row.CreatedAt,
+	}
+}
@@ -1,18 +1,25 @@
 package stripe
 
 import (
+	"encoding/json"
+
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 	"github.com/stripe/stripe-go/v74"
 	"github.com/stripe/stripe-go/v74/paymentintent"
+	"github.com/stripe/stripe-go/v74/refund"
+	"github.com/stripe/stripe-go/v74/webhook"
 )
 
-type StripeGateway struct{}
+type StripeGateway struct {
+	webhookSecret string
+}
 
-func NewStripeGateway(secretKey string) *StripeGateway {
+func NewStripeGateway(secretKey string, webhookSecret string) *StripeGateway {
 	stripe.Key = secretKey
-	return &StripeGateway{}
+	return &StripeGateway{webhookSecret: webhookSecret}
 }
 
-func (s *StripeGateway) CreatePaymentIntent(amount int64, currency string, metadata map[string]string) (string, error) {
+func (s *StripeGateway) CreatePaymentIntent(amount int64, currency string, metadata map[string]string) (entities.PaymentIntent, error) {
 	params := &stripe.PaymentIntentParams{
 		Amount:   stripe.Int64(amount),
 		Currency: stripe.String(currency),
@@ -21,8 +28,54 @@ func (s *StripeGateway) CreatePaymentIntent(amount int64, currency string, metad
 		params.AddMetadata(k, v)
 	}
 	intent, err := paymentintent.New(params)
+	if err != nil {
+		return entities.PaymentIntent{}, err
+	}
+	return entities.PaymentIntent{ID: intent.ID, ClientSecret: intent.ClientSecret}, nil
+}
+
+// CreateRefund refunds amount from the succeeded payment intent intentID.
+func (s *StripeGateway) CreateRefund(intentID string, amount int64, metadata map[string]string) (string, error) {
+	params := &stripe.RefundParams{
+		PaymentIntent: stripe.String(intentID),
+		Amount:        stripe.Int64(amount),
+	}
+	for k, v := range metadata {
+		params.AddMetadata(k, v)
+	}
+	r, err := refund.New(params)
 	if err != nil {
 		return "", err
 	}
-	return intent.ClientSecret, nil
+	return r.ID, nil
+}
+
+// ParseEvent verifies the Stripe-Signature header of a webhook payload and returns the
+// payment intent event it carries. A cancelled intent is reported as failed; a declined
+// card is not, since the customer may still complete the same intent.
+func (s *StripeGateway) ParseEvent(payload []byte, signature string) (entities.PaymentEvent, error) {
+	event, err := webhook.ConstructEvent(payload, signature, s.webhookSecret)
+	if err != nil {
+		return entities.PaymentEvent{}, err
+	}
+
+	var eventType entities.PaymentEventType
+	switch event.Type {
+	case "payment_intent.succeeded":
+		eventType = entities.PaymentEventSucceeded
+	case "payment_intent.canceled":
+		eventType = entities.PaymentEventFailed
+	default:
+		return entities.PaymentEvent{Type: entities.PaymentEventIgnored}, nil
+	}
+
+	var intent stripe.PaymentIntent
+	if err := json.Unmarshal(event.Data.Raw, &intent); err != nil {
+		return entities.PaymentEvent{}, err
+	}
+	return entities.PaymentEvent{
+		Type:           eventType,
+		IntentID:       intent.ID,
+		AmountReceived: intent.AmountReceived,
+	}, nil
 }
@@ -12,6 +12,21 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskOfferWaitlistSeat(
+		ctx context.Context,
+		payload *PayloadOfferWaitlistSeat,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskReleaseGroupBooking(
+		ctx context.Context,
+		payload *PayloadReleaseGroupBooking,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskAccrueLoyaltyPoints(
+		ctx context.Context,
+		payload *PayloadAccrueLoyaltyPoints,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type LoyaltyScheduler struct {
+	distributor TaskDistributor
+}
+
+func NewLoyaltyScheduler(distributor TaskDistributor) adapters.ILoyaltyScheduler {
+	return &LoyaltyScheduler{distributor: distributor}
+}
+
+// ScheduleAccrual enqueues the accrual task of flight for when it lands. Only one task is
+// kept per flight, so a task already scheduled is not an error.
+func (s *LoyaltyScheduler) ScheduleAccrual(ctx context.Context, flight entities.Flight) error {
+	err := s.distributor.DistributeTaskAccrueLoyaltyPoints(ctx,
+		&PayloadAccrueLoyaltyPoints{FlightID: flight.FlightID},
+		asynq.TaskID(AccrueLoyaltyPointsTaskID(flight.FlightID)),
+		asynq.ProcessAt(flight.ArrivalTime),
+		asynq.MaxRetry(10),
+		asynq.Queue(QueueDefault),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
@@ -2,10 +2,12 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
 	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
 	"github.com/spaghetti-lover/qairlines/internal/infra/mail"
 )
 
@@ -18,15 +20,27 @@ type TaskProcessor interface {
 	Start() error
 	Shutdown()
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskOfferWaitlistSeat(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReleaseGroupBooking(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAccrueLoyaltyPoints(ctx context.Context, task *asynq.Task) error
+	ProcessTaskRecalculateLoyaltyTiers(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
 	server *asynq.Server
 	store  db.Store
 	mailer mail.EmailSender
+	// distributor dùng để lên lịch các task nối tiếp, ví dụ hết hạn lời mời danh sách chờ
+	distributor      TaskDistributor
+	waitlistOfferTTL time.Duration
+	waitlistClaimURL string
+	loyaltyRules     entities.LoyaltyRules
+	tierPolicy       entities.TierPolicy
+	// cabinLayout cho biết số ghế của từng hạng, dùng khi mời khách trong danh sách chờ
+	cabinLayout entities.CabinLayout
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender, distributor TaskDistributor, waitlistOfferTTL time.Duration, waitlistClaimURL string, loyaltyRules entities.LoyaltyRules, tierPolicy entities.TierPolicy, cabinLayout entities.CabinLayout) TaskProcessor {
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
@@ -44,9 +58,15 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 		},
 	)
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		server:           server,
+		store:            store,
+		mailer:           mailer,
+		distributor:      distributor,
+		waitlistOfferTTL: waitlistOfferTTL,
+		waitlistClaimURL: waitlistClaimURL,
+		loyaltyRules:     loyaltyRules,
+		tierPolicy:       tierPolicy,
+		cabinLayout:      cabinLayout,
 	}
 }
 
@@ -54,6 +74,10 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskOfferWaitlistSeat, processor.ProcessTaskOfferWaitlistSeat)
+	mux.HandleFunc(TaskReleaseGroupBooking, processor.ProcessTaskReleaseGroupBooking)
+	mux.HandleFunc(TaskAccrueLoyaltyPoints, processor.ProcessTaskAccrueLoyaltyPoints)
+	mux.HandleFunc(TaskRecalculateLoyaltyTiers, processor.ProcessTaskRecalculateLoyaltyTiers)
 
 	return processor.server.Start(mux)
 }
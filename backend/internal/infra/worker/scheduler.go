@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// TaskScheduler enqueues the tasks that run on a fixed interval rather than in response
+// to a request.
+type TaskScheduler interface {
+	Start() error
+	Shutdown()
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+	// tierRecalculationInterval là chu kỳ xét lại hạng thành viên
+	tierRecalculationInterval time.Duration
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt, tierRecalculationInterval time.Duration) TaskScheduler {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Location: time.UTC,
+	})
+	return &RedisTaskScheduler{
+		scheduler:                 scheduler,
+		tierRecalculationInterval: tierRecalculationInterval,
+	}
+}
+
+// Start registers the periodic tasks and starts enqueuing them. A task is unique for its
+// interval, so several API instances running a scheduler still enqueue it once.
+func (s *RedisTaskScheduler) Start() error {
+	payload, err := json.Marshal(&PayloadRecalculateLoyaltyTiers{})
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+	_, err = s.scheduler.Register(
+		fmt.Sprintf("@every %s", s.tierRecalculationInterval),
+		asynq.NewTask(TaskRecalculateLoyaltyTiers, payload),
+		asynq.MaxRetry(3),
+		asynq.Queue(QueueDefault),
+		asynq.Unique(s.tierRecalculationInterval),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register loyalty tier recalculation: %w", err)
+	}
+	return s.scheduler.Start()
+}
+
+func (s *RedisTaskScheduler) Shutdown() {
+	s.scheduler.Shutdown()
+}
@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+)
+
+// PayloadAccrueLoyaltyPoints identifies a flight whose passengers earn points once it lands.
+type PayloadAccrueLoyaltyPoints struct {
+	FlightID int64 `json:"flight_id"`
+}
+
+const TaskAccrueLoyaltyPoints = "task:accrue_loyalty_points"
+
+// AccrueLoyaltyPointsTaskID is the task ID that keeps a single pending accrual task per flight.
+func AccrueLoyaltyPointsTaskID(flightID int64) string {
+	return fmt.Sprintf("accrue-loyalty-points-%d", flightID)
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskAccrueLoyaltyPoints(
+	ctx context.Context,
+	payload *PayloadAccrueLoyaltyPoints,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+	task := asynq.NewTask(TaskAccrueLoyaltyPoints, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskAccrueLoyaltyPoints credits the passengers of a landed flight. A flight that
+// was delayed past the scheduled run is checked again at its new arrival time, and a
+// cancelled flight earns nothing. Tickets already credited are skipped, so running the
+// task twice is harmless.
+func (processor *RedisTaskProcessor) ProcessTaskAccrueLoyaltyPoints(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadAccrueLoyaltyPoints
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	flight, err := processor.store.GetFlight(ctx, payload.FlightID)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return fmt.Errorf("flight %d not found: %w", payload.FlightID, asynq.SkipRetry)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get flight: %w", err)
+	}
+	if flight.Status == db.FlightStatusCancelled {
+		log.Info().Str("type", task.Type()).
+			Bytes("payload", task.Payload()).
+			Msg("cancelled flight earns no points")
+		return nil
+	}
+
+	now := time.Now()
+	if flight.ArrivalTime.After(now) {
+		err := processor.distributor.DistributeTaskAccrueLoyaltyPoints(ctx, &payload,
+			asynq.ProcessAt(flight.ArrivalTime),
+			asynq.MaxRetry(10),
+			asynq.Queue(QueueDefault),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to reschedule points accrual: %w", err)
+		}
+		return nil
+	}
+
+	result, err := processor.store.AccrueFlightLoyaltyTx(ctx, db.AccrueFlightLoyaltyTxParams{
+		FlightID: payload.FlightID,
+		Rules:    processor.loyaltyRules,
+		Now:      now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to accrue loyalty points: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Int("credited_tickets", len(result.Accruals)).
+		Msg("processed task")
+	return nil
+}
@@ -0,0 +1,163 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// PayloadOfferWaitlistSeat identifies the cabin in which a seat has become free.
+type PayloadOfferWaitlistSeat struct {
+	FlightID    int64  `json:"flight_id"`
+	FlightClass string `json:"flight_class"`
+	// ExpiredEntryID là lượt chờ có lời mời hết hạn, 0 khi ghế được giải phóng do huỷ vé
+	ExpiredEntryID int64 `json:"expired_entry_id,omitempty"`
+	// OfferKey định danh ghế được giải phóng, ví dụ WaitlistTicketOfferKey của vé vừa huỷ
+	OfferKey string `json:"offer_key"`
+}
+
+const TaskOfferWaitlistSeat = "task:offer_waitlist_seat"
+
+// WaitlistTicketOfferKey is the offer key of the seat freed by cancelling a ticket.
+func WaitlistTicketOfferKey(ticketID int64) string {
+	return fmt.Sprintf("ticket:%d", ticketID)
+}
+
+// WaitlistEntryOfferKey is the offer key of the seat handed on when the offer made to a
+// waitlist entry expires unbooked. It is also the ID of the task scheduled for then.
+func WaitlistEntryOfferKey(entryID int64) string {
+	return fmt.Sprintf("entry:%d", entryID)
+}
+
+// WaitlistDeclinedOfferKey is the offer key of the seat handed on when the customer of a
+// waitlist entry leaves the waitlist while holding an offer.
+func WaitlistDeclinedOfferKey(entryID int64) string {
+	return fmt.Sprintf("declined:%d", entryID)
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskOfferWaitlistSeat(
+	ctx context.Context,
+	payload *PayloadOfferWaitlistSeat,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+	task := asynq.NewTask(TaskOfferWaitlistSeat, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskOfferWaitlistSeat offers the freed seat to the next waitlisted customer by
+// email and schedules the same task to run again when the offer expires, so that an
+// unclaimed seat moves down the waitlist. A retry after the offer was recorded sends
+// the email of that offer again instead of inviting another customer.
+func (processor *RedisTaskProcessor) ProcessTaskOfferWaitlistSeat(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadOfferWaitlistSeat
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	flight, err := processor.store.GetFlight(ctx, payload.FlightID)
+	if err != nil {
+		return fmt.Errorf("failed to get flight: %w", err)
+	}
+	capacity := processor.cabinLayout.Capacity(entities.Flight{
+		TotalSeatsRow:    flight.TotalSeatsRow,
+		TotalSeatsColumn: flight.TotalSeatsColumn,
+	}, entities.FlightClass(payload.FlightClass))
+
+	var priorityTiers []string
+	for _, tier := range processor.tierPolicy.WaitlistPriorityTiers() {
+		priorityTiers = append(priorityTiers, string(tier))
+	}
+
+	result, err := processor.store.OfferWaitlistSeatTx(ctx, db.OfferWaitlistSeatTxParams{
+		FlightID:       payload.FlightID,
+		FlightClass:    db.FlightClass(payload.FlightClass),
+		Capacity:       capacity,
+		ExpiredEntryID: payload.ExpiredEntryID,
+		OfferKey:       payload.OfferKey,
+		ClaimToken:     uuid.NewString(),
+		ExpiresAt:      time.Now().Add(processor.waitlistOfferTTL),
+		PriorityTiers:  priorityTiers,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to offer waitlist seat: %w", err)
+	}
+	if result.Entry == nil {
+		log.Info().Str("type", task.Type()).
+			Bytes("payload", task.Payload()).
+			Msg("no waitlisted customer to offer the seat to")
+		return nil
+	}
+	entry := result.Entry
+	expiresAt := entry.OfferExpiresAt.Time
+
+	claimLink := processor.waitlistClaimURL + "?token=" + url.QueryEscape(entry.ClaimToken.String)
+	subject := "Ghế trống cho chuyến bay " + flight.FlightNumber
+	content := fmt.Sprintf(
+		`<html>
+			<body>
+				<h2>Xin chào,</h2>
+				<p>Một ghế hạng <b>%s</b> trên chuyến bay <b>%s</b> (%s - %s) khởi hành lúc %s vừa được giải phóng.</p>
+				<p>Bạn là người tiếp theo trong danh sách chờ. Vui lòng nhận ghế trước <b>%s</b>:</p>
+				<p><a href="%s">Nhận ghế</a></p>
+				<p>Sau thời hạn này, ghế sẽ được dành cho khách hàng tiếp theo.</p>
+				<br>
+				<p>Trân trọng,<br>
+				<b>Đội ngũ Qairlines</b></p>
+			</body>
+			</html>`,
+		html.EscapeString(string(entry.FlightClass)),
+		html.EscapeString(flight.FlightNumber),
+		html.EscapeString(flight.DepartureCity.String),
+		html.EscapeString(flight.ArrivalCity.String),
+		flight.DepartureTime.Format("02/01/2006 15:04"),
+		expiresAt.Format("02/01/2006 15:04"),
+		html.EscapeString(claimLink),
+	)
+	if err := processor.mailer.SendEmail(subject, content, []string{entry.UserEmail}, nil, nil, nil); err != nil {
+		return fmt.Errorf("failed to send waitlist offer email: %w", err)
+	}
+
+	// Lên lịch chuyển ghế cho khách tiếp theo nếu lời mời hết hạn mà chưa được đặt chỗ;
+	// lần chạy lại của task không lên lịch thêm task thứ hai
+	offerKey := WaitlistEntryOfferKey(entry.ID)
+	err = processor.distributor.DistributeTaskOfferWaitlistSeat(ctx, &PayloadOfferWaitlistSeat{
+		FlightID:       payload.FlightID,
+		FlightClass:    payload.FlightClass,
+		ExpiredEntryID: entry.ID,
+		OfferKey:       offerKey,
+	}, asynq.TaskID(offerKey), asynq.ProcessAt(expiresAt), asynq.Queue(QueueDefault))
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return err
+	}
+
+	log.Info().Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Int64("waitlist_entry_id", entry.ID).
+		Msg("processed task")
+	return nil
+}
@@ -0,0 +1,161 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// PayloadRecalculateLoyaltyTiers starts a pass over every customer's tier; the window
+// always ends when the task runs, so it carries no data.
+type PayloadRecalculateLoyaltyTiers struct{}
+
+const TaskRecalculateLoyaltyTiers = "task:recalculate_loyalty_tiers"
+
+// loyaltyTierBatchSize is how many customers are read per query during a recalculation.
+const loyaltyTierBatchSize = 500
+
+// ProcessTaskRecalculateLoyaltyTiers moves every customer to the tier their qualifying
+// points of the rolling window earn, up or down, and emails those whose tier changed.
+// Customers are walked in user ID order, so a retried task only redoes what is left
+// to change.
+func (processor *RedisTaskProcessor) ProcessTaskRecalculateLoyaltyTiers(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadRecalculateLoyaltyTiers
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	since := processor.tierPolicy.WindowStart(time.Now())
+	var lastUserID int64
+	changed := 0
+	for {
+		candidates, err := processor.store.ListLoyaltyTierCandidates(ctx, db.ListLoyaltyTierCandidatesParams{
+			CreatedAt: since,
+			UserID:    lastUserID,
+			Limit:     loyaltyTierBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list loyalty tier candidates: %w", err)
+		}
+
+		for _, candidate := range candidates {
+			lastUserID = candidate.UserID
+			change := entities.TierChange{
+				UserID: candidate.UserID,
+				From:   entities.LoyaltyTier(candidate.LoyaltyTier),
+				To:     processor.tierPolicy.TierFor(candidate.QualifyingPoints),
+			}
+			if change.From == change.To {
+				continue
+			}
+			err := processor.store.UpdateCustomerLoyaltyTier(ctx, db.UpdateCustomerLoyaltyTierParams{
+				UserID:      change.UserID,
+				LoyaltyTier: string(change.To),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update loyalty tier: %w", err)
+			}
+			changed++
+
+			// Hạng đã được cập nhật nên lỗi gửi email chỉ được ghi log, không chạy lại task
+			subject, content := loyaltyTierChangeEmail(candidate.FirstName.String, change, candidate.QualifyingPoints, processor.tierPolicy)
+			if err := processor.mailer.SendEmail(subject, content, []string{candidate.Email}, nil, nil, nil); err != nil {
+				log.Error().Err(err).Int64("user_id", change.UserID).Msg("failed to send loyalty tier email")
+			}
+		}
+
+		if len(candidates) < loyaltyTierBatchSize {
+			break
+		}
+	}
+
+	log.Info().Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Int("changed_tiers", changed).
+		Msg("processed task")
+	return nil
+}
+
+// loyaltyTierChangeEmail renders the email telling a customer about their new tier.
+func loyaltyTierChangeEmail(firstName string, change entities.TierChange, points int32, policy entities.TierPolicy) (string, string) {
+	subject := fmt.Sprintf("Hạng thành viên Qairlines của bạn đã chuyển sang %s", tierName(change.To))
+	headline := fmt.Sprintf("Chúc mừng! Bạn đã được nâng lên hạng <b>%s</b>.", tierName(change.To))
+	if !change.Upgrade() {
+		headline = fmt.Sprintf("Hạng thành viên của bạn đã được điều chỉnh từ <b>%s</b> xuống <b>%s</b>.", tierName(change.From), tierName(change.To))
+	}
+	content := fmt.Sprintf(
+		`<html>
+			<body>
+				<h2>Xin chào %s,</h2>
+				<p>%s</p>
+				<p><strong>Điểm xét hạng trong %s gần nhất:</strong> %d</p>
+				<p><strong>Quyền lợi của hạng:</strong></p>
+				<ul>%s</ul>
+				<br>
+				<p>Trân trọng,<br>
+				<b>Đội ngũ Qairlines</b></p>
+			</body>
+			</html>`,
+		html.EscapeString(firstName),
+		headline,
+		formatTierWindow(policy.Window),
+		points,
+		formatTierBenefits(policy.Benefits(change.To)),
+	)
+	return subject, content
+}
+
+func tierName(tier entities.LoyaltyTier) string {
+	name := string(tier)
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// formatTierWindow renders the qualifying window in whole months when it is a multiple
+// of 30 days, and in days otherwise; 365 days read as 12 months.
+func formatTierWindow(window time.Duration) string {
+	days := int(window / (24 * time.Hour))
+	switch {
+	case days > 0 && days%365 == 0:
+		return fmt.Sprintf("%d tháng", days/365*12)
+	case days > 0 && days%30 == 0:
+		return fmt.Sprintf("%d tháng", days/30)
+	case days > 0:
+		return fmt.Sprintf("%d ngày", days)
+	}
+	return fmt.Sprintf("%d giờ", int(window/time.Hour))
+}
+
+// formatTierBenefits renders one <li> per benefit of the tier.
+func formatTierBenefits(benefits entities.TierBenefits) string {
+	var sb strings.Builder
+	if benefits.ExtraCheckedBaggageKg > 0 {
+		fmt.Fprintf(&sb, "<li>Thêm %dkg hành lý ký gửi</li>", benefits.ExtraCheckedBaggageKg)
+	}
+	if benefits.FreeSeatSelection {
+		sb.WriteString("<li>Miễn phí chọn ghế</li>")
+	}
+	if benefits.WaitlistPriority {
+		sb.WriteString("<li>Ưu tiên trong danh sách chờ</li>")
+	}
+	if benefits.PriorityBoarding {
+		sb.WriteString("<li>Lên máy bay ưu tiên</li>")
+	}
+	if benefits.LoungeAccess {
+		sb.WriteString("<li>Sử dụng phòng chờ hạng thương gia</li>")
+	}
+	if sb.Len() == 0 {
+		sb.WriteString("<li>Tích điểm trên mọi chuyến bay</li>")
+	}
+	return sb.String()
+}
@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/spaghetti-lover/qairlines/db/sqlc"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+// PayloadReleaseGroupBooking identifies a quoted group whose deposit deadline has come.
+type PayloadReleaseGroupBooking struct {
+	GroupBookingID int64 `json:"group_booking_id"`
+	// DepositDeadline là hạn cọc của báo giá đã lên lịch task này
+	DepositDeadline time.Time `json:"deposit_deadline"`
+}
+
+const TaskReleaseGroupBooking = "task:release_group_booking"
+
+func (distributor *RedisTaskDistributor) DistributeTaskReleaseGroupBooking(
+	ctx context.Context,
+	payload *PayloadReleaseGroupBooking,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+	task := asynq.NewTask(TaskReleaseGroupBooking, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().
+		Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).
+		Int("max_retry", info.MaxRetry).
+		Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskReleaseGroupBooking gives back the seats of a group that did not pay its
+// deposit in time. A group that paid, or was quoted again with a later deadline, is left
+// untouched. When a previous attempt released the group but failed to notify, the
+// notifications are sent again. The freed seats are offered to the waitlists of the group's flights.
+func (processor *RedisTaskProcessor) ProcessTaskReleaseGroupBooking(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadReleaseGroupBooking
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	group, err := processor.store.ReleaseGroupBooking(ctx, payload.GroupBookingID)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		// Lần chạy trước có thể đã trả chỗ rồi lỗi khi gửi thông báo; khi đó gửi lại
+		group, err = processor.store.GetGroupBooking(ctx, payload.GroupBookingID)
+		if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get group booking: %w", err)
+		}
+		if err != nil || !releasedByPayload(group, payload) {
+			log.Info().Str("type", task.Type()).
+				Bytes("payload", task.Payload()).
+				Msg("group booking no longer needs releasing")
+			return nil
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to release group booking: %w", err)
+	}
+
+	for _, flightID := range []int64{group.OutboundFlightID.Int64, group.ReturnFlightID.Int64} {
+		if flightID == 0 {
+			continue
+		}
+		err := processor.distributor.DistributeTaskOfferWaitlistSeat(ctx, &PayloadOfferWaitlistSeat{
+			FlightID:    flightID,
+			FlightClass: string(group.FlightClass),
+		}, asynq.MaxRetry(10), asynq.Queue(QueueDefault))
+		if err != nil {
+			log.Error().Err(err).Int64("flight_id", flightID).Msg("failed to enqueue waitlist offer")
+		}
+	}
+
+	subject := fmt.Sprintf("Yêu cầu đặt chỗ đoàn #%d đã hết hạn giữ chỗ", group.ID)
+	content := fmt.Sprintf(
+		`<html>
+			<body>
+				<h2>Xin chào,</h2>
+				<p>Đoàn <b>#%d</b> (%d khách, %s - %s) chưa đặt cọc trước hạn nên các chỗ đã giữ cho đoàn đã được trả lại.</p>
+				<p>Nếu bạn vẫn muốn đi theo đoàn, vui lòng gửi yêu cầu mới.</p>
+				<br>
+				<p>Trân trọng,<br>
+				<b>Đội ngũ Qairlines</b></p>
+			</body>
+			</html>`,
+		group.ID,
+		group.Headcount,
+		html.EscapeString(group.DepartureCity),
+		html.EscapeString(group.ArrivalCity),
+	)
+	if err := processor.mailer.SendEmail(subject, content, []string{group.UserEmail}, nil, nil, nil); err != nil {
+		return fmt.Errorf("failed to send group release email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).
+		Bytes("payload", task.Payload()).
+		Msg("processed task")
+	return nil
+}
+
+// releasedByPayload reports whether group was released for the quote that scheduled payload.
+func releasedByPayload(group db.GroupBooking, payload PayloadReleaseGroupBooking) bool {
+	return group.Status == string(entities.GroupBookingStatusReleased) &&
+		group.DepositDeadline.Valid &&
+		!payload.DepositDeadline.IsZero() &&
+		group.DepositDeadline.Time.Equal(payload.DepositDeadline.Truncate(time.Microsecond))
+}
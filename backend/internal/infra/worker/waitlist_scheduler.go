@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	"github.com/spaghetti-lover/qairlines/internal/domain/adapters"
+	"github.com/spaghetti-lover/qairlines/internal/domain/entities"
+)
+
+type WaitlistScheduler struct {
+	distributor TaskDistributor
+}
+
+func NewWaitlistScheduler(distributor TaskDistributor) adapters.IWaitlistScheduler {
+	return &WaitlistScheduler{distributor: distributor}
+}
+
+// OfferTicketSeat enqueues the offer of the seat freed by ticket, keyed by the ticket so
+// that the seat is offered once however often the task runs.
+func (s *WaitlistScheduler) OfferTicketSeat(ctx context.Context, ticket entities.Ticket) error {
+	if ticket.SeatID == 0 {
+		return nil
+	}
+	return s.distributor.DistributeTaskOfferWaitlistSeat(ctx, &PayloadOfferWaitlistSeat{
+		FlightID:    ticket.FlightID,
+		FlightClass: string(ticket.FlightClass),
+		OfferKey:    WaitlistTicketOfferKey(ticket.TicketID),
+	}, asynq.MaxRetry(10), asynq.Queue(QueueDefault))
+}
+
+// OfferDeclinedSeat enqueues the offer of the seat entry held before its customer left.
+func (s *WaitlistScheduler) OfferDeclinedSeat(ctx context.Context, entry entities.WaitlistEntry) error {
+	return s.distributor.DistributeTaskOfferWaitlistSeat(ctx, &PayloadOfferWaitlistSeat{
+		FlightID:    entry.FlightID,
+		FlightClass: string(entry.FlightClass),
+		OfferKey:    WaitlistDeclinedOfferKey(entry.WaitlistEntryID),
+	}, asynq.MaxRetry(10), asynq.Queue(QueueDefault))
+}
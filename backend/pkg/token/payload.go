@@ -19,6 +19,9 @@ type TokenType byte
 const (
 	TokenTypeAccessToken  = 1
 	TokenTypeRefreshToken = 2
+	// TokenTypeManageBooking is issued by the manage-booking lookup. Its UserId holds
+	// the booking ID, so it only grants access to that single booking.
+	TokenTypeManageBooking = 3
 )
 
 // Payload contains the payload data of the token
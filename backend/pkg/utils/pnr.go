@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// PNRLength is the number of characters in a booking record locator
+const PNRLength = 6
+
+// pnrAlphabet leaves out 0, O, 1, I and L so a PNR can be read over the phone
+const pnrAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// GeneratePNR returns a random 6-character record locator
+func GeneratePNR() (string, error) {
+	max := big.NewInt(int64(len(pnrAlphabet)))
+	var sb strings.Builder
+	for i := 0; i < PNRLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate PNR: %w", err)
+		}
+		sb.WriteByte(pnrAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// NormalizePNR upper-cases and trims a PNR typed by a customer
+func NormalizePNR(pnr string) string {
+	return strings.ToUpper(strings.TrimSpace(pnr))
+}
+
+// IsValidPNR reports whether pnr has the shape of a record locator produced by GeneratePNR
+func IsValidPNR(pnr string) bool {
+	if len(pnr) != PNRLength {
+		return false
+	}
+	for i := 0; i < len(pnr); i++ {
+		if !strings.ContainsRune(pnrAlphabet, rune(pnr[i])) {
+			return false
+		}
+	}
+	return true
+}
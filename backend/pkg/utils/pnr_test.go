@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePNR(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		pnr, err := GeneratePNR()
+		require.NoError(t, err)
+		assert.Len(t, pnr, PNRLength)
+		assert.True(t, IsValidPNR(pnr), "Generated invalid PNR: %s", pnr)
+		seen[pnr] = true
+	}
+	assert.Greater(t, len(seen), 990)
+}
+
+func TestIsValidPNR(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"ABC234", true},
+		{"XYZ789", true},
+		{"ABC23", false},
+		{"ABC2345", false},
+		{"ABCD0E", false},
+		{"ABCDOE", false},
+		{"ABCD1E", false},
+		{"ABCDIE", false},
+		{"ABCDLE", false},
+		{"abc234", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, IsValidPNR(test.input), "Failed for input: %s", test.input)
+	}
+}
+
+func TestNormalizePNR(t *testing.T) {
+	assert.Equal(t, "ABC234", NormalizePNR("  abc234 "))
+}
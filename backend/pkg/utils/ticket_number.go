@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TicketNumberLength is the length of an e-ticket number: 3-digit airline prefix,
+// 9-digit serial and 1 check digit
+const TicketNumberLength = 13
+
+const maxTicketSerial = 999_999_999
+
+// TicketNumberCheckDigit returns the check digit of a serial. It follows the IATA
+// modulus 7 scheme for accountable documents: the serial number (without the airline
+// prefix) divided by 7, the remainder being the check digit, so it is always 0-6
+func TicketNumberCheckDigit(serial int64) int64 {
+	return serial % 7
+}
+
+// FormatTicketNumber builds a 13-digit e-ticket number from the airline prefix and a serial
+func FormatTicketNumber(prefix string, serial int64) (string, error) {
+	if len(prefix) != 3 || !isDigits(prefix) {
+		return "", fmt.Errorf("airline ticket prefix must be 3 digits, got %q", prefix)
+	}
+	if serial <= 0 || serial > maxTicketSerial {
+		return "", fmt.Errorf("ticket serial %d is out of range", serial)
+	}
+	return fmt.Sprintf("%s%09d%d", prefix, serial, TicketNumberCheckDigit(serial)), nil
+}
+
+// NormalizeTicketNumber strips the spaces and dashes people type between the number groups
+func NormalizeTicketNumber(number string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(number))
+}
+
+// IsValidTicketNumber reports whether number is 13 digits with a matching check digit
+func IsValidTicketNumber(number string) bool {
+	if len(number) != TicketNumberLength || !isDigits(number) {
+		return false
+	}
+	serial, err := strconv.ParseInt(number[3:12], 10, 64)
+	if err != nil {
+		return false
+	}
+	return int64(number[12]-'0') == TicketNumberCheckDigit(serial)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTicketNumber(t *testing.T) {
+	tests := []struct {
+		prefix   string
+		serial   int64
+		expected string
+	}{
+		{"888", 1, "8880000000011"},
+		{"888", 7, "8880000000070"},
+		{"738", 123456789, "7381234567891"},
+		{"738", 999999999, "7389999999995"},
+	}
+
+	for _, test := range tests {
+		number, err := FormatTicketNumber(test.prefix, test.serial)
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, number)
+		assert.True(t, IsValidTicketNumber(number), "Generated invalid number: %s", number)
+	}
+}
+
+func TestFormatTicketNumber_InvalidInput(t *testing.T) {
+	_, err := FormatTicketNumber("88", 1)
+	assert.Error(t, err)
+	_, err = FormatTicketNumber("8a8", 1)
+	assert.Error(t, err)
+	_, err = FormatTicketNumber("888", 0)
+	assert.Error(t, err)
+	_, err = FormatTicketNumber("888", 1_000_000_000)
+	assert.Error(t, err)
+}
+
+func TestIsValidTicketNumber(t *testing.T) {
+	assert.True(t, IsValidTicketNumber("7381234567891"))
+	assert.False(t, IsValidTicketNumber("7381234567890"))
+	assert.False(t, IsValidTicketNumber("738123456789"))
+	assert.False(t, IsValidTicketNumber("73812345678a0"))
+	assert.Equal(t, "7381234567890", NormalizeTicketNumber(" 738-123456789-0 "))
+}
@@ -11,7 +11,7 @@ const FlightBookingTabs = () => {
 
   // State cho các tab khác nhau
   const [bookingID, setBookingID] = useState(''); // Quản lý mã đặt chỗ
-  const [email, setEmail] = useState('');
+  const [lastName, setLastName] = useState(''); // Họ của một hành khách trong booking
 
   const handleSearch = (data) => {
     router.push({
@@ -28,8 +28,8 @@ const FlightBookingTabs = () => {
   };
 
   const handleBookingManagement = () => {
-    if (!bookingID || !email) {
-      alert('Vui lòng nhập mã đặt chỗ và email!');
+    if (!bookingID || !lastName) {
+      alert('Vui lòng nhập mã đặt chỗ và họ hành khách!');
       return;
     }
 
@@ -37,14 +37,14 @@ const FlightBookingTabs = () => {
       pathname: '/booking-management',
       query: {
         bookingID: bookingID,
-        email: email,
+        lastName: lastName,
       },
     });
   };
 
   const handleCheckIn = () => {
-    if (!bookingID || !email) {
-      alert('Vui lòng nhập mã đặt chỗ và email!');
+    if (!bookingID || !lastName) {
+      alert('Vui lòng nhập mã đặt chỗ và họ hành khách!');
       return;
     }
 
@@ -52,7 +52,7 @@ const FlightBookingTabs = () => {
       pathname: '/check-in',
       query: {
         bookingID: bookingID,
-        email: email,
+        lastName: lastName,
       },
     });
   };
@@ -95,14 +95,14 @@ const FlightBookingTabs = () => {
           <TabsContent value="manage" className="mt-2">
             <div className="flex flex-col gap-2">
               <Input
-                placeholder="Mã đặt chỗ/Số vé điện tử"
+                placeholder="Mã đặt chỗ"
                 value={bookingID}
                 onChange={(e) => setBookingID(e.target.value)}
               />
               <Input
-                placeholder="Hòm thư điện tử"
-                value={email}
-                onChange={(e) => setEmail(e.target.value)}
+                placeholder="Họ hành khách"
+                value={lastName}
+                onChange={(e) => setLastName(e.target.value)}
               />
               <Button
                 className="w-full bg-orange"
@@ -122,9 +122,9 @@ const FlightBookingTabs = () => {
                 onChange={(e) => setBookingID(e.target.value)}
               />
               <Input
-                placeholder="Hòm thư điện tử"
-                value={email}
-                onChange={(e) => setEmail(e.target.value)}
+                placeholder="Họ hành khách"
+                value={lastName}
+                onChange={(e) => setLastName(e.target.value)}
               />
               <Button
                 className="w-full bg-orange"
@@ -83,9 +83,12 @@ export function PaymentStep({
     if (bookingId && amount && currency) {
       fetch(`${process.env.NEXT_PUBLIC_API_BASE_URL}/api/payment-intents`, {
         method: "POST",
-        headers: { "Content-Type": "application/json" },
+        headers: {
+          "Content-Type": "application/json",
+          "Idempotency-Key": `payment-intent-${bookingId}-${amount}-${currency}`,
+        },
         body: JSON.stringify({
-          booking_id: parseInt(bookingId),
+          booking_id: bookingId,
           amount: parseInt(amount),
           currency: currency,
         }),
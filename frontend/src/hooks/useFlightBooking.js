@@ -6,7 +6,7 @@ import { useToast } from '@/hooks/use-toast';
 
 const API_BASE_URL = process.env.NEXT_PUBLIC_API_BASE_URL;
 
-export function useFlightBooking(bookingID) {
+export function useFlightBooking(bookingID, lastName) {
   const [bookingData, setBookingData] = useState(null);
   const [departureTicketData, setDepartureTicketData] = useState([]);
   const [returnTicketData, setReturnTicketData] = useState([]);
@@ -20,7 +20,7 @@ export function useFlightBooking(bookingID) {
   const { toast } = useToast();
 
   useEffect(() => {
-    if (!bookingID) return;
+    if (!bookingID || !lastName) return;
     const token = localStorage.getItem('token');
 
     if (!token) {
@@ -30,7 +30,7 @@ export function useFlightBooking(bookingID) {
 
     // Fetch booking data
     axios
-      .get(`${API_BASE_URL}/api/booking?id=${bookingID}`, {
+      .get(`${API_BASE_URL}/api/booking?id=${encodeURIComponent(bookingID)}&lastName=${encodeURIComponent(lastName)}`, {
         headers: {
           Authorization: `Bearer ${token}`,
         },
@@ -126,7 +126,7 @@ export function useFlightBooking(bookingID) {
         setError('Không thể tải dữ liệu đặt chỗ. Vui lòng thử lại sau.');
         console.error(err);
       });
-  }, [bookingID]);
+  }, [bookingID, lastName]);
 
   const handleViewTicket = (ticket) => {
     const translatedClass =
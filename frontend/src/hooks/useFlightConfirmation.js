@@ -60,6 +60,8 @@ export function useFlightConfirmation() {
   const [error, setError] = useState(null);
   const [isPassengerInfoOpen, setIsPassengerInfoOpen] = useState(false);
   const [bookingId, setBookingId] = useState(null);
+  // Một key cho mỗi lần xác nhận đặt vé, để bấm "Đặt vé" nhiều lần không tạo ra nhiều booking
+  const [bookingIdempotencyKey] = useState(() => crypto.randomUUID());
 
   const { personalInfo, loading: accountLoading } = useAccountInfo();
 
@@ -212,6 +214,7 @@ export function useFlightConfirmation() {
         headers: {
           "Content-Type": "application/json",
           Authorization: `Bearer ${localStorage.getItem("token")}`,
+          "Idempotency-Key": bookingIdempotencyKey,
         },
         body: JSON.stringify(bookingData),
       });
@@ -221,10 +224,11 @@ export function useFlightConfirmation() {
         throw new Error(errorData.message || "Lỗi khi tạo booking.");
       }
       const result = await response.json();
-      setBookingId(result.data.bookingId);
+      // Khách chỉ dùng mã PNR để tra cứu, check-in và thanh toán
+      setBookingId(result.data.pnr);
       toast({
         title: "Đặt vé thành công",
-        description: `Mã đặt vé của bạn là: ${result.data.bookingId}`,
+        description: `Mã đặt vé của bạn là: ${result.data.pnr}`,
         variant: "success",
       });
     } catch (error) {
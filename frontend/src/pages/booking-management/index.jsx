@@ -10,7 +10,7 @@ import { useToast } from '@/hooks/use-toast';
 
 export default function FlightBookingPage() {
   const router = useRouter();
-  const { bookingID } = router.query;
+  const { bookingID, lastName } = router.query;
   const { toast } = useToast();
 
   const {
@@ -27,7 +27,7 @@ export default function FlightBookingPage() {
     handleViewTicket,
     handleDownload,
     handleCancelTicket,
-  } = useFlightBooking(bookingID, {
+  } = useFlightBooking(bookingID, lastName, {
     // Giả sử ta bổ sung option gọi callback khi hủy vé thành công hoặc lỗi
     onCancelSuccess: () => {
       toast({
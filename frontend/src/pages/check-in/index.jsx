@@ -24,7 +24,7 @@ const steps = [
 export default function CheckInPage() {
   const [currentStep, setCurrentStep] = useState(0);
   const [bookingID, setBookingID] = useState(null);
-  const [email, setEmail] = useState(null);
+  const [lastName, setLastName] = useState(null);
 
   const [bookingData, setBookingData] = useState(null);
   const [departureFlight, setDepartureFlight] = useState(null);
@@ -176,7 +176,7 @@ export default function CheckInPage() {
   );
 
   const fetchBooking = useCallback(async () => {
-    if (!bookingID) return;
+    if (!bookingID || !lastName) return;
 
     try {
       setLoading(true);
@@ -186,7 +186,7 @@ export default function CheckInPage() {
 
       // Fetch booking
       const response = await fetch(
-        `${API_BASE_URL}/api/booking?id=${bookingID}`,
+        `${API_BASE_URL}/api/booking?id=${encodeURIComponent(bookingID)}&lastName=${encodeURIComponent(lastName)}`,
         {
           headers: { Authorization: `Bearer ${token}` },
         }
@@ -232,6 +232,7 @@ export default function CheckInPage() {
     }
   }, [
     bookingID,
+    lastName,
     fetchFlightDetails,
     fetchTickets,
     generateSeatData,
@@ -246,13 +247,13 @@ export default function CheckInPage() {
   // ------------------------------------
   // 3. Các useEffect
   // ------------------------------------
-  // Lấy bookingID, email từ query
+  // Lấy bookingID, họ hành khách từ query
   useEffect(() => {
     if (router.query.bookingID) {
       setBookingID(router.query.bookingID);
     }
-    if (router.query.email) {
-      setEmail(router.query.email);
+    if (router.query.lastName) {
+      setLastName(router.query.lastName);
     }
   }, [router.query]);
 
@@ -441,7 +442,7 @@ export default function CheckInPage() {
 
       {currentStep === 4 && (
         <ConfirmationStep
-          bookingReference={bookingData?.bookingId || "Không rõ"}
+          bookingReference={bookingData?.pnr || "Không rõ"}
           departurePassengers={passengerList.departure || []}
           returnPassengers={passengerList.return || []}
           departureFlight={{
@@ -23,8 +23,8 @@ export default function AccountPage() {
   const [activityData, setActivityData] = useState([]);
 
   useEffect(() => {
-    const fetchBookingHistory = async () => {
-      if (!personalInfo || !personalInfo.bookingHistory) return;
+    const fetchTrips = async () => {
+      if (!personalInfo) return;
 
       const token = localStorage.getItem("token");
       if (!token) {
@@ -32,11 +32,12 @@ export default function AccountPage() {
         return;
       }
 
-      const bookings = await Promise.all(
-        personalInfo.bookingHistory.map(async (bookingId) => {
+      // Lấy chuyến sắp tới và chuyến đã bay trong một lần gọi cho mỗi tab
+      const trips = await Promise.all(
+        ["upcoming", "past"].map(async (when) => {
           try {
             const response = await fetch(
-              `${API_BASE_URL}/api/booking?id=${bookingId}`,
+              `${API_BASE_URL}/api/customer/trips?when=${when}&limit=50`,
               {
                 headers: {
                   Authorization: `Bearer ${token}`,
@@ -45,27 +46,35 @@ export default function AccountPage() {
             );
 
             if (!response.ok) {
-              throw new Error("Failed to fetch booking.");
+              throw new Error("Failed to fetch trips.");
             }
 
             const { data } = await response.json();
-            return {
-              date: new Date(data.createdAt.seconds * 1000),
-              type: "Đặt vé máy bay",
-              bookingId: data.bookingId,
-              details: `${data.departureCity} → ${data.arrivalCity}`,
-            };
+            return data.trips;
           } catch (error) {
-            console.error(`Error fetching booking ${bookingId}:`, error);
-            return null;
+            console.error(`Error fetching ${when} trips:`, error);
+            return [];
           }
         })
       );
 
-      setActivityData(bookings.filter(Boolean));
+      setActivityData(
+        trips.flat().map((trip) => {
+          const first = trip.segments[0];
+          const last = trip.segments[trip.segments.length - 1];
+          return {
+            date: new Date(trip.createdAt),
+            type: trip.upcoming ? "Chuyến bay sắp tới" : "Chuyến bay đã qua",
+            bookingId: trip.pnr || trip.bookingId,
+            details: first
+              ? `${first.departureCity} → ${last.arrivalCity}`
+              : "",
+          };
+        })
+      );
     };
 
-    fetchBookingHistory();
+    fetchTrips();
   }, [personalInfo]);
 
   if (loading) {